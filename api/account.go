@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -24,13 +26,29 @@ func (server *Server) createAccount(ctx *gin.Context) {
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
+	number, err := util.NewAccountNumber()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	arg := db.CreateAccountParams{
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Number:   number,
 	}
 
-	account, err := server.store.CreateAccount(ctx, arg)
+	var account db.Account
+	err = server.auditTx(ctx, "account.create", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		account, err = store.CreateAccount(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), after: account}, nil
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -40,9 +58,12 @@ func (server *Server) createAccount(ctx *gin.Context) {
 				ctx.JSON(http.StatusForbidden, errorResponse(err))
 				return
 			case db.UniqueViolation:
-				err := fmt.Errorf("user [%s] already has account with currency [%s]", arg.Owner, arg.Currency)
-				ctx.JSON(http.StatusForbidden, errorResponse(err))
-				return
+				// a clash of the random account numbers is an internal error the client can retry
+				if pgErr.ConstraintName != "accounts_number_key" {
+					err := fmt.Errorf("user [%s] already has account with currency [%s]", arg.Owner, arg.Currency)
+					ctx.JSON(http.StatusForbidden, errorResponse(err))
+					return
+				}
 			}
 		}
 
@@ -54,7 +75,7 @@ func (server *Server) createAccount(ctx *gin.Context) {
 }
 
 type GetAccountRequest struct {
-	ID int64 `uri:"id" binding:"required,min=1"`
+	ID string `uri:"id" binding:"required,account_ref"`
 }
 
 func (server *Server) getAccount(ctx *gin.Context) {
@@ -64,7 +85,7 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
+	account, err := server.getAccountByRef(ctx, req.ID)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -75,10 +96,7 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if !server.authorizeAccount(ctx, account, util.ViewerHolderRole) {
 		return
 	}
 
@@ -115,7 +133,7 @@ func (server *Server) listAccount(ctx *gin.Context) {
 }
 
 type DeleteAccountRequest struct {
-	ID int64 `uri:"id" binding:"required,min=1"`
+	ID string `uri:"id" binding:"required,account_ref"`
 }
 
 func (server *Server) deleteAccount(ctx *gin.Context) {
@@ -125,7 +143,8 @@ func (server *Server) deleteAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
+	// the balance decides whether the account can be closed, so it is read from the primary
+	account, err := server.getAccountByRef(db.WithReadYourWrites(ctx), req.ID)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -136,14 +155,18 @@ func (server *Server) deleteAccount(ctx *gin.Context) {
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if !server.authorizeAccount(ctx, account, util.OwnerHolderRole) {
 		return
 	}
 
-	err = server.store.DeleteAccount(ctx, req.ID)
+	err = server.auditTx(ctx, "account.delete", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		err := store.DeleteAccount(ctx, account.ID)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: account}, nil
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -0,0 +1,362 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// accountRole returns the role the user holds on the account, or an empty string if the user is not a holder.
+func (server *Server) accountRole(ctx context.Context, account db.Account, username string) (string, error) {
+	if account.Owner == username {
+		return util.OwnerHolderRole, nil
+	}
+
+	holder, err := server.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return holder.Role, nil
+}
+
+// authorizeAccount checks that the authenticated user holds at least requiredRole on the account.
+// It writes the error response and returns false otherwise.
+func (server *Server) authorizeAccount(ctx *gin.Context, account db.Account, requiredRole string) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	role, err := server.accountRole(ctx, account, authPayload.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if !util.HolderRoleAllows(role, requiredRole) {
+		err := errors.New("account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+// AccountIDRequest takes the account from the URI by its id or its account number.
+type AccountIDRequest struct {
+	ID string `uri:"id" binding:"required,account_ref"`
+}
+
+// getAccountByRef loads the account by a reference that passed the account_ref validation.
+func (server *Server) getAccountByRef(ctx context.Context, ref string) (db.Account, error) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		return server.store.GetAccount(ctx, id)
+	}
+
+	return server.store.GetAccountByNumber(ctx, util.NormalizeAccountNumber(ref))
+}
+
+// getAuthorizedAccount loads the account from the URI and checks the caller's role on it.
+func (server *Server) getAuthorizedAccount(ctx *gin.Context, requiredRole string) (db.Account, bool) {
+	var req AccountIDRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	account, err := server.getAccountByRef(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return db.Account{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	if !server.authorizeAccount(ctx, account, requiredRole) {
+		return db.Account{}, false
+	}
+
+	return account, true
+}
+
+func (server *Server) listAccountHolders(ctx *gin.Context) {
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	holders, err := server.store.ListAccountHolders(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holders)
+}
+
+type RemoveAccountHolderRequest struct {
+	ID       string `uri:"id" binding:"required,account_ref"`
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+// removeAccountHolder lets an owner remove any holder, and any holder remove themselves.
+func (server *Server) removeAccountHolder(ctx *gin.Context) {
+	var req RemoveAccountHolderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	requiredRole := util.OwnerHolderRole
+	if req.Username == authPayload.Username {
+		requiredRole = util.ViewerHolderRole
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, requiredRole)
+	if !ok {
+		return
+	}
+
+	if req.Username == account.Owner {
+		err := errors.New("cannot remove the account owner")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	arg := db.DeleteAccountHolderParams{
+		AccountID: account.ID,
+		Username:  req.Username,
+	}
+
+	err := server.auditTx(ctx, "account_holder.delete", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		err := store.DeleteAccountHolder(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: arg}, nil
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+type CreateInvitationRequest struct {
+	Username string `json:"username" binding:"required,alphanum"`
+	Role     string `json:"role" binding:"required,invitable_role"`
+}
+
+func (server *Server) createInvitation(ctx *gin.Context) {
+	var req CreateInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.OwnerHolderRole)
+	if !ok {
+		return
+	}
+
+	role, err := server.accountRole(ctx, account, req.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if role != "" {
+		err := fmt.Errorf("user [%s] already holds account [%d]", req.Username, account.ID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.CreateAccountInvitationParams{
+		AccountID: account.ID,
+		Inviter:   authPayload.Username,
+		Invitee:   req.Username,
+		Role:      req.Role,
+	}
+
+	var invitation db.AccountInvitation
+	err = server.auditTx(ctx, "invitation.create", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		invitation, err = store.CreateAccountInvitation(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), after: invitation}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.ForeignKeyViolation {
+			err := fmt.Errorf("user [%s] doesn't exist", req.Username)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+}
+
+type ListInvitationsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=20"`
+}
+
+func (server *Server) listInvitations(ctx *gin.Context) {
+	var req ListInvitationsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.ListAccountInvitationsParams{
+		Invitee: authPayload.Username,
+		Limit:   req.PageSize,
+		Offset:  (req.PageID - 1) * req.PageSize,
+	}
+
+	invitations, err := server.store.ListAccountInvitations(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invitations)
+}
+
+type InvitationIDRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getPendingInvitation loads the invitation from the URI and checks that it is still pending and addressed to the caller.
+func (server *Server) getPendingInvitation(ctx *gin.Context) (db.AccountInvitation, bool) {
+	var req InvitationIDRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.AccountInvitation{}, false
+	}
+
+	invitation, err := server.store.GetAccountInvitation(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return db.AccountInvitation{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.AccountInvitation{}, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if invitation.Invitee != authPayload.Username {
+		err := errors.New("invitation doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return db.AccountInvitation{}, false
+	}
+
+	if invitation.Status != util.InvitationPending {
+		err := fmt.Errorf("invitation is already %s", invitation.Status)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return db.AccountInvitation{}, false
+	}
+
+	return invitation, true
+}
+
+func (server *Server) acceptInvitation(ctx *gin.Context) {
+	invitation, ok := server.getPendingInvitation(ctx)
+	if !ok {
+		return
+	}
+
+	var result db.AcceptInvitationTxResult
+	err := server.auditTx(ctx, "invitation.accept", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.AcceptInvitationTx(ctx, db.AcceptInvitationTxParams{InvitationID: invitation.ID})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), before: invitation, after: result.Invitation}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			err := fmt.Errorf("user [%s] already holds account [%d]", invitation.Invitee, invitation.AccountID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (server *Server) declineInvitation(ctx *gin.Context) {
+	invitation, ok := server.getPendingInvitation(ctx)
+	if !ok {
+		return
+	}
+
+	var declined db.AccountInvitation
+	err := server.auditTx(ctx, "invitation.decline", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		declined, err = store.UpdateAccountInvitationStatus(ctx, db.UpdateAccountInvitationStatusParams{
+			ID:     invitation.ID,
+			Status: util.InvitationDeclined,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), before: invitation, after: declined}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, declined)
+}
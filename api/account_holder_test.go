@@ -0,0 +1,283 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateInvitationAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	invitee, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(owner.Username)
+
+	invitation := db.AccountInvitation{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Inviter:   owner.Username,
+		Invitee:   invitee.Username,
+		Role:      util.CoOwnerHolderRole,
+		Status:    util.InvitationPending,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"username": invitee.Username,
+				"role":     util.CoOwnerHolderRole,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(db.GetAccountHolderParams{AccountID: account.ID, Username: invitee.Username})).
+					Times(1).
+					Return(db.AccountHolder{}, db.ErrRecordNotFound)
+
+				arg := db.CreateAccountInvitationParams{
+					AccountID: account.ID,
+					Inviter:   owner.Username,
+					Invitee:   invitee.Username,
+					Role:      util.CoOwnerHolderRole,
+				}
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Eq(arg)).Times(1).Return(invitation, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.AccountInvitation
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, invitation.ID, got.ID)
+				require.Equal(t, invitation.Role, got.Role)
+			},
+		},
+		{
+			name: "NotAnOwner",
+			body: gin.H{
+				"username": owner.Username,
+				"role":     util.CoOwnerHolderRole,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, invitee.Username, invitee.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: invitee.Username, Role: util.CoOwnerHolderRole}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyHolder",
+			body: gin.H{
+				"username": invitee.Username,
+				"role":     util.ViewerHolderRole,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: invitee.Username, Role: util.ViewerHolderRole}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "OwnerRole",
+			body: gin.H{
+				"username": invitee.Username,
+				"role":     util.OwnerHolderRole,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidRole",
+			body: gin.H{
+				"username": invitee.Username,
+				"role":     "admin",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/invitations", account.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAcceptInvitationAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	invitee, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(owner.Username)
+
+	invitation := db.AccountInvitation{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Inviter:   owner.Username,
+		Invitee:   invitee.Username,
+		Role:      util.ViewerHolderRole,
+		Status:    util.InvitationPending,
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().
+					AcceptInvitationTx(gomock.Any(), gomock.Eq(db.AcceptInvitationTxParams{InvitationID: invitation.ID})).
+					Times(1).
+					Return(db.AcceptInvitationTxResult{}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "NotTheInvitee",
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "AlreadyAnswered",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				declined := invitation
+				declined.Status = util.InvitationDeclined
+
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(declined, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(db.AccountInvitation{}, db.ErrRecordNotFound)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "InternalError",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AcceptInvitationTxResult{}, sql.ErrTxDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/invitations/%d/accept", invitation.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			setAuthorizationHeader(t, server.tokenMaker, authorizationTypeBearer, tc.username, util.DepositorRole, time.Minute, request)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
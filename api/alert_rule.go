@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateAlertRuleRequest struct {
+	Kind      string `json:"kind" binding:"required,alert_kind"`
+	Threshold int64  `json:"threshold" binding:"required,gt=0"`
+}
+
+// createAlertRule lets any holder of the account set up an alert for themselves.
+func (server *Server) createAlertRule(ctx *gin.Context) {
+	var req CreateAlertRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var rule db.AlertRule
+	err := server.auditTx(ctx, "alert_rule.create", util.AuditTargetAlertRule, func(store db.Store) (auditedChange, error) {
+		var err error
+		rule, err = store.CreateAlertRule(ctx, db.CreateAlertRuleParams{
+			AccountID: account.ID,
+			Username:  authPayload.Username,
+			Kind:      req.Kind,
+			Threshold: req.Threshold,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(rule.ID, 10), after: rule}, nil
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rule)
+}
+
+// listAlertRules lists the caller's own alert rules on the account.
+func (server *Server) listAlertRules(ctx *gin.Context) {
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	rules, err := server.store.ListAlertRules(ctx, db.ListAlertRulesParams{
+		AccountID: account.ID,
+		Username:  authPayload.Username,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+}
+
+type DeleteAlertRuleRequest struct {
+	ID     string `uri:"id" binding:"required,account_ref"`
+	RuleID int64  `uri:"rule_id" binding:"required,min=1"`
+}
+
+func (server *Server) deleteAlertRule(ctx *gin.Context) {
+	var req DeleteAlertRuleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	err := server.auditTx(ctx, "alert_rule.delete", util.AuditTargetAlertRule, func(store db.Store) (auditedChange, error) {
+		deleted, err := store.DeleteAlertRule(ctx, db.DeleteAlertRuleParams{
+			ID:        req.RuleID,
+			AccountID: account.ID,
+			Username:  authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		// rules of other holders are reported as missing too
+		if deleted == 0 {
+			return auditedChange{}, db.ErrRecordNotFound
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(req.RuleID, 10)}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			err := fmt.Errorf("alert rule [%d] not found", req.RuleID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAlertRuleAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	viewer, _ := createRandomUser(t, util.DepositorRole)
+	stranger, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"kind": util.AlertLowBalance, "threshold": 100},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateAlertRuleParams{
+					AccountID: account.ID,
+					Username:  user.Username,
+					Kind:      util.AlertLowBalance,
+					Threshold: 100,
+				}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateAlertRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AlertRule{ID: 1, AccountID: account.ID, Username: user.Username, Kind: arg.Kind, Threshold: arg.Threshold}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.AlertRule
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, util.AlertLowBalance, got.Kind)
+				require.Equal(t, int64(100), got.Threshold)
+			},
+		},
+		{
+			name: "ViewerCreatesOwnRule",
+			body: gin.H{"kind": util.AlertLargeDebit, "threshold": 500},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, viewer.Username, viewer.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{AccountID: account.ID, Username: viewer.Username, Role: util.ViewerHolderRole}, nil)
+				store.EXPECT().
+					CreateAlertRule(gomock.Any(), gomock.Eq(db.CreateAlertRuleParams{
+						AccountID: account.ID,
+						Username:  viewer.Username,
+						Kind:      util.AlertLargeDebit,
+						Threshold: 500,
+					})).
+					Times(1).
+					Return(db.AlertRule{}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotAHolder",
+			body: gin.H{"kind": util.AlertLowBalance, "threshold": 100},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, stranger.Username, stranger.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "UnsupportedKind",
+			body: gin.H{"kind": "weekly_spend", "threshold": 100},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NegativeThreshold",
+			body: gin.H{"kind": util.AlertDailySpend, "threshold": -1},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/alert_rules", account.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteAlertRuleAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(user.Username)
+	ruleID := util.RandomInt(1, 1000)
+
+	testCases := []struct {
+		name          string
+		deleted       int64
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "OK",
+			deleted: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "NotFound",
+			deleted: 0,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			store.EXPECT().
+				DeleteAlertRule(gomock.Any(), gomock.Eq(db.DeleteAlertRuleParams{ID: ruleID, AccountID: account.ID, Username: user.Username})).
+				Times(1).
+				Return(tc.deleted, nil)
+			store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(int(tc.deleted))
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/%d/alert_rules/%d", account.ID, ruleID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			setAuthorizationHeader(t, server.tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// auditedChange is the audited target of a change and its state before and after the change.
+type auditedChange struct {
+	targetID string
+	before   any
+	after    any
+}
+
+// auditTx makes the change and appends it to the audit log in one transaction, on behalf of the authenticated user if any,
+// so a change that can't be audited fails. change must make its queries on the store it is given.
+func (server *Server) auditTx(ctx *gin.Context, action string, targetType string, change func(store db.Store) (auditedChange, error)) error {
+	var actor *token.Payload
+	if value, ok := ctx.Get(authorizationPayloadKey); ok {
+		actor = value.(*token.Payload)
+	}
+
+	return server.auditTxAs(ctx, actor, action, targetType, change)
+}
+
+// auditTxAs is auditTx on behalf of actor, e.g. the user a login authenticates. actor is nil for anonymous calls.
+func (server *Server) auditTxAs(
+	ctx *gin.Context,
+	actor *token.Payload,
+	action string,
+	targetType string,
+	change func(store db.Store) (auditedChange, error),
+) error {
+	return server.store.AuditTx(ctx, func(store db.Store) (db.CreateAuditEventParams, error) {
+		audited, err := change(store)
+		if err != nil {
+			return db.CreateAuditEventParams{}, err
+		}
+
+		diff, err := util.AuditDiff(audited.before, audited.after)
+		if err != nil {
+			return db.CreateAuditEventParams{}, err
+		}
+
+		arg := db.CreateAuditEventParams{
+			Action:     action,
+			TargetType: targetType,
+			TargetID:   audited.targetID,
+			Diff:       diff,
+			ClientIp:   ctx.ClientIP(),
+			UserAgent:  ctx.Request.UserAgent(),
+		}
+
+		if actor != nil {
+			arg.Actor = actor.Username
+			arg.ActorRole = actor.Role
+		}
+
+		return arg, nil
+	})
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type OpenDisputeRequest struct {
+	TransferID int64  `json:"transfer_id" binding:"required,min=1"`
+	Reason     string `json:"reason" binding:"required,max=200"`
+	Evidence   string `json:"evidence" binding:"required,max=5000"`
+}
+
+// openDispute lets a holder who can spend from the account dispute a completed transfer paid from it.
+// A transfer can only be disputed once.
+func (server *Server) openDispute(ctx *gin.Context) {
+	var req OpenDisputeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.TransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.authorizeAccount(ctx, account, util.CoOwnerHolderRole) {
+		return
+	}
+
+	if transfer.Status != util.TransferCompleted {
+		err := fmt.Errorf("transfer [%d] is %s, only completed transfers can be disputed", transfer.ID, transfer.Status)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var dispute db.Dispute
+	err = server.auditTx(ctx, "dispute.open", util.AuditTargetDispute, func(store db.Store) (auditedChange, error) {
+		var err error
+		dispute, err = store.OpenDisputeTx(ctx, db.OpenDisputeTxParams{
+			TransferID: transfer.ID,
+			AccountID:  account.ID,
+			OpenedBy:   authPayload.Username,
+			Reason:     req.Reason,
+			Evidence:   req.Evidence,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(dispute.ID, 10), after: dispute}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			err := fmt.Errorf("transfer [%d] is already disputed", transfer.ID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dispute)
+}
+
+type DisputeIDRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getDispute shows a dispute to every holder of the disputed account.
+func (server *Server) getDispute(ctx *gin.Context) {
+	var req DisputeIDRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	dispute, err := server.store.GetDispute(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, dispute.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.authorizeAccount(ctx, account, util.ViewerHolderRole) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dispute)
+}
+
+type ListDisputesRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=20"`
+}
+
+// listDisputes lists the disputes the authenticated user opened, newest first.
+func (server *Server) listDisputes(ctx *gin.Context) {
+	var req ListDisputesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	disputes, err := server.store.ListDisputesByOpener(ctx, db.ListDisputesByOpenerParams{
+		OpenedBy: authPayload.Username,
+		Limit:    req.PageSize,
+		Offset:   (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, disputes)
+}
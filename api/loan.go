@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// listLoans lists the loans of the account with their outstanding principal and interest.
+func (server *Server) listLoans(ctx *gin.Context) {
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	loans, err := server.store.ListLoans(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, loans)
+}
+
+type LoanIDRequest struct {
+	ID     string `uri:"id" binding:"required,account_ref"`
+	LoanID int64  `uri:"loan_id" binding:"required,min=1"`
+}
+
+type loanResponse struct {
+	Loan         db.Loan              `json:"loan"`
+	Installments []db.LoanInstallment `json:"installments"`
+}
+
+// getLoan returns a loan of the account with the schedule of its installments.
+func (server *Server) getLoan(ctx *gin.Context) {
+	var uri LoanIDRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	loan, err := server.store.GetLoan(ctx, uri.LoanID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// a loan of another account is reported as missing
+	if loan.AccountID != account.ID {
+		err := fmt.Errorf("loan [%d] does not exist", uri.LoanID)
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	installments, err := server.store.ListLoanInstallments(ctx, loan.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, loanResponse{Loan: loan, Installments: installments})
+}
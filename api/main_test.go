@@ -1,17 +1,20 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"testing"
 	"time"
 
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/token"
 	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestMain(m *testing.M) {
@@ -25,6 +28,23 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 		AccessTokenDuration: time.Minute,
 	}
 
+	// the users the tests authorize are not locked, unless a test expects otherwise before creating the server
+	if mockStore, ok := store.(*mockdb.MockStore); ok {
+		mockStore.EXPECT().IsUserBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+		// audited changes run on the mock itself, so the tests expect the queries of the change and its audit event
+		mockStore.EXPECT().AuditTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, change func(store db.Store) (db.CreateAuditEventParams, error)) error {
+				event, err := change(mockStore)
+				if err != nil {
+					return err
+				}
+
+				_, err = mockStore.CreateAuditEvent(ctx, event)
+				return err
+			})
+	}
+
 	server, err := NewServer(config, store)
 	require.NoError(t, err)
 	return server
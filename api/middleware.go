@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/token"
 	"github.com/gin-gonic/gin"
 )
@@ -14,9 +15,10 @@ const (
 	authorizationheaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
 	authorizationPayloadKey = "authorization_payload"
+	readYourWritesHeaderKey = "x-read-your-writes"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+func authMiddleware(tokenMaker token.Maker, store db.Store) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationheaderKey)
 		if len(authorizationHeader) == 0 {
@@ -47,7 +49,32 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		// locking or erasing a user blocks the sessions, the access tokens already issued are rejected here
+		blocked, err := store.IsUserBlocked(ctx, payload.Username)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if blocked {
+			err := fmt.Errorf("user [%s] is locked", payload.Username)
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
+
+// readYourWritesMiddleware routes every read of the request to the primary database
+// when the client sends the read-your-writes header, e.g. right after it made a change.
+func readYourWritesMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if strings.EqualFold(ctx.GetHeader(readYourWritesHeaderKey), "true") {
+			ctx.Request = ctx.Request.WithContext(db.WithReadYourWrites(ctx.Request.Context()))
+		}
+
+		ctx.Next()
+	}
+}
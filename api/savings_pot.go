@@ -0,0 +1,180 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateSavingsPotRequest struct {
+	Name         string `json:"name" binding:"required,max=64"`
+	TargetAmount int64  `json:"target_amount" binding:"required,gt=0"`
+	Deadline     string `json:"deadline" binding:"required,datetime=2006-01-02"`
+}
+
+func (server *Server) createSavingsPot(ctx *gin.Context) {
+	var req CreateSavingsPotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	deadline, _ := time.Parse(util.BusinessDateLayout, req.Deadline)
+	if deadline.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("deadline must not be in the past")))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.CoOwnerHolderRole)
+	if !ok {
+		return
+	}
+
+	var pot db.SavingsPot
+	err := server.auditTx(ctx, "savings_pot.create", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		pot, err = store.CreateSavingsPot(ctx, db.CreateSavingsPotParams{
+			AccountID:    account.ID,
+			Name:         req.Name,
+			TargetAmount: req.TargetAmount,
+			Deadline:     pgtype.Date{Time: deadline, Valid: true},
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(pot.ID, 10), after: pot}, nil
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, pot)
+}
+
+func (server *Server) listSavingsPots(ctx *gin.Context) {
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	pots, err := server.store.ListSavingsPots(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, pots)
+}
+
+type SavingsPotIDRequest struct {
+	ID    string `uri:"id" binding:"required,account_ref"`
+	PotID int64  `uri:"pot_id" binding:"required,min=1"`
+}
+
+type MoveSavingsPotMoneyRequest struct {
+	Direction string `json:"direction" binding:"required,oneof=in out"`
+	Amount    int64  `json:"amount" binding:"required,gt=0"`
+}
+
+// moveSavingsPotMoney moves money from the main balance into the pot or back.
+// Only the available balance, which excludes the money already in pots, can be moved into a pot.
+func (server *Server) moveSavingsPotMoney(ctx *gin.Context) {
+	var uri SavingsPotIDRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req MoveSavingsPotMoneyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.CoOwnerHolderRole)
+	if !ok {
+		return
+	}
+
+	amount := req.Amount
+	if req.Direction == util.PotMoveOut {
+		amount = -req.Amount
+	}
+
+	var result db.MovePotMoneyTxResult
+	err := server.auditTx(ctx, "savings_pot.move", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.MovePotMoneyTx(ctx, db.MovePotMoneyTxParams{
+			AccountID: account.ID,
+			PotID:     uri.PotID,
+			Amount:    amount,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Pot.ID, 10), after: result.Entry}, nil
+	})
+	if err != nil {
+		ctx.JSON(savingsPotErrorStatus(err), errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// closeSavingsPot sweeps the money of the pot back to the main balance and closes it.
+func (server *Server) closeSavingsPot(ctx *gin.Context) {
+	var uri SavingsPotIDRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.CoOwnerHolderRole)
+	if !ok {
+		return
+	}
+
+	var result db.CloseSavingsPotTxResult
+	err := server.auditTx(ctx, "savings_pot.close", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.CloseSavingsPotTx(ctx, db.CloseSavingsPotTxParams{
+			AccountID: account.ID,
+			PotID:     uri.PotID,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Pot.ID, 10), after: result.Pot}, nil
+	})
+	if err != nil {
+		ctx.JSON(savingsPotErrorStatus(err), errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// savingsPotErrorStatus maps the errors of the savings pot transactions to HTTP statuses.
+func savingsPotErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, db.ErrPotClosed),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.Is(err, db.ErrInsufficientPotBalance):
+		return http.StatusForbidden
+	}
+
+	return http.StatusInternalServerError
+}
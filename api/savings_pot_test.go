@@ -0,0 +1,190 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateSavingsPotAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(user.Username)
+	deadline := time.Now().AddDate(0, 6, 0).Format(util.BusinessDateLayout)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"name": "Holiday", "target_amount": 1000, "deadline": deadline},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateSavingsPot(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateSavingsPotParams) (db.SavingsPot, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, "Holiday", arg.Name)
+						require.Equal(t, int64(1000), arg.TargetAmount)
+						require.Equal(t, deadline, arg.Deadline.Time.Format(util.BusinessDateLayout))
+						return db.SavingsPot{ID: 1, AccountID: arg.AccountID, Name: arg.Name, TargetAmount: arg.TargetAmount, Deadline: arg.Deadline, Status: util.PotOpen}, nil
+					})
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DeadlineInThePast",
+			body: gin.H{"name": "Holiday", "target_amount": 1000, "deadline": "2000-01-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSavingsPot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTarget",
+			body: gin.H{"name": "Holiday", "target_amount": 0, "deadline": deadline},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSavingsPot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/pots", account.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			setAuthorizationHeader(t, server.tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestMoveSavingsPotMoneyAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(user.Username)
+	potID := util.RandomInt(1, 1000)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "MoveIn",
+			body: gin.H{"direction": "in", "amount": 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.MovePotMoneyTxParams{AccountID: account.ID, PotID: potID, Amount: 50}
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MovePotMoneyTxResult{Pot: db.SavingsPot{ID: potID, Balance: 50}}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "MoveOut",
+			body: gin.H{"direction": "out", "amount": 20},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.MovePotMoneyTxParams{AccountID: account.ID, PotID: potID, Amount: -20}
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MovePotMoneyTxResult{}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{"direction": "in", "amount": 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MovePotMoneyTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "PotOfAnotherAccount",
+			body: gin.H{"direction": "in", "amount": 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MovePotMoneyTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidDirection",
+			body: gin.H{"direction": "sideways", "amount": 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).AnyTimes().Return(account, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/pots/%d/moves", account.ID, potID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			setAuthorizationHeader(t, server.tokenMaker, authorizationTypeBearer, user.Username, user.Role, time.Minute, request)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
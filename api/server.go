@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/fraud"
 	"github.com/Drolfothesgnir/simplebank/token"
 	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/gin-gonic/gin"
@@ -12,10 +13,11 @@ import (
 )
 
 type Server struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config      util.Config
+	store       db.Store
+	tokenMaker  token.Maker
+	router      *gin.Engine
+	fraudEngine *fraud.Engine
 }
 
 func NewServer(config util.Config, store db.Store) (*Server, error) {
@@ -24,15 +26,20 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 	server := Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:      config,
+		store:       store,
+		tokenMaker:  tokenMaker,
+		fraudEngine: fraud.NewEngine(fraud.DefaultRules(config)...),
 	}
 
 	server.setupRouter()
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", isValidCurency)
+		v.RegisterValidation("invitable_role", isInvitableHolderRole)
+		v.RegisterValidation("account_number", isValidAccountNumber)
+		v.RegisterValidation("account_ref", isValidAccountRef)
+		v.RegisterValidation("alert_kind", isValidAlertKind)
 	}
 
 	return &server, nil
@@ -40,23 +47,57 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 
 func (server *Server) setupRouter() {
 	router := gin.Default()
+	// let the store see values of the request context, such as the read-your-writes flag
+	router.ContextWithFallback = true
+	router.Use(readYourWritesMiddleware())
 
 	// users
 	router.POST("/users", server.createUser)
 	router.POST("/users/login", server.loginUser)
 	router.POST("/tokens/renew_access", server.renewAccessToken)
 
-	authGroup := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authGroup := router.Group("/").Use(authMiddleware(server.tokenMaker, server.store))
 
 	// accounts
 	authGroup.POST("/accounts", server.createAccount)
 	authGroup.GET("/accounts/:id", server.getAccount)
 	authGroup.GET("/accounts", server.listAccount)
 	authGroup.DELETE("/accounts/:id", server.deleteAccount)
+	authGroup.GET("/accounts/:id/statement", server.exportStatement)
+
+	// account holders
+	authGroup.GET("/accounts/:id/holders", server.listAccountHolders)
+	authGroup.DELETE("/accounts/:id/holders/:username", server.removeAccountHolder)
+	authGroup.POST("/accounts/:id/invitations", server.createInvitation)
+
+	// alert rules
+	authGroup.POST("/accounts/:id/alert_rules", server.createAlertRule)
+	authGroup.GET("/accounts/:id/alert_rules", server.listAlertRules)
+	authGroup.DELETE("/accounts/:id/alert_rules/:rule_id", server.deleteAlertRule)
+
+	// savings pots
+	authGroup.POST("/accounts/:id/pots", server.createSavingsPot)
+	authGroup.GET("/accounts/:id/pots", server.listSavingsPots)
+	authGroup.POST("/accounts/:id/pots/:pot_id/moves", server.moveSavingsPotMoney)
+	authGroup.POST("/accounts/:id/pots/:pot_id/close", server.closeSavingsPot)
+
+	// loans are disbursed by bankers through the admin API
+	authGroup.GET("/accounts/:id/loans", server.listLoans)
+	authGroup.GET("/accounts/:id/loans/:loan_id", server.getLoan)
+
+	// invitations
+	authGroup.GET("/invitations", server.listInvitations)
+	authGroup.POST("/invitations/:id/accept", server.acceptInvitation)
+	authGroup.POST("/invitations/:id/decline", server.declineInvitation)
 
 	// transfers
 	authGroup.POST("/transfers", server.createTransfer)
 
+	// disputes
+	authGroup.POST("/disputes", server.openDispute)
+	authGroup.GET("/disputes/:id", server.getDispute)
+	authGroup.GET("/disputes", server.listDisputes)
+
 	server.router = router
 }
 
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/statement"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type ExportStatementRequest struct {
+	FromDate string `form:"from_date" binding:"required,datetime=2006-01-02"`
+	ToDate   string `form:"to_date" binding:"required,datetime=2006-01-02"`
+}
+
+// exportStatement writes the entries booked on the account between two business dates
+// as an ISO 20022 camt.053 statement.
+func (server *Server) exportStatement(ctx *gin.Context) {
+	var req ExportStatementRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	from, _ := time.Parse(util.BusinessDateLayout, req.FromDate)
+	to, _ := time.Parse(util.BusinessDateLayout, req.ToDate)
+	if to.Before(from) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("to_date must not be before from_date")))
+		return
+	}
+
+	if to.Sub(from) >= statement.MaxDays*24*time.Hour {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("a statement can cover at most %d days", statement.MaxDays)))
+		return
+	}
+
+	account, ok := server.getAuthorizedAccount(ctx, util.ViewerHolderRole)
+	if !ok {
+		return
+	}
+
+	owner, err := server.store.GetUser(ctx, account.Owner)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	openingBalance, err := statement.OpeningBalance(ctx, server.store, account.ID, from)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entries, err := server.store.ListStatementEntries(ctx, db.ListStatementEntriesParams{
+		AccountID: account.ID,
+		FromDate:  db.BusinessDate(from),
+		ToDate:    db.BusinessDate(to),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	now := time.Now()
+	stmt := statement.Statement{
+		ID:             fmt.Sprintf("%d-%s-%s", account.ID, from.Format("20060102"), to.Format("20060102")),
+		CreatedAt:      now,
+		Account:        statement.Party{Name: owner.FullName, Account: account.Number},
+		Currency:       account.Currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		Entries:        make([]statement.Entry, len(entries)),
+	}
+
+	for i, entry := range entries {
+		stmt.Entries[i] = statement.NewEntry(entry)
+		stmt.ClosingBalance += entry.Amount
+	}
+
+	ctx.Header("Content-Type", "application/xml")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="statement-%s.xml"`, stmt.ID))
+	ctx.Status(http.StatusOK)
+
+	// the response has started, so an error can only be logged
+	err = statement.WriteCamt053(ctx.Writer, fmt.Sprintf("STMT-%d", now.UnixNano()), now, stmt)
+	if err != nil {
+		log.Error().Err(err).Int64("account_id", account.ID).Msg("failed to write statement")
+	}
+}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/statement"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// camt053Statement picks the balances and entries out of an exported statement.
+type camt053Statement struct {
+	Balances []struct {
+		Code        string `xml:"Tp>CdOrPrtry>Cd"`
+		Amount      string `xml:"Amt"`
+		CreditDebit string `xml:"CdtDbtInd"`
+	} `xml:"BkToCstmrStmt>Stmt>Bal"`
+	Entries []struct {
+		Amount      string `xml:"Amt"`
+		CreditDebit string `xml:"CdtDbtInd"`
+		Code        string `xml:"BkTxCd>Prtry>Cd"`
+		EndToEndID  string `xml:"NtryDtls>TxDtls>Refs>EndToEndId"`
+		Debtor      string `xml:"NtryDtls>TxDtls>RltdPties>Dbtr>Nm"`
+		DebtorIBAN  string `xml:"NtryDtls>TxDtls>RltdPties>DbtrAcct>Id>IBAN"`
+	} `xml:"BkToCstmrStmt>Stmt>Ntry"`
+}
+
+func TestExportStatementAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+	account := createRandomAccount(owner.Username)
+	account.Balance = 10_000
+
+	from := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	entries := []db.ListStatementEntriesRow{
+		{
+			ID:                        101,
+			AccountID:                 account.ID,
+			Amount:                    2_500,
+			BusinessDate:              db.BusinessDate(from),
+			TransferID:                pgtype.Int8{Int64: 7, Valid: true},
+			CounterpartyAccountNumber: "XS71SIMP577474894439",
+		},
+		{
+			ID:                102,
+			AccountID:         account.ID,
+			Amount:            -1_000,
+			BusinessDate:      db.BusinessDate(to),
+			CashOperationKind: util.CashWithdrawal,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "from_date=2024-03-04&to_date=2024-03-05",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+				store.EXPECT().
+					GetAccountBalanceSnapshot(gomock.Any(), gomock.Eq(db.GetAccountBalanceSnapshotParams{
+						AccountID:    account.ID,
+						BusinessDate: db.BusinessDate(from.AddDate(0, 0, -1)),
+					})).
+					Times(1).
+					Return(db.AccountBalanceSnapshot{ClosingBalance: 8_500}, nil)
+				store.EXPECT().GetOpeningBalance(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					ListStatementEntries(gomock.Any(), gomock.Eq(db.ListStatementEntriesParams{
+						AccountID: account.ID,
+						FromDate:  db.BusinessDate(from),
+						ToDate:    db.BusinessDate(to),
+					})).
+					Times(1).
+					Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/xml", recorder.Header().Get("Content-Type"))
+
+				var got camt053Statement
+				require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &got))
+
+				require.Len(t, got.Balances, 2)
+				require.Equal(t, "85.00", got.Balances[0].Amount)
+				require.Equal(t, "100.00", got.Balances[1].Amount)
+
+				require.Len(t, got.Entries, 2)
+				require.Equal(t, statement.CodeTransfer, got.Entries[0].Code)
+				require.Equal(t, "T7", got.Entries[0].EndToEndID)
+				require.Empty(t, got.Entries[0].Debtor)
+				require.Equal(t, "XS71SIMP577474894439", got.Entries[0].DebtorIBAN)
+				require.Equal(t, "CASH_WITHDRAWAL", got.Entries[1].Code)
+				require.Equal(t, "DBIT", got.Entries[1].CreditDebit)
+			},
+		},
+		{
+			name:  "OpeningBalanceWithoutSnapshot",
+			query: "from_date=2024-03-04&to_date=2024-03-05",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+				store.EXPECT().
+					GetAccountBalanceSnapshot(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountBalanceSnapshot{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetOpeningBalance(gomock.Any(), gomock.Eq(db.GetOpeningBalanceParams{
+						BusinessDate: db.BusinessDate(from),
+						AccountID:    account.ID,
+					})).
+					Times(1).
+					Return(int64(8_500), nil)
+				store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Times(1).Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got camt053Statement
+				require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, "85.00", got.Balances[0].Amount)
+				require.Equal(t, "100.00", got.Balances[1].Amount)
+			},
+		},
+		{
+			name:  "InvalidPeriod",
+			query: "from_date=2024-03-05&to_date=2024-03-04",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "PeriodTooLong",
+			query: "from_date=2023-01-01&to_date=2024-03-04",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "from_date=04.03.2024&to_date=2024-03-05",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, owner.Username, owner.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "NotAHolder",
+			query: "from_date=2024-03-04&to_date=2024-03-05",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				setAuthorizationHeader(t, tokenMaker, authorizationTypeBearer, other.Username, other.Role, time.Minute, request)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/%d/statement?%s", account.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
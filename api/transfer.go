@@ -4,17 +4,27 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/fraud"
 	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/gin-gonic/gin"
 )
 
+const defaultTransferApprovalTTL = 24 * time.Hour
+
+// CreateTransferRequest takes each account either by its id or by its account number.
 type CreateTransferRequest struct {
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"currency"`
+	FromAccountID     int64  `json:"from_account_id" binding:"required_without=FromAccountNumber,excluded_with=FromAccountNumber,omitempty,min=1"`
+	FromAccountNumber string `json:"from_account_number" binding:"omitempty,account_number"`
+	ToAccountID       int64  `json:"to_account_id" binding:"required_without=ToAccountNumber,excluded_with=ToAccountNumber,omitempty,min=1"`
+	ToAccountNumber   string `json:"to_account_number" binding:"omitempty,account_number"`
+	Amount            int64  `json:"amount" binding:"required,gt=0"`
+	Currency          string `json:"currency" binding:"currency"`
+	Memo              string `json:"memo" binding:"max=140"`
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -24,53 +34,159 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	if !server.isValidAccount(ctx, req.FromAccountID, req.Currency, true) {
+	fromAccount, ok := server.validAccount(ctx, req.FromAccountID, req.FromAccountNumber, req.Currency, util.CoOwnerHolderRole)
+	if !ok {
 		return
 	}
 
-	if !server.isValidAccount(ctx, req.ToAccountID, req.Currency, false) {
+	toAccount, ok := server.validAccount(ctx, req.ToAccountID, req.ToAccountNumber, req.Currency, "")
+	if !ok {
+		return
+	}
+
+	if toAccount.ID == fromAccount.ID {
+		err := fmt.Errorf("can't transfer from account [%d] to itself", fromAccount.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	transfer := fraud.Transfer{
+		Username:      authPayload.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.Amount,
+	}
+
+	// denied transfers are recorded as usual without asking for an approval. Otherwise the transfer
+	// is screened again when a banker approves it, so a review verdict then holds it for a fraud review
+	if server.needsApproval(req.Amount) {
+		verdict, err := server.fraudEngine.Evaluate(ctx, server.store, transfer)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if verdict.Decision != util.FraudDeny {
+			server.requestTransferApproval(ctx, fromAccount, toAccount, req.Amount, req.Memo, authPayload.Username)
+			return
+		}
+	}
+
+	// the fraud rules are evaluated inside the transaction of the transfer,
+	// held and denied transfers are recorded too, so that bankers can see every rule hit
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
 		Amount:        req.Amount,
+		Screen:        server.fraudEngine.Screen(transfer),
+		Memo:          req.Memo,
+		InitiatedBy:   authPayload.Username,
 	}
 
-	result, err := server.store.TransferTx(ctx, arg)
+	var result db.TransferTxResult
+	err := server.auditTx(ctx, "transfer.create", util.AuditTargetTransfer, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.TransferTx(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Transfer.ID, 10), after: result.Transfer}, nil
+	})
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			err := fmt.Errorf("account [%d] has insufficient funds", fromAccount.ID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, result)
+	switch result.Transfer.Status {
+	case util.TransferDenied:
+		err := fmt.Errorf("transfer [%d] was denied by the fraud checks", result.Transfer.ID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+	case util.TransferHeld:
+		// a banker has to release the transfer before the money moves
+		ctx.JSON(http.StatusAccepted, result)
+	default:
+		ctx.JSON(http.StatusOK, result)
+	}
 }
 
-func (server *Server) isValidAccount(ctx *gin.Context, accountID int64, currency string, checkOwner bool) bool {
-	account, err := server.store.GetAccount(ctx, accountID)
+// needsApproval tells if the transfer amount is above the approval threshold.
+func (server *Server) needsApproval(amount int64) bool {
+	return server.config.TransferApprovalThreshold > 0 && amount > server.config.TransferApprovalThreshold
+}
+
+// requestTransferApproval stores the transfer as pending until a banker other than the initiator
+// approves or rejects it. Pending transfers expire after TransferApprovalTTL.
+func (server *Server) requestTransferApproval(ctx *gin.Context, fromAccount db.Account, toAccount db.Account, amount int64, memo string, initiatedBy string) {
+	ttl := server.config.TransferApprovalTTL
+	if ttl <= 0 {
+		ttl = defaultTransferApprovalTTL
+	}
+
+	var approval db.TransferApproval
+	err := server.auditTx(ctx, "transfer.request_approval", util.AuditTargetApproval, func(store db.Store) (auditedChange, error) {
+		var err error
+		approval, err = store.CreateTransferApproval(ctx, db.CreateTransferApprovalParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        amount,
+			Currency:      fromAccount.Currency,
+			InitiatedBy:   initiatedBy,
+			ExpiresAt:     time.Now().Add(ttl),
+			Memo:          memo,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(approval.ID, 10), after: approval}, nil
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, approval)
+}
+
+// validAccount loads the account by its id or, if the id is zero, by its account number,
+// and checks that it has the given currency.
+// If requiredRole is not empty, the authenticated user must also hold at least that role on the account.
+func (server *Server) validAccount(ctx *gin.Context, accountID int64, accountNumber string, currency string, requiredRole string) (db.Account, bool) {
+	var account db.Account
+	var err error
+	if accountID != 0 {
+		account, err = server.store.GetAccount(ctx, accountID)
+	} else {
+		account, err = server.store.GetAccountByNumber(ctx, util.NormalizeAccountNumber(accountNumber))
+	}
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return false
+			return account, false
 		}
 
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return false
+		return account, false
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if checkOwner && account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-		return false
+	if requiredRole != "" && !server.authorizeAccount(ctx, account, requiredRole) {
+		return account, false
 	}
 
 	if account.Currency != currency {
-		err := fmt.Errorf("account [%d] currency mismatch: %s vs %s", accountID, account.Currency, currency)
+		err := fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return false
+		return account, false
 	}
 
-	return true
+	return account, true
 }
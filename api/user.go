@@ -58,7 +58,16 @@ func (server *Server) createUser(ctx *gin.Context) {
 		Email:          req.Email,
 	}
 
-	user, err := server.store.CreateUser(ctx, arg)
+	var user db.User
+	err = server.auditTx(ctx, "user.create", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		user, err = store.CreateUser(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: user.Username, after: user}, nil
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -107,7 +116,8 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
-	user, err := server.store.GetUser(ctx, req.Username)
+	// the user is read from the primary, so a password that was just changed or a lock just set takes effect at once
+	user, err := server.store.GetUser(db.WithReadYourWrites(ctx), req.Username)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			err = fmt.Errorf("user [%s] does not exist", req.Username)
@@ -119,9 +129,17 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	// the password is checked first, so the lock of an account is not revealed to whoever guesses its username,
+	// an erased user has no password hash and never gets past it
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("invalid password")))
+		return
+	}
+
+	if user.LockedAt.Valid {
+		err = fmt.Errorf("user [%s] is locked", req.Username)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
 		return
 	}
 
@@ -147,8 +165,18 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ExpiresAt:    refreshPayload.ExpiredAt,
 	}
 
-	session, err := server.store.CreateSession(ctx, sessionParams)
+	// the login is audited on behalf of the user it authenticates, without the tokens of the session
+	var session db.Session
+	err = server.auditTxAs(ctx, accessPayload, "session.create", util.AuditTargetSession, func(store db.Store) (auditedChange, error) {
+		var err error
+		session, err = store.CreateSession(ctx, sessionParams)
+		if err != nil {
+			return auditedChange{}, err
+		}
 
+		after := map[string]any{"id": session.ID, "expires_at": session.ExpiresAt}
+		return auditedChange{targetID: session.ID.String(), after: after}, nil
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -9,12 +10,14 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -92,6 +95,7 @@ func TestCreateUser(t *testing.T) {
 					Email:          user.Email,
 				}
 				store.EXPECT().CreateUser(gomock.Any(), EqCreateUserParams(arg, password)).Times(1)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -231,6 +235,17 @@ func TestLoginUser(t *testing.T) {
 		buildStubs: func(store *mockdb.MockStore) {
 			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
 			store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+			store.EXPECT().
+				CreateAuditEvent(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+					require.Equal(t, "session.create", arg.Action)
+					require.Equal(t, user.Username, arg.Actor)
+					require.Equal(t, user.Role, arg.ActorRole)
+					require.Equal(t, util.AuditTargetSession, arg.TargetType)
+					require.NotContains(t, string(arg.Diff), "refresh_token")
+					return db.AuditEvent{}, nil
+				})
 		},
 		checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 			require.Equal(t, http.StatusOK, recorder.Code)
@@ -302,6 +317,61 @@ func TestLoginUser(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name: "UserLocked",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				locked := user
+				locked.LockedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(locked, nil)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "UserLockedWrongPassword",
+			body: gin.H{
+				"username": user.Username,
+				"password": "wrong-password",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				locked := user
+				locked.LockedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(locked, nil)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// the lock is not revealed without the password
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.JSONEq(t, `{"error":"invalid password"}`, recorder.Body.String())
+			},
+		},
+		{
+			name: "UserErased",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				erased := user
+				erased.HashedPassword = ""
+				erased.ErasedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(erased, nil)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.JSONEq(t, `{"error":"invalid password"}`, recorder.Body.String())
+			},
+		},
 		{
 			name: "CreateSessionError",
 			body: gin.H{
@@ -311,8 +381,25 @@ func TestLoginUser(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
 				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, fmt.Errorf("error"))
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "CreateAuditEventError",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, fmt.Errorf("error"))
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// the session is not handed out when its audit event can't be recorded
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		}}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -11,3 +12,31 @@ var isValidCurency validator.Func = func(fl validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var isInvitableHolderRole validator.Func = func(fl validator.FieldLevel) bool {
+	if role, ok := fl.Field().Interface().(string); ok {
+		return util.IsInvitableHolderRole(role)
+	}
+	return false
+}
+
+var isValidAccountNumber validator.Func = func(fl validator.FieldLevel) bool {
+	if number, ok := fl.Field().Interface().(string); ok {
+		return val.ValidateAccountNumber(number) == nil
+	}
+	return false
+}
+
+var isValidAccountRef validator.Func = func(fl validator.FieldLevel) bool {
+	if ref, ok := fl.Field().Interface().(string); ok {
+		return val.ValidateAccountRef(ref) == nil
+	}
+	return false
+}
+
+var isValidAlertKind validator.Func = func(fl validator.FieldLevel) bool {
+	if kind, ok := fl.Field().Interface().(string); ok {
+		return util.IsSupportedAlertKind(kind)
+	}
+	return false
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "account_invitations";
+
+DROP TABLE IF EXISTS "account_holders";
@@ -0,0 +1,36 @@
+CREATE TABLE "account_holders" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE TABLE "account_invitations" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "inviter" varchar NOT NULL,
+  "invitee" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "responded_at" timestamptz
+);
+
+CREATE INDEX ON "account_holders" ("username");
+
+CREATE INDEX ON "account_invitations" ("invitee", "status");
+
+COMMENT ON COLUMN "account_holders"."role" IS 'owner, co_owner or view_only';
+
+COMMENT ON COLUMN "account_invitations"."status" IS 'pending, accepted or declined';
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("inviter") REFERENCES "users" ("username");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("invitee") REFERENCES "users" ("username");
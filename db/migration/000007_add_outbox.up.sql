@@ -0,0 +1,15 @@
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "aggregate_type" varchar NOT NULL,
+  "aggregate_id" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE INDEX ON "outbox" ("id") WHERE "sent_at" IS NULL;
+
+COMMENT ON COLUMN "outbox"."aggregate_type" IS 'kind of entity the event belongs to, see the Aggregate constants in db/sqlc/outbox.go';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'null until the relay has published the event';
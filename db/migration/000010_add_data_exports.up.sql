@@ -0,0 +1,24 @@
+CREATE TABLE "data_exports" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "download_token" varchar NOT NULL,
+  "archive" bytea,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz,
+  "expires_at" timestamptz
+);
+
+CREATE INDEX ON "data_exports" ("username");
+
+COMMENT ON COLUMN "data_exports"."status" IS 'pending, ready or failed';
+
+COMMENT ON COLUMN "data_exports"."archive" IS 'ZIP archive of the user data as JSON files';
+
+COMMENT ON COLUMN "data_exports"."expires_at" IS 'the archive can not be downloaded after this time';
+
+ALTER TABLE "data_exports" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "users" ADD COLUMN "erased_at" timestamptz;
+
+COMMENT ON COLUMN "users"."erased_at" IS 'personal data has been pseudonymized on request';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "fraud_rule_hits";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reviewed_at";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reviewed_by";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "status";
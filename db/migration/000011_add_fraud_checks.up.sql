@@ -0,0 +1,26 @@
+ALTER TABLE "transfers" ADD COLUMN "status" varchar NOT NULL DEFAULT 'completed';
+
+ALTER TABLE "transfers" ADD COLUMN "reviewed_by" varchar;
+
+ALTER TABLE "transfers" ADD COLUMN "reviewed_at" timestamptz;
+
+CREATE TABLE "fraud_rule_hits" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "rule" varchar NOT NULL,
+  "decision" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfers" ("status");
+
+CREATE INDEX ON "fraud_rule_hits" ("transfer_id");
+
+COMMENT ON COLUMN "transfers"."status" IS 'completed, held, denied or rejected. Money moves only for completed transfers';
+
+COMMENT ON COLUMN "transfers"."reviewed_by" IS 'banker who released or rejected the held transfer';
+
+COMMENT ON COLUMN "fraud_rule_hits"."decision" IS 'review or deny';
+
+ALTER TABLE "fraud_rule_hits" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
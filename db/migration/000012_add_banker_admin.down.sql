@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "balance_adjustments";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "lock_reason";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "locked_at";
@@ -0,0 +1,27 @@
+ALTER TABLE "users" ADD COLUMN "locked_at" timestamptz;
+
+ALTER TABLE "users" ADD COLUMN "lock_reason" varchar;
+
+CREATE TABLE "balance_adjustments" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "reason" varchar NOT NULL,
+  "adjusted_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "balance_adjustments" ("account_id");
+
+COMMENT ON COLUMN "users"."locked_at" IS 'a locked user can not log in or renew access tokens';
+
+COMMENT ON COLUMN "balance_adjustments"."amount" IS 'can be negative or positive';
+
+COMMENT ON COLUMN "balance_adjustments"."adjusted_by" IS 'banker who made the adjustment';
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("adjusted_by") REFERENCES "users" ("username");
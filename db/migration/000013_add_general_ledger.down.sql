@@ -0,0 +1,5 @@
+ALTER TABLE "balance_adjustments" DROP COLUMN IF EXISTS "ledger_entry_id";
+
+DROP TABLE IF EXISTS "ledger_entries";
+
+DROP TABLE IF EXISTS "ledger_accounts";
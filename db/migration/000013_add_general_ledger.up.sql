@@ -0,0 +1,50 @@
+CREATE TABLE "ledger_accounts" (
+  "id" bigserial PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "name" varchar NOT NULL,
+  "type" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "balance" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "ledger_entries" (
+  "id" bigserial PRIMARY KEY,
+  "ledger_account_id" bigint NOT NULL,
+  "entry_id" bigint,
+  "amount" bigint NOT NULL,
+  "description" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "balance_adjustments" ADD COLUMN "ledger_entry_id" bigint;
+
+CREATE UNIQUE INDEX ON "ledger_accounts" ("code", "currency");
+
+CREATE INDEX ON "ledger_entries" ("ledger_account_id");
+
+COMMENT ON COLUMN "ledger_accounts"."type" IS 'asset, liability, equity, revenue or expense';
+
+COMMENT ON COLUMN "ledger_accounts"."balance" IS 'credits are positive and debits are negative, like the balances of customer accounts';
+
+COMMENT ON COLUMN "ledger_entries"."entry_id" IS 'the opposite entry on a customer account, if any';
+
+COMMENT ON COLUMN "ledger_entries"."amount" IS 'credits are positive and debits are negative';
+
+ALTER TABLE "ledger_entries" ADD FOREIGN KEY ("ledger_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "ledger_entries" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("ledger_entry_id") REFERENCES "ledger_entries" ("id");
+
+-- the chart of accounts every currency starts with
+INSERT INTO "ledger_accounts" ("code", "name", "type", "currency")
+SELECT chart.code, chart.name, chart.type, currencies.currency
+FROM (VALUES
+  ('3000', 'Equity', 'equity'),
+  ('4000', 'Fee income', 'revenue'),
+  ('4100', 'Interest income', 'revenue'),
+  ('5000', 'Interest expense', 'expense'),
+  ('5100', 'Manual adjustments', 'expense')
+) AS chart (code, name, type)
+CROSS JOIN (VALUES ('USD'), ('EUR'), ('CAD')) AS currencies (currency);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "cash_operations";
+
+DELETE FROM "ledger_entries"
+WHERE "ledger_account_id" IN (SELECT "id" FROM "ledger_accounts" WHERE "code" = '1000');
+
+DELETE FROM "ledger_accounts" WHERE "code" = '1000';
@@ -0,0 +1,42 @@
+CREATE TABLE "cash_operations" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "clearing_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "balance_after" bigint NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "clearing_entry_id" bigint NOT NULL,
+  "performed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "cash_operations" ("account_id");
+
+COMMENT ON COLUMN "cash_operations"."kind" IS 'deposit or withdrawal';
+
+COMMENT ON COLUMN "cash_operations"."clearing_account_id" IS 'cash clearing ledger account in the currency of the account';
+
+COMMENT ON COLUMN "cash_operations"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "cash_operations"."balance_after" IS 'balance of the account printed on the receipt';
+
+COMMENT ON COLUMN "cash_operations"."clearing_entry_id" IS 'the opposite ledger entry on the clearing account';
+
+COMMENT ON COLUMN "cash_operations"."performed_by" IS 'banker who handled the cash';
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("clearing_entry_id") REFERENCES "ledger_entries" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("performed_by") REFERENCES "users" ("username");
+
+-- the cash paid in and out at the counter is booked against these bank-internal accounts
+INSERT INTO "ledger_accounts" ("code", "name", "type", "currency")
+SELECT '1000', 'Cash clearing', 'asset', currencies.currency
+FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS currencies (currency);
@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS ledger_entries_open_day_only ON "ledger_entries";
+
+DROP TRIGGER IF EXISTS entries_open_day_only ON "entries";
+
+DROP FUNCTION IF EXISTS reject_closed_day_posting();
+
+DROP TABLE IF EXISTS "daily_totals";
+
+DROP TABLE IF EXISTS "account_balance_snapshots";
+
+DROP TABLE IF EXISTS "business_days";
+
+ALTER TABLE "ledger_entries" DROP COLUMN IF EXISTS "business_date";
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "business_date";
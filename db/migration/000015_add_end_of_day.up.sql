@@ -0,0 +1,67 @@
+ALTER TABLE "entries" ADD COLUMN "business_date" date NOT NULL DEFAULT ((now() AT TIME ZONE 'UTC')::date);
+
+UPDATE "entries" SET "business_date" = ("created_at" AT TIME ZONE 'UTC')::date;
+
+ALTER TABLE "ledger_entries" ADD COLUMN "business_date" date NOT NULL DEFAULT ((now() AT TIME ZONE 'UTC')::date);
+
+UPDATE "ledger_entries" SET "business_date" = ("created_at" AT TIME ZONE 'UTC')::date;
+
+CREATE TABLE "business_days" (
+  "business_date" date PRIMARY KEY,
+  "closed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_balance_snapshots" (
+  "business_date" date NOT NULL,
+  "account_id" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  PRIMARY KEY ("business_date", "account_id")
+);
+
+CREATE TABLE "daily_totals" (
+  "business_date" date NOT NULL,
+  "currency" varchar NOT NULL,
+  "total_debits" bigint NOT NULL,
+  "total_credits" bigint NOT NULL,
+  "entry_count" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  PRIMARY KEY ("business_date", "currency")
+);
+
+CREATE INDEX ON "entries" ("business_date");
+
+CREATE INDEX ON "account_balance_snapshots" ("account_id");
+
+COMMENT ON COLUMN "entries"."business_date" IS 'UTC day the entry is booked on';
+
+COMMENT ON COLUMN "ledger_entries"."business_date" IS 'UTC day the entry is booked on';
+
+COMMENT ON COLUMN "business_days"."business_date" IS 'a closed day, no entries can be booked on it or before it';
+
+COMMENT ON COLUMN "daily_totals"."closing_balance" IS 'sum of the closing balances of the accounts in the currency';
+
+ALTER TABLE "account_balance_snapshots" ADD FOREIGN KEY ("business_date") REFERENCES "business_days" ("business_date");
+
+-- only accounts without entries can be deleted, their snapshots carry nothing the daily totals don't have
+ALTER TABLE "account_balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "daily_totals" ADD FOREIGN KEY ("business_date") REFERENCES "business_days" ("business_date");
+
+CREATE FUNCTION reject_closed_day_posting() RETURNS trigger AS $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM "business_days" WHERE "business_date" >= NEW."business_date") THEN
+    RAISE EXCEPTION 'business day % is closed', NEW."business_date" USING ERRCODE = 'SB001';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER entries_open_day_only
+BEFORE INSERT ON "entries"
+FOR EACH ROW EXECUTE FUNCTION reject_closed_day_posting();
+
+CREATE TRIGGER ledger_entries_open_day_only
+BEFORE INSERT ON "ledger_entries"
+FOR EACH ROW EXECUTE FUNCTION reject_closed_day_posting();
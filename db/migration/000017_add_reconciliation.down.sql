@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "external_statement_lines";
+
+DROP TABLE IF EXISTS "external_statements";
@@ -0,0 +1,61 @@
+CREATE TABLE "external_statements" (
+  "id" bigserial PRIMARY KEY,
+  "format" varchar NOT NULL,
+  "reference" varchar NOT NULL,
+  "external_account" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "clearing_account_id" bigint NOT NULL,
+  "from_date" date NOT NULL,
+  "to_date" date NOT NULL,
+  "opening_balance" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  "imported_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "external_statement_lines" (
+  "id" bigserial PRIMARY KEY,
+  "statement_id" bigint NOT NULL,
+  "booking_date" date NOT NULL,
+  "value_date" date NOT NULL,
+  "amount" bigint NOT NULL,
+  "reference" varchar NOT NULL,
+  "end_to_end_id" varchar NOT NULL,
+  "counterparty" varchar NOT NULL,
+  "description" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'unmatched',
+  "entry_id" bigint UNIQUE,
+  "resolved_by" varchar,
+  "resolution_note" varchar,
+  "resolved_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "external_statements" ("external_account", "reference");
+
+CREATE INDEX ON "external_statement_lines" ("statement_id");
+
+CREATE INDEX ON "external_statement_lines" ("status");
+
+COMMENT ON COLUMN "external_statements"."format" IS 'camt053 or mt940';
+
+COMMENT ON COLUMN "external_statements"."reference" IS 'id of the statement at the external bank';
+
+COMMENT ON COLUMN "external_statements"."clearing_account_id" IS 'cash clearing ledger account mirroring the external account';
+
+COMMENT ON COLUMN "external_statement_lines"."amount" IS 'credits on the external account are positive';
+
+COMMENT ON COLUMN "external_statement_lines"."status" IS 'unmatched, matched or resolved';
+
+COMMENT ON COLUMN "external_statement_lines"."entry_id" IS 'the clearing ledger entry the line matches';
+
+COMMENT ON COLUMN "external_statement_lines"."resolved_by" IS 'banker who resolved the line by hand, empty for automatic matches';
+
+ALTER TABLE "external_statements" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "external_statements" ADD FOREIGN KEY ("imported_by") REFERENCES "users" ("username");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("statement_id") REFERENCES "external_statements" ("id");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("entry_id") REFERENCES "ledger_entries" ("id");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
@@ -0,0 +1,17 @@
+ALTER TABLE "accounts" ADD COLUMN "number" varchar;
+
+COMMENT ON COLUMN "accounts"."number" IS 'IBAN-like account number with mod-97 check digits, e.g. XS51SIMP012345678901';
+
+-- Same scheme as util.NewAccountNumber: XS + check digits + SIMP + 12 random digits.
+-- The check digits are 98 minus the remainder of SIMP<digits>XS00 with letters replaced by 10 to 35.
+WITH "generated" AS (
+  SELECT "id", lpad(floor(random() * 1e12)::bigint::text, 12, '0') AS "digits" FROM "accounts"
+)
+UPDATE "accounts" a
+SET "number" = 'XS' || lpad((98 - ('28182225' || g."digits" || '332800')::numeric % 97)::text, 2, '0') || 'SIMP' || g."digits"
+FROM "generated" g
+WHERE a."id" = g."id";
+
+ALTER TABLE "accounts" ALTER COLUMN "number" SET NOT NULL;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_number_key" UNIQUE ("number");
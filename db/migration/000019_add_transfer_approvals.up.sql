@@ -0,0 +1,34 @@
+CREATE TABLE "transfer_approvals" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "decided_by" varchar,
+  "decided_at" timestamptz,
+  "transfer_id" bigint UNIQUE,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfer_approvals" ("status", "expires_at");
+
+COMMENT ON COLUMN "transfer_approvals"."initiated_by" IS 'user who requested the transfer';
+
+COMMENT ON COLUMN "transfer_approvals"."status" IS 'pending, approved, rejected or expired';
+
+COMMENT ON COLUMN "transfer_approvals"."decided_by" IS 'banker who approved or rejected the transfer, never the initiator';
+
+COMMENT ON COLUMN "transfer_approvals"."transfer_id" IS 'the transfer executed on approval';
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "disputes";
+
+DELETE FROM "ledger_entries"
+WHERE "ledger_account_id" IN (SELECT "id" FROM "ledger_accounts" WHERE "code" = '1100');
+
+DELETE FROM "ledger_accounts" WHERE "code" = '1100';
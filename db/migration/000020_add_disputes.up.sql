@@ -0,0 +1,47 @@
+CREATE TABLE "disputes" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "account_id" bigint NOT NULL,
+  "opened_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "evidence" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "assigned_to" varchar,
+  "resolution_note" varchar,
+  "credit_entry_id" bigint,
+  "reversal_entry_id" bigint,
+  "resolved_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "disputes" ("status", "created_at");
+
+CREATE INDEX ON "disputes" ("opened_by");
+
+COMMENT ON COLUMN "disputes"."account_id" IS 'the account the disputed transfer was paid from';
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, investigating, resolved_customer or resolved_merchant';
+
+COMMENT ON COLUMN "disputes"."assigned_to" IS 'banker investigating the dispute';
+
+COMMENT ON COLUMN "disputes"."credit_entry_id" IS 'provisional credit to the customer, only for resolutions in favour of the customer';
+
+COMMENT ON COLUMN "disputes"."reversal_entry_id" IS 'final reversal debited from the payee, only for resolutions in favour of the customer';
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("opened_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("assigned_to") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("credit_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("reversal_entry_id") REFERENCES "entries" ("id");
+
+-- the provisional credits of disputes are paid from this account until the payee is debited
+INSERT INTO "ledger_accounts" ("code", "name", "type", "currency")
+SELECT '1100', 'Dispute suspense', 'asset', currencies.currency
+FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS currencies (currency);
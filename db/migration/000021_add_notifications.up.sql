@@ -0,0 +1,39 @@
+CREATE TABLE "notification_preferences" (
+  "username" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "channel" varchar NOT NULL DEFAULT 'email',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "event_type")
+);
+
+CREATE TABLE "notification_digest_items" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "notification_digest_items" ("username", "event_type", "transfer_id");
+
+CREATE INDEX ON "notification_digest_items" ("username", "sent_at");
+
+COMMENT ON COLUMN "notification_preferences"."event_type" IS 'money_in or money_out';
+
+COMMENT ON COLUMN "notification_preferences"."min_amount" IS 'smaller transfers are batched into the digest instead of notified one by one';
+
+COMMENT ON COLUMN "notification_digest_items"."sent_at" IS 'empty until the digest with the item is sent';
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,25 @@
+CREATE TABLE "alert_rules" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "kind" varchar NOT NULL,
+  "threshold" bigint NOT NULL,
+  "triggered_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "alert_rules" ("account_id");
+
+CREATE INDEX ON "alert_rules" ("username");
+
+COMMENT ON COLUMN "alert_rules"."username" IS 'the holder of the account who receives the alert';
+
+COMMENT ON COLUMN "alert_rules"."kind" IS 'low_balance, large_debit or daily_spend';
+
+COMMENT ON COLUMN "alert_rules"."threshold" IS 'must be positive';
+
+COMMENT ON COLUMN "alert_rules"."triggered_at" IS 'set when the alert is sent and cleared when the rule is rearmed, so the alert is not repeated';
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "pot_entries";
+
+DROP TABLE IF EXISTS "savings_pots";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "pot_balance";
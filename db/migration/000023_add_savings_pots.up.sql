@@ -0,0 +1,37 @@
+ALTER TABLE "accounts" ADD COLUMN "pot_balance" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "accounts"."pot_balance" IS 'part of the balance set aside in savings pots, the available balance is balance - pot_balance';
+
+CREATE TABLE "savings_pots" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "target_amount" bigint NOT NULL,
+  "deadline" date NOT NULL,
+  "balance" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pot_entries" (
+  "id" bigserial PRIMARY KEY,
+  "pot_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "savings_pots" ("account_id");
+
+CREATE INDEX ON "pot_entries" ("pot_id");
+
+COMMENT ON COLUMN "savings_pots"."status" IS 'open or closed';
+
+COMMENT ON COLUMN "pot_entries"."amount" IS 'positive when moved from the main balance into the pot, negative when moved back';
+
+ALTER TABLE "savings_pots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pot_entries" ADD FOREIGN KEY ("pot_id") REFERENCES "savings_pots" ("id");
+
+ALTER TABLE "pot_entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
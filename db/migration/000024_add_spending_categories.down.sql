@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "entry_categories";
+
+DROP TABLE IF EXISTS "category_rules";
+
+ALTER TABLE "transfer_approvals" DROP COLUMN IF EXISTS "memo";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "memo";
@@ -0,0 +1,36 @@
+ALTER TABLE "transfers" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfer_approvals" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+CREATE TABLE "category_rules" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "category" varchar NOT NULL,
+  "counterparty_account_id" bigint,
+  "memo_contains" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("counterparty_account_id" IS NOT NULL OR "memo_contains" <> '')
+);
+
+CREATE TABLE "entry_categories" (
+  "entry_id" bigint PRIMARY KEY,
+  "category" varchar NOT NULL,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "category_rules" ("username");
+
+COMMENT ON COLUMN "category_rules"."counterparty_account_id" IS 'matches transfers with this account, any counterparty if empty';
+
+COMMENT ON COLUMN "category_rules"."memo_contains" IS 'matches transfers whose memo contains the text ignoring case, any memo if empty';
+
+COMMENT ON TABLE "entry_categories" IS 'categories set by the user, they take precedence over the category rules';
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("counterparty_account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "entry_categories" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "entry_categories" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
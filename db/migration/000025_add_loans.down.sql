@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "loan_installments";
+
+DROP TABLE IF EXISTS "loans";
+
+DELETE FROM "ledger_entries"
+WHERE "ledger_account_id" IN (SELECT "id" FROM "ledger_accounts" WHERE "code" = '1200');
+
+DELETE FROM "ledger_accounts" WHERE "code" = '1200';
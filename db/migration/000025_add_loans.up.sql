@@ -0,0 +1,62 @@
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate_bps" integer NOT NULL,
+  "term_months" integer NOT NULL,
+  "method" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "outstanding_principal" bigint NOT NULL,
+  "outstanding_interest" bigint NOT NULL,
+  "disbursement_entry_id" bigint NOT NULL,
+  "disbursed_by" varchar NOT NULL,
+  "paid_off_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_installments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "seq" integer NOT NULL,
+  "due_date" date NOT NULL,
+  "principal" bigint NOT NULL,
+  "interest" bigint NOT NULL,
+  "late_fee" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'scheduled',
+  "entry_id" bigint,
+  "paid_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE UNIQUE INDEX ON "loan_installments" ("loan_id", "seq");
+
+CREATE INDEX ON "loan_installments" ("status", "due_date");
+
+COMMENT ON COLUMN "loans"."annual_rate_bps" IS 'annual interest rate in basis points';
+
+COMMENT ON COLUMN "loans"."method" IS 'annuity or linear';
+
+COMMENT ON COLUMN "loans"."status" IS 'active or paid_off';
+
+COMMENT ON COLUMN "loans"."disbursed_by" IS 'banker who disbursed the loan';
+
+COMMENT ON COLUMN "loan_installments"."late_fee" IS 'charged once when the installment could not be collected on the due date';
+
+COMMENT ON COLUMN "loan_installments"."status" IS 'scheduled, overdue or paid';
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursement_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+INSERT INTO "ledger_accounts" ("code", "name", "type", "currency")
+SELECT '1200', 'Loans receivable', 'asset', currencies.currency
+FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS currencies (currency);
@@ -0,0 +1 @@
+-- the stripped ports can't be restored
@@ -0,0 +1,8 @@
+-- sessions stored the remote address of the gRPC peer, port included
+UPDATE "sessions"
+SET "client_ip" = substring("client_ip" from '^\[(.*)\]:[0-9]+$')
+WHERE "client_ip" ~ '^\[.*\]:[0-9]+$';
+
+UPDATE "sessions"
+SET "client_ip" = substring("client_ip" from '^([^:]+):[0-9]+$')
+WHERE "client_ip" ~ '^[^:]+:[0-9]+$';
@@ -0,0 +1,16 @@
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+BEGIN
+  -- erasing a user may replace the personal data in the diffs and blank the client of the events, nothing else
+  IF TG_OP = 'UPDATE'
+    AND current_setting('simplebank.erase_personal_data', true) = 'on'
+    AND (NEW."id", NEW."actor", NEW."actor_role", NEW."action", NEW."target_type", NEW."target_id", NEW."created_at")
+      IS NOT DISTINCT FROM (OLD."id", OLD."actor", OLD."actor_role", OLD."action", OLD."target_type", OLD."target_id", OLD."created_at")
+    AND NEW."client_ip" IN (OLD."client_ip", '')
+    AND NEW."user_agent" IN (OLD."user_agent", '')
+  THEN
+    RETURN NEW;
+  END IF;
+
+  RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,8 @@
+-- the tokens can't be recovered from their hashes, so the links emailed before stop working
+UPDATE "data_exports" SET "download_token_hash" = '' WHERE "download_token_hash" IS NULL;
+
+COMMENT ON COLUMN "data_exports"."download_token_hash" IS NULL;
+
+ALTER TABLE "data_exports" ALTER COLUMN "download_token_hash" SET NOT NULL;
+
+ALTER TABLE "data_exports" RENAME COLUMN "download_token_hash" TO "download_token";
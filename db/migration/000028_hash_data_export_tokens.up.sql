@@ -0,0 +1,9 @@
+ALTER TABLE "data_exports" RENAME COLUMN "download_token" TO "download_token_hash";
+
+ALTER TABLE "data_exports" ALTER COLUMN "download_token_hash" DROP NOT NULL;
+
+-- the links already emailed keep working
+UPDATE "data_exports"
+SET "download_token_hash" = encode(sha256(convert_to("download_token_hash", 'UTF8')), 'hex');
+
+COMMENT ON COLUMN "data_exports"."download_token_hash" IS 'SHA-256 of the download token emailed to the user, set once the archive is ready';
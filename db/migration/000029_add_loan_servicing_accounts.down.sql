@@ -0,0 +1,8 @@
+-- accounts that collected installments are kept, their entries are part of the general ledger
+DELETE FROM "accounts" a
+WHERE a."owner" = 'simplebank'
+  AND NOT EXISTS (SELECT 1 FROM "entries" e WHERE e."account_id" = a."id");
+
+DELETE FROM "users" u
+WHERE u."username" = 'simplebank'
+  AND NOT EXISTS (SELECT 1 FROM "accounts" a WHERE a."owner" = u."username");
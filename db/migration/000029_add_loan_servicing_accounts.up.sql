@@ -0,0 +1,10 @@
+-- the bank collects loan installments by transfer into these accounts, one per currency.
+-- The user can't log in: the password hash matches no password and the user is locked.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "locked_at", "lock_reason")
+VALUES ('simplebank', '', 'SimpleBank loan servicing', 'loans@simplebank.invalid', now(), 'system user');
+
+INSERT INTO "accounts" ("owner", "balance", "currency", "number")
+VALUES
+  ('simplebank', 0, 'USD', 'XS02SIMP000000000001'),
+  ('simplebank', 0, 'EUR', 'XS72SIMP000000000002'),
+  ('simplebank', 0, 'CAD', 'XS45SIMP000000000003');
@@ -0,0 +1,3 @@
+ALTER TABLE "transfers" ADD COLUMN "initiated_by" varchar;
+
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'user who made the transfer, null for transfers made by the bank and those made before it was recorded';
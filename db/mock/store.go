@@ -15,6 +15,7 @@ import (
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,288 +43,2887 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitationTx mocks base method.
+func (m *MockStore) AcceptInvitationTx(ctx context.Context, arg db.AcceptInvitationTxParams) (db.AcceptInvitationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationTx", ctx, arg)
+	ret0, _ := ret[0].(db.AcceptInvitationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationTx indicates an expected call of AcceptInvitationTx.
+func (mr *MockStoreMockRecorder) AcceptInvitationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationTx", reflect.TypeOf((*MockStore)(nil).AcceptInvitationTx), ctx, arg)
+}
+
 // AddAccountBalance mocks base method.
 func (m *MockStore) AddAccountBalance(ctx context.Context, arg db.AddAccountBalanceParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountBalance", ctx, arg)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "AddAccountBalance", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountBalance indicates an expected call of AddAccountBalance.
+func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
+}
+
+// AddAccountPotBalance mocks base method.
+func (m *MockStore) AddAccountPotBalance(ctx context.Context, arg db.AddAccountPotBalanceParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountPotBalance", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountPotBalance indicates an expected call of AddAccountPotBalance.
+func (mr *MockStoreMockRecorder) AddAccountPotBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountPotBalance", reflect.TypeOf((*MockStore)(nil).AddAccountPotBalance), ctx, arg)
+}
+
+// AddLedgerAccountBalance mocks base method.
+func (m *MockStore) AddLedgerAccountBalance(ctx context.Context, arg db.AddLedgerAccountBalanceParams) (db.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLedgerAccountBalance", ctx, arg)
+	ret0, _ := ret[0].(db.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLedgerAccountBalance indicates an expected call of AddLedgerAccountBalance.
+func (mr *MockStoreMockRecorder) AddLedgerAccountBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLedgerAccountBalance", reflect.TypeOf((*MockStore)(nil).AddLedgerAccountBalance), ctx, arg)
+}
+
+// AddSavingsPotBalance mocks base method.
+func (m *MockStore) AddSavingsPotBalance(ctx context.Context, arg db.AddSavingsPotBalanceParams) (db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSavingsPotBalance", ctx, arg)
+	ret0, _ := ret[0].(db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSavingsPotBalance indicates an expected call of AddSavingsPotBalance.
+func (mr *MockStoreMockRecorder) AddSavingsPotBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSavingsPotBalance", reflect.TypeOf((*MockStore)(nil).AddSavingsPotBalance), ctx, arg)
+}
+
+// AdjustBalanceTx mocks base method.
+func (m *MockStore) AdjustBalanceTx(ctx context.Context, arg db.AdjustBalanceTxParams) (db.AdjustBalanceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBalanceTx", ctx, arg)
+	ret0, _ := ret[0].(db.AdjustBalanceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustBalanceTx indicates an expected call of AdjustBalanceTx.
+func (mr *MockStoreMockRecorder) AdjustBalanceTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBalanceTx", reflect.TypeOf((*MockStore)(nil).AdjustBalanceTx), ctx, arg)
+}
+
+// AllowAuditEventErasure mocks base method.
+func (m *MockStore) AllowAuditEventErasure(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowAuditEventErasure", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllowAuditEventErasure indicates an expected call of AllowAuditEventErasure.
+func (mr *MockStoreMockRecorder) AllowAuditEventErasure(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowAuditEventErasure", reflect.TypeOf((*MockStore)(nil).AllowAuditEventErasure), ctx)
+}
+
+// AuditTx mocks base method.
+func (m *MockStore) AuditTx(ctx context.Context, change func(db.Store) (db.CreateAuditEventParams, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditTx", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuditTx indicates an expected call of AuditTx.
+func (mr *MockStoreMockRecorder) AuditTx(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditTx", reflect.TypeOf((*MockStore)(nil).AuditTx), ctx, change)
+}
+
+// BlockSessions mocks base method.
+func (m *MockStore) BlockSessions(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessions", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSessions indicates an expected call of BlockSessions.
+func (mr *MockStoreMockRecorder) BlockSessions(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessions", reflect.TypeOf((*MockStore)(nil).BlockSessions), ctx, username)
+}
+
+// CashOperationTx mocks base method.
+func (m *MockStore) CashOperationTx(ctx context.Context, arg db.CashOperationTxParams) (db.CashOperationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CashOperationTx", ctx, arg)
+	ret0, _ := ret[0].(db.CashOperationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CashOperationTx indicates an expected call of CashOperationTx.
+func (mr *MockStoreMockRecorder) CashOperationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CashOperationTx", reflect.TypeOf((*MockStore)(nil).CashOperationTx), ctx, arg)
+}
+
+// CloseBusinessDayTx mocks base method.
+func (m *MockStore) CloseBusinessDayTx(ctx context.Context, arg db.CloseBusinessDayTxParams) (db.CloseBusinessDayTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseBusinessDayTx", ctx, arg)
+	ret0, _ := ret[0].(db.CloseBusinessDayTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseBusinessDayTx indicates an expected call of CloseBusinessDayTx.
+func (mr *MockStoreMockRecorder) CloseBusinessDayTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseBusinessDayTx", reflect.TypeOf((*MockStore)(nil).CloseBusinessDayTx), ctx, arg)
+}
+
+// CloseSavingsPot mocks base method.
+func (m *MockStore) CloseSavingsPot(ctx context.Context, id int64) (db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSavingsPot", ctx, id)
+	ret0, _ := ret[0].(db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseSavingsPot indicates an expected call of CloseSavingsPot.
+func (mr *MockStoreMockRecorder) CloseSavingsPot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSavingsPot", reflect.TypeOf((*MockStore)(nil).CloseSavingsPot), ctx, id)
+}
+
+// CloseSavingsPotTx mocks base method.
+func (m *MockStore) CloseSavingsPotTx(ctx context.Context, arg db.CloseSavingsPotTxParams) (db.CloseSavingsPotTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSavingsPotTx", ctx, arg)
+	ret0, _ := ret[0].(db.CloseSavingsPotTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseSavingsPotTx indicates an expected call of CloseSavingsPotTx.
+func (mr *MockStoreMockRecorder) CloseSavingsPotTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSavingsPotTx", reflect.TypeOf((*MockStore)(nil).CloseSavingsPotTx), ctx, arg)
+}
+
+// CollectLoanInstallmentTx mocks base method.
+func (m *MockStore) CollectLoanInstallmentTx(ctx context.Context, arg db.CollectLoanInstallmentTxParams) (db.CollectLoanInstallmentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectLoanInstallmentTx", ctx, arg)
+	ret0, _ := ret[0].(db.CollectLoanInstallmentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectLoanInstallmentTx indicates an expected call of CollectLoanInstallmentTx.
+func (mr *MockStoreMockRecorder) CollectLoanInstallmentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectLoanInstallmentTx", reflect.TypeOf((*MockStore)(nil).CollectLoanInstallmentTx), ctx, arg)
+}
+
+// CompleteDataExport mocks base method.
+func (m *MockStore) CompleteDataExport(ctx context.Context, arg db.CompleteDataExportParams) (db.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, arg)
+	ret0, _ := ret[0].(db.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockStoreMockRecorder) CompleteDataExport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockStore)(nil).CompleteDataExport), ctx, arg)
+}
+
+// CountCompletedTransfersBetween mocks base method.
+func (m *MockStore) CountCompletedTransfersBetween(ctx context.Context, arg db.CountCompletedTransfersBetweenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompletedTransfersBetween", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompletedTransfersBetween indicates an expected call of CountCompletedTransfersBetween.
+func (mr *MockStoreMockRecorder) CountCompletedTransfersBetween(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompletedTransfersBetween", reflect.TypeOf((*MockStore)(nil).CountCompletedTransfersBetween), ctx, arg)
+}
+
+// CountCompletedTransfersSince mocks base method.
+func (m *MockStore) CountCompletedTransfersSince(ctx context.Context, arg db.CountCompletedTransfersSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompletedTransfersSince", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompletedTransfersSince indicates an expected call of CountCompletedTransfersSince.
+func (mr *MockStoreMockRecorder) CountCompletedTransfersSince(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompletedTransfersSince", reflect.TypeOf((*MockStore)(nil).CountCompletedTransfersSince), ctx, arg)
+}
+
+// CountSessionsFromIP mocks base method.
+func (m *MockStore) CountSessionsFromIP(ctx context.Context, arg db.CountSessionsFromIPParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSessionsFromIP", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSessionsFromIP indicates an expected call of CountSessionsFromIP.
+func (mr *MockStoreMockRecorder) CountSessionsFromIP(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSessionsFromIP", reflect.TypeOf((*MockStore)(nil).CountSessionsFromIP), ctx, arg)
+}
+
+// CreateAccount mocks base method.
+func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+}
+
+// CreateAccountBalanceSnapshot mocks base method.
+func (m *MockStore) CreateAccountBalanceSnapshot(ctx context.Context, arg db.CreateAccountBalanceSnapshotParams) (db.AccountBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountBalanceSnapshot", ctx, arg)
+	ret0, _ := ret[0].(db.AccountBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountBalanceSnapshot indicates an expected call of CreateAccountBalanceSnapshot.
+func (mr *MockStoreMockRecorder) CreateAccountBalanceSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountBalanceSnapshot", reflect.TypeOf((*MockStore)(nil).CreateAccountBalanceSnapshot), ctx, arg)
+}
+
+// CreateAccountBalanceSnapshots mocks base method.
+func (m *MockStore) CreateAccountBalanceSnapshots(ctx context.Context, businessDate pgtype.Date) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountBalanceSnapshots", ctx, businessDate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountBalanceSnapshots indicates an expected call of CreateAccountBalanceSnapshots.
+func (mr *MockStoreMockRecorder) CreateAccountBalanceSnapshots(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountBalanceSnapshots", reflect.TypeOf((*MockStore)(nil).CreateAccountBalanceSnapshots), ctx, businessDate)
+}
+
+// CreateAccountHolder mocks base method.
+func (m *MockStore) CreateAccountHolder(ctx context.Context, arg db.CreateAccountHolderParams) (db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountHolder indicates an expected call of CreateAccountHolder.
+func (mr *MockStoreMockRecorder) CreateAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountHolder", reflect.TypeOf((*MockStore)(nil).CreateAccountHolder), ctx, arg)
+}
+
+// CreateAccountInvitation mocks base method.
+func (m *MockStore) CreateAccountInvitation(ctx context.Context, arg db.CreateAccountInvitationParams) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", ctx, arg)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockStoreMockRecorder) CreateAccountInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockStore)(nil).CreateAccountInvitation), ctx, arg)
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockStore) CreateAlertRule(ctx context.Context, arg db.CreateAlertRuleParams) (db.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, arg)
+	ret0, _ := ret[0].(db.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockStoreMockRecorder) CreateAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockStore)(nil).CreateAlertRule), ctx, arg)
+}
+
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", ctx, arg)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), ctx, arg)
+}
+
+// CreateBalanceAdjustment mocks base method.
+func (m *MockStore) CreateBalanceAdjustment(ctx context.Context, arg db.CreateBalanceAdjustmentParams) (db.BalanceAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceAdjustment", ctx, arg)
+	ret0, _ := ret[0].(db.BalanceAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceAdjustment indicates an expected call of CreateBalanceAdjustment.
+func (mr *MockStoreMockRecorder) CreateBalanceAdjustment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceAdjustment", reflect.TypeOf((*MockStore)(nil).CreateBalanceAdjustment), ctx, arg)
+}
+
+// CreateBusinessDay mocks base method.
+func (m *MockStore) CreateBusinessDay(ctx context.Context, businessDate pgtype.Date) (db.BusinessDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBusinessDay", ctx, businessDate)
+	ret0, _ := ret[0].(db.BusinessDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBusinessDay indicates an expected call of CreateBusinessDay.
+func (mr *MockStoreMockRecorder) CreateBusinessDay(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBusinessDay", reflect.TypeOf((*MockStore)(nil).CreateBusinessDay), ctx, businessDate)
+}
+
+// CreateCashOperation mocks base method.
+func (m *MockStore) CreateCashOperation(ctx context.Context, arg db.CreateCashOperationParams) (db.CashOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCashOperation", ctx, arg)
+	ret0, _ := ret[0].(db.CashOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCashOperation indicates an expected call of CreateCashOperation.
+func (mr *MockStoreMockRecorder) CreateCashOperation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCashOperation", reflect.TypeOf((*MockStore)(nil).CreateCashOperation), ctx, arg)
+}
+
+// CreateCategoryRule mocks base method.
+func (m *MockStore) CreateCategoryRule(ctx context.Context, arg db.CreateCategoryRuleParams) (db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, arg)
+	ret0, _ := ret[0].(db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockStoreMockRecorder) CreateCategoryRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockStore)(nil).CreateCategoryRule), ctx, arg)
+}
+
+// CreateDailyTotal mocks base method.
+func (m *MockStore) CreateDailyTotal(ctx context.Context, arg db.CreateDailyTotalParams) (db.DailyTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDailyTotal", ctx, arg)
+	ret0, _ := ret[0].(db.DailyTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDailyTotal indicates an expected call of CreateDailyTotal.
+func (mr *MockStoreMockRecorder) CreateDailyTotal(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDailyTotal", reflect.TypeOf((*MockStore)(nil).CreateDailyTotal), ctx, arg)
+}
+
+// CreateDataExport mocks base method.
+func (m *MockStore) CreateDataExport(ctx context.Context, username string) (db.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExport", ctx, username)
+	ret0, _ := ret[0].(db.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockStoreMockRecorder) CreateDataExport(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*MockStore)(nil).CreateDataExport), ctx, username)
+}
+
+// CreateDispute mocks base method.
+func (m *MockStore) CreateDispute(ctx context.Context, arg db.CreateDisputeParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDispute", ctx, arg)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDispute indicates an expected call of CreateDispute.
+func (mr *MockStoreMockRecorder) CreateDispute(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDispute", reflect.TypeOf((*MockStore)(nil).CreateDispute), ctx, arg)
+}
+
+// CreateEntry mocks base method.
+func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, arg)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
+}
+
+// CreateExternalStatement mocks base method.
+func (m *MockStore) CreateExternalStatement(ctx context.Context, arg db.CreateExternalStatementParams) (db.ExternalStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalStatement", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalStatement indicates an expected call of CreateExternalStatement.
+func (mr *MockStoreMockRecorder) CreateExternalStatement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalStatement", reflect.TypeOf((*MockStore)(nil).CreateExternalStatement), ctx, arg)
+}
+
+// CreateExternalStatementLine mocks base method.
+func (m *MockStore) CreateExternalStatementLine(ctx context.Context, arg db.CreateExternalStatementLineParams) (db.ExternalStatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalStatementLine", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalStatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalStatementLine indicates an expected call of CreateExternalStatementLine.
+func (mr *MockStoreMockRecorder) CreateExternalStatementLine(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalStatementLine", reflect.TypeOf((*MockStore)(nil).CreateExternalStatementLine), ctx, arg)
+}
+
+// CreateFraudRuleHit mocks base method.
+func (m *MockStore) CreateFraudRuleHit(ctx context.Context, arg db.CreateFraudRuleHitParams) (db.FraudRuleHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFraudRuleHit", ctx, arg)
+	ret0, _ := ret[0].(db.FraudRuleHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFraudRuleHit indicates an expected call of CreateFraudRuleHit.
+func (mr *MockStoreMockRecorder) CreateFraudRuleHit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFraudRuleHit", reflect.TypeOf((*MockStore)(nil).CreateFraudRuleHit), ctx, arg)
+}
+
+// CreateLedgerAccount mocks base method.
+func (m *MockStore) CreateLedgerAccount(ctx context.Context, arg db.CreateLedgerAccountParams) (db.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerAccount", ctx, arg)
+	ret0, _ := ret[0].(db.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLedgerAccount indicates an expected call of CreateLedgerAccount.
+func (mr *MockStoreMockRecorder) CreateLedgerAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerAccount", reflect.TypeOf((*MockStore)(nil).CreateLedgerAccount), ctx, arg)
+}
+
+// CreateLedgerEntry mocks base method.
+func (m *MockStore) CreateLedgerEntry(ctx context.Context, arg db.CreateLedgerEntryParams) (db.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerEntry", ctx, arg)
+	ret0, _ := ret[0].(db.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLedgerEntry indicates an expected call of CreateLedgerEntry.
+func (mr *MockStoreMockRecorder) CreateLedgerEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerEntry", reflect.TypeOf((*MockStore)(nil).CreateLedgerEntry), ctx, arg)
+}
+
+// CreateLoan mocks base method.
+func (m *MockStore) CreateLoan(ctx context.Context, arg db.CreateLoanParams) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoan", ctx, arg)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoan indicates an expected call of CreateLoan.
+func (mr *MockStoreMockRecorder) CreateLoan(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoan", reflect.TypeOf((*MockStore)(nil).CreateLoan), ctx, arg)
+}
+
+// CreateLoanInstallment mocks base method.
+func (m *MockStore) CreateLoanInstallment(ctx context.Context, arg db.CreateLoanInstallmentParams) (db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoanInstallment", ctx, arg)
+	ret0, _ := ret[0].(db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoanInstallment indicates an expected call of CreateLoanInstallment.
+func (mr *MockStoreMockRecorder) CreateLoanInstallment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoanInstallment", reflect.TypeOf((*MockStore)(nil).CreateLoanInstallment), ctx, arg)
+}
+
+// CreateNotificationDigestItem mocks base method.
+func (m *MockStore) CreateNotificationDigestItem(ctx context.Context, arg db.CreateNotificationDigestItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationDigestItem", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotificationDigestItem indicates an expected call of CreateNotificationDigestItem.
+func (mr *MockStoreMockRecorder) CreateNotificationDigestItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationDigestItem", reflect.TypeOf((*MockStore)(nil).CreateNotificationDigestItem), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockStore) CreateOutboxEvent(ctx context.Context, arg db.CreateOutboxEventParams) (db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockStoreMockRecorder) CreateOutboxEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockStore)(nil).CreateOutboxEvent), ctx, arg)
+}
+
+// CreatePotEntry mocks base method.
+func (m *MockStore) CreatePotEntry(ctx context.Context, arg db.CreatePotEntryParams) (db.PotEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePotEntry", ctx, arg)
+	ret0, _ := ret[0].(db.PotEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePotEntry indicates an expected call of CreatePotEntry.
+func (mr *MockStoreMockRecorder) CreatePotEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePotEntry", reflect.TypeOf((*MockStore)(nil).CreatePotEntry), ctx, arg)
+}
+
+// CreateSavingsPot mocks base method.
+func (m *MockStore) CreateSavingsPot(ctx context.Context, arg db.CreateSavingsPotParams) (db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavingsPot", ctx, arg)
+	ret0, _ := ret[0].(db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSavingsPot indicates an expected call of CreateSavingsPot.
+func (mr *MockStoreMockRecorder) CreateSavingsPot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavingsPot", reflect.TypeOf((*MockStore)(nil).CreateSavingsPot), ctx, arg)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+}
+
+// CreateTransferApproval mocks base method.
+func (m *MockStore) CreateTransferApproval(ctx context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferApproval indicates an expected call of CreateTransferApproval.
+func (mr *MockStoreMockRecorder) CreateTransferApproval(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferApproval", reflect.TypeOf((*MockStore)(nil).CreateTransferApproval), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockStore) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockStoreMockRecorder) CreateUserTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), ctx, arg)
+}
+
+// CreateVerificationEmail mocks base method.
+func (m *MockStore) CreateVerificationEmail(ctx context.Context, arg db.CreateVerificationEmailParams) (db.VerificationEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerificationEmail", ctx, arg)
+	ret0, _ := ret[0].(db.VerificationEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerificationEmail indicates an expected call of CreateVerificationEmail.
+func (mr *MockStoreMockRecorder) CreateVerificationEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerificationEmail", reflect.TypeOf((*MockStore)(nil).CreateVerificationEmail), ctx, arg)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockStore) CreateWebhook(ctx context.Context, arg db.CreateWebhookParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockStoreMockRecorder) CreateWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockStore)(nil).CreateWebhook), ctx, arg)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockStore) CreateWebhookDelivery(ctx context.Context, arg db.CreateWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockStoreMockRecorder) CreateWebhookDelivery(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockStore)(nil).CreateWebhookDelivery), ctx, arg)
+}
+
+// DecideTransferApproval mocks base method.
+func (m *MockStore) DecideTransferApproval(ctx context.Context, arg db.DecideTransferApprovalParams) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideTransferApproval indicates an expected call of DecideTransferApproval.
+func (mr *MockStoreMockRecorder) DecideTransferApproval(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideTransferApproval", reflect.TypeOf((*MockStore)(nil).DecideTransferApproval), ctx, arg)
+}
+
+// DecideTransferApprovalTx mocks base method.
+func (m *MockStore) DecideTransferApprovalTx(ctx context.Context, arg db.DecideTransferApprovalTxParams) (db.DecideTransferApprovalTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideTransferApprovalTx", ctx, arg)
+	ret0, _ := ret[0].(db.DecideTransferApprovalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideTransferApprovalTx indicates an expected call of DecideTransferApprovalTx.
+func (mr *MockStoreMockRecorder) DecideTransferApprovalTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideTransferApprovalTx", reflect.TypeOf((*MockStore)(nil).DecideTransferApprovalTx), ctx, arg)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+}
+
+// DeleteAccountHolder mocks base method.
+func (m *MockStore) DeleteAccountHolder(ctx context.Context, arg db.DeleteAccountHolderParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountHolder indicates an expected call of DeleteAccountHolder.
+func (mr *MockStoreMockRecorder) DeleteAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountHolder", reflect.TypeOf((*MockStore)(nil).DeleteAccountHolder), ctx, arg)
+}
+
+// DeleteAccountHoldersByUsername mocks base method.
+func (m *MockStore) DeleteAccountHoldersByUsername(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountHoldersByUsername", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountHoldersByUsername indicates an expected call of DeleteAccountHoldersByUsername.
+func (mr *MockStoreMockRecorder) DeleteAccountHoldersByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountHoldersByUsername", reflect.TypeOf((*MockStore)(nil).DeleteAccountHoldersByUsername), ctx, username)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockStore) DeleteAlertRule(ctx context.Context, arg db.DeleteAlertRuleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockStoreMockRecorder) DeleteAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockStore)(nil).DeleteAlertRule), ctx, arg)
+}
+
+// DeleteAlertRulesByUsername mocks base method.
+func (m *MockStore) DeleteAlertRulesByUsername(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRulesByUsername", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRulesByUsername indicates an expected call of DeleteAlertRulesByUsername.
+func (mr *MockStoreMockRecorder) DeleteAlertRulesByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRulesByUsername", reflect.TypeOf((*MockStore)(nil).DeleteAlertRulesByUsername), ctx, username)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockStore) DeleteCategoryRule(ctx context.Context, arg db.DeleteCategoryRuleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockStoreMockRecorder) DeleteCategoryRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockStore)(nil).DeleteCategoryRule), ctx, arg)
+}
+
+// DeleteCategoryRulesByUsername mocks base method.
+func (m *MockStore) DeleteCategoryRulesByUsername(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRulesByUsername", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryRulesByUsername indicates an expected call of DeleteCategoryRulesByUsername.
+func (mr *MockStoreMockRecorder) DeleteCategoryRulesByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRulesByUsername", reflect.TypeOf((*MockStore)(nil).DeleteCategoryRulesByUsername), ctx, username)
+}
+
+// DeleteDataExports mocks base method.
+func (m *MockStore) DeleteDataExports(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataExports", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataExports indicates an expected call of DeleteDataExports.
+func (mr *MockStoreMockRecorder) DeleteDataExports(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataExports", reflect.TypeOf((*MockStore)(nil).DeleteDataExports), ctx, username)
+}
+
+// DeleteEntryCategory mocks base method.
+func (m *MockStore) DeleteEntryCategory(ctx context.Context, entryID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntryCategory", ctx, entryID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEntryCategory indicates an expected call of DeleteEntryCategory.
+func (mr *MockStoreMockRecorder) DeleteEntryCategory(ctx, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntryCategory", reflect.TypeOf((*MockStore)(nil).DeleteEntryCategory), ctx, entryID)
+}
+
+// DeleteNotificationPreference mocks base method.
+func (m *MockStore) DeleteNotificationPreference(ctx context.Context, arg db.DeleteNotificationPreferenceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationPreference", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationPreference indicates an expected call of DeleteNotificationPreference.
+func (mr *MockStoreMockRecorder) DeleteNotificationPreference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationPreference", reflect.TypeOf((*MockStore)(nil).DeleteNotificationPreference), ctx, arg)
+}
+
+// DeleteNotificationPreferences mocks base method.
+func (m *MockStore) DeleteNotificationPreferences(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationPreferences", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationPreferences indicates an expected call of DeleteNotificationPreferences.
+func (mr *MockStoreMockRecorder) DeleteNotificationPreferences(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationPreferences", reflect.TypeOf((*MockStore)(nil).DeleteNotificationPreferences), ctx, username)
+}
+
+// DeletePendingAccountInvitationsByUser mocks base method.
+func (m *MockStore) DeletePendingAccountInvitationsByUser(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingAccountInvitationsByUser", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingAccountInvitationsByUser indicates an expected call of DeletePendingAccountInvitationsByUser.
+func (mr *MockStoreMockRecorder) DeletePendingAccountInvitationsByUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingAccountInvitationsByUser", reflect.TypeOf((*MockStore)(nil).DeletePendingAccountInvitationsByUser), ctx, username)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockStore) DeleteWebhook(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockStoreMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockStore)(nil).DeleteWebhook), ctx, id)
+}
+
+// DeleteWebhookDeliveriesByOwner mocks base method.
+func (m *MockStore) DeleteWebhookDeliveriesByOwner(ctx context.Context, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookDeliveriesByOwner", ctx, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookDeliveriesByOwner indicates an expected call of DeleteWebhookDeliveriesByOwner.
+func (mr *MockStoreMockRecorder) DeleteWebhookDeliveriesByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookDeliveriesByOwner", reflect.TypeOf((*MockStore)(nil).DeleteWebhookDeliveriesByOwner), ctx, owner)
+}
+
+// DeleteWebhooksByOwner mocks base method.
+func (m *MockStore) DeleteWebhooksByOwner(ctx context.Context, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhooksByOwner", ctx, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhooksByOwner indicates an expected call of DeleteWebhooksByOwner.
+func (mr *MockStoreMockRecorder) DeleteWebhooksByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhooksByOwner", reflect.TypeOf((*MockStore)(nil).DeleteWebhooksByOwner), ctx, owner)
+}
+
+// DisburseLoanTx mocks base method.
+func (m *MockStore) DisburseLoanTx(ctx context.Context, arg db.DisburseLoanTxParams) (db.DisburseLoanTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisburseLoanTx", ctx, arg)
+	ret0, _ := ret[0].(db.DisburseLoanTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisburseLoanTx indicates an expected call of DisburseLoanTx.
+func (mr *MockStoreMockRecorder) DisburseLoanTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisburseLoanTx", reflect.TypeOf((*MockStore)(nil).DisburseLoanTx), ctx, arg)
+}
+
+// EraseAuditEventClients mocks base method.
+func (m *MockStore) EraseAuditEventClients(ctx context.Context, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseAuditEventClients", ctx, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseAuditEventClients indicates an expected call of EraseAuditEventClients.
+func (mr *MockStoreMockRecorder) EraseAuditEventClients(ctx, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseAuditEventClients", reflect.TypeOf((*MockStore)(nil).EraseAuditEventClients), ctx, actor)
+}
+
+// EraseAuditEventFields mocks base method.
+func (m *MockStore) EraseAuditEventFields(ctx context.Context, arg db.EraseAuditEventFieldsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseAuditEventFields", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseAuditEventFields indicates an expected call of EraseAuditEventFields.
+func (mr *MockStoreMockRecorder) EraseAuditEventFields(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseAuditEventFields", reflect.TypeOf((*MockStore)(nil).EraseAuditEventFields), ctx, arg)
+}
+
+// EraseSessions mocks base method.
+func (m *MockStore) EraseSessions(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseSessions", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseSessions indicates an expected call of EraseSessions.
+func (mr *MockStoreMockRecorder) EraseSessions(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSessions", reflect.TypeOf((*MockStore)(nil).EraseSessions), ctx, username)
+}
+
+// EraseUser mocks base method.
+func (m *MockStore) EraseUser(ctx context.Context, arg db.EraseUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *MockStoreMockRecorder) EraseUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*MockStore)(nil).EraseUser), ctx, arg)
+}
+
+// EraseUserOutboxEmails mocks base method.
+func (m *MockStore) EraseUserOutboxEmails(ctx context.Context, arg db.EraseUserOutboxEmailsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserOutboxEmails", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUserOutboxEmails indicates an expected call of EraseUserOutboxEmails.
+func (mr *MockStoreMockRecorder) EraseUserOutboxEmails(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserOutboxEmails", reflect.TypeOf((*MockStore)(nil).EraseUserOutboxEmails), ctx, arg)
+}
+
+// EraseUserTx mocks base method.
+func (m *MockStore) EraseUserTx(ctx context.Context, arg db.EraseUserTxParams) (db.EraseUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.EraseUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseUserTx indicates an expected call of EraseUserTx.
+func (mr *MockStoreMockRecorder) EraseUserTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserTx", reflect.TypeOf((*MockStore)(nil).EraseUserTx), ctx, arg)
+}
+
+// EraseUserWebhookDeliveryEmails mocks base method.
+func (m *MockStore) EraseUserWebhookDeliveryEmails(ctx context.Context, arg db.EraseUserWebhookDeliveryEmailsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserWebhookDeliveryEmails", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUserWebhookDeliveryEmails indicates an expected call of EraseUserWebhookDeliveryEmails.
+func (mr *MockStoreMockRecorder) EraseUserWebhookDeliveryEmails(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserWebhookDeliveryEmails", reflect.TypeOf((*MockStore)(nil).EraseUserWebhookDeliveryEmails), ctx, arg)
+}
+
+// EraseVerificationEmails mocks base method.
+func (m *MockStore) EraseVerificationEmails(ctx context.Context, arg db.EraseVerificationEmailsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVerificationEmails", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseVerificationEmails indicates an expected call of EraseVerificationEmails.
+func (mr *MockStoreMockRecorder) EraseVerificationEmails(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVerificationEmails", reflect.TypeOf((*MockStore)(nil).EraseVerificationEmails), ctx, arg)
+}
+
+// ExpireTransferApprovals mocks base method.
+func (m *MockStore) ExpireTransferApprovals(ctx context.Context, arg db.ExpireTransferApprovalsParams) ([]db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransferApprovals", ctx, arg)
+	ret0, _ := ret[0].([]db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransferApprovals indicates an expected call of ExpireTransferApprovals.
+func (mr *MockStoreMockRecorder) ExpireTransferApprovals(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransferApprovals", reflect.TypeOf((*MockStore)(nil).ExpireTransferApprovals), ctx, arg)
+}
+
+// ExpireTransferApprovalsTx mocks base method.
+func (m *MockStore) ExpireTransferApprovalsTx(ctx context.Context, arg db.ExpireTransferApprovalsTxParams) ([]db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransferApprovalsTx", ctx, arg)
+	ret0, _ := ret[0].([]db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransferApprovalsTx indicates an expected call of ExpireTransferApprovalsTx.
+func (mr *MockStoreMockRecorder) ExpireTransferApprovalsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransferApprovalsTx", reflect.TypeOf((*MockStore)(nil).ExpireTransferApprovalsTx), ctx, arg)
+}
+
+// FailDataExport mocks base method.
+func (m *MockStore) FailDataExport(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailDataExport", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailDataExport indicates an expected call of FailDataExport.
+func (mr *MockStoreMockRecorder) FailDataExport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailDataExport", reflect.TypeOf((*MockStore)(nil).FailDataExport), ctx, id)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+}
+
+// GetAccountBalanceSnapshot mocks base method.
+func (m *MockStore) GetAccountBalanceSnapshot(ctx context.Context, arg db.GetAccountBalanceSnapshotParams) (db.AccountBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceSnapshot", ctx, arg)
+	ret0, _ := ret[0].(db.AccountBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceSnapshot indicates an expected call of GetAccountBalanceSnapshot.
+func (mr *MockStoreMockRecorder) GetAccountBalanceSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceSnapshot", reflect.TypeOf((*MockStore)(nil).GetAccountBalanceSnapshot), ctx, arg)
+}
+
+// GetAccountBalanceTotals mocks base method.
+func (m *MockStore) GetAccountBalanceTotals(ctx context.Context) ([]db.GetAccountBalanceTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceTotals", ctx)
+	ret0, _ := ret[0].([]db.GetAccountBalanceTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceTotals indicates an expected call of GetAccountBalanceTotals.
+func (mr *MockStoreMockRecorder) GetAccountBalanceTotals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceTotals", reflect.TypeOf((*MockStore)(nil).GetAccountBalanceTotals), ctx)
+}
+
+// GetAccountByNumber mocks base method.
+func (m *MockStore) GetAccountByNumber(ctx context.Context, number string) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByNumber", ctx, number)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByNumber indicates an expected call of GetAccountByNumber.
+func (mr *MockStoreMockRecorder) GetAccountByNumber(ctx, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockStore)(nil).GetAccountByNumber), ctx, number)
+}
+
+// GetAccountByOwnerAndCurrency mocks base method.
+func (m *MockStore) GetAccountByOwnerAndCurrency(ctx context.Context, arg db.GetAccountByOwnerAndCurrencyParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByOwnerAndCurrency", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByOwnerAndCurrency indicates an expected call of GetAccountByOwnerAndCurrency.
+func (mr *MockStoreMockRecorder) GetAccountByOwnerAndCurrency(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByOwnerAndCurrency", reflect.TypeOf((*MockStore)(nil).GetAccountByOwnerAndCurrency), ctx, arg)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+}
+
+// GetAccountHolder mocks base method.
+func (m *MockStore) GetAccountHolder(ctx context.Context, arg db.GetAccountHolderParams) (db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountHolder indicates an expected call of GetAccountHolder.
+func (mr *MockStoreMockRecorder) GetAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHolder", reflect.TypeOf((*MockStore)(nil).GetAccountHolder), ctx, arg)
+}
+
+// GetAccountInvitation mocks base method.
+func (m *MockStore) GetAccountInvitation(ctx context.Context, id int64) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitation", ctx, id)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitation indicates an expected call of GetAccountInvitation.
+func (mr *MockStoreMockRecorder) GetAccountInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitation", reflect.TypeOf((*MockStore)(nil).GetAccountInvitation), ctx, id)
+}
+
+// GetAlertRule mocks base method.
+func (m *MockStore) GetAlertRule(ctx context.Context, id int64) (db.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertRule", ctx, id)
+	ret0, _ := ret[0].(db.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertRule indicates an expected call of GetAlertRule.
+func (mr *MockStoreMockRecorder) GetAlertRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertRule", reflect.TypeOf((*MockStore)(nil).GetAlertRule), ctx, id)
+}
+
+// GetBusinessDay mocks base method.
+func (m *MockStore) GetBusinessDay(ctx context.Context, businessDate pgtype.Date) (db.BusinessDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBusinessDay", ctx, businessDate)
+	ret0, _ := ret[0].(db.BusinessDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBusinessDay indicates an expected call of GetBusinessDay.
+func (mr *MockStoreMockRecorder) GetBusinessDay(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBusinessDay", reflect.TypeOf((*MockStore)(nil).GetBusinessDay), ctx, businessDate)
+}
+
+// GetCashOperation mocks base method.
+func (m *MockStore) GetCashOperation(ctx context.Context, id int64) (db.CashOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCashOperation", ctx, id)
+	ret0, _ := ret[0].(db.CashOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCashOperation indicates an expected call of GetCashOperation.
+func (mr *MockStoreMockRecorder) GetCashOperation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCashOperation", reflect.TypeOf((*MockStore)(nil).GetCashOperation), ctx, id)
+}
+
+// GetDailyPostingTotals mocks base method.
+func (m *MockStore) GetDailyPostingTotals(ctx context.Context, businessDate pgtype.Date) ([]db.GetDailyPostingTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyPostingTotals", ctx, businessDate)
+	ret0, _ := ret[0].([]db.GetDailyPostingTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyPostingTotals indicates an expected call of GetDailyPostingTotals.
+func (mr *MockStoreMockRecorder) GetDailyPostingTotals(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyPostingTotals", reflect.TypeOf((*MockStore)(nil).GetDailyPostingTotals), ctx, businessDate)
+}
+
+// GetDataExport mocks base method.
+func (m *MockStore) GetDataExport(ctx context.Context, id int64) (db.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataExport", ctx, id)
+	ret0, _ := ret[0].(db.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockStoreMockRecorder) GetDataExport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockStore)(nil).GetDataExport), ctx, id)
+}
+
+// GetDebitTotalSince mocks base method.
+func (m *MockStore) GetDebitTotalSince(ctx context.Context, arg db.GetDebitTotalSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebitTotalSince", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebitTotalSince indicates an expected call of GetDebitTotalSince.
+func (mr *MockStoreMockRecorder) GetDebitTotalSince(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebitTotalSince", reflect.TypeOf((*MockStore)(nil).GetDebitTotalSince), ctx, arg)
+}
+
+// GetDispute mocks base method.
+func (m *MockStore) GetDispute(ctx context.Context, id int64) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispute", ctx, id)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispute indicates an expected call of GetDispute.
+func (mr *MockStoreMockRecorder) GetDispute(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispute", reflect.TypeOf((*MockStore)(nil).GetDispute), ctx, id)
+}
+
+// GetDisputeForUpdate mocks base method.
+func (m *MockStore) GetDisputeForUpdate(ctx context.Context, id int64) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputeForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDisputeForUpdate indicates an expected call of GetDisputeForUpdate.
+func (mr *MockStoreMockRecorder) GetDisputeForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputeForUpdate", reflect.TypeOf((*MockStore)(nil).GetDisputeForUpdate), ctx, id)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+}
+
+// GetExternalStatement mocks base method.
+func (m *MockStore) GetExternalStatement(ctx context.Context, id int64) (db.ExternalStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalStatement", ctx, id)
+	ret0, _ := ret[0].(db.ExternalStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalStatement indicates an expected call of GetExternalStatement.
+func (mr *MockStoreMockRecorder) GetExternalStatement(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalStatement", reflect.TypeOf((*MockStore)(nil).GetExternalStatement), ctx, id)
+}
+
+// GetExternalStatementByReference mocks base method.
+func (m *MockStore) GetExternalStatementByReference(ctx context.Context, arg db.GetExternalStatementByReferenceParams) (db.ExternalStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalStatementByReference", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalStatementByReference indicates an expected call of GetExternalStatementByReference.
+func (mr *MockStoreMockRecorder) GetExternalStatementByReference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalStatementByReference", reflect.TypeOf((*MockStore)(nil).GetExternalStatementByReference), ctx, arg)
+}
+
+// GetExternalStatementLine mocks base method.
+func (m *MockStore) GetExternalStatementLine(ctx context.Context, id int64) (db.ExternalStatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalStatementLine", ctx, id)
+	ret0, _ := ret[0].(db.ExternalStatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalStatementLine indicates an expected call of GetExternalStatementLine.
+func (mr *MockStoreMockRecorder) GetExternalStatementLine(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalStatementLine", reflect.TypeOf((*MockStore)(nil).GetExternalStatementLine), ctx, id)
+}
+
+// GetFirstUnpaidLoanInstallment mocks base method.
+func (m *MockStore) GetFirstUnpaidLoanInstallment(ctx context.Context, loanID int64) (db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstUnpaidLoanInstallment", ctx, loanID)
+	ret0, _ := ret[0].(db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstUnpaidLoanInstallment indicates an expected call of GetFirstUnpaidLoanInstallment.
+func (mr *MockStoreMockRecorder) GetFirstUnpaidLoanInstallment(ctx, loanID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstUnpaidLoanInstallment", reflect.TypeOf((*MockStore)(nil).GetFirstUnpaidLoanInstallment), ctx, loanID)
+}
+
+// GetLastClosedBusinessDay mocks base method.
+func (m *MockStore) GetLastClosedBusinessDay(ctx context.Context) (db.BusinessDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastClosedBusinessDay", ctx)
+	ret0, _ := ret[0].(db.BusinessDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastClosedBusinessDay indicates an expected call of GetLastClosedBusinessDay.
+func (mr *MockStoreMockRecorder) GetLastClosedBusinessDay(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastClosedBusinessDay", reflect.TypeOf((*MockStore)(nil).GetLastClosedBusinessDay), ctx)
+}
+
+// GetLastEntryID mocks base method.
+func (m *MockStore) GetLastEntryID(ctx context.Context, accountID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastEntryID", ctx, accountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastEntryID indicates an expected call of GetLastEntryID.
+func (mr *MockStoreMockRecorder) GetLastEntryID(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEntryID", reflect.TypeOf((*MockStore)(nil).GetLastEntryID), ctx, accountID)
+}
+
+// GetLatestSession mocks base method.
+func (m *MockStore) GetLatestSession(ctx context.Context, username string) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestSession", ctx, username)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestSession indicates an expected call of GetLatestSession.
+func (mr *MockStoreMockRecorder) GetLatestSession(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSession", reflect.TypeOf((*MockStore)(nil).GetLatestSession), ctx, username)
+}
+
+// GetLedgerAccountByCode mocks base method.
+func (m *MockStore) GetLedgerAccountByCode(ctx context.Context, arg db.GetLedgerAccountByCodeParams) (db.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerAccountByCode", ctx, arg)
+	ret0, _ := ret[0].(db.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerAccountByCode indicates an expected call of GetLedgerAccountByCode.
+func (mr *MockStoreMockRecorder) GetLedgerAccountByCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerAccountByCode", reflect.TypeOf((*MockStore)(nil).GetLedgerAccountByCode), ctx, arg)
+}
+
+// GetLedgerEntry mocks base method.
+func (m *MockStore) GetLedgerEntry(ctx context.Context, id int64) (db.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerEntry", ctx, id)
+	ret0, _ := ret[0].(db.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerEntry indicates an expected call of GetLedgerEntry.
+func (mr *MockStoreMockRecorder) GetLedgerEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerEntry", reflect.TypeOf((*MockStore)(nil).GetLedgerEntry), ctx, id)
+}
+
+// GetLoan mocks base method.
+func (m *MockStore) GetLoan(ctx context.Context, id int64) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", ctx, id)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockStoreMockRecorder) GetLoan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockStore)(nil).GetLoan), ctx, id)
+}
+
+// GetLoanForUpdate mocks base method.
+func (m *MockStore) GetLoanForUpdate(ctx context.Context, id int64) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanForUpdate indicates an expected call of GetLoanForUpdate.
+func (mr *MockStoreMockRecorder) GetLoanForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanForUpdate", reflect.TypeOf((*MockStore)(nil).GetLoanForUpdate), ctx, id)
+}
+
+// GetLoanInstallment mocks base method.
+func (m *MockStore) GetLoanInstallment(ctx context.Context, id int64) (db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanInstallment", ctx, id)
+	ret0, _ := ret[0].(db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanInstallment indicates an expected call of GetLoanInstallment.
+func (mr *MockStoreMockRecorder) GetLoanInstallment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanInstallment", reflect.TypeOf((*MockStore)(nil).GetLoanInstallment), ctx, id)
+}
+
+// GetLoanInstallmentForUpdate mocks base method.
+func (m *MockStore) GetLoanInstallmentForUpdate(ctx context.Context, id int64) (db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanInstallmentForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanInstallmentForUpdate indicates an expected call of GetLoanInstallmentForUpdate.
+func (mr *MockStoreMockRecorder) GetLoanInstallmentForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanInstallmentForUpdate", reflect.TypeOf((*MockStore)(nil).GetLoanInstallmentForUpdate), ctx, id)
+}
+
+// GetNotificationPreference mocks base method.
+func (m *MockStore) GetNotificationPreference(ctx context.Context, arg db.GetNotificationPreferenceParams) (db.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreference", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreference indicates an expected call of GetNotificationPreference.
+func (mr *MockStoreMockRecorder) GetNotificationPreference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreference", reflect.TypeOf((*MockStore)(nil).GetNotificationPreference), ctx, arg)
+}
+
+// GetOpeningBalance mocks base method.
+func (m *MockStore) GetOpeningBalance(ctx context.Context, arg db.GetOpeningBalanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpeningBalance", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpeningBalance indicates an expected call of GetOpeningBalance.
+func (mr *MockStoreMockRecorder) GetOpeningBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpeningBalance", reflect.TypeOf((*MockStore)(nil).GetOpeningBalance), ctx, arg)
+}
+
+// GetPostingTotals mocks base method.
+func (m *MockStore) GetPostingTotals(ctx context.Context) ([]db.GetPostingTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostingTotals", ctx)
+	ret0, _ := ret[0].([]db.GetPostingTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostingTotals indicates an expected call of GetPostingTotals.
+func (mr *MockStoreMockRecorder) GetPostingTotals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostingTotals", reflect.TypeOf((*MockStore)(nil).GetPostingTotals), ctx)
+}
+
+// GetSavingsPot mocks base method.
+func (m *MockStore) GetSavingsPot(ctx context.Context, id int64) (db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavingsPot", ctx, id)
+	ret0, _ := ret[0].(db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavingsPot indicates an expected call of GetSavingsPot.
+func (mr *MockStoreMockRecorder) GetSavingsPot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavingsPot", reflect.TypeOf((*MockStore)(nil).GetSavingsPot), ctx, id)
+}
+
+// GetSavingsPotForUpdate mocks base method.
+func (m *MockStore) GetSavingsPotForUpdate(ctx context.Context, id int64) (db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavingsPotForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavingsPotForUpdate indicates an expected call of GetSavingsPotForUpdate.
+func (mr *MockStoreMockRecorder) GetSavingsPotForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavingsPotForUpdate", reflect.TypeOf((*MockStore)(nil).GetSavingsPotForUpdate), ctx, id)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, id)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+}
+
+// GetSnapshotTotals mocks base method.
+func (m *MockStore) GetSnapshotTotals(ctx context.Context, businessDate pgtype.Date) ([]db.GetSnapshotTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotTotals", ctx, businessDate)
+	ret0, _ := ret[0].([]db.GetSnapshotTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotTotals indicates an expected call of GetSnapshotTotals.
+func (mr *MockStoreMockRecorder) GetSnapshotTotals(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotTotals", reflect.TypeOf((*MockStore)(nil).GetSnapshotTotals), ctx, businessDate)
+}
+
+// GetSpendingByCategory mocks base method.
+func (m *MockStore) GetSpendingByCategory(ctx context.Context, arg db.GetSpendingByCategoryParams) ([]db.GetSpendingByCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByCategory", ctx, arg)
+	ret0, _ := ret[0].([]db.GetSpendingByCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByCategory indicates an expected call of GetSpendingByCategory.
+func (mr *MockStoreMockRecorder) GetSpendingByCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByCategory", reflect.TypeOf((*MockStore)(nil).GetSpendingByCategory), ctx, arg)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+}
+
+// GetTransferAmountStats mocks base method.
+func (m *MockStore) GetTransferAmountStats(ctx context.Context, fromAccountID int64) (db.GetTransferAmountStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferAmountStats", ctx, fromAccountID)
+	ret0, _ := ret[0].(db.GetTransferAmountStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferAmountStats indicates an expected call of GetTransferAmountStats.
+func (mr *MockStoreMockRecorder) GetTransferAmountStats(ctx, fromAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferAmountStats", reflect.TypeOf((*MockStore)(nil).GetTransferAmountStats), ctx, fromAccountID)
+}
+
+// GetTransferApproval mocks base method.
+func (m *MockStore) GetTransferApproval(ctx context.Context, id int64) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApproval", ctx, id)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApproval indicates an expected call of GetTransferApproval.
+func (mr *MockStoreMockRecorder) GetTransferApproval(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApproval", reflect.TypeOf((*MockStore)(nil).GetTransferApproval), ctx, id)
+}
+
+// GetTransferApprovalForUpdate mocks base method.
+func (m *MockStore) GetTransferApprovalForUpdate(ctx context.Context, id int64) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApprovalForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApprovalForUpdate indicates an expected call of GetTransferApprovalForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferApprovalForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApprovalForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferApprovalForUpdate), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+}
+
+// GetVerificationEmail mocks base method.
+func (m *MockStore) GetVerificationEmail(ctx context.Context, id int64) (db.VerificationEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationEmail", ctx, id)
+	ret0, _ := ret[0].(db.VerificationEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationEmail indicates an expected call of GetVerificationEmail.
+func (mr *MockStoreMockRecorder) GetVerificationEmail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationEmail", reflect.TypeOf((*MockStore)(nil).GetVerificationEmail), ctx, id)
+}
+
+// GetWebhook mocks base method.
+func (m *MockStore) GetWebhook(ctx context.Context, id int64) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockStoreMockRecorder) GetWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockStore)(nil).GetWebhook), ctx, id)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockStore) GetWebhookDelivery(ctx context.Context, id int64) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, id)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockStoreMockRecorder) GetWebhookDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockStore)(nil).GetWebhookDelivery), ctx, id)
+}
+
+// ImportStatementTx mocks base method.
+func (m *MockStore) ImportStatementTx(ctx context.Context, arg db.ImportStatementTxParams) (db.ImportStatementTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportStatementTx", ctx, arg)
+	ret0, _ := ret[0].(db.ImportStatementTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportStatementTx indicates an expected call of ImportStatementTx.
+func (mr *MockStoreMockRecorder) ImportStatementTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportStatementTx", reflect.TypeOf((*MockStore)(nil).ImportStatementTx), ctx, arg)
+}
+
+// IsUserBlocked mocks base method.
+func (m *MockStore) IsUserBlocked(ctx context.Context, username string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserBlocked", ctx, username)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserBlocked indicates an expected call of IsUserBlocked.
+func (mr *MockStoreMockRecorder) IsUserBlocked(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserBlocked", reflect.TypeOf((*MockStore)(nil).IsUserBlocked), ctx, username)
+}
+
+// ListAccountHolders mocks base method.
+func (m *MockStore) ListAccountHolders(ctx context.Context, accountID int64) ([]db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountHolders", ctx, accountID)
+	ret0, _ := ret[0].([]db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountHolders indicates an expected call of ListAccountHolders.
+func (mr *MockStoreMockRecorder) ListAccountHolders(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountHolders", reflect.TypeOf((*MockStore)(nil).ListAccountHolders), ctx, accountID)
+}
+
+// ListAccountInvitations mocks base method.
+func (m *MockStore) ListAccountInvitations(ctx context.Context, arg db.ListAccountInvitationsParams) ([]db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountInvitations", ctx, arg)
+	ret0, _ := ret[0].([]db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountInvitations indicates an expected call of ListAccountInvitations.
+func (mr *MockStoreMockRecorder) ListAccountInvitations(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountInvitations", reflect.TypeOf((*MockStore)(nil).ListAccountInvitations), ctx, arg)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+}
+
+// ListAccountsByOwner mocks base method.
+func (m *MockStore) ListAccountsByOwner(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByOwner", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByOwner indicates an expected call of ListAccountsByOwner.
+func (mr *MockStoreMockRecorder) ListAccountsByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByOwner", reflect.TypeOf((*MockStore)(nil).ListAccountsByOwner), ctx, owner)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockStore) ListAlertRules(ctx context.Context, arg db.ListAlertRulesParams) ([]db.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx, arg)
+	ret0, _ := ret[0].([]db.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockStoreMockRecorder) ListAlertRules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockStore)(nil).ListAlertRules), ctx, arg)
+}
+
+// ListAlertRulesByAccount mocks base method.
+func (m *MockStore) ListAlertRulesByAccount(ctx context.Context, accountID int64) ([]db.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRulesByAccount", ctx, accountID)
+	ret0, _ := ret[0].([]db.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRulesByAccount indicates an expected call of ListAlertRulesByAccount.
+func (mr *MockStoreMockRecorder) ListAlertRulesByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRulesByAccount", reflect.TypeOf((*MockStore)(nil).ListAlertRulesByAccount), ctx, accountID)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockStore) ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStoreMockRecorder) ListAuditEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStore)(nil).ListAuditEvents), ctx, arg)
+}
+
+// ListBalanceAdjustments mocks base method.
+func (m *MockStore) ListBalanceAdjustments(ctx context.Context, accountID int64) ([]db.BalanceAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceAdjustments", ctx, accountID)
+	ret0, _ := ret[0].([]db.BalanceAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceAdjustments indicates an expected call of ListBalanceAdjustments.
+func (mr *MockStoreMockRecorder) ListBalanceAdjustments(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceAdjustments", reflect.TypeOf((*MockStore)(nil).ListBalanceAdjustments), ctx, accountID)
+}
+
+// ListCategoryRules mocks base method.
+func (m *MockStore) ListCategoryRules(ctx context.Context, username string) ([]db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryRules", ctx, username)
+	ret0, _ := ret[0].([]db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryRules indicates an expected call of ListCategoryRules.
+func (mr *MockStoreMockRecorder) ListCategoryRules(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRules", reflect.TypeOf((*MockStore)(nil).ListCategoryRules), ctx, username)
+}
+
+// ListDailyTotals mocks base method.
+func (m *MockStore) ListDailyTotals(ctx context.Context, businessDate pgtype.Date) ([]db.DailyTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyTotals", ctx, businessDate)
+	ret0, _ := ret[0].([]db.DailyTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyTotals indicates an expected call of ListDailyTotals.
+func (mr *MockStoreMockRecorder) ListDailyTotals(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyTotals", reflect.TypeOf((*MockStore)(nil).ListDailyTotals), ctx, businessDate)
+}
+
+// ListDigestRecipients mocks base method.
+func (m *MockStore) ListDigestRecipients(ctx context.Context, arg db.ListDigestRecipientsParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDigestRecipients", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDigestRecipients indicates an expected call of ListDigestRecipients.
+func (mr *MockStoreMockRecorder) ListDigestRecipients(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestRecipients", reflect.TypeOf((*MockStore)(nil).ListDigestRecipients), ctx, arg)
+}
+
+// ListDisputesByAccount mocks base method.
+func (m *MockStore) ListDisputesByAccount(ctx context.Context, arg db.ListDisputesByAccountParams) ([]db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputesByAccount", ctx, arg)
+	ret0, _ := ret[0].([]db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputesByAccount indicates an expected call of ListDisputesByAccount.
+func (mr *MockStoreMockRecorder) ListDisputesByAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputesByAccount", reflect.TypeOf((*MockStore)(nil).ListDisputesByAccount), ctx, arg)
+}
+
+// ListDisputesByOpener mocks base method.
+func (m *MockStore) ListDisputesByOpener(ctx context.Context, arg db.ListDisputesByOpenerParams) ([]db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputesByOpener", ctx, arg)
+	ret0, _ := ret[0].([]db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputesByOpener indicates an expected call of ListDisputesByOpener.
+func (mr *MockStoreMockRecorder) ListDisputesByOpener(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputesByOpener", reflect.TypeOf((*MockStore)(nil).ListDisputesByOpener), ctx, arg)
+}
+
+// ListDisputesByStatus mocks base method.
+func (m *MockStore) ListDisputesByStatus(ctx context.Context, arg db.ListDisputesByStatusParams) ([]db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputesByStatus", ctx, arg)
+	ret0, _ := ret[0].([]db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputesByStatus indicates an expected call of ListDisputesByStatus.
+func (mr *MockStoreMockRecorder) ListDisputesByStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputesByStatus", reflect.TypeOf((*MockStore)(nil).ListDisputesByStatus), ctx, arg)
+}
+
+// ListDueLoanInstallments mocks base method.
+func (m *MockStore) ListDueLoanInstallments(ctx context.Context, arg db.ListDueLoanInstallmentsParams) ([]db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueLoanInstallments", ctx, arg)
+	ret0, _ := ret[0].([]db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueLoanInstallments indicates an expected call of ListDueLoanInstallments.
+func (mr *MockStoreMockRecorder) ListDueLoanInstallments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueLoanInstallments", reflect.TypeOf((*MockStore)(nil).ListDueLoanInstallments), ctx, arg)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+}
+
+// ListEntriesAfter mocks base method.
+func (m *MockStore) ListEntriesAfter(ctx context.Context, arg db.ListEntriesAfterParams) ([]db.ListEntriesAfterRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesAfter", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEntriesAfterRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesAfter indicates an expected call of ListEntriesAfter.
+func (mr *MockStoreMockRecorder) ListEntriesAfter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesAfter", reflect.TypeOf((*MockStore)(nil).ListEntriesAfter), ctx, arg)
+}
+
+// ListEntriesByOwner mocks base method.
+func (m *MockStore) ListEntriesByOwner(ctx context.Context, owner string) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesByOwner", ctx, owner)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByOwner indicates an expected call of ListEntriesByOwner.
+func (mr *MockStoreMockRecorder) ListEntriesByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByOwner", reflect.TypeOf((*MockStore)(nil).ListEntriesByOwner), ctx, owner)
+}
+
+// ListExternalStatementLinesByStatus mocks base method.
+func (m *MockStore) ListExternalStatementLinesByStatus(ctx context.Context, arg db.ListExternalStatementLinesByStatusParams) ([]db.ExternalStatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalStatementLinesByStatus", ctx, arg)
+	ret0, _ := ret[0].([]db.ExternalStatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExternalStatementLinesByStatus indicates an expected call of ListExternalStatementLinesByStatus.
+func (mr *MockStoreMockRecorder) ListExternalStatementLinesByStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalStatementLinesByStatus", reflect.TypeOf((*MockStore)(nil).ListExternalStatementLinesByStatus), ctx, arg)
+}
+
+// ListFraudRuleHits mocks base method.
+func (m *MockStore) ListFraudRuleHits(ctx context.Context, transferID int64) ([]db.FraudRuleHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudRuleHits", ctx, transferID)
+	ret0, _ := ret[0].([]db.FraudRuleHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudRuleHits indicates an expected call of ListFraudRuleHits.
+func (mr *MockStoreMockRecorder) ListFraudRuleHits(ctx, transferID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudRuleHits", reflect.TypeOf((*MockStore)(nil).ListFraudRuleHits), ctx, transferID)
+}
+
+// ListLedgerAccounts mocks base method.
+func (m *MockStore) ListLedgerAccounts(ctx context.Context) ([]db.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerAccounts", ctx)
+	ret0, _ := ret[0].([]db.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerAccounts indicates an expected call of ListLedgerAccounts.
+func (mr *MockStoreMockRecorder) ListLedgerAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerAccounts", reflect.TypeOf((*MockStore)(nil).ListLedgerAccounts), ctx)
+}
+
+// ListLedgerEntries mocks base method.
+func (m *MockStore) ListLedgerEntries(ctx context.Context, arg db.ListLedgerEntriesParams) ([]db.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerEntries indicates an expected call of ListLedgerEntries.
+func (mr *MockStoreMockRecorder) ListLedgerEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerEntries", reflect.TypeOf((*MockStore)(nil).ListLedgerEntries), ctx, arg)
+}
+
+// ListLoanInstallments mocks base method.
+func (m *MockStore) ListLoanInstallments(ctx context.Context, loanID int64) ([]db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanInstallments", ctx, loanID)
+	ret0, _ := ret[0].([]db.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanInstallments indicates an expected call of ListLoanInstallments.
+func (mr *MockStoreMockRecorder) ListLoanInstallments(ctx, loanID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanInstallments", reflect.TypeOf((*MockStore)(nil).ListLoanInstallments), ctx, loanID)
+}
+
+// ListLoans mocks base method.
+func (m *MockStore) ListLoans(ctx context.Context, accountID int64) ([]db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoans", ctx, accountID)
+	ret0, _ := ret[0].([]db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoans indicates an expected call of ListLoans.
+func (mr *MockStoreMockRecorder) ListLoans(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoans", reflect.TypeOf((*MockStore)(nil).ListLoans), ctx, accountID)
+}
+
+// ListNotificationPreferences mocks base method.
+func (m *MockStore) ListNotificationPreferences(ctx context.Context, username string) ([]db.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationPreferences", ctx, username)
+	ret0, _ := ret[0].([]db.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationPreferences indicates an expected call of ListNotificationPreferences.
+func (mr *MockStoreMockRecorder) ListNotificationPreferences(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationPreferences", reflect.TypeOf((*MockStore)(nil).ListNotificationPreferences), ctx, username)
+}
+
+// ListPendingDigestItems mocks base method.
+func (m *MockStore) ListPendingDigestItems(ctx context.Context, username string) ([]db.NotificationDigestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingDigestItems", ctx, username)
+	ret0, _ := ret[0].([]db.NotificationDigestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingDigestItems indicates an expected call of ListPendingDigestItems.
+func (mr *MockStoreMockRecorder) ListPendingDigestItems(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingDigestItems", reflect.TypeOf((*MockStore)(nil).ListPendingDigestItems), ctx, username)
+}
+
+// ListPendingOutboxEvents mocks base method.
+func (m *MockStore) ListPendingOutboxEvents(ctx context.Context, limit int32) ([]db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboxEvents", ctx, limit)
+	ret0, _ := ret[0].([]db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboxEvents indicates an expected call of ListPendingOutboxEvents.
+func (mr *MockStoreMockRecorder) ListPendingOutboxEvents(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboxEvents", reflect.TypeOf((*MockStore)(nil).ListPendingOutboxEvents), ctx, limit)
+}
+
+// ListPendingTransferApprovals mocks base method.
+func (m *MockStore) ListPendingTransferApprovals(ctx context.Context, arg db.ListPendingTransferApprovalsParams) ([]db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingTransferApprovals", ctx, arg)
+	ret0, _ := ret[0].([]db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTransferApprovals indicates an expected call of ListPendingTransferApprovals.
+func (mr *MockStoreMockRecorder) ListPendingTransferApprovals(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransferApprovals", reflect.TypeOf((*MockStore)(nil).ListPendingTransferApprovals), ctx, arg)
+}
+
+// ListPotEntries mocks base method.
+func (m *MockStore) ListPotEntries(ctx context.Context, potID int64) ([]db.PotEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPotEntries", ctx, potID)
+	ret0, _ := ret[0].([]db.PotEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPotEntries indicates an expected call of ListPotEntries.
+func (mr *MockStoreMockRecorder) ListPotEntries(ctx, potID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPotEntries", reflect.TypeOf((*MockStore)(nil).ListPotEntries), ctx, potID)
+}
+
+// ListRecentEntries mocks base method.
+func (m *MockStore) ListRecentEntries(ctx context.Context, arg db.ListRecentEntriesParams) ([]db.ListRecentEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.ListRecentEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentEntries indicates an expected call of ListRecentEntries.
+func (mr *MockStoreMockRecorder) ListRecentEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentEntries", reflect.TypeOf((*MockStore)(nil).ListRecentEntries), ctx, arg)
+}
+
+// ListReconciliationCandidates mocks base method.
+func (m *MockStore) ListReconciliationCandidates(ctx context.Context, arg db.ListReconciliationCandidatesParams) ([]db.ListReconciliationCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliationCandidates", ctx, arg)
+	ret0, _ := ret[0].([]db.ListReconciliationCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliationCandidates indicates an expected call of ListReconciliationCandidates.
+func (mr *MockStoreMockRecorder) ListReconciliationCandidates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliationCandidates", reflect.TypeOf((*MockStore)(nil).ListReconciliationCandidates), ctx, arg)
+}
+
+// ListSavingsPots mocks base method.
+func (m *MockStore) ListSavingsPots(ctx context.Context, accountID int64) ([]db.SavingsPot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavingsPots", ctx, accountID)
+	ret0, _ := ret[0].([]db.SavingsPot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavingsPots indicates an expected call of ListSavingsPots.
+func (mr *MockStoreMockRecorder) ListSavingsPots(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavingsPots", reflect.TypeOf((*MockStore)(nil).ListSavingsPots), ctx, accountID)
+}
+
+// ListSessionsByUsername mocks base method.
+func (m *MockStore) ListSessionsByUsername(ctx context.Context, username string) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionsByUsername", ctx, username)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionsByUsername indicates an expected call of ListSessionsByUsername.
+func (mr *MockStoreMockRecorder) ListSessionsByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionsByUsername", reflect.TypeOf((*MockStore)(nil).ListSessionsByUsername), ctx, username)
+}
+
+// ListStatementEntries mocks base method.
+func (m *MockStore) ListStatementEntries(ctx context.Context, arg db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatementEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.ListStatementEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatementEntries indicates an expected call of ListStatementEntries.
+func (mr *MockStoreMockRecorder) ListStatementEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementEntries", reflect.TypeOf((*MockStore)(nil).ListStatementEntries), ctx, arg)
+}
+
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+}
+
+// ListTransfersByOwner mocks base method.
+func (m *MockStore) ListTransfersByOwner(ctx context.Context, owner string) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfersByOwner", ctx, owner)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfersByOwner indicates an expected call of ListTransfersByOwner.
+func (mr *MockStoreMockRecorder) ListTransfersByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByOwner", reflect.TypeOf((*MockStore)(nil).ListTransfersByOwner), ctx, owner)
+}
+
+// ListTransfersByOwnerPage mocks base method.
+func (m *MockStore) ListTransfersByOwnerPage(ctx context.Context, arg db.ListTransfersByOwnerPageParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfersByOwnerPage", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
+// ListTransfersByOwnerPage indicates an expected call of ListTransfersByOwnerPage.
+func (mr *MockStoreMockRecorder) ListTransfersByOwnerPage(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByOwnerPage", reflect.TypeOf((*MockStore)(nil).ListTransfersByOwnerPage), ctx, arg)
 }
 
-// CreateAccount mocks base method.
-func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+// ListTransfersByStatus mocks base method.
+func (m *MockStore) ListTransfersByStatus(ctx context.Context, arg db.ListTransfersByStatusParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ListTransfersByStatus", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+// ListTransfersByStatus indicates an expected call of ListTransfersByStatus.
+func (mr *MockStoreMockRecorder) ListTransfersByStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByStatus", reflect.TypeOf((*MockStore)(nil).ListTransfersByStatus), ctx, arg)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", ctx, arg)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+// ListVerificationEmailsByUsername mocks base method.
+func (m *MockStore) ListVerificationEmailsByUsername(ctx context.Context, username string) ([]db.VerificationEmail, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ListVerificationEmailsByUsername", ctx, username)
+	ret0, _ := ret[0].([]db.VerificationEmail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+// ListVerificationEmailsByUsername indicates an expected call of ListVerificationEmailsByUsername.
+func (mr *MockStoreMockRecorder) ListVerificationEmailsByUsername(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVerificationEmailsByUsername", reflect.TypeOf((*MockStore)(nil).ListVerificationEmailsByUsername), ctx, username)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+// ListWebhookDeliveries mocks base method.
+func (m *MockStore) ListWebhookDeliveries(ctx context.Context, arg db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ListWebhookDeliveries(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ListWebhookDeliveries), ctx, arg)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// ListWebhooks mocks base method.
+func (m *MockStore) ListWebhooks(ctx context.Context, owner string) ([]db.Webhook, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx, owner)
+	ret0, _ := ret[0].([]db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockStoreMockRecorder) ListWebhooks(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockStore)(nil).ListWebhooks), ctx, owner)
+}
+
+// ListWebhooksForEvent mocks base method.
+func (m *MockStore) ListWebhooksForEvent(ctx context.Context, arg db.ListWebhooksForEventParams) ([]db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooksForEvent", ctx, arg)
+	ret0, _ := ret[0].([]db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooksForEvent indicates an expected call of ListWebhooksForEvent.
+func (mr *MockStoreMockRecorder) ListWebhooksForEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksForEvent", reflect.TypeOf((*MockStore)(nil).ListWebhooksForEvent), ctx, arg)
+}
+
+// LockPostings mocks base method.
+func (m *MockStore) LockPostings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPostings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPostings indicates an expected call of LockPostings.
+func (mr *MockStoreMockRecorder) LockPostings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPostings", reflect.TypeOf((*MockStore)(nil).LockPostings), ctx)
+}
+
+// LockUser mocks base method.
+func (m *MockStore) LockUser(ctx context.Context, arg db.LockUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUser", ctx, arg)
 	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+// LockUser indicates an expected call of LockUser.
+func (mr *MockStoreMockRecorder) LockUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockStore)(nil).LockUser), ctx, arg)
 }
 
-// CreateUserTx mocks base method.
-func (m *MockStore) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+// LockUserTx mocks base method.
+func (m *MockStore) LockUserTx(ctx context.Context, arg db.LockUserTxParams) (db.LockUserTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserTx", ctx, arg)
-	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret := m.ctrl.Call(m, "LockUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.LockUserTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserTx indicates an expected call of CreateUserTx.
-func (mr *MockStoreMockRecorder) CreateUserTx(ctx, arg any) *gomock.Call {
+// LockUserTx indicates an expected call of LockUserTx.
+func (mr *MockStoreMockRecorder) LockUserTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserTx", reflect.TypeOf((*MockStore)(nil).LockUserTx), ctx, arg)
 }
 
-// CreateVerificationEmail mocks base method.
-func (m *MockStore) CreateVerificationEmail(ctx context.Context, arg db.CreateVerificationEmailParams) (db.VerificationEmail, error) {
+// MarkDigestItemsSent mocks base method.
+func (m *MockStore) MarkDigestItemsSent(ctx context.Context, arg db.MarkDigestItemsSentParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerificationEmail", ctx, arg)
-	ret0, _ := ret[0].(db.VerificationEmail)
+	ret := m.ctrl.Call(m, "MarkDigestItemsSent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDigestItemsSent indicates an expected call of MarkDigestItemsSent.
+func (mr *MockStoreMockRecorder) MarkDigestItemsSent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestItemsSent", reflect.TypeOf((*MockStore)(nil).MarkDigestItemsSent), ctx, arg)
+}
+
+// MarkLoanInstallmentOverdue mocks base method.
+func (m *MockStore) MarkLoanInstallmentOverdue(ctx context.Context, arg db.MarkLoanInstallmentOverdueParams) (db.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLoanInstallmentOverdue", ctx, arg)
+	ret0, _ := ret[0].(db.LoanInstallment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerificationEmail indicates an expected call of CreateVerificationEmail.
-func (mr *MockStoreMockRecorder) CreateVerificationEmail(ctx, arg any) *gomock.Call {
+// MarkLoanInstallmentOverdue indicates an expected call of MarkLoanInstallmentOverdue.
+func (mr *MockStoreMockRecorder) MarkLoanInstallmentOverdue(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerificationEmail", reflect.TypeOf((*MockStore)(nil).CreateVerificationEmail), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLoanInstallmentOverdue", reflect.TypeOf((*MockStore)(nil).MarkLoanInstallmentOverdue), ctx, arg)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+// MarkOutboxEventSent mocks base method.
+func (m *MockStore) MarkOutboxEventSent(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret := m.ctrl.Call(m, "MarkOutboxEventSent", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+// MarkOutboxEventSent indicates an expected call of MarkOutboxEventSent.
+func (mr *MockStoreMockRecorder) MarkOutboxEventSent(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventSent", reflect.TypeOf((*MockStore)(nil).MarkOutboxEventSent), ctx, id)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+// MatchExternalStatementLine mocks base method.
+func (m *MockStore) MatchExternalStatementLine(ctx context.Context, arg db.MatchExternalStatementLineParams) (db.ExternalStatementLine, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "MatchExternalStatementLine", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalStatementLine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+// MatchExternalStatementLine indicates an expected call of MatchExternalStatementLine.
+func (mr *MockStoreMockRecorder) MatchExternalStatementLine(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchExternalStatementLine", reflect.TypeOf((*MockStore)(nil).MatchExternalStatementLine), ctx, arg)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+// MovePotMoneyTx mocks base method.
+func (m *MockStore) MovePotMoneyTx(ctx context.Context, arg db.MovePotMoneyTxParams) (db.MovePotMoneyTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "MovePotMoneyTx", ctx, arg)
+	ret0, _ := ret[0].(db.MovePotMoneyTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+// MovePotMoneyTx indicates an expected call of MovePotMoneyTx.
+func (mr *MockStoreMockRecorder) MovePotMoneyTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePotMoneyTx", reflect.TypeOf((*MockStore)(nil).MovePotMoneyTx), ctx, arg)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+// NotifyAccountActivity mocks base method.
+func (m *MockStore) NotifyAccountActivity(ctx context.Context, accountID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "NotifyAccountActivity", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyAccountActivity indicates an expected call of NotifyAccountActivity.
+func (mr *MockStoreMockRecorder) NotifyAccountActivity(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyAccountActivity", reflect.TypeOf((*MockStore)(nil).NotifyAccountActivity), ctx, accountID)
+}
+
+// OpenDisputeTx mocks base method.
+func (m *MockStore) OpenDisputeTx(ctx context.Context, arg db.OpenDisputeTxParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDisputeTx", ctx, arg)
+	ret0, _ := ret[0].(db.Dispute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+// OpenDisputeTx indicates an expected call of OpenDisputeTx.
+func (mr *MockStoreMockRecorder) OpenDisputeTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDisputeTx", reflect.TypeOf((*MockStore)(nil).OpenDisputeTx), ctx, arg)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+// PayLoanInstallment mocks base method.
+func (m *MockStore) PayLoanInstallment(ctx context.Context, arg db.PayLoanInstallmentParams) (db.LoanInstallment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, id)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "PayLoanInstallment", ctx, arg)
+	ret0, _ := ret[0].(db.LoanInstallment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+// PayLoanInstallment indicates an expected call of PayLoanInstallment.
+func (mr *MockStoreMockRecorder) PayLoanInstallment(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayLoanInstallment", reflect.TypeOf((*MockStore)(nil).PayLoanInstallment), ctx, arg)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+// PayOffLoan mocks base method.
+func (m *MockStore) PayOffLoan(ctx context.Context, id int64) (db.Loan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "PayOffLoan", ctx, id)
+	ret0, _ := ret[0].(db.Loan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+// PayOffLoan indicates an expected call of PayOffLoan.
+func (mr *MockStoreMockRecorder) PayOffLoan(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOffLoan", reflect.TypeOf((*MockStore)(nil).PayOffLoan), ctx, id)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+// RearmAlertRule mocks base method.
+func (m *MockStore) RearmAlertRule(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, username)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "RearmAlertRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RearmAlertRule indicates an expected call of RearmAlertRule.
+func (mr *MockStoreMockRecorder) RearmAlertRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RearmAlertRule", reflect.TypeOf((*MockStore)(nil).RearmAlertRule), ctx, id)
+}
+
+// RelayOutboxTx mocks base method.
+func (m *MockStore) RelayOutboxTx(ctx context.Context, arg db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutboxTx", ctx, arg)
+	ret0, _ := ret[0].(db.RelayOutboxTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+// RelayOutboxTx indicates an expected call of RelayOutboxTx.
+func (mr *MockStoreMockRecorder) RelayOutboxTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxTx", reflect.TypeOf((*MockStore)(nil).RelayOutboxTx), ctx, arg)
 }
 
-// GetVerificationEmail mocks base method.
-func (m *MockStore) GetVerificationEmail(ctx context.Context, id int64) (db.VerificationEmail, error) {
+// RepayLoan mocks base method.
+func (m *MockStore) RepayLoan(ctx context.Context, arg db.RepayLoanParams) (db.Loan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVerificationEmail", ctx, id)
-	ret0, _ := ret[0].(db.VerificationEmail)
+	ret := m.ctrl.Call(m, "RepayLoan", ctx, arg)
+	ret0, _ := ret[0].(db.Loan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVerificationEmail indicates an expected call of GetVerificationEmail.
-func (mr *MockStoreMockRecorder) GetVerificationEmail(ctx, id any) *gomock.Call {
+// RepayLoan indicates an expected call of RepayLoan.
+func (mr *MockStoreMockRecorder) RepayLoan(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationEmail", reflect.TypeOf((*MockStore)(nil).GetVerificationEmail), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepayLoan", reflect.TypeOf((*MockStore)(nil).RepayLoan), ctx, arg)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+// ResolveDispute mocks base method.
+func (m *MockStore) ResolveDispute(ctx context.Context, arg db.ResolveDisputeParams) (db.Dispute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "ResolveDispute", ctx, arg)
+	ret0, _ := ret[0].(db.Dispute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+// ResolveDispute indicates an expected call of ResolveDispute.
+func (mr *MockStoreMockRecorder) ResolveDispute(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDispute", reflect.TypeOf((*MockStore)(nil).ResolveDispute), ctx, arg)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// ResolveDisputeTx mocks base method.
+func (m *MockStore) ResolveDisputeTx(ctx context.Context, arg db.ResolveDisputeTxParams) (db.ResolveDisputeTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "ResolveDisputeTx", ctx, arg)
+	ret0, _ := ret[0].(db.ResolveDisputeTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+// ResolveDisputeTx indicates an expected call of ResolveDisputeTx.
+func (mr *MockStoreMockRecorder) ResolveDisputeTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDisputeTx", reflect.TypeOf((*MockStore)(nil).ResolveDisputeTx), ctx, arg)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+// ReviewHeldTransfer mocks base method.
+func (m *MockStore) ReviewHeldTransfer(ctx context.Context, arg db.ReviewHeldTransferParams) (db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "ReviewHeldTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+// ReviewHeldTransfer indicates an expected call of ReviewHeldTransfer.
+func (mr *MockStoreMockRecorder) ReviewHeldTransfer(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewHeldTransfer", reflect.TypeOf((*MockStore)(nil).ReviewHeldTransfer), ctx, arg)
+}
+
+// ReviewTransferTx mocks base method.
+func (m *MockStore) ReviewTransferTx(ctx context.Context, arg db.ReviewTransferTxParams) (db.TransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewTransferTx", ctx, arg)
+	ret0, _ := ret[0].(db.TransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewTransferTx indicates an expected call of ReviewTransferTx.
+func (mr *MockStoreMockRecorder) ReviewTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTransferTx", reflect.TypeOf((*MockStore)(nil).ReviewTransferTx), ctx, arg)
+}
+
+// SetEntryCategory mocks base method.
+func (m *MockStore) SetEntryCategory(ctx context.Context, arg db.SetEntryCategoryParams) (db.EntryCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEntryCategory", ctx, arg)
+	ret0, _ := ret[0].(db.EntryCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEntryCategory indicates an expected call of SetEntryCategory.
+func (mr *MockStoreMockRecorder) SetEntryCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEntryCategory", reflect.TypeOf((*MockStore)(nil).SetEntryCategory), ctx, arg)
+}
+
+// StartDisputeInvestigation mocks base method.
+func (m *MockStore) StartDisputeInvestigation(ctx context.Context, arg db.StartDisputeInvestigationParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartDisputeInvestigation", ctx, arg)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDisputeInvestigation indicates an expected call of StartDisputeInvestigation.
+func (mr *MockStoreMockRecorder) StartDisputeInvestigation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDisputeInvestigation", reflect.TypeOf((*MockStore)(nil).StartDisputeInvestigation), ctx, arg)
+}
+
+// StartDisputeInvestigationTx mocks base method.
+func (m *MockStore) StartDisputeInvestigationTx(ctx context.Context, arg db.StartDisputeInvestigationTxParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartDisputeInvestigationTx", ctx, arg)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDisputeInvestigationTx indicates an expected call of StartDisputeInvestigationTx.
+func (mr *MockStoreMockRecorder) StartDisputeInvestigationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDisputeInvestigationTx", reflect.TypeOf((*MockStore)(nil).StartDisputeInvestigationTx), ctx, arg)
 }
 
 // TransferTx mocks base method.
@@ -341,6 +2941,36 @@ func (mr *MockStoreMockRecorder) TransferTx(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), ctx, arg)
 }
 
+// TriggerAlertRule mocks base method.
+func (m *MockStore) TriggerAlertRule(ctx context.Context, arg db.TriggerAlertRuleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerAlertRule", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerAlertRule indicates an expected call of TriggerAlertRule.
+func (mr *MockStoreMockRecorder) TriggerAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerAlertRule", reflect.TypeOf((*MockStore)(nil).TriggerAlertRule), ctx, arg)
+}
+
+// UnlockUser mocks base method.
+func (m *MockStore) UnlockUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockStoreMockRecorder) UnlockUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockStore)(nil).UnlockUser), ctx, username)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +2986,21 @@ func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
 }
 
+// UpdateAccountInvitationStatus mocks base method.
+func (m *MockStore) UpdateAccountInvitationStatus(ctx context.Context, arg db.UpdateAccountInvitationStatusParams) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountInvitationStatus", ctx, arg)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountInvitationStatus indicates an expected call of UpdateAccountInvitationStatus.
+func (mr *MockStoreMockRecorder) UpdateAccountInvitationStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountInvitationStatus", reflect.TypeOf((*MockStore)(nil).UpdateAccountInvitationStatus), ctx, arg)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +3031,36 @@ func (mr *MockStoreMockRecorder) UpdateVerificationEmail(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerificationEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerificationEmail), ctx, arg)
 }
 
+// UpdateWebhookDeliveryAttempt mocks base method.
+func (m *MockStore) UpdateWebhookDeliveryAttempt(ctx context.Context, arg db.UpdateWebhookDeliveryAttemptParams) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDeliveryAttempt", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookDeliveryAttempt indicates an expected call of UpdateWebhookDeliveryAttempt.
+func (mr *MockStoreMockRecorder) UpdateWebhookDeliveryAttempt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDeliveryAttempt", reflect.TypeOf((*MockStore)(nil).UpdateWebhookDeliveryAttempt), ctx, arg)
+}
+
+// UpsertNotificationPreference mocks base method.
+func (m *MockStore) UpsertNotificationPreference(ctx context.Context, arg db.UpsertNotificationPreferenceParams) (db.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreference", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertNotificationPreference indicates an expected call of UpsertNotificationPreference.
+func (mr *MockStoreMockRecorder) UpsertNotificationPreference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreference", reflect.TypeOf((*MockStore)(nil).UpsertNotificationPreference), ctx, arg)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(ctx context.Context, arg db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
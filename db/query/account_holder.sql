@@ -0,0 +1,25 @@
+-- name: CreateAccountHolder :one
+INSERT INTO account_holders (
+  account_id,
+  username,
+  role
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetAccountHolder :one
+SELECT * FROM account_holders
+WHERE account_id = $1 AND username = $2 LIMIT 1;
+
+-- name: ListAccountHolders :many
+SELECT * FROM account_holders
+WHERE account_id = $1
+ORDER BY created_at;
+
+-- name: DeleteAccountHolder :exec
+DELETE FROM account_holders
+WHERE account_id = $1 AND username = $2;
+
+-- name: DeleteAccountHoldersByUsername :exec
+DELETE FROM account_holders
+WHERE username = $1;
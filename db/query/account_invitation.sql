@@ -0,0 +1,32 @@
+-- name: CreateAccountInvitation :one
+INSERT INTO account_invitations (
+  account_id,
+  inviter,
+  invitee,
+  role
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetAccountInvitation :one
+SELECT * FROM account_invitations
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAccountInvitations :many
+SELECT * FROM account_invitations
+WHERE invitee = $1 AND status = 'pending'
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdateAccountInvitationStatus :one
+UPDATE account_invitations
+SET
+  status = $2,
+  responded_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: DeletePendingAccountInvitationsByUser :exec
+DELETE FROM account_invitations
+WHERE (inviter = sqlc.arg(username) OR invitee = sqlc.arg(username)) AND status = 'pending';
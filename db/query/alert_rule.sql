@@ -0,0 +1,44 @@
+-- name: CreateAlertRule :one
+INSERT INTO alert_rules (
+  account_id,
+  username,
+  kind,
+  threshold
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetAlertRule :one
+SELECT * FROM alert_rules
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAlertRules :many
+SELECT * FROM alert_rules
+WHERE account_id = $1 AND username = $2
+ORDER BY id;
+
+-- name: ListAlertRulesByAccount :many
+SELECT * FROM alert_rules
+WHERE account_id = $1
+ORDER BY id;
+
+-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rules
+WHERE id = $1 AND account_id = $2 AND username = $3;
+
+-- name: DeleteAlertRulesByUsername :exec
+DELETE FROM alert_rules
+WHERE username = $1;
+
+-- name: TriggerAlertRule :execrows
+-- Marks the rule as triggered unless it already was. A rule triggered before rearm_before counts as rearmed,
+-- an empty rearm_before keeps the rule silent until RearmAlertRule is called.
+UPDATE alert_rules
+SET triggered_at = now()
+WHERE id = sqlc.arg(id)
+  AND (triggered_at IS NULL OR triggered_at < sqlc.narg(rearm_before));
+
+-- name: RearmAlertRule :exec
+UPDATE alert_rules
+SET triggered_at = NULL
+WHERE id = $1 AND triggered_at IS NOT NULL;
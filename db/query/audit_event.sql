@@ -0,0 +1,49 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+  actor,
+  actor_role,
+  action,
+  target_type,
+  target_id,
+  diff,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+  (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor)) AND
+  (sqlc.narg(target_type)::varchar IS NULL OR target_type = sqlc.narg(target_type)) AND
+  (sqlc.narg(target_id)::varchar IS NULL OR target_id = sqlc.narg(target_id)) AND
+  (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time)) AND
+  (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: AllowAuditEventErasure :exec
+-- lets the current transaction replace the personal data in the audit diffs
+SELECT set_config('simplebank.erase_personal_data', 'on', true);
+
+-- name: EraseAuditEventFields :exec
+UPDATE audit_events
+SET diff = (
+  SELECT jsonb_object_agg(
+    key,
+    CASE WHEN key = ANY(sqlc.arg(fields)::varchar[])
+      THEN jsonb_build_object('before', sqlc.arg(erased_value)::varchar, 'after', sqlc.arg(erased_value)::varchar)
+      ELSE value
+    END
+  )
+  FROM jsonb_each(diff)
+)
+WHERE target_type = 'user' AND target_id = sqlc.arg(username) AND diff ?| sqlc.arg(fields)::varchar[];
+
+-- name: EraseAuditEventClients :exec
+-- the client address and user agent identify the user in the events the user made
+UPDATE audit_events
+SET client_ip = '', user_agent = ''
+WHERE actor = $1 AND (client_ip <> '' OR user_agent <> '');
@@ -0,0 +1,84 @@
+-- name: GetLastClosedBusinessDay :one
+SELECT * FROM business_days
+ORDER BY business_date DESC
+LIMIT 1;
+
+-- name: GetBusinessDay :one
+SELECT * FROM business_days
+WHERE business_date = $1 LIMIT 1;
+
+-- name: CreateBusinessDay :one
+INSERT INTO business_days (
+  business_date
+) VALUES (
+  $1
+) RETURNING *;
+
+-- name: LockPostings :exec
+LOCK TABLE entries, ledger_entries IN SHARE MODE;
+
+-- name: CreateAccountBalanceSnapshots :execrows
+INSERT INTO account_balance_snapshots (business_date, account_id, currency, closing_balance)
+SELECT
+  sqlc.arg(business_date)::date,
+  a.id,
+  a.currency,
+  a.balance - COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.account_id = a.id AND e.business_date > sqlc.arg(business_date)::date
+  ), 0)
+FROM accounts a;
+
+-- name: CreateAccountBalanceSnapshot :one
+INSERT INTO account_balance_snapshots (
+  business_date,
+  account_id,
+  currency,
+  closing_balance
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetAccountBalanceSnapshot :one
+SELECT * FROM account_balance_snapshots
+WHERE account_id = $1 AND business_date = $2 LIMIT 1;
+
+-- name: GetDailyPostingTotals :many
+SELECT
+  postings.currency::varchar AS currency,
+  COALESCE(SUM(-postings.amount) FILTER (WHERE postings.amount < 0), 0)::bigint AS total_debits,
+  COALESCE(SUM(postings.amount) FILTER (WHERE postings.amount > 0), 0)::bigint AS total_credits,
+  COUNT(*)::bigint AS entry_count
+FROM (
+  SELECT a.currency, e.amount FROM entries e JOIN accounts a ON a.id = e.account_id
+  WHERE e.business_date = sqlc.arg(business_date)::date
+  UNION ALL
+  SELECT la.currency, le.amount FROM ledger_entries le JOIN ledger_accounts la ON la.id = le.ledger_account_id
+  WHERE le.business_date = sqlc.arg(business_date)::date
+) AS postings
+GROUP BY postings.currency;
+
+-- name: GetSnapshotTotals :many
+SELECT
+  currency::varchar AS currency,
+  COALESCE(SUM(closing_balance), 0)::bigint AS closing_balance
+FROM account_balance_snapshots
+WHERE business_date = $1
+GROUP BY currency;
+
+-- name: CreateDailyTotal :one
+INSERT INTO daily_totals (
+  business_date,
+  currency,
+  total_debits,
+  total_credits,
+  entry_count,
+  closing_balance
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListDailyTotals :many
+SELECT * FROM daily_totals
+WHERE business_date = $1
+ORDER BY currency;
@@ -0,0 +1,18 @@
+-- name: CreateCashOperation :one
+INSERT INTO cash_operations (
+  kind,
+  account_id,
+  clearing_account_id,
+  amount,
+  currency,
+  balance_after,
+  entry_id,
+  clearing_entry_id,
+  performed_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetCashOperation :one
+SELECT * FROM cash_operations
+WHERE id = $1 LIMIT 1;
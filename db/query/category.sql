@@ -0,0 +1,82 @@
+-- name: CreateCategoryRule :one
+INSERT INTO category_rules (
+  username,
+  category,
+  counterparty_account_id,
+  memo_contains
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListCategoryRules :many
+SELECT * FROM category_rules
+WHERE username = $1
+ORDER BY id;
+
+-- name: DeleteCategoryRule :execrows
+DELETE FROM category_rules
+WHERE id = $1 AND username = $2;
+
+-- name: DeleteCategoryRulesByUsername :exec
+DELETE FROM category_rules
+WHERE username = $1;
+
+-- name: SetEntryCategory :one
+INSERT INTO entry_categories (
+  entry_id,
+  category,
+  updated_by
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (entry_id) DO UPDATE
+SET
+  category = EXCLUDED.category,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteEntryCategory :execrows
+DELETE FROM entry_categories
+WHERE entry_id = $1;
+
+-- name: GetSpendingByCategory :many
+-- Covers the accounts the user owns or holds. The category of an entry is the one set by a user, else the one
+-- of the oldest matching rule of the requesting user. Entries without a transfer, like cash operations,
+-- only get a category set by a user. The memo is matched as a plain substring, % and _ are not wildcards.
+WITH categorized AS (
+  SELECT
+    e.amount,
+    e.created_at,
+    a.currency,
+    COALESCE(ec.category, (
+      SELECT r.category FROM category_rules r
+      WHERE t.id IS NOT NULL
+        AND r.username = sqlc.arg(username)
+        AND (r.counterparty_account_id IS NULL
+          OR r.counterparty_account_id = CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END)
+        AND (r.memo_contains = '' OR strpos(lower(t.memo), lower(r.memo_contains)) > 0)
+      ORDER BY r.id
+      LIMIT 1
+    ), 'uncategorized') AS category
+  FROM entries e
+  JOIN accounts a ON a.id = e.account_id
+  LEFT JOIN transfers t ON t.id = e.transfer_id
+  LEFT JOIN entry_categories ec ON ec.entry_id = e.id
+  WHERE (a.owner = sqlc.arg(username) OR a.id IN (
+      SELECT account_id FROM account_holders
+      WHERE username = sqlc.arg(username)
+    ))
+    AND e.created_at >= sqlc.arg(from_time)
+    AND e.created_at < sqlc.arg(to_time)
+)
+SELECT
+  date_trunc(sqlc.arg(period)::text, created_at)::timestamptz AS period_start,
+  category::varchar AS category,
+  currency::varchar AS currency,
+  COALESCE(SUM(-amount) FILTER (WHERE amount < 0), 0)::bigint AS total_debits,
+  COALESCE(SUM(amount) FILTER (WHERE amount > 0), 0)::bigint AS total_credits,
+  COUNT(*)::bigint AS entry_count
+FROM categorized
+GROUP BY period_start, category, currency
+ORDER BY period_start, category, currency;
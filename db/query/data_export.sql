@@ -0,0 +1,32 @@
+-- name: CreateDataExport :one
+INSERT INTO data_exports (
+  username
+) VALUES (
+  $1
+) RETURNING *;
+
+-- name: GetDataExport :one
+SELECT * FROM data_exports
+WHERE id = $1 LIMIT 1;
+
+-- name: CompleteDataExport :one
+UPDATE data_exports
+SET
+  status = 'ready',
+  archive = $2,
+  completed_at = now(),
+  expires_at = $3,
+  download_token_hash = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: FailDataExport :exec
+UPDATE data_exports
+SET
+  status = 'failed',
+  completed_at = now()
+WHERE id = $1;
+
+-- name: DeleteDataExports :exec
+DELETE FROM data_exports
+WHERE username = $1;
@@ -0,0 +1,61 @@
+-- name: CreateDispute :one
+INSERT INTO disputes (
+  transfer_id,
+  account_id,
+  opened_by,
+  reason,
+  evidence
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetDispute :one
+SELECT * FROM disputes
+WHERE id = $1 LIMIT 1;
+
+-- name: GetDisputeForUpdate :one
+SELECT * FROM disputes
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListDisputesByOpener :many
+SELECT * FROM disputes
+WHERE opened_by = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ListDisputesByAccount :many
+SELECT * FROM disputes
+WHERE account_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ListDisputesByStatus :many
+SELECT * FROM disputes
+WHERE status = $1
+ORDER BY created_at, id
+LIMIT $2
+OFFSET $3;
+
+-- name: StartDisputeInvestigation :one
+UPDATE disputes
+SET
+  status = 'investigating',
+  assigned_to = sqlc.arg(assigned_to),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'open'
+RETURNING *;
+
+-- name: ResolveDispute :one
+UPDATE disputes
+SET
+  status = sqlc.arg(status),
+  resolution_note = sqlc.arg(resolution_note),
+  credit_entry_id = sqlc.narg(credit_entry_id),
+  reversal_entry_id = sqlc.narg(reversal_entry_id),
+  resolved_at = now(),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'investigating'
+RETURNING *;
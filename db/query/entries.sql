@@ -1,9 +1,10 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
   account_id, 
-  amount
+  amount,
+  transfer_id
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -15,4 +16,72 @@ SELECT * FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2 
-OFFSET $3;
\ No newline at end of file
+OFFSET $3;
+-- name: ListEntriesAfter :many
+SELECT
+  e.*,
+  a.currency,
+  (a.balance - COALESCE((
+    SELECT SUM(l.amount) FROM entries l
+    WHERE l.account_id = e.account_id AND l.id > e.id
+  ), 0))::bigint AS balance
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = $1 AND e.id > $2
+ORDER BY e.id
+LIMIT $3;
+
+-- name: ListRecentEntries :many
+SELECT
+  e.*,
+  a.currency,
+  (a.balance - COALESCE((
+    SELECT SUM(l.amount) FROM entries l
+    WHERE l.account_id = e.account_id AND l.id > e.id
+  ), 0))::bigint AS balance
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = $1 AND e.id <= $2 AND e.created_at >= $3
+ORDER BY e.id;
+
+-- name: GetLastEntryID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_entry_id FROM entries
+WHERE account_id = $1;
+
+-- name: NotifyAccountActivity :exec
+SELECT pg_notify('account_activity', sqlc.arg(account_id)::text);
+
+-- name: ListEntriesByOwner :many
+SELECT * FROM entries
+WHERE account_id IN (SELECT id FROM accounts WHERE owner = $1)
+ORDER BY id;
+
+-- name: ListStatementEntries :many
+-- The statement goes to the account holders, so the counterparty is given by its account number only
+-- and the internal reason of a balance adjustment is left out.
+SELECT
+  e.*,
+  COALESCE((
+    SELECT a.number FROM transfers t
+    JOIN accounts a ON a.id = CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END
+    WHERE t.id = e.transfer_id
+  ), '')::varchar AS counterparty_account_number,
+  COALESCE((SELECT c.kind FROM cash_operations c WHERE c.entry_id = e.id), '')::varchar AS cash_operation_kind,
+  EXISTS (SELECT 1 FROM balance_adjustments b WHERE b.entry_id = e.id)::bool AS is_adjustment
+FROM entries e
+WHERE e.account_id = sqlc.arg(account_id)
+  AND e.business_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+ORDER BY e.id;
+
+-- name: GetOpeningBalance :one
+-- The balance and the entries are read in one snapshot, so a posting committed meanwhile can't be counted in only one of them.
+SELECT (a.balance - COALESCE((
+  SELECT SUM(e.amount) FROM entries e
+  WHERE e.account_id = a.id AND e.business_date >= sqlc.arg(business_date)
+), 0))::bigint AS opening_balance
+FROM accounts a
+WHERE a.id = sqlc.arg(account_id);
+
+-- name: GetDebitTotalSince :one
+SELECT COALESCE(SUM(-amount), 0)::bigint AS total FROM entries
+WHERE account_id = $1 AND amount < 0 AND created_at >= $2;
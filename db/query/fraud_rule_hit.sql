@@ -0,0 +1,14 @@
+-- name: CreateFraudRuleHit :one
+INSERT INTO fraud_rule_hits (
+  transfer_id,
+  rule,
+  decision,
+  reason
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListFraudRuleHits :many
+SELECT * FROM fraud_rule_hits
+WHERE transfer_id = $1
+ORDER BY id;
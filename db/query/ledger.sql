@@ -0,0 +1,65 @@
+-- name: CreateLedgerAccount :one
+INSERT INTO ledger_accounts (
+  code,
+  name,
+  type,
+  currency
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetLedgerAccountByCode :one
+SELECT * FROM ledger_accounts
+WHERE code = $1 AND currency = $2 LIMIT 1;
+
+-- name: ListLedgerAccounts :many
+SELECT * FROM ledger_accounts
+ORDER BY currency, code;
+
+-- name: AddLedgerAccountBalance :one
+UPDATE ledger_accounts
+SET balance = balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateLedgerEntry :one
+INSERT INTO ledger_entries (
+  ledger_account_id,
+  entry_id,
+  amount,
+  description
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetLedgerEntry :one
+SELECT * FROM ledger_entries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListLedgerEntries :many
+SELECT * FROM ledger_entries
+WHERE ledger_account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: GetAccountBalanceTotals :many
+SELECT
+  currency::varchar AS currency,
+  COALESCE(SUM(balance), 0)::bigint AS customer_balance
+FROM accounts
+GROUP BY currency
+ORDER BY currency;
+
+-- name: GetPostingTotals :many
+SELECT
+  postings.currency::varchar AS currency,
+  COALESCE(SUM(-postings.amount) FILTER (WHERE postings.amount < 0), 0)::bigint AS total_debits,
+  COALESCE(SUM(postings.amount) FILTER (WHERE postings.amount > 0), 0)::bigint AS total_credits
+FROM (
+  SELECT a.currency, e.amount FROM entries e JOIN accounts a ON a.id = e.account_id
+  UNION ALL
+  SELECT la.currency, le.amount FROM ledger_entries le JOIN ledger_accounts la ON la.id = le.ledger_account_id
+) AS postings
+GROUP BY postings.currency
+ORDER BY postings.currency;
@@ -0,0 +1,102 @@
+-- name: CreateLoan :one
+INSERT INTO loans (
+  account_id,
+  principal,
+  currency,
+  annual_rate_bps,
+  term_months,
+  method,
+  outstanding_principal,
+  outstanding_interest,
+  disbursement_entry_id,
+  disbursed_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetLoan :one
+SELECT * FROM loans
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLoanForUpdate :one
+SELECT * FROM loans
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListLoans :many
+SELECT * FROM loans
+WHERE account_id = $1
+ORDER BY id;
+
+-- name: RepayLoan :one
+UPDATE loans
+SET
+  outstanding_principal = outstanding_principal - sqlc.arg(principal),
+  outstanding_interest = outstanding_interest - sqlc.arg(interest)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: PayOffLoan :one
+UPDATE loans
+SET
+  status = 'paid_off',
+  paid_off_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateLoanInstallment :one
+INSERT INTO loan_installments (
+  loan_id,
+  seq,
+  due_date,
+  principal,
+  interest
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetLoanInstallment :one
+SELECT * FROM loan_installments
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLoanInstallmentForUpdate :one
+SELECT * FROM loan_installments
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListLoanInstallments :many
+SELECT * FROM loan_installments
+WHERE loan_id = $1
+ORDER BY seq;
+
+-- name: GetFirstUnpaidLoanInstallment :one
+SELECT * FROM loan_installments
+WHERE loan_id = $1 AND status <> 'paid'
+ORDER BY seq
+LIMIT 1;
+
+-- name: ListDueLoanInstallments :many
+-- Lists the unpaid installments due on or before the date, after_id pages through them by id.
+SELECT * FROM loan_installments
+WHERE status <> 'paid'
+  AND due_date <= sqlc.arg(due_date)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: MarkLoanInstallmentOverdue :one
+UPDATE loan_installments
+SET
+  status = 'overdue',
+  late_fee = sqlc.arg(late_fee)
+WHERE id = sqlc.arg(id) AND status = 'scheduled'
+RETURNING *;
+
+-- name: PayLoanInstallment :one
+UPDATE loan_installments
+SET
+  status = 'paid',
+  entry_id = sqlc.arg(entry_id),
+  paid_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,62 @@
+-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+  username,
+  event_type,
+  min_amount,
+  channel
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (username, event_type) DO UPDATE
+SET
+  min_amount = EXCLUDED.min_amount,
+  channel = EXCLUDED.channel,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetNotificationPreference :one
+SELECT * FROM notification_preferences
+WHERE username = $1 AND event_type = $2 LIMIT 1;
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences
+WHERE username = $1
+ORDER BY event_type;
+
+-- name: DeleteNotificationPreference :execrows
+DELETE FROM notification_preferences
+WHERE username = $1 AND event_type = $2;
+
+-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences
+WHERE username = $1;
+
+-- name: CreateNotificationDigestItem :exec
+INSERT INTO notification_digest_items (
+  username,
+  event_type,
+  transfer_id,
+  account_id,
+  amount,
+  currency
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (username, event_type, transfer_id) DO NOTHING;
+
+-- name: ListDigestRecipients :many
+SELECT DISTINCT username FROM notification_digest_items
+WHERE sent_at IS NULL
+ORDER BY username
+LIMIT $1
+OFFSET $2;
+
+-- name: ListPendingDigestItems :many
+SELECT * FROM notification_digest_items
+WHERE username = $1 AND sent_at IS NULL
+ORDER BY id;
+
+-- name: MarkDigestItemsSent :exec
+UPDATE notification_digest_items
+SET sent_at = now()
+WHERE username = sqlc.arg(username) AND id <= sqlc.arg(last_item_id) AND sent_at IS NULL;
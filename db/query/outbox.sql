@@ -0,0 +1,26 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+  aggregate_type,
+  aggregate_id,
+  event_type,
+  payload
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListPendingOutboxEvents :many
+SELECT * FROM outbox
+WHERE sent_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE;
+
+-- name: MarkOutboxEventSent :exec
+UPDATE outbox
+SET sent_at = now()
+WHERE id = $1;
+
+-- name: EraseUserOutboxEmails :exec
+UPDATE outbox
+SET payload = jsonb_set(payload, '{email}', to_jsonb(sqlc.arg(email)::varchar))
+WHERE aggregate_type = 'user' AND aggregate_id = sqlc.arg(username) AND payload ? 'email';
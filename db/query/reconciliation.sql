@@ -0,0 +1,70 @@
+-- name: CreateExternalStatement :one
+INSERT INTO external_statements (
+  format,
+  reference,
+  external_account,
+  currency,
+  clearing_account_id,
+  from_date,
+  to_date,
+  opening_balance,
+  closing_balance,
+  imported_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetExternalStatement :one
+SELECT * FROM external_statements
+WHERE id = $1 LIMIT 1;
+
+-- name: CreateExternalStatementLine :one
+INSERT INTO external_statement_lines (
+  statement_id,
+  booking_date,
+  value_date,
+  amount,
+  reference,
+  end_to_end_id,
+  counterparty,
+  description
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetExternalStatementLine :one
+SELECT * FROM external_statement_lines
+WHERE id = $1 LIMIT 1;
+
+-- name: ListExternalStatementLinesByStatus :many
+SELECT * FROM external_statement_lines
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListReconciliationCandidates :many
+SELECT
+  le.*,
+  COALESCE((SELECT c.id FROM cash_operations c WHERE c.clearing_entry_id = le.id), 0)::bigint AS cash_operation_id,
+  COALESCE((SELECT c.kind FROM cash_operations c WHERE c.clearing_entry_id = le.id), '')::varchar AS cash_operation_kind
+FROM ledger_entries le
+WHERE le.ledger_account_id = sqlc.arg(ledger_account_id)
+  AND le.business_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+  AND NOT EXISTS (SELECT 1 FROM external_statement_lines l WHERE l.entry_id = le.id)
+ORDER BY le.id;
+
+-- name: MatchExternalStatementLine :one
+UPDATE external_statement_lines
+SET
+  status = sqlc.arg(status),
+  entry_id = sqlc.narg(entry_id),
+  resolved_by = sqlc.narg(resolved_by),
+  resolution_note = sqlc.narg(resolution_note),
+  resolved_at = CASE WHEN sqlc.narg(resolved_by)::varchar IS NULL THEN NULL ELSE now() END
+WHERE id = sqlc.arg(id) AND status = 'unmatched'
+RETURNING *;
+
+-- name: GetExternalStatementByReference :one
+SELECT * FROM external_statements
+WHERE external_account = $1 AND reference = $2 LIMIT 1;
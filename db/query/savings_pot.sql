@@ -0,0 +1,51 @@
+-- name: CreateSavingsPot :one
+INSERT INTO savings_pots (
+  account_id,
+  name,
+  target_amount,
+  deadline
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetSavingsPot :one
+SELECT * FROM savings_pots
+WHERE id = $1 LIMIT 1;
+
+-- name: GetSavingsPotForUpdate :one
+SELECT * FROM savings_pots
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListSavingsPots :many
+SELECT * FROM savings_pots
+WHERE account_id = $1
+ORDER BY id;
+
+-- name: AddSavingsPotBalance :one
+UPDATE savings_pots
+SET balance = balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CloseSavingsPot :one
+UPDATE savings_pots
+SET
+  status = 'closed',
+  closed_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePotEntry :one
+INSERT INTO pot_entries (
+  pot_id,
+  account_id,
+  amount
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListPotEntries :many
+SELECT * FROM pot_entries
+WHERE pot_id = $1
+ORDER BY id;
@@ -13,4 +13,33 @@ INSERT INTO sessions (
 
 -- name: GetSession :one
 SELECT * FROM sessions
-WHERE id = $1 LIMIT 1;
\ No newline at end of file
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSessionsByUsername :many
+SELECT * FROM sessions
+WHERE username = $1
+ORDER BY created_at;
+
+-- name: EraseSessions :exec
+UPDATE sessions
+SET
+  refresh_token = '',
+  user_agent = '',
+  client_ip = '',
+  is_blocked = true
+WHERE username = $1;
+
+-- name: GetLatestSession :one
+SELECT * FROM sessions
+WHERE username = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CountSessionsFromIP :one
+SELECT COUNT(*) FROM sessions
+WHERE username = $1 AND client_ip = $2 AND created_at < $3;
+
+-- name: BlockSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE username = $1;
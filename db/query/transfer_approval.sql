@@ -0,0 +1,50 @@
+-- name: CreateTransferApproval :one
+INSERT INTO transfer_approvals (
+  from_account_id,
+  to_account_id,
+  amount,
+  currency,
+  initiated_by,
+  expires_at,
+  memo
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetTransferApproval :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferApprovalForUpdate :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPendingTransferApprovals :many
+SELECT * FROM transfer_approvals
+WHERE status = 'pending' AND expires_at > now()
+ORDER BY expires_at, id
+LIMIT $1
+OFFSET $2;
+
+-- name: DecideTransferApproval :one
+UPDATE transfer_approvals
+SET
+  status = sqlc.arg(status),
+  decided_by = sqlc.arg(decided_by),
+  decided_at = now(),
+  transfer_id = sqlc.narg(transfer_id)
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: ExpireTransferApprovals :many
+UPDATE transfer_approvals
+SET status = 'expired'
+WHERE id IN (
+  SELECT id FROM transfer_approvals
+  WHERE status = 'pending' AND expires_at <= sqlc.arg(now)
+  ORDER BY expires_at, id
+  LIMIT sqlc.arg(batch_size)
+  FOR NO KEY UPDATE SKIP LOCKED
+)
+RETURNING *;
@@ -2,9 +2,12 @@
 INSERT INTO transfers (
   from_account_id, 
   to_account_id,
-  amount
+  amount,
+  status,
+  memo,
+  initiated_by
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4, $5, $6
 ) RETURNING *;
 
 -- name: GetTransfer :one
@@ -18,4 +21,50 @@ WHERE
   to_account_id = $2
 ORDER BY id
 LIMIT $3 
-OFFSET $4;
\ No newline at end of file
+OFFSET $4;
+-- name: ListTransfersByOwner :many
+SELECT * FROM transfers
+WHERE
+  from_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner)) OR
+  to_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner))
+ORDER BY id;
+
+-- name: ListTransfersByStatus :many
+SELECT * FROM transfers
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ReviewHeldTransfer :one
+UPDATE transfers
+SET
+  status = $2,
+  reviewed_by = $3,
+  reviewed_at = now()
+WHERE id = $1 AND status = 'held'
+RETURNING *;
+
+-- name: CountCompletedTransfersSince :one
+SELECT COUNT(*) FROM transfers
+WHERE from_account_id = $1 AND created_at >= $2 AND status = 'completed';
+
+-- name: CountCompletedTransfersBetween :one
+SELECT COUNT(*) FROM transfers
+WHERE from_account_id = $1 AND to_account_id = $2 AND status = 'completed';
+
+-- name: GetTransferAmountStats :one
+SELECT
+  COUNT(*)::bigint AS transfer_count,
+  COALESCE(AVG(amount), 0)::bigint AS average_amount
+FROM transfers
+WHERE from_account_id = $1 AND status = 'completed';
+
+-- name: ListTransfersByOwnerPage :many
+SELECT * FROM transfers
+WHERE
+  from_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner)) OR
+  to_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.arg(owner))
+ORDER BY id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
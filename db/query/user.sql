@@ -23,3 +23,47 @@ SET
   is_email_verified = COALESCE(sqlc.narg('is_email_verified'), is_email_verified)
 WHERE username = $1
 RETURNING *;
+
+-- name: EraseUser :one
+UPDATE users
+SET
+  hashed_password = '',
+  full_name = 'erased',
+  email = $2,
+  is_email_verified = false,
+  erased_at = now()
+WHERE username = $1 AND erased_at IS NULL
+RETURNING *;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(search)::varchar IS NULL OR
+    username ILIKE '%' || sqlc.narg(search) || '%' OR
+    email ILIKE '%' || sqlc.narg(search) || '%' OR
+    full_name ILIKE '%' || sqlc.narg(search) || '%') AND
+  (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role))
+ORDER BY username
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: IsUserBlocked :one
+-- access tokens outlive a lock or an erasure, so they are checked on every request
+SELECT (locked_at IS NOT NULL OR erased_at IS NOT NULL)::bool AS blocked FROM users
+WHERE username = $1 LIMIT 1;
+
+-- name: LockUser :one
+UPDATE users
+SET
+  locked_at = now(),
+  lock_reason = $2
+WHERE username = $1
+RETURNING *;
+
+-- name: UnlockUser :one
+UPDATE users
+SET
+  locked_at = NULL,
+  lock_reason = NULL
+WHERE username = $1
+RETURNING *;
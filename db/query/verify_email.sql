@@ -18,3 +18,16 @@ SET
   is_used = COALESCE(sqlc.narg('is_used'), is_used)
 WHERE id = $1
 RETURNING *;
+
+-- name: ListVerificationEmailsByUsername :many
+SELECT * FROM verification_emails
+WHERE username = $1
+ORDER BY id;
+
+-- name: EraseVerificationEmails :exec
+UPDATE verification_emails
+SET
+  email = $2,
+  secret_code = '',
+  is_used = true
+WHERE username = $1;
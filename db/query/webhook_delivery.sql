@@ -0,0 +1,47 @@
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+  webhook_id,
+  event_id,
+  event_type,
+  payload
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (webhook_id, event_id) DO UPDATE
+SET webhook_id = EXCLUDED.webhook_id
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdateWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET
+  status = $2,
+  attempts = attempts + 1,
+  response_status = $3,
+  last_error = $4,
+  delivered_at = CASE WHEN $2 = 'succeeded' THEN now() ELSE delivered_at END
+WHERE id = $1
+RETURNING *;
+
+-- name: EraseUserWebhookDeliveryEmails :exec
+UPDATE webhook_deliveries d
+SET payload = jsonb_set(d.payload, '{email}', to_jsonb(sqlc.arg(email)::varchar))
+FROM outbox o
+WHERE o.id = d.event_id
+  AND o.aggregate_type = 'user'
+  AND o.aggregate_id = sqlc.arg(username)
+  AND d.payload ? 'email';
+
+-- name: DeleteWebhookDeliveriesByOwner :exec
+DELETE FROM webhook_deliveries
+WHERE webhook_id IN (SELECT id FROM webhooks WHERE owner = sqlc.arg(owner)::varchar);
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomInvitation(t *testing.T, account Account, role string) AccountInvitation {
+	invitee := createRandomUser(t)
+
+	arg := CreateAccountInvitationParams{
+		AccountID: account.ID,
+		Inviter:   account.Owner,
+		Invitee:   invitee.Username,
+		Role:      role,
+	}
+
+	invitation, err := testStore.CreateAccountInvitation(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, invitation)
+
+	require.Equal(t, arg.AccountID, invitation.AccountID)
+	require.Equal(t, arg.Inviter, invitation.Inviter)
+	require.Equal(t, arg.Invitee, invitation.Invitee)
+	require.Equal(t, arg.Role, invitation.Role)
+	require.Equal(t, util.InvitationPending, invitation.Status)
+	require.False(t, invitation.RespondedAt.Valid)
+
+	return invitation
+}
+
+func TestAcceptInvitationTx(t *testing.T) {
+	account := createRandomAccount(t)
+	invitation := createRandomInvitation(t, account, util.CoOwnerHolderRole)
+
+	result, err := testStore.AcceptInvitationTx(context.Background(), AcceptInvitationTxParams{InvitationID: invitation.ID})
+	require.NoError(t, err)
+
+	require.Equal(t, util.InvitationAccepted, result.Invitation.Status)
+	require.True(t, result.Invitation.RespondedAt.Valid)
+
+	require.Equal(t, account.ID, result.Holder.AccountID)
+	require.Equal(t, invitation.Invitee, result.Holder.Username)
+	require.Equal(t, util.CoOwnerHolderRole, result.Holder.Role)
+
+	holder, err := testStore.GetAccountHolder(context.Background(), GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  invitation.Invitee,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Holder, holder)
+
+	// the invitation cannot be accepted twice
+	_, err = testStore.AcceptInvitationTx(context.Background(), AcceptInvitationTxParams{InvitationID: invitation.ID})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestListAccountsIncludesHeldAccounts(t *testing.T) {
+	account := createRandomAccount(t)
+	invitation := createRandomInvitation(t, account, util.ViewerHolderRole)
+
+	_, err := testStore.AcceptInvitationTx(context.Background(), AcceptInvitationTxParams{InvitationID: invitation.ID})
+	require.NoError(t, err)
+
+	accounts, err := testStore.ListAccounts(context.Background(), ListAccountsParams{
+		Owner:  invitation.Invitee,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// AccountActivityChannel is the Postgres notification channel the transfer flow notifies
+// with the id of every account whose balance changed.
+const AccountActivityChannel = "account_activity"
+
+const activityListenerRetryDelay = time.Second
+
+// ActivityListener wakes up subscribers when one of their accounts has new entries.
+type ActivityListener interface {
+	// Subscribe returns a channel that receives a value whenever one of the accounts changes.
+	// Wake-ups are coalesced, so the subscriber must read everything it missed on every wake-up.
+	// The returned function cancels the subscription.
+	Subscribe(accountIDs []int64) (<-chan struct{}, func())
+}
+
+type PGActivityListener struct {
+	connPool    *pgxpool.Pool
+	mu          sync.Mutex
+	subscribers map[int64]map[chan struct{}]bool
+}
+
+func NewPGActivityListener(connPool *pgxpool.Pool) *PGActivityListener {
+	return &PGActivityListener{
+		connPool:    connPool,
+		subscribers: make(map[int64]map[chan struct{}]bool),
+	}
+}
+
+func (listener *PGActivityListener) Subscribe(accountIDs []int64) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	listener.mu.Lock()
+	for _, id := range accountIDs {
+		if listener.subscribers[id] == nil {
+			listener.subscribers[id] = make(map[chan struct{}]bool)
+		}
+		listener.subscribers[id][ch] = true
+	}
+	listener.mu.Unlock()
+
+	cancel := func() {
+		listener.mu.Lock()
+		defer listener.mu.Unlock()
+
+		for _, id := range accountIDs {
+			delete(listener.subscribers[id], ch)
+			if len(listener.subscribers[id]) == 0 {
+				delete(listener.subscribers, id)
+			}
+		}
+	}
+
+	return ch, cancel
+}
+
+// Run listens to the notification channel until ctx is done, reconnecting on errors.
+func (listener *PGActivityListener) Run(ctx context.Context) error {
+	for {
+		err := listener.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		log.Error().Err(err).Msg("account activity listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(activityListenerRetryDelay):
+		}
+	}
+}
+
+func (listener *PGActivityListener) listen(ctx context.Context) error {
+	conn, err := listener.connPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(ctx, "LISTEN "+AccountActivityChannel)
+	if err != nil {
+		return err
+	}
+
+	// notifications sent while disconnected are lost, so let everyone catch up
+	listener.wakeAll()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		accountID, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Str("payload", notification.Payload).Msg("invalid account activity notification")
+			continue
+		}
+
+		listener.wake(accountID)
+	}
+}
+
+func (listener *PGActivityListener) wake(accountID int64) {
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+
+	for ch := range listener.subscribers[accountID] {
+		notify(ch)
+	}
+}
+
+func (listener *PGActivityListener) wakeAll() {
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+
+	for _, subscribers := range listener.subscribers {
+		for ch := range subscribers {
+			notify(ch)
+		}
+	}
+}
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
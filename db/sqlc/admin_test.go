@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsersSearch(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomUser(t)
+
+	users, err := testStore.ListUsers(context.Background(), ListUsersParams{
+		Search: pgtype.Text{String: user.Email[:len(user.Email)-4], Valid: true},
+		Role:   pgtype.Text{String: util.DepositorRole, Valid: true},
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.Username, users[0].Username)
+}
+
+func TestLockUserTx(t *testing.T) {
+	ctx := context.Background()
+	user := createRandomUser(t)
+
+	session, err := testStore.CreateSession(ctx, CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     user.Username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    "test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.LockUserTx(ctx, LockUserTxParams{Username: user.Username, Reason: "fraud"})
+	require.NoError(t, err)
+	require.True(t, result.User.LockedAt.Valid)
+	require.Equal(t, "fraud", result.User.LockReason.String)
+
+	session, err = testStore.GetSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+
+	unlocked, err := testStore.UnlockUser(ctx, user.Username)
+	require.NoError(t, err)
+	require.False(t, unlocked.LockedAt.Valid)
+	require.False(t, unlocked.LockReason.Valid)
+}
+
+func TestAdjustBalanceTx(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	result, err := testStore.AdjustBalanceTx(ctx, AdjustBalanceTxParams{
+		AccountID:  account.ID,
+		Amount:     -account.Balance,
+		Reason:     "write off",
+		AdjustedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Account.Balance)
+	require.Equal(t, -account.Balance, result.Entry.Amount)
+	require.Equal(t, result.Entry.ID, result.Adjustment.EntryID)
+	require.Equal(t, banker.Username, result.Adjustment.AdjustedBy)
+
+	adjustments, err := testStore.ListBalanceAdjustments(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, adjustments, 1)
+	require.Equal(t, "write off", adjustments[0].Reason)
+}
+
+func TestAdjustBalanceTxInsufficientFunds(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	_, err := testStore.AdjustBalanceTx(ctx, AdjustBalanceTxParams{
+		AccountID:  account.ID,
+		Amount:     -account.Balance - 1,
+		Reason:     "write off",
+		AdjustedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	adjustments, err := testStore.ListBalanceAdjustments(ctx, account.ID)
+	require.NoError(t, err)
+	require.Empty(t, adjustments)
+}
+
+func TestAdjustBalanceTxPostsToLedger(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	before, err := testStore.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+		Code:     util.LedgerCodeManualAdjustments,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.AdjustBalanceTx(ctx, AdjustBalanceTxParams{
+		AccountID:  account.ID,
+		Amount:     15,
+		Reason:     "goodwill credit",
+		AdjustedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(-15), result.LedgerEntry.Amount)
+	require.Equal(t, result.Entry.ID, result.LedgerEntry.EntryID.Int64)
+	require.Equal(t, result.LedgerEntry.ID, result.Adjustment.LedgerEntryID.Int64)
+
+	after, err := testStore.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+		Code:     util.LedgerCodeManualAdjustments,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, before.Balance-15, after.Balance)
+
+	totals, err := testStore.GetPostingTotals(ctx)
+	require.NoError(t, err)
+	for _, total := range totals {
+		require.Equal(t, total.TotalDebits, total.TotalCredits, total.Currency)
+	}
+}
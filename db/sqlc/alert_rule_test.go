@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAlertRule(t *testing.T, kind string) AlertRule {
+	account := createRandomAccount(t)
+
+	rule, err := testStore.CreateAlertRule(context.Background(), CreateAlertRuleParams{
+		AccountID: account.ID,
+		Username:  account.Owner,
+		Kind:      kind,
+		Threshold: util.RandomMoney(),
+	})
+	require.NoError(t, err)
+	require.False(t, rule.TriggeredAt.Valid)
+
+	return rule
+}
+
+func TestTriggerAlertRuleUntilRearmed(t *testing.T) {
+	ctx := context.Background()
+	rule := createRandomAlertRule(t, util.AlertLowBalance)
+
+	triggered, err := testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), triggered)
+
+	triggered, err = testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	require.Zero(t, triggered)
+
+	err = testStore.RearmAlertRule(ctx, rule.ID)
+	require.NoError(t, err)
+
+	triggered, err = testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), triggered)
+}
+
+func TestTriggerAlertRuleRearmBefore(t *testing.T) {
+	ctx := context.Background()
+	rule := createRandomAlertRule(t, util.AlertDailySpend)
+
+	triggered, err := testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), triggered)
+
+	// triggered after the start of the day, so the rule stays silent
+	triggered, err = testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{
+		ID:          rule.ID,
+		RearmBefore: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Zero(t, triggered)
+
+	triggered, err = testStore.TriggerAlertRule(ctx, TriggerAlertRuleParams{
+		ID:          rule.ID,
+		RearmBefore: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), triggered)
+}
+
+func TestGetDebitTotalSince(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	since := time.Now().Add(-time.Minute)
+
+	for _, amount := range []int64{10, 15} {
+		_, err := testStore.TransferTx(ctx, TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+	}
+
+	total, err := testStore.GetDebitTotalSince(ctx, GetDebitTotalSinceParams{AccountID: account1.ID, CreatedAt: since})
+	require.NoError(t, err)
+	require.Equal(t, int64(25), total)
+
+	total, err = testStore.GetDebitTotalSince(ctx, GetDebitTotalSinceParams{AccountID: account2.ID, CreatedAt: since})
+	require.NoError(t, err)
+	require.Zero(t, total)
+}
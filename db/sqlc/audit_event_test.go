@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAuditEvent(t *testing.T, actor string, targetID string) AuditEvent {
+	arg := CreateAuditEventParams{
+		Actor:      actor,
+		ActorRole:  util.DepositorRole,
+		Action:     "user.update",
+		TargetType: util.AuditTargetUser,
+		TargetID:   targetID,
+		Diff:       []byte(`{"full_name":{"before":"a","after":"b"}}`),
+		ClientIp:   "127.0.0.1",
+		UserAgent:  "test",
+	}
+
+	event, err := testStore.CreateAuditEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, event.ID)
+	require.Equal(t, arg.Actor, event.Actor)
+	require.Equal(t, arg.Action, event.Action)
+	require.Equal(t, arg.TargetID, event.TargetID)
+	require.JSONEq(t, string(arg.Diff), string(event.Diff))
+	require.WithinDuration(t, time.Now(), event.CreatedAt, time.Second)
+
+	return event
+}
+
+func TestListAuditEvents(t *testing.T) {
+	actor := util.RandomOwner()
+	target := util.RandomOwner()
+
+	var created []AuditEvent
+	for i := 0; i < 3; i++ {
+		created = append(created, createRandomAuditEvent(t, actor, target))
+	}
+	createRandomAuditEvent(t, util.RandomOwner(), target)
+
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: actor, Valid: true},
+		TargetID: pgtype.Text{String: target, Valid: true},
+		FromTime: pgtype.Timestamptz{Time: created[0].CreatedAt, Valid: true},
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	// newest first
+	require.Equal(t, created[2].ID, events[0].ID)
+	require.Equal(t, created[0].ID, events[2].ID)
+}
+
+func TestAuditTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	target := util.RandomOwner()
+	amount := int64(10)
+
+	audit := func(diff string) error {
+		return testStore.AuditTx(context.Background(), func(store Store) (CreateAuditEventParams, error) {
+			// the transaction of the transfer runs as a savepoint of the audited one
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+			if err != nil {
+				return CreateAuditEventParams{}, err
+			}
+
+			return CreateAuditEventParams{
+				Actor:      account1.Owner,
+				ActorRole:  util.DepositorRole,
+				Action:     "transfer.create",
+				TargetType: util.AuditTargetTransfer,
+				TargetID:   target,
+				Diff:       []byte(diff),
+			}, nil
+		})
+	}
+
+	listEvents := func() []AuditEvent {
+		events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+			TargetID: pgtype.Text{String: target, Valid: true},
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		return events
+	}
+
+	// the transfer is rolled back with the audit event that can't be recorded
+	err := audit("not json")
+	require.Error(t, err)
+	require.Empty(t, listEvents())
+
+	account, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, account.Balance)
+
+	err = audit(`{}`)
+	require.NoError(t, err)
+	require.Len(t, listEvents(), 1)
+
+	account, err = testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-amount, account.Balance)
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseBusinessDayTx(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+
+	_, err := testStore.CloseBusinessDayTx(ctx, CloseBusinessDayTxParams{BusinessDate: time.Now()})
+	require.ErrorIs(t, err, ErrBusinessDayNotOver)
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	businessDate := BusinessDate(yesterday)
+
+	result, err := testStore.CloseBusinessDayTx(ctx, CloseBusinessDayTxParams{BusinessDate: yesterday})
+	if errors.Is(err, ErrBusinessDayAlreadyClosed) {
+		// closed by a previous test run today
+		day, err := testStore.GetBusinessDay(ctx, businessDate)
+		require.NoError(t, err)
+		require.Equal(t, businessDate.Time, day.BusinessDate.Time)
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, businessDate.Time, result.BusinessDay.BusinessDate.Time)
+	require.NotZero(t, result.Snapshots)
+	require.NotEmpty(t, result.Totals)
+
+	// the account has no entries after the closed day, so its closing balance is its balance
+	snapshot, err := testStore.GetAccountBalanceSnapshot(ctx, GetAccountBalanceSnapshotParams{
+		AccountID:    account.ID,
+		BusinessDate: businessDate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, snapshot.ClosingBalance)
+
+	totals, err := testStore.ListDailyTotals(ctx, businessDate)
+	require.NoError(t, err)
+	require.Equal(t, result.Totals, totals)
+
+	_, err = testStore.CloseBusinessDayTx(ctx, CloseBusinessDayTxParams{BusinessDate: yesterday})
+	require.ErrorIs(t, err, ErrBusinessDayAlreadyClosed)
+}
+
+func TestDeleteAccountAfterBusinessDayClose(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	businessDate := BusinessDate(yesterday)
+
+	_, err := testStore.CloseBusinessDayTx(ctx, CloseBusinessDayTxParams{BusinessDate: yesterday})
+	if errors.Is(err, ErrBusinessDayAlreadyClosed) {
+		// the day was closed by a previous test before the account existed, so its snapshot is taken by hand
+		_, err = testStore.CreateAccountBalanceSnapshot(ctx, CreateAccountBalanceSnapshotParams{
+			BusinessDate:   businessDate,
+			AccountID:      account.ID,
+			Currency:       account.Currency,
+			ClosingBalance: account.Balance,
+		})
+	}
+	require.NoError(t, err)
+
+	_, err = testStore.GetAccountBalanceSnapshot(ctx, GetAccountBalanceSnapshotParams{
+		AccountID:    account.ID,
+		BusinessDate: businessDate,
+	})
+	require.NoError(t, err)
+
+	err = testStore.DeleteAccount(ctx, account.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetAccountBalanceSnapshot(ctx, GetAccountBalanceSnapshotParams{
+		AccountID:    account.ID,
+		BusinessDate: businessDate,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// the totals of the closed day are kept
+	totals, err := testStore.ListDailyTotals(ctx, businessDate)
+	require.NoError(t, err)
+	require.NotEmpty(t, totals)
+}
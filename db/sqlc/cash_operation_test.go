@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCashOperationTx(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	clearing, err := testStore.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+		Code:     util.LedgerCodeCashClearing,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+
+	deposit, err := testStore.CashOperationTx(ctx, CashOperationTxParams{
+		Kind:        util.CashDeposit,
+		AccountID:   account.ID,
+		Amount:      10,
+		PerformedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+10, deposit.Account.Balance)
+	require.Equal(t, account.Balance+10, deposit.Operation.BalanceAfter)
+	require.Equal(t, clearing.ID, deposit.ClearingEntry.LedgerAccountID)
+	require.Equal(t, clearing.ID, deposit.Operation.ClearingAccountID)
+	require.Equal(t, deposit.Entry.ID, deposit.ClearingEntry.EntryID.Int64)
+	require.Zero(t, deposit.Entry.Amount+deposit.ClearingEntry.Amount)
+
+	withdrawal, err := testStore.CashOperationTx(ctx, CashOperationTxParams{
+		Kind:        util.CashWithdrawal,
+		AccountID:   account.ID,
+		Amount:      10,
+		PerformedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, withdrawal.Account.Balance)
+	require.Equal(t, int64(-10), withdrawal.Entry.Amount)
+	require.Equal(t, int64(10), withdrawal.ClearingEntry.Amount)
+
+	// the clearing account took both sides
+	clearingAfter, err := testStore.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+		Code:     util.LedgerCodeCashClearing,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, clearing.Balance, clearingAfter.Balance)
+
+	operation, err := testStore.GetCashOperation(ctx, withdrawal.Operation.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CashWithdrawal, operation.Kind)
+
+	_, err = testStore.CashOperationTx(ctx, CashOperationTxParams{
+		Kind:        util.CashWithdrawal,
+		AccountID:   account.ID,
+		Amount:      account.Balance + 1,
+		PerformedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
@@ -0,0 +1,157 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpendingByCategory(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	landlord := createRandomAccount(t)
+	shop := createRandomAccount(t)
+	from := time.Now().Add(-time.Minute)
+
+	transfer := func(to Account, amount int64, memo string) TransferTxResult {
+		result, err := testStore.TransferTx(ctx, TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+			Memo:          memo,
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	_, err := testStore.CreateCategoryRule(ctx, CreateCategoryRuleParams{
+		Username:              account.Owner,
+		Category:              "rent",
+		CounterpartyAccountID: pgtype.Int8{Int64: landlord.ID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateCategoryRule(ctx, CreateCategoryRuleParams{
+		Username:     account.Owner,
+		Category:     "groceries",
+		MemoContains: "market",
+	})
+	require.NoError(t, err)
+
+	// the memo is not a pattern, 50% must not match 500
+	_, err = testStore.CreateCategoryRule(ctx, CreateCategoryRuleParams{
+		Username:     account.Owner,
+		Category:     "discounts",
+		MemoContains: "50%",
+	})
+	require.NoError(t, err)
+
+	transfer(landlord, 50, "March rent")
+	transfer(shop, 2, "500 coupons")
+	transfer(shop, 7, "Farmers MARKET")
+	transfer(shop, 3, "Market stall")
+	overridden := transfer(shop, 4, "market, actually a gift")
+
+	_, err = testStore.SetEntryCategory(ctx, SetEntryCategoryParams{
+		EntryID:   overridden.FromEntry.ID,
+		Category:  "gifts",
+		UpdatedBy: account.Owner,
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.GetSpendingByCategory(ctx, GetSpendingByCategoryParams{
+		Username: account.Owner,
+		FromTime: from,
+		ToTime:   time.Now().Add(time.Minute),
+		Period:   "month",
+	})
+	require.NoError(t, err)
+
+	debits := make(map[string]int64)
+	counts := make(map[string]int64)
+	for _, row := range rows {
+		debits[row.Category] += row.TotalDebits
+		counts[row.Category] += row.EntryCount
+	}
+
+	require.Equal(t, int64(50), debits["rent"])
+	require.Equal(t, int64(10), debits["groceries"])
+	require.Equal(t, int64(2), counts["groceries"])
+	require.Equal(t, int64(4), debits["gifts"])
+	require.Equal(t, int64(2), debits["uncategorized"])
+	require.Zero(t, debits["discounts"])
+
+	// a co-owner sees the spending of the account, categorized by the co-owner's own rules
+	holder := createRandomUser(t)
+	_, err = testStore.CreateAccountHolder(ctx, CreateAccountHolderParams{
+		AccountID: account.ID,
+		Username:  holder.Username,
+		Role:      util.CoOwnerHolderRole,
+	})
+	require.NoError(t, err)
+
+	rows, err = testStore.GetSpendingByCategory(ctx, GetSpendingByCategoryParams{
+		Username: holder.Username,
+		FromTime: from,
+		ToTime:   time.Now().Add(time.Minute),
+		Period:   "month",
+	})
+	require.NoError(t, err)
+
+	debits = make(map[string]int64)
+	for _, row := range rows {
+		debits[row.Category] += row.TotalDebits
+	}
+
+	require.Equal(t, int64(4), debits["gifts"])
+	require.Equal(t, int64(62), debits["uncategorized"])
+}
+
+func TestGetSpendingByCategoryCashEntries(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	shop := createRandomAccount(t)
+	from := time.Now().Add(-time.Minute)
+
+	// a rule without conditions matches every transfer, but not the entries without one
+	_, err := testStore.CreateCategoryRule(ctx, CreateCategoryRuleParams{
+		Username: account.Owner,
+		Category: "shopping",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   shop.ID,
+		Amount:        5,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CashOperationTx(ctx, CashOperationTxParams{
+		Kind:        util.CashWithdrawal,
+		AccountID:   account.ID,
+		Amount:      7,
+		PerformedBy: account.Owner,
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.GetSpendingByCategory(ctx, GetSpendingByCategoryParams{
+		Username: account.Owner,
+		FromTime: from,
+		ToTime:   time.Now().Add(time.Minute),
+		Period:   "month",
+	})
+	require.NoError(t, err)
+
+	debits := make(map[string]int64)
+	for _, row := range rows {
+		debits[row.Category] += row.TotalDebits
+	}
+
+	require.Equal(t, int64(5), debits["shopping"])
+	require.Equal(t, int64(7), debits["uncategorized"])
+}
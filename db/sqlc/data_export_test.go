@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseUserTx(t *testing.T) {
+	ctx := context.Background()
+	user := createRandomUser(t)
+
+	account, err := testStore.CreateAccount(ctx, CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  0,
+		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateSession(ctx, CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     user.Username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    "test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateVerificationEmail(ctx, CreateVerificationEmailParams{
+		Username:   user.Username,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateDataExport(ctx, user.Username)
+	require.NoError(t, err)
+
+	_, err = testStore.CreateAuditEvent(ctx, CreateAuditEventParams{
+		Actor:      user.Username,
+		ActorRole:  user.Role,
+		Action:     "user.update",
+		TargetType: util.AuditTargetUser,
+		TargetID:   user.Username,
+		Diff:       []byte(fmt.Sprintf(`{"email":{"before":%q,"after":"new@example.com"},"role":{"before":"a","after":"b"}}`, user.Email)),
+		ClientIp:   "127.0.0.1",
+		UserAgent:  "test",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateWebhook(ctx, CreateWebhookParams{
+		Owner:      user.Username,
+		Url:        "https://example.com/hooks",
+		EventTypes: []string{EventUserCreated},
+		Secret:     util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	held := createRandomAccount(t)
+	_, err = testStore.CreateAccountHolder(ctx, CreateAccountHolderParams{
+		AccountID: held.ID,
+		Username:  user.Username,
+		Role:      util.ViewerHolderRole,
+	})
+	require.NoError(t, err)
+
+	invited := createRandomAccount(t)
+	_, err = testStore.CreateAccountInvitation(ctx, CreateAccountInvitationParams{
+		AccountID: invited.ID,
+		Inviter:   invited.Owner,
+		Invitee:   user.Username,
+		Role:      util.ViewerHolderRole,
+	})
+	require.NoError(t, err)
+
+	err = addOutboxEvent(ctx, testStore.(*SQLStore).Queries, AggregateUser, user.Username, EventUserCreated, UserCreatedEvent{
+		Username: user.Username,
+		Email:    user.Email,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.EraseUserTx(ctx, EraseUserTxParams{Username: user.Username})
+	require.NoError(t, err)
+
+	erased := result.User
+	require.Equal(t, user.Username, erased.Username)
+	require.NotEqual(t, user.Email, erased.Email)
+	require.NotEqual(t, user.FullName, erased.FullName)
+	require.Empty(t, erased.HashedPassword)
+	require.True(t, erased.ErasedAt.Valid)
+
+	sessions, err := testStore.ListSessionsByUsername(ctx, user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].IsBlocked)
+	require.Empty(t, sessions[0].RefreshToken)
+	require.Empty(t, sessions[0].ClientIp)
+
+	emails, err := testStore.ListVerificationEmailsByUsername(ctx, user.Username)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	require.Equal(t, erased.Email, emails[0].Email)
+
+	events, err := testStore.ListAuditEvents(ctx, ListAuditEventsParams{
+		TargetType: pgtype.Text{String: util.AuditTargetUser, Valid: true},
+		TargetID:   pgtype.Text{String: user.Username, Valid: true},
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NotContains(t, string(events[0].Diff), user.Email)
+	require.Contains(t, string(events[0].Diff), util.AuditErasedValue)
+	require.Contains(t, string(events[0].Diff), `"role"`)
+	require.Empty(t, events[0].ClientIp)
+	require.Empty(t, events[0].UserAgent)
+
+	webhooks, err := testStore.ListWebhooks(ctx, user.Username)
+	require.NoError(t, err)
+	require.Empty(t, webhooks)
+
+	_, err = testStore.GetAccountHolder(ctx, GetAccountHolderParams{AccountID: held.ID, Username: user.Username})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	invitations, err := testStore.ListAccountInvitations(ctx, ListAccountInvitationsParams{Invitee: user.Username, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, invitations)
+
+	// the events of the user are published without the email
+	var published int
+	_, err = testStore.RelayOutboxTx(ctx, RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateType == AggregateUser && event.AggregateID == user.Username {
+				require.NotContains(t, string(event.Payload), user.Email)
+				published++
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, published)
+
+	// audit events stay append-only outside of erasure
+	err = testStore.EraseAuditEventFields(ctx, EraseAuditEventFieldsParams{
+		Fields:      []string{"role"},
+		ErasedValue: util.AuditErasedValue,
+		Username:    user.Username,
+	})
+	require.Error(t, err)
+
+	// the ledger is intact
+	kept, err := testStore.GetAccount(ctx, account.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, kept.Owner)
+
+	_, err = testStore.EraseUserTx(ctx, EraseUserTxParams{Username: user.Username})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestEraseUserTxWithBalance(t *testing.T) {
+	account := createRandomAccount(t)
+	account, err := testStore.UpdateAccount(context.Background(), UpdateAccountParams{ID: account.ID, Balance: 100})
+	require.NoError(t, err)
+
+	_, err = testStore.EraseUserTx(context.Background(), EraseUserTxParams{Username: account.Owner})
+	require.ErrorIs(t, err, ErrAccountsNotSettled)
+
+	user, err := testStore.GetUser(context.Background(), account.Owner)
+	require.NoError(t, err)
+	require.False(t, user.ErasedAt.Valid)
+}
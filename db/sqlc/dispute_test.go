@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomDispute(t *testing.T) (Dispute, TransferTxResult) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	dispute, err := testStore.OpenDisputeTx(context.Background(), OpenDisputeTxParams{
+		TransferID: transfer.Transfer.ID,
+		AccountID:  account1.ID,
+		OpenedBy:   account1.Owner,
+		Reason:     "not authorized",
+		Evidence:   util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeOpen, dispute.Status)
+	require.False(t, dispute.AssignedTo.Valid)
+
+	return dispute, transfer
+}
+
+// getDisputeSuspense loads the dispute suspense account in the currency.
+func getDisputeSuspense(t *testing.T, currency string) LedgerAccount {
+	suspense, err := testStore.GetLedgerAccountByCode(context.Background(), GetLedgerAccountByCodeParams{
+		Code:     util.LedgerCodeDisputeSuspense,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+
+	return suspense
+}
+
+func TestOpenDisputeMovesNoMoney(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	suspenseBefore := getDisputeSuspense(t, account1.Currency)
+
+	dispute, err := testStore.OpenDisputeTx(ctx, OpenDisputeTxParams{
+		TransferID: transfer.Transfer.ID,
+		AccountID:  account1.ID,
+		OpenedBy:   account1.Owner,
+		Reason:     "not authorized",
+		Evidence:   util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.False(t, dispute.CreditEntryID.Valid)
+	require.False(t, dispute.ReversalEntryID.Valid)
+
+	customer, err := testStore.GetAccount(ctx, account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.FromAccount.Balance, customer.Balance)
+
+	suspenseAfter := getDisputeSuspense(t, account1.Currency)
+	require.Equal(t, suspenseBefore.Balance, suspenseAfter.Balance)
+
+	// a transfer is disputed only once
+	_, err = testStore.OpenDisputeTx(ctx, OpenDisputeTxParams{
+		TransferID: transfer.Transfer.ID,
+		AccountID:  account1.ID,
+		OpenedBy:   account1.Owner,
+		Reason:     "not authorized",
+		Evidence:   util.RandomString(32),
+	})
+	require.Error(t, err)
+}
+
+func TestDisputeResolvedForCustomer(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	dispute, transfer := createRandomDispute(t)
+
+	_, err := testStore.ResolveDisputeTx(ctx, ResolveDisputeTxParams{
+		DisputeID: dispute.ID,
+		Status:    util.DisputeResolvedCustomer,
+		Note:      "too early",
+	})
+	require.ErrorIs(t, err, ErrDisputeNotInvestigating)
+
+	investigated, err := testStore.StartDisputeInvestigationTx(ctx, StartDisputeInvestigationTxParams{
+		DisputeID:  dispute.ID,
+		AssignedTo: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeInvestigating, investigated.Status)
+	require.Equal(t, banker.Username, investigated.AssignedTo.String)
+
+	_, err = testStore.StartDisputeInvestigationTx(ctx, StartDisputeInvestigationTxParams{
+		DisputeID:  dispute.ID,
+		AssignedTo: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrDisputeNotOpen)
+
+	suspenseBefore := getDisputeSuspense(t, transfer.FromAccount.Currency)
+
+	result, err := testStore.ResolveDisputeTx(ctx, ResolveDisputeTxParams{
+		DisputeID: dispute.ID,
+		Status:    util.DisputeResolvedCustomer,
+		Note:      "card was stolen",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeResolvedCustomer, result.Dispute.Status)
+	require.True(t, result.Dispute.ResolvedAt.Valid)
+	require.Equal(t, result.CreditEntry.ID, result.Dispute.CreditEntryID.Int64)
+	require.Equal(t, transfer.Transfer.Amount, result.CreditEntry.Amount)
+	require.Equal(t, transfer.FromAccount.ID, result.CreditEntry.AccountID)
+	require.Equal(t, result.ReversalEntry.ID, result.Dispute.ReversalEntryID.Int64)
+	require.Equal(t, -transfer.Transfer.Amount, result.ReversalEntry.Amount)
+	require.Equal(t, transfer.ToAccount.ID, result.ReversalEntry.AccountID)
+
+	customer, err := testStore.GetAccount(ctx, transfer.FromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.FromAccount.Balance+transfer.Transfer.Amount, customer.Balance)
+
+	payee, err := testStore.GetAccount(ctx, transfer.ToAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.ToAccount.Balance-transfer.Transfer.Amount, payee.Balance)
+
+	// the reversal settles the provisional credit
+	suspenseAfter := getDisputeSuspense(t, transfer.FromAccount.Currency)
+	require.Equal(t, suspenseBefore.Balance, suspenseAfter.Balance)
+}
+
+func TestDisputeResolvedForMerchant(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	dispute, transfer := createRandomDispute(t)
+
+	_, err := testStore.StartDisputeInvestigationTx(ctx, StartDisputeInvestigationTxParams{
+		DisputeID:  dispute.ID,
+		AssignedTo: banker.Username,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.ResolveDisputeTx(ctx, ResolveDisputeTxParams{
+		DisputeID: dispute.ID,
+		Status:    util.DisputeResolvedMerchant,
+		Note:      "signed receipt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeResolvedMerchant, result.Dispute.Status)
+	require.False(t, result.Dispute.CreditEntryID.Valid)
+	require.False(t, result.Dispute.ReversalEntryID.Valid)
+
+	// no money moves
+	customer, err := testStore.GetAccount(ctx, transfer.FromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.FromAccount.Balance, customer.Balance)
+
+	payee, err := testStore.GetAccount(ctx, transfer.ToAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.ToAccount.Balance, payee.Balance)
+}
+
+func TestDisputeResolutionInsufficientFunds(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	dispute, transfer := createRandomDispute(t)
+
+	_, err := testStore.StartDisputeInvestigationTx(ctx, StartDisputeInvestigationTxParams{
+		DisputeID:  dispute.ID,
+		AssignedTo: banker.Username,
+	})
+	require.NoError(t, err)
+
+	// the payee spends everything before the dispute is resolved
+	_, err = testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: transfer.ToAccount.ID,
+		ToAccountID:   createRandomAccount(t).ID,
+		Amount:        transfer.ToAccount.Balance,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ResolveDisputeTx(ctx, ResolveDisputeTxParams{
+		DisputeID: dispute.ID,
+		Status:    util.DisputeResolvedCustomer,
+		Note:      "card was stolen",
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	payee, err := testStore.GetAccount(ctx, transfer.ToAccount.ID)
+	require.NoError(t, err)
+	require.Zero(t, payee.Balance)
+
+	// the customer isn't credited either
+	customer, err := testStore.GetAccount(ctx, transfer.FromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.FromAccount.Balance, customer.Balance)
+
+	current, err := testStore.GetDispute(ctx, dispute.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeInvestigating, current.Status)
+}
+
+func TestListDisputesByAccount(t *testing.T) {
+	dispute, _ := createRandomDispute(t)
+
+	disputes, err := testStore.ListDisputesByAccount(context.Background(), ListDisputesByAccountParams{
+		AccountID: dispute.AccountID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, disputes, 1)
+	require.Equal(t, dispute.ID, disputes[0].ID)
+}
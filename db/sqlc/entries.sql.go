@@ -7,36 +7,60 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
   account_id, 
-  amount
+  amount,
+  transfer_id
 ) VALUES (
-  $1, $2
-) RETURNING id, account_id, amount, created_at
+  $1, $2, $3
+) RETURNING id, account_id, amount, created_at, business_date, transfer_id
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID  int64       `json:"account_id"`
+	Amount     int64       `json:"amount"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount, arg.TransferID)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.BusinessDate,
+		&i.TransferID,
 	)
 	return i, err
 }
 
+const getDebitTotalSince = `-- name: GetDebitTotalSince :one
+SELECT COALESCE(SUM(-amount), 0)::bigint AS total FROM entries
+WHERE account_id = $1 AND amount < 0 AND created_at >= $2
+`
+
+type GetDebitTotalSinceParams struct {
+	AccountID int64     `json:"account_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) GetDebitTotalSince(ctx context.Context, arg GetDebitTotalSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getDebitTotalSince, arg.AccountID, arg.CreatedAt)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, business_date, transfer_id FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,12 +72,48 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.BusinessDate,
+		&i.TransferID,
 	)
 	return i, err
 }
 
+const getLastEntryID = `-- name: GetLastEntryID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_entry_id FROM entries
+WHERE account_id = $1
+`
+
+func (q *Queries) GetLastEntryID(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastEntryID, accountID)
+	var lastEntryID int64
+	err := row.Scan(&lastEntryID)
+	return lastEntryID, err
+}
+
+const getOpeningBalance = `-- name: GetOpeningBalance :one
+SELECT (a.balance - COALESCE((
+  SELECT SUM(e.amount) FROM entries e
+  WHERE e.account_id = a.id AND e.business_date >= $1
+), 0))::bigint AS opening_balance
+FROM accounts a
+WHERE a.id = $2
+`
+
+type GetOpeningBalanceParams struct {
+	BusinessDate pgtype.Date `json:"business_date"`
+	AccountID    int64       `json:"account_id"`
+}
+
+// The balance and the entries are read in one snapshot, so a posting committed meanwhile can't be counted in only one of them.
+func (q *Queries) GetOpeningBalance(ctx context.Context, arg GetOpeningBalanceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getOpeningBalance, arg.BusinessDate, arg.AccountID)
+	var openingBalance int64
+	err := row.Scan(&openingBalance)
+	return openingBalance, err
+}
+
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, business_date, transfer_id FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2 
@@ -80,6 +140,158 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.BusinessDate,
+			&i.TransferID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesAfter = `-- name: ListEntriesAfter :many
+SELECT
+  e.id, e.account_id, e.amount, e.created_at, e.business_date, e.transfer_id, a.currency, (a.balance - COALESCE((
+    SELECT SUM(l.amount) FROM entries l
+    WHERE l.account_id = e.account_id AND l.id > e.id
+  ), 0))::bigint AS balance
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = $1 AND e.id > $2
+ORDER BY e.id
+LIMIT $3
+`
+
+type ListEntriesAfterParams struct {
+	AccountID int64 `json:"account_id"`
+	ID        int64 `json:"id"`
+	Limit     int32 `json:"limit"`
+}
+
+type ListEntriesAfterRow struct {
+	ID           int64       `json:"id"`
+	AccountID    int64       `json:"account_id"`
+	Amount       int64       `json:"amount"`
+	CreatedAt    time.Time   `json:"created_at"`
+	BusinessDate pgtype.Date `json:"business_date"`
+	TransferID   pgtype.Int8 `json:"transfer_id"`
+	Currency     string      `json:"currency"`
+	Balance      int64       `json:"balance"`
+}
+
+func (q *Queries) ListEntriesAfter(ctx context.Context, arg ListEntriesAfterParams) ([]ListEntriesAfterRow, error) {
+	rows, err := q.db.Query(ctx, listEntriesAfter, arg.AccountID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEntriesAfterRow{}
+	for rows.Next() {
+		var i ListEntriesAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.BusinessDate,
+			&i.TransferID,
+			&i.Currency,
+			&i.Balance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesByOwner = `-- name: ListEntriesByOwner :many
+SELECT id, account_id, amount, created_at, business_date, transfer_id FROM entries
+WHERE account_id IN (SELECT id FROM accounts WHERE owner = $1)
+ORDER BY id
+`
+
+func (q *Queries) ListEntriesByOwner(ctx context.Context, owner string) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, listEntriesByOwner, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.BusinessDate,
+			&i.TransferID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentEntries = `-- name: ListRecentEntries :many
+SELECT
+  e.id, e.account_id, e.amount, e.created_at, e.business_date, e.transfer_id, a.currency, (a.balance - COALESCE((
+    SELECT SUM(l.amount) FROM entries l
+    WHERE l.account_id = e.account_id AND l.id > e.id
+  ), 0))::bigint AS balance
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = $1 AND e.id <= $2 AND e.created_at >= $3
+ORDER BY e.id
+`
+
+type ListRecentEntriesParams struct {
+	AccountID int64     `json:"account_id"`
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListRecentEntriesRow struct {
+	ID           int64       `json:"id"`
+	AccountID    int64       `json:"account_id"`
+	Amount       int64       `json:"amount"`
+	CreatedAt    time.Time   `json:"created_at"`
+	BusinessDate pgtype.Date `json:"business_date"`
+	TransferID   pgtype.Int8 `json:"transfer_id"`
+	Currency     string      `json:"currency"`
+	Balance      int64       `json:"balance"`
+}
+
+func (q *Queries) ListRecentEntries(ctx context.Context, arg ListRecentEntriesParams) ([]ListRecentEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listRecentEntries, arg.AccountID, arg.ID, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRecentEntriesRow{}
+	for rows.Next() {
+		var i ListRecentEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.BusinessDate,
+			&i.TransferID,
+			&i.Currency,
+			&i.Balance,
 		); err != nil {
 			return nil, err
 		}
@@ -90,3 +302,75 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 	}
 	return items, nil
 }
+
+const listStatementEntries = `-- name: ListStatementEntries :many
+SELECT
+  e.id, e.account_id, e.amount, e.created_at, e.business_date, e.transfer_id, COALESCE((
+    SELECT a.number FROM transfers t
+    JOIN accounts a ON a.id = CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END
+    WHERE t.id = e.transfer_id
+  ), '')::varchar AS counterparty_account_number, COALESCE((SELECT c.kind FROM cash_operations c WHERE c.entry_id = e.id), '')::varchar AS cash_operation_kind, EXISTS (SELECT 1 FROM balance_adjustments b WHERE b.entry_id = e.id)::bool AS is_adjustment
+FROM entries e
+WHERE e.account_id = $1
+  AND e.business_date BETWEEN $2::date AND $3::date
+ORDER BY e.id
+`
+
+type ListStatementEntriesParams struct {
+	AccountID int64       `json:"account_id"`
+	FromDate  pgtype.Date `json:"from_date"`
+	ToDate    pgtype.Date `json:"to_date"`
+}
+
+type ListStatementEntriesRow struct {
+	ID                        int64       `json:"id"`
+	AccountID                 int64       `json:"account_id"`
+	Amount                    int64       `json:"amount"`
+	CreatedAt                 time.Time   `json:"created_at"`
+	BusinessDate              pgtype.Date `json:"business_date"`
+	TransferID                pgtype.Int8 `json:"transfer_id"`
+	CounterpartyAccountNumber string      `json:"counterparty_account_number"`
+	CashOperationKind         string      `json:"cash_operation_kind"`
+	IsAdjustment              bool        `json:"is_adjustment"`
+}
+
+// The statement goes to the account holders, so the counterparty is given by its account number only
+// and the internal reason of a balance adjustment is left out.
+func (q *Queries) ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listStatementEntries, arg.AccountID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementEntriesRow{}
+	for rows.Next() {
+		var i ListStatementEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.BusinessDate,
+			&i.TransferID,
+			&i.CounterpartyAccountNumber,
+			&i.CashOperationKind,
+			&i.IsAdjustment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const notifyAccountActivity = `-- name: NotifyAccountActivity :exec
+SELECT pg_notify('account_activity', $1::text)
+`
+
+func (q *Queries) NotifyAccountActivity(ctx context.Context, accountID string) error {
+	_, err := q.db.Exec(ctx, notifyAccountActivity, accountID)
+	return err
+}
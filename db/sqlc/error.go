@@ -9,4 +9,6 @@ var ErrRecordNotFound = pgx.ErrNoRows
 const (
 	ForeignKeyViolation = "23503"
 	UniqueViolation     = "23505"
+	// raised by the trigger that refuses entries booked on a closed business day
+	BusinessDayClosed = "SB001"
 )
@@ -3,16 +3,29 @@ package db
 import (
 	"context"
 	"fmt"
+
+	"github.com/jackc/pgx/v5"
 )
 
+// txBeginner begins the transactions of a store: the connection pool,
+// or the enclosing transaction of AuditTx, in which case they run as savepoints.
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.connPool.Begin(ctx)
+	return store.inTx(ctx, func(tx pgx.Tx) error {
+		return fn(New(tx))
+	})
+}
+
+func (store *SQLStore) inTx(ctx context.Context, fn func(pgx.Tx) error) error {
+	tx, err := store.conn.Begin(ctx)
 	if err != nil {
 		return err
 	}
 
-	q := New(tx)
-	err = fn(q)
+	err = fn(tx)
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
 			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
@@ -23,3 +36,27 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	return tx.Commit(ctx)
 }
+
+// AuditTx makes the change and records the audit event it returns in one transaction,
+// so the change is not committed without its audit event. The store passed to change
+// runs its queries and transactions within that transaction.
+func (store *SQLStore) AuditTx(ctx context.Context, change func(store Store) (CreateAuditEventParams, error)) error {
+	return store.inTx(ctx, func(tx pgx.Tx) error {
+		txStore := &SQLStore{
+			Queries: New(tx),
+			conn:    tx,
+		}
+
+		event, err := change(txStore)
+		if err != nil {
+			return err
+		}
+
+		_, err = txStore.CreateAuditEvent(ctx, event)
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeldTransferRelease(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	hit := TransferRuleHit{Rule: "new_recipient", Decision: util.FraudReview, Reason: "first transfer"}
+
+	held, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Status:        util.TransferHeld,
+		RuleHits:      []TransferRuleHit{hit},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferHeld, held.Transfer.Status)
+	require.Zero(t, held.FromEntry.ID)
+
+	hits, err := testStore.ListFraudRuleHits(ctx, held.Transfer.ID)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	require.Equal(t, hit.Rule, hits[0].Rule)
+	require.Equal(t, hit.Decision, hits[0].Decision)
+
+	// no money moves while the transfer is held
+	unchanged, err := testStore.GetAccount(ctx, account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, unchanged.Balance)
+
+	released, err := testStore.ReviewTransferTx(ctx, ReviewTransferTxParams{
+		TransferID: held.Transfer.ID,
+		ReviewedBy: banker.Username,
+		Release:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferCompleted, released.Transfer.Status)
+	require.Equal(t, banker.Username, released.Transfer.ReviewedBy.String)
+	require.Equal(t, account1.Balance-10, released.FromAccount.Balance)
+	require.Equal(t, account2.Balance+10, released.ToAccount.Balance)
+
+	_, err = testStore.ReviewTransferTx(ctx, ReviewTransferTxParams{
+		TransferID: held.Transfer.ID,
+		ReviewedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrTransferNotHeld)
+}
+
+func TestTransferTxScreen(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	since := time.Now().Add(-time.Minute)
+
+	_, err := testStore.TransferTx(ctx, TransferTxParams{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 10})
+	require.NoError(t, err)
+
+	// the screen sees the completed transfer, held ones are left out of the count
+	hit := TransferRuleHit{Rule: "velocity", Decision: util.FraudDeny, Reason: "too many transfers"}
+	screen := func(ctx context.Context, q Querier) (string, []TransferRuleHit, error) {
+		count, err := q.CountCompletedTransfersSince(ctx, CountCompletedTransfersSinceParams{
+			FromAccountID: account1.ID,
+			CreatedAt:     since,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		if count < 1 {
+			return util.TransferCompleted, nil, nil
+		}
+		return util.TransferDenied, []TransferRuleHit{hit}, nil
+	}
+
+	denied, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Screen:        screen,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferDenied, denied.Transfer.Status)
+	require.Zero(t, denied.FromEntry.ID)
+
+	hits, err := testStore.ListFraudRuleHits(ctx, denied.Transfer.ID)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	require.Equal(t, hit.Rule, hits[0].Rule)
+}
+
+func TestHeldTransferReleaseInsufficientFunds(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	held, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + 1,
+		Status:        util.TransferHeld,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ReviewTransferTx(ctx, ReviewTransferTxParams{
+		TransferID: held.Transfer.ID,
+		ReviewedBy: banker.Username,
+		Release:    true,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	transfer, err := testStore.GetTransfer(ctx, held.Transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferHeld, transfer.Status)
+}
+
+func TestHeldTransferReject(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	held, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Status:        util.TransferHeld,
+	})
+	require.NoError(t, err)
+
+	rejected, err := testStore.ReviewTransferTx(ctx, ReviewTransferTxParams{
+		TransferID: held.Transfer.ID,
+		ReviewedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferRejected, rejected.Transfer.Status)
+
+	unchanged, err := testStore.GetAccount(ctx, account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, unchanged.Balance)
+}
+
+func TestHeldTransferSelfReview(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	held, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Status:        util.TransferHeld,
+		InitiatedBy:   account1.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.Owner, held.Transfer.InitiatedBy.String)
+
+	_, err = testStore.ReviewTransferTx(ctx, ReviewTransferTxParams{
+		TransferID: held.Transfer.ID,
+		ReviewedBy: account1.Owner,
+		Release:    true,
+	})
+	require.ErrorIs(t, err, ErrSelfReview)
+
+	transfer, err := testStore.GetTransfer(ctx, held.Transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferHeld, transfer.Status)
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type postLedgerEntryParams struct {
+	Code        string
+	Currency    string
+	Amount      int64
+	EntryID     pgtype.Int8
+	Description string
+}
+
+// postLedgerEntry posts amount to the ledger account with the code in the currency and updates its balance.
+// A positive amount is a credit. It must be called with the queries of the transaction
+// that posts the opposite entry, so that the books stay balanced.
+func postLedgerEntry(ctx context.Context, q *Queries, arg postLedgerEntryParams) (LedgerEntry, error) {
+	account, err := q.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+		Code:     arg.Code,
+		Currency: arg.Currency,
+	})
+	if err != nil {
+		return LedgerEntry{}, err
+	}
+
+	entry, err := q.CreateLedgerEntry(ctx, CreateLedgerEntryParams{
+		LedgerAccountID: account.ID,
+		EntryID:         arg.EntryID,
+		Amount:          arg.Amount,
+		Description:     arg.Description,
+	})
+	if err != nil {
+		return LedgerEntry{}, err
+	}
+
+	_, err = q.AddLedgerAccountBalance(ctx, AddLedgerAccountBalanceParams{
+		ID:     account.ID,
+		Amount: arg.Amount,
+	})
+
+	return entry, err
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/loan"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisburseLoanTx(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+
+	schedule, err := loan.Schedule(1000, 1200, 3, util.LoanLinear, time.Now())
+	require.NoError(t, err)
+
+	result, err := testStore.DisburseLoanTx(ctx, DisburseLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     1000,
+		AnnualRateBps: 1200,
+		TermMonths:    3,
+		Method:        util.LoanLinear,
+		DisbursedBy:   account.Owner,
+		Schedule:      schedule,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+1000, result.Account.Balance)
+	require.Equal(t, int64(1000), result.Entry.Amount)
+
+	require.Equal(t, util.LoanActive, result.Loan.Status)
+	require.Equal(t, account.Currency, result.Loan.Currency)
+	require.Equal(t, int64(1000), result.Loan.OutstandingPrincipal)
+	require.Equal(t, int64(20), result.Loan.OutstandingInterest)
+	require.Equal(t, result.Entry.ID, result.Loan.DisbursementEntryID)
+
+	installments, err := testStore.ListLoanInstallments(ctx, result.Loan.ID)
+	require.NoError(t, err)
+	require.Len(t, installments, 3)
+	for i, installment := range installments {
+		require.Equal(t, schedule[i].Seq, installment.Seq)
+		require.Equal(t, schedule[i].Principal, installment.Principal)
+		require.Equal(t, schedule[i].Interest, installment.Interest)
+		require.Equal(t, util.InstallmentScheduled, installment.Status)
+	}
+}
+
+func TestCollectLoanInstallmentTx(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+
+	schedule, err := loan.Schedule(1000, 1200, 3, util.LoanLinear, time.Now())
+	require.NoError(t, err)
+
+	disbursed, err := testStore.DisburseLoanTx(ctx, DisburseLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     1000,
+		AnnualRateBps: 1200,
+		TermMonths:    3,
+		Method:        util.LoanLinear,
+		DisbursedBy:   account.Owner,
+		Schedule:      schedule,
+	})
+	require.NoError(t, err)
+
+	// the interest and the late fee are paid on top of the principal
+	_, err = testStore.AdjustBalanceTx(ctx, AdjustBalanceTxParams{AccountID: account.ID, Amount: 100, Reason: "test", AdjustedBy: account.Owner})
+	require.NoError(t, err)
+
+	first, second := disbursed.Installments[0], disbursed.Installments[1]
+
+	// the second installment waits for the first one and is charged the fee only once
+	for range 2 {
+		result, err := testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: second.ID, LateFee: 5})
+		require.NoError(t, err)
+		require.False(t, result.Collected)
+		require.Equal(t, util.InstallmentOverdue, result.Installment.Status)
+		require.Equal(t, int64(5), result.Installment.LateFee)
+	}
+
+	result, err := testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: first.ID, LateFee: 5})
+	require.NoError(t, err)
+	require.True(t, result.Collected)
+	require.Equal(t, util.InstallmentPaid, result.Installment.Status)
+	require.True(t, result.Installment.EntryID.Valid)
+	require.Equal(t, util.TransferCompleted, result.Transfer.Status)
+	require.Equal(t, account.ID, result.Transfer.FromAccountID)
+	require.Equal(t, first.Principal+first.Interest, result.Transfer.Amount)
+	require.Equal(t, -(first.Principal + first.Interest), result.Entry.Amount)
+
+	// the installment is swept from the servicing account into the ledger
+	servicing, err := testStore.GetAccount(ctx, result.Transfer.ToAccountID)
+	require.NoError(t, err)
+	require.Equal(t, util.LoanServicingOwner, servicing.Owner)
+	require.Equal(t, account.Currency, servicing.Currency)
+	require.Equal(t, servicing.ID, result.Entry.AccountID)
+	require.Equal(t, int64(1000)-first.Principal, result.Loan.OutstandingPrincipal)
+	require.Equal(t, int64(20)-first.Interest, result.Loan.OutstandingInterest)
+
+	result, err = testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: second.ID, LateFee: 5})
+	require.NoError(t, err)
+	require.True(t, result.Collected)
+	require.Equal(t, -(second.Principal + second.Interest + 5), result.Entry.Amount)
+	require.Equal(t, util.LoanActive, result.Loan.Status)
+
+	result, err = testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: disbursed.Installments[2].ID, LateFee: 5})
+	require.NoError(t, err)
+	require.True(t, result.Collected)
+	require.Equal(t, util.LoanPaidOff, result.Loan.Status)
+	require.True(t, result.Loan.PaidOffAt.Valid)
+	require.Zero(t, result.Loan.OutstandingPrincipal)
+	require.Zero(t, result.Loan.OutstandingInterest)
+	require.Equal(t, account.Balance+100-1000-20-5+1000, result.Account.Balance)
+
+	// a paid installment is not collected twice
+	result, err = testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: first.ID, LateFee: 5})
+	require.NoError(t, err)
+	require.False(t, result.Collected)
+}
+
+func TestCollectLoanInstallmentTxInsufficientFunds(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+
+	schedule, err := loan.Schedule(1000, 1200, 3, util.LoanAnnuity, time.Now())
+	require.NoError(t, err)
+
+	disbursed, err := testStore.DisburseLoanTx(ctx, DisburseLoanTxParams{
+		AccountID:     account.ID,
+		Principal:     1000,
+		AnnualRateBps: 1200,
+		TermMonths:    3,
+		Method:        util.LoanAnnuity,
+		DisbursedBy:   account.Owner,
+		Schedule:      schedule,
+	})
+	require.NoError(t, err)
+
+	// the money in a savings pot is not available for the installment
+	pot := createRandomSavingsPot(t, account)
+	_, err = testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: disbursed.Account.Balance})
+	require.NoError(t, err)
+
+	result, err := testStore.CollectLoanInstallmentTx(ctx, CollectLoanInstallmentTxParams{InstallmentID: disbursed.Installments[0].ID, LateFee: 5})
+	require.NoError(t, err)
+	require.False(t, result.Collected)
+	require.Equal(t, util.InstallmentOverdue, result.Installment.Status)
+	require.Equal(t, int64(5), result.Installment.LateFee)
+	require.Equal(t, disbursed.Account.Balance, result.Account.Balance)
+	require.Equal(t, int64(1000), result.Loan.OutstandingPrincipal)
+}
@@ -23,7 +23,7 @@ func TestMain(m *testing.M) {
 		log.Fatal("Cannot connect to the database: ", err)
 	}
 
-	testStore = NewStore(connPool)
+	testStore = NewStore(connPool, nil)
 
 	os.Exit(m.Run())
 }
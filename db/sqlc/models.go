@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
@@ -16,6 +17,163 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// IBAN-like account number with mod-97 check digits, e.g. XS51SIMP012345678901
+	Number string `json:"number"`
+	// part of the balance set aside in savings pots, the available balance is balance - pot_balance
+	PotBalance int64 `json:"pot_balance"`
+}
+
+type AccountBalanceSnapshot struct {
+	BusinessDate   pgtype.Date `json:"business_date"`
+	AccountID      int64       `json:"account_id"`
+	Currency       string      `json:"currency"`
+	ClosingBalance int64       `json:"closing_balance"`
+}
+
+type AccountHolder struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+	// owner, co_owner or view_only
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AccountInvitation struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Inviter   string `json:"inviter"`
+	Invitee   string `json:"invitee"`
+	Role      string `json:"role"`
+	// pending, accepted or declined
+	Status      string             `json:"status"`
+	CreatedAt   time.Time          `json:"created_at"`
+	RespondedAt pgtype.Timestamptz `json:"responded_at"`
+}
+
+type AlertRule struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// the holder of the account who receives the alert
+	Username string `json:"username"`
+	// low_balance, large_debit or daily_spend
+	Kind string `json:"kind"`
+	// must be positive
+	Threshold int64 `json:"threshold"`
+	// set when the alert is sent and cleared when the rule is rearmed, so the alert is not repeated
+	TriggeredAt pgtype.Timestamptz `json:"triggered_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// empty for anonymous calls
+	Actor      string `json:"actor"`
+	ActorRole  string `json:"actor_role"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// changed fields with their before and after values
+	Diff      []byte    `json:"diff"`
+	ClientIp  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BalanceAdjustment struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	EntryID   int64 `json:"entry_id"`
+	// can be negative or positive
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason"`
+	// banker who made the adjustment
+	AdjustedBy    string      `json:"adjusted_by"`
+	CreatedAt     time.Time   `json:"created_at"`
+	LedgerEntryID pgtype.Int8 `json:"ledger_entry_id"`
+}
+
+type BusinessDay struct {
+	// a closed day, no entries can be booked on it or before it
+	BusinessDate pgtype.Date `json:"business_date"`
+	ClosedAt     time.Time   `json:"closed_at"`
+}
+
+type CashOperation struct {
+	ID int64 `json:"id"`
+	// deposit or withdrawal
+	Kind      string `json:"kind"`
+	AccountID int64  `json:"account_id"`
+	// cash clearing ledger account in the currency of the account
+	ClearingAccountID int64 `json:"clearing_account_id"`
+	// must be positive
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	// balance of the account printed on the receipt
+	BalanceAfter int64 `json:"balance_after"`
+	EntryID      int64 `json:"entry_id"`
+	// the opposite ledger entry on the clearing account
+	ClearingEntryID int64 `json:"clearing_entry_id"`
+	// banker who handled the cash
+	PerformedBy string    `json:"performed_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CategoryRule struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Category string `json:"category"`
+	// matches transfers with this account, any counterparty if empty
+	CounterpartyAccountID pgtype.Int8 `json:"counterparty_account_id"`
+	// matches transfers whose memo contains the text ignoring case, any memo if empty
+	MemoContains string    `json:"memo_contains"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type DailyTotal struct {
+	BusinessDate pgtype.Date `json:"business_date"`
+	Currency     string      `json:"currency"`
+	TotalDebits  int64       `json:"total_debits"`
+	TotalCredits int64       `json:"total_credits"`
+	EntryCount   int64       `json:"entry_count"`
+	// sum of the closing balances of the accounts in the currency
+	ClosingBalance int64 `json:"closing_balance"`
+}
+
+type DataExport struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// pending, ready or failed
+	Status string `json:"status"`
+	// ZIP archive of the user data as JSON files
+	Archive     []byte             `json:"archive"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+	// the archive can not be downloaded after this time
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	// SHA-256 of the download token emailed to the user, set once the archive is ready
+	DownloadTokenHash pgtype.Text `json:"download_token_hash"`
+}
+
+type Dispute struct {
+	ID         int64 `json:"id"`
+	TransferID int64 `json:"transfer_id"`
+	// the account the disputed transfer was paid from
+	AccountID int64  `json:"account_id"`
+	OpenedBy  string `json:"opened_by"`
+	Reason    string `json:"reason"`
+	Evidence  string `json:"evidence"`
+	// open, investigating, resolved_customer or resolved_merchant
+	Status string `json:"status"`
+	// banker investigating the dispute
+	AssignedTo     pgtype.Text `json:"assigned_to"`
+	ResolutionNote pgtype.Text `json:"resolution_note"`
+	// provisional credit to the customer, only for resolutions in favour of the customer
+	CreditEntryID pgtype.Int8 `json:"credit_entry_id"`
+	// final reversal debited from the payee, only for resolutions in favour of the customer
+	ReversalEntryID pgtype.Int8        `json:"reversal_entry_id"`
+	ResolvedAt      pgtype.Timestamptz `json:"resolved_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	CreatedAt       time.Time          `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +182,186 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// UTC day the entry is booked on
+	BusinessDate pgtype.Date `json:"business_date"`
+	// the transfer that posted the entry, if any
+	TransferID pgtype.Int8 `json:"transfer_id"`
+}
+
+type EntryCategory struct {
+	EntryID   int64     `json:"entry_id"`
+	Category  string    `json:"category"`
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExternalStatement struct {
+	ID int64 `json:"id"`
+	// camt053 or mt940
+	Format string `json:"format"`
+	// id of the statement at the external bank
+	Reference       string `json:"reference"`
+	ExternalAccount string `json:"external_account"`
+	Currency        string `json:"currency"`
+	// cash clearing ledger account mirroring the external account
+	ClearingAccountID int64       `json:"clearing_account_id"`
+	FromDate          pgtype.Date `json:"from_date"`
+	ToDate            pgtype.Date `json:"to_date"`
+	OpeningBalance    int64       `json:"opening_balance"`
+	ClosingBalance    int64       `json:"closing_balance"`
+	ImportedBy        string      `json:"imported_by"`
+	CreatedAt         time.Time   `json:"created_at"`
+}
+
+type ExternalStatementLine struct {
+	ID          int64       `json:"id"`
+	StatementID int64       `json:"statement_id"`
+	BookingDate pgtype.Date `json:"booking_date"`
+	ValueDate   pgtype.Date `json:"value_date"`
+	// credits on the external account are positive
+	Amount       int64  `json:"amount"`
+	Reference    string `json:"reference"`
+	EndToEndID   string `json:"end_to_end_id"`
+	Counterparty string `json:"counterparty"`
+	Description  string `json:"description"`
+	// unmatched, matched or resolved
+	Status string `json:"status"`
+	// the clearing ledger entry the line matches
+	EntryID pgtype.Int8 `json:"entry_id"`
+	// banker who resolved the line by hand, empty for automatic matches
+	ResolvedBy     pgtype.Text        `json:"resolved_by"`
+	ResolutionNote pgtype.Text        `json:"resolution_note"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+}
+
+type FraudRuleHit struct {
+	ID         int64  `json:"id"`
+	TransferID int64  `json:"transfer_id"`
+	Rule       string `json:"rule"`
+	// review or deny
+	Decision  string    `json:"decision"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LedgerAccount struct {
+	ID   int64  `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// asset, liability, equity, revenue or expense
+	Type     string `json:"type"`
+	Currency string `json:"currency"`
+	// credits are positive and debits are negative, like the balances of customer accounts
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LedgerEntry struct {
+	ID              int64 `json:"id"`
+	LedgerAccountID int64 `json:"ledger_account_id"`
+	// the opposite entry on a customer account, if any
+	EntryID pgtype.Int8 `json:"entry_id"`
+	// credits are positive and debits are negative
+	Amount      int64     `json:"amount"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	// UTC day the entry is booked on
+	BusinessDate pgtype.Date `json:"business_date"`
+}
+
+type Loan struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Principal int64  `json:"principal"`
+	Currency  string `json:"currency"`
+	// annual interest rate in basis points
+	AnnualRateBps int32 `json:"annual_rate_bps"`
+	TermMonths    int32 `json:"term_months"`
+	// annuity or linear
+	Method string `json:"method"`
+	// active or paid_off
+	Status               string `json:"status"`
+	OutstandingPrincipal int64  `json:"outstanding_principal"`
+	OutstandingInterest  int64  `json:"outstanding_interest"`
+	DisbursementEntryID  int64  `json:"disbursement_entry_id"`
+	// banker who disbursed the loan
+	DisbursedBy string             `json:"disbursed_by"`
+	PaidOffAt   pgtype.Timestamptz `json:"paid_off_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type LoanInstallment struct {
+	ID        int64       `json:"id"`
+	LoanID    int64       `json:"loan_id"`
+	Seq       int32       `json:"seq"`
+	DueDate   pgtype.Date `json:"due_date"`
+	Principal int64       `json:"principal"`
+	Interest  int64       `json:"interest"`
+	// charged once when the installment could not be collected on the due date
+	LateFee int64 `json:"late_fee"`
+	// scheduled, overdue or paid
+	Status    string             `json:"status"`
+	EntryID   pgtype.Int8        `json:"entry_id"`
+	PaidAt    pgtype.Timestamptz `json:"paid_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type NotificationDigestItem struct {
+	ID         int64  `json:"id"`
+	Username   string `json:"username"`
+	EventType  string `json:"event_type"`
+	TransferID int64  `json:"transfer_id"`
+	AccountID  int64  `json:"account_id"`
+	Amount     int64  `json:"amount"`
+	Currency   string `json:"currency"`
+	// empty until the digest with the item is sent
+	SentAt    pgtype.Timestamptz `json:"sent_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	Username string `json:"username"`
+	// money_in or money_out
+	EventType string `json:"event_type"`
+	// smaller transfers are batched into the digest instead of notified one by one
+	MinAmount int64     `json:"min_amount"`
+	Channel   string    `json:"channel"`
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Outbox struct {
+	ID int64 `json:"id"`
+	// kind of entity the event belongs to, see the Aggregate constants in db/sqlc/outbox.go
+	AggregateType string    `json:"aggregate_type"`
+	AggregateID   string    `json:"aggregate_id"`
+	EventType     string    `json:"event_type"`
+	Payload       []byte    `json:"payload"`
+	CreatedAt     time.Time `json:"created_at"`
+	// null until the relay has published the event
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+}
+
+type PotEntry struct {
+	ID        int64 `json:"id"`
+	PotID     int64 `json:"pot_id"`
+	AccountID int64 `json:"account_id"`
+	// positive when moved from the main balance into the pot, negative when moved back
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SavingsPot struct {
+	ID           int64       `json:"id"`
+	AccountID    int64       `json:"account_id"`
+	Name         string      `json:"name"`
+	TargetAmount int64       `json:"target_amount"`
+	Deadline     pgtype.Date `json:"deadline"`
+	Balance      int64       `json:"balance"`
+	// open or closed
+	Status    string             `json:"status"`
+	ClosedAt  pgtype.Timestamptz `json:"closed_at"`
+	CreatedAt time.Time          `json:"created_at"`
 }
 
 type Session struct {
@@ -44,6 +382,34 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// completed, held, denied or rejected. Money moves only for completed transfers
+	Status string `json:"status"`
+	// banker who released or rejected the held transfer
+	ReviewedBy pgtype.Text        `json:"reviewed_by"`
+	ReviewedAt pgtype.Timestamptz `json:"reviewed_at"`
+	Memo       string             `json:"memo"`
+	// user who made the transfer, null for transfers made by the bank and those made before it was recorded
+	InitiatedBy pgtype.Text `json:"initiated_by"`
+}
+
+type TransferApproval struct {
+	ID            int64  `json:"id"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	// user who requested the transfer
+	InitiatedBy string `json:"initiated_by"`
+	// pending, approved, rejected or expired
+	Status string `json:"status"`
+	// banker who approved or rejected the transfer, never the initiator
+	DecidedBy pgtype.Text        `json:"decided_by"`
+	DecidedAt pgtype.Timestamptz `json:"decided_at"`
+	// the transfer executed on approval
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+	Memo       string      `json:"memo"`
 }
 
 type User struct {
@@ -55,6 +421,11 @@ type User struct {
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
 	Role              string    `json:"role"`
+	// personal data has been pseudonymized on request
+	ErasedAt pgtype.Timestamptz `json:"erased_at"`
+	// a locked user can not log in or renew access tokens
+	LockedAt   pgtype.Timestamptz `json:"locked_at"`
+	LockReason pgtype.Text        `json:"lock_reason"`
 }
 
 type VerificationEmail struct {
@@ -66,3 +437,29 @@ type VerificationEmail struct {
 	CreatedAt  time.Time `json:"created_at"`
 	ExpiredAt  time.Time `json:"expired_at"`
 }
+
+type Webhook struct {
+	ID         int64    `json:"id"`
+	Owner      string   `json:"owner"`
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	// key of the HMAC-SHA256 payload signature
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID        int64 `json:"id"`
+	WebhookID int64 `json:"webhook_id"`
+	// id of the outbox event
+	EventID   int64  `json:"event_id"`
+	EventType string `json:"event_type"`
+	Payload   []byte `json:"payload"`
+	// pending, succeeded or failed
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      time.Time          `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertNotificationPreference(t *testing.T) {
+	ctx := context.Background()
+	user := createRandomUser(t)
+
+	preference, err := testStore.UpsertNotificationPreference(ctx, UpsertNotificationPreferenceParams{
+		Username:  user.Username,
+		EventType: util.NotificationMoneyIn,
+		MinAmount: 100,
+		Channel:   util.NotificationChannelEmail,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), preference.MinAmount)
+
+	updated, err := testStore.UpsertNotificationPreference(ctx, UpsertNotificationPreferenceParams{
+		Username:  user.Username,
+		EventType: util.NotificationMoneyIn,
+		MinAmount: 500,
+		Channel:   util.NotificationChannelEmail,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), updated.MinAmount)
+	require.Equal(t, preference.CreatedAt, updated.CreatedAt)
+
+	preferences, err := testStore.ListNotificationPreferences(ctx, user.Username)
+	require.NoError(t, err)
+	require.Len(t, preferences, 1)
+
+	deleted, err := testStore.DeleteNotificationPreference(ctx, DeleteNotificationPreferenceParams{
+		Username:  user.Username,
+		EventType: util.NotificationMoneyIn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
+
+func TestNotificationDigestItems(t *testing.T) {
+	ctx := context.Background()
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := testStore.TransferTx(ctx, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	arg := CreateNotificationDigestItemParams{
+		Username:   account2.Owner,
+		EventType:  util.NotificationMoneyIn,
+		TransferID: transfer.Transfer.ID,
+		AccountID:  account2.ID,
+		Amount:     10,
+		Currency:   account2.Currency,
+	}
+	require.NoError(t, testStore.CreateNotificationDigestItem(ctx, arg))
+	// a redelivered task must not add the transfer twice
+	require.NoError(t, testStore.CreateNotificationDigestItem(ctx, arg))
+
+	items, err := testStore.ListPendingDigestItems(ctx, account2.Owner)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	err = testStore.MarkDigestItemsSent(ctx, MarkDigestItemsSentParams{
+		Username:   account2.Owner,
+		LastItemID: items[0].ID,
+	})
+	require.NoError(t, err)
+
+	items, err = testStore.ListPendingDigestItems(ctx, account2.Owner)
+	require.NoError(t, err)
+	require.Empty(t, items)
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Aggregate types of the outbox events.
+const (
+	AggregateUser             = "user"
+	AggregateTransfer         = "transfer"
+	AggregateTransferApproval = "transfer_approval"
+	AggregateDispute          = "dispute"
+	AggregateAccount          = "account"
+)
+
+// Event types of the outbox events.
+const (
+	EventUserCreated             = "user.created"
+	EventEmailVerified           = "user.email_verified"
+	EventTransferCompleted       = "transfer.completed"
+	EventTransferApprovalExpired = "transfer_approval.expired"
+	EventDisputeStatusChanged    = "dispute.status_changed"
+	EventAccountPosted           = "account.posted"
+)
+
+type UserCreatedEvent struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+type EmailVerifiedEvent struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// AccountPostedEvent describes a change of the account balance or of its part set aside in savings pots.
+// Amount is zero for a move of money between the main balance and a pot.
+type AccountPostedEvent struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	Balance   int64 `json:"balance"`
+}
+
+// addOutboxEvent stores the event in the outbox table.
+// It must be called with the queries of the transaction that made the change the event describes.
+func addOutboxEvent(ctx context.Context, q *Queries, aggregateType string, aggregateID string, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize %s event payload: %w", eventType, err)
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+	})
+
+	return err
+}
+
+// addAccountPostedEvent records the change of the account balance or of the pots, so the alert rules of the account
+// are evaluated once the posting is committed.
+func addAccountPostedEvent(ctx context.Context, q *Queries, account Account, amount int64) error {
+	event := AccountPostedEvent{
+		AccountID: account.ID,
+		Amount:    amount,
+		Balance:   account.Balance,
+	}
+
+	return addOutboxEvent(ctx, q, AggregateAccount, strconv.FormatInt(account.ID, 10), EventAccountPosted, event)
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserTxWritesOutboxEvent(t *testing.T) {
+	user := createRandomUser(t)
+
+	arg := CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       user.Username + "x",
+			HashedPassword: user.HashedPassword,
+			FullName:       user.FullName,
+			Email:          "x" + user.Email,
+		},
+	}
+
+	result, err := testStore.CreateUserTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	var published []Outbox
+	_, err = testStore.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateID == result.User.Username {
+				published = append(published, event)
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, published, 1)
+	require.Equal(t, AggregateUser, published[0].AggregateType)
+	require.Equal(t, EventUserCreated, published[0].EventType)
+
+	var payload UserCreatedEvent
+	require.NoError(t, json.Unmarshal(published[0].Payload, &payload))
+	require.Equal(t, result.User.Username, payload.Username)
+	require.Equal(t, result.User.Email, payload.Email)
+}
+
+func TestRelayOutboxTx(t *testing.T) {
+	ctx := context.Background()
+
+	aggregateID := createRandomUser(t).Username
+	for _, eventType := range []string{EventUserCreated, EventEmailVerified} {
+		err := addOutboxEvent(ctx, testStore.(*SQLStore).Queries, AggregateUser, aggregateID, eventType, struct{}{})
+		require.NoError(t, err)
+	}
+
+	// the first event of the aggregate fails, so the second one must not be published either
+	result, err := testStore.RelayOutboxTx(ctx, RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateID == aggregateID {
+				return errors.New("publish failed")
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	var failed []string
+	for _, event := range result.Failed {
+		if event.AggregateID == aggregateID {
+			failed = append(failed, event.EventType)
+		}
+	}
+	require.Equal(t, []string{EventUserCreated}, failed)
+
+	var published []string
+	_, err = testStore.RelayOutboxTx(ctx, RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateID == aggregateID {
+				published = append(published, event.EventType)
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{EventUserCreated, EventEmailVerified}, published)
+
+	// sent events are not published again
+	published = nil
+	_, err = testStore.RelayOutboxTx(ctx, RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateID == aggregateID {
+				published = append(published, event.EventType)
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, published)
+}
@@ -8,30 +8,204 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountPotBalance(ctx context.Context, arg AddAccountPotBalanceParams) (Account, error)
+	AddLedgerAccountBalance(ctx context.Context, arg AddLedgerAccountBalanceParams) (LedgerAccount, error)
+	AddSavingsPotBalance(ctx context.Context, arg AddSavingsPotBalanceParams) (SavingsPot, error)
+	// lets the current transaction replace the personal data in the audit diffs
+	AllowAuditEventErasure(ctx context.Context) error
+	BlockSessions(ctx context.Context, username string) error
+	CloseSavingsPot(ctx context.Context, id int64) (SavingsPot, error)
+	CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) (DataExport, error)
+	CountCompletedTransfersBetween(ctx context.Context, arg CountCompletedTransfersBetweenParams) (int64, error)
+	CountCompletedTransfersSince(ctx context.Context, arg CountCompletedTransfersSinceParams) (int64, error)
+	CountSessionsFromIP(ctx context.Context, arg CountSessionsFromIPParams) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountBalanceSnapshot(ctx context.Context, arg CreateAccountBalanceSnapshotParams) (AccountBalanceSnapshot, error)
+	CreateAccountBalanceSnapshots(ctx context.Context, businessDate pgtype.Date) (int64, error)
+	CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error)
+	CreateAccountInvitation(ctx context.Context, arg CreateAccountInvitationParams) (AccountInvitation, error)
+	CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (AlertRule, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	CreateBalanceAdjustment(ctx context.Context, arg CreateBalanceAdjustmentParams) (BalanceAdjustment, error)
+	CreateBusinessDay(ctx context.Context, businessDate pgtype.Date) (BusinessDay, error)
+	CreateCashOperation(ctx context.Context, arg CreateCashOperationParams) (CashOperation, error)
+	CreateCategoryRule(ctx context.Context, arg CreateCategoryRuleParams) (CategoryRule, error)
+	CreateDailyTotal(ctx context.Context, arg CreateDailyTotalParams) (DailyTotal, error)
+	CreateDataExport(ctx context.Context, username string) (DataExport, error)
+	CreateDispute(ctx context.Context, arg CreateDisputeParams) (Dispute, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateExternalStatement(ctx context.Context, arg CreateExternalStatementParams) (ExternalStatement, error)
+	CreateExternalStatementLine(ctx context.Context, arg CreateExternalStatementLineParams) (ExternalStatementLine, error)
+	CreateFraudRuleHit(ctx context.Context, arg CreateFraudRuleHitParams) (FraudRuleHit, error)
+	CreateLedgerAccount(ctx context.Context, arg CreateLedgerAccountParams) (LedgerAccount, error)
+	CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) (LedgerEntry, error)
+	CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error)
+	CreateLoanInstallment(ctx context.Context, arg CreateLoanInstallmentParams) (LoanInstallment, error)
+	CreateNotificationDigestItem(ctx context.Context, arg CreateNotificationDigestItemParams) error
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error)
+	CreatePotEntry(ctx context.Context, arg CreatePotEntryParams) (PotEntry, error)
+	CreateSavingsPot(ctx context.Context, arg CreateSavingsPotParams) (SavingsPot, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerificationEmail(ctx context.Context, arg CreateVerificationEmailParams) (VerificationEmail, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	DecideTransferApproval(ctx context.Context, arg DecideTransferApprovalParams) (TransferApproval, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) error
+	DeleteAccountHoldersByUsername(ctx context.Context, username string) error
+	DeleteAlertRule(ctx context.Context, arg DeleteAlertRuleParams) (int64, error)
+	DeleteAlertRulesByUsername(ctx context.Context, username string) error
+	DeleteCategoryRule(ctx context.Context, arg DeleteCategoryRuleParams) (int64, error)
+	DeleteCategoryRulesByUsername(ctx context.Context, username string) error
+	DeleteDataExports(ctx context.Context, username string) error
+	DeleteEntryCategory(ctx context.Context, entryID int64) (int64, error)
+	DeleteNotificationPreference(ctx context.Context, arg DeleteNotificationPreferenceParams) (int64, error)
+	DeleteNotificationPreferences(ctx context.Context, username string) error
+	DeletePendingAccountInvitationsByUser(ctx context.Context, username string) error
+	DeleteWebhook(ctx context.Context, id int64) error
+	DeleteWebhookDeliveriesByOwner(ctx context.Context, owner string) error
+	DeleteWebhooksByOwner(ctx context.Context, owner string) error
+	// the client address and user agent identify the user in the events the user made
+	EraseAuditEventClients(ctx context.Context, actor string) error
+	EraseAuditEventFields(ctx context.Context, arg EraseAuditEventFieldsParams) error
+	EraseSessions(ctx context.Context, username string) error
+	EraseUser(ctx context.Context, arg EraseUserParams) (User, error)
+	EraseUserOutboxEmails(ctx context.Context, arg EraseUserOutboxEmailsParams) error
+	EraseUserWebhookDeliveryEmails(ctx context.Context, arg EraseUserWebhookDeliveryEmailsParams) error
+	EraseVerificationEmails(ctx context.Context, arg EraseVerificationEmailsParams) error
+	ExpireTransferApprovals(ctx context.Context, arg ExpireTransferApprovalsParams) ([]TransferApproval, error)
+	FailDataExport(ctx context.Context, id int64) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountBalanceSnapshot(ctx context.Context, arg GetAccountBalanceSnapshotParams) (AccountBalanceSnapshot, error)
+	GetAccountBalanceTotals(ctx context.Context) ([]GetAccountBalanceTotalsRow, error)
+	GetAccountByNumber(ctx context.Context, number string) (Account, error)
+	GetAccountByOwnerAndCurrency(ctx context.Context, arg GetAccountByOwnerAndCurrencyParams) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountHolder(ctx context.Context, arg GetAccountHolderParams) (AccountHolder, error)
+	GetAccountInvitation(ctx context.Context, id int64) (AccountInvitation, error)
+	GetAlertRule(ctx context.Context, id int64) (AlertRule, error)
+	GetBusinessDay(ctx context.Context, businessDate pgtype.Date) (BusinessDay, error)
+	GetCashOperation(ctx context.Context, id int64) (CashOperation, error)
+	GetDailyPostingTotals(ctx context.Context, businessDate pgtype.Date) ([]GetDailyPostingTotalsRow, error)
+	GetDataExport(ctx context.Context, id int64) (DataExport, error)
+	GetDebitTotalSince(ctx context.Context, arg GetDebitTotalSinceParams) (int64, error)
+	GetDispute(ctx context.Context, id int64) (Dispute, error)
+	GetDisputeForUpdate(ctx context.Context, id int64) (Dispute, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExternalStatement(ctx context.Context, id int64) (ExternalStatement, error)
+	GetExternalStatementByReference(ctx context.Context, arg GetExternalStatementByReferenceParams) (ExternalStatement, error)
+	GetExternalStatementLine(ctx context.Context, id int64) (ExternalStatementLine, error)
+	GetFirstUnpaidLoanInstallment(ctx context.Context, loanID int64) (LoanInstallment, error)
+	GetLastClosedBusinessDay(ctx context.Context) (BusinessDay, error)
+	GetLastEntryID(ctx context.Context, accountID int64) (int64, error)
+	GetLatestSession(ctx context.Context, username string) (Session, error)
+	GetLedgerAccountByCode(ctx context.Context, arg GetLedgerAccountByCodeParams) (LedgerAccount, error)
+	GetLedgerEntry(ctx context.Context, id int64) (LedgerEntry, error)
+	GetLoan(ctx context.Context, id int64) (Loan, error)
+	GetLoanForUpdate(ctx context.Context, id int64) (Loan, error)
+	GetLoanInstallment(ctx context.Context, id int64) (LoanInstallment, error)
+	GetLoanInstallmentForUpdate(ctx context.Context, id int64) (LoanInstallment, error)
+	GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) (NotificationPreference, error)
+	// The balance and the entries are read in one snapshot, so a posting committed meanwhile can't be counted in only one of them.
+	GetOpeningBalance(ctx context.Context, arg GetOpeningBalanceParams) (int64, error)
+	GetPostingTotals(ctx context.Context) ([]GetPostingTotalsRow, error)
+	GetSavingsPot(ctx context.Context, id int64) (SavingsPot, error)
+	GetSavingsPotForUpdate(ctx context.Context, id int64) (SavingsPot, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSnapshotTotals(ctx context.Context, businessDate pgtype.Date) ([]GetSnapshotTotalsRow, error)
+	// Covers the accounts the user owns or holds. The category of an entry is the one set by a user, else the one
+	// of the oldest matching rule of the requesting user. Entries without a transfer, like cash operations,
+	// only get a category set by a user. The memo is matched as a plain substring, % and _ are not wildcards.
+	GetSpendingByCategory(ctx context.Context, arg GetSpendingByCategoryParams) ([]GetSpendingByCategoryRow, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferAmountStats(ctx context.Context, fromAccountID int64) (GetTransferAmountStatsRow, error)
+	GetTransferApproval(ctx context.Context, id int64) (TransferApproval, error)
+	GetTransferApprovalForUpdate(ctx context.Context, id int64) (TransferApproval, error)
 	GetUser(ctx context.Context, username string) (User, error)
 	GetVerificationEmail(ctx context.Context, id int64) (VerificationEmail, error)
+	GetWebhook(ctx context.Context, id int64) (Webhook, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	// access tokens outlive a lock or an erasure, so they are checked on every request
+	IsUserBlocked(ctx context.Context, username string) (bool, error)
+	ListAccountHolders(ctx context.Context, accountID int64) ([]AccountHolder, error)
+	ListAccountInvitations(ctx context.Context, arg ListAccountInvitationsParams) ([]AccountInvitation, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsByOwner(ctx context.Context, owner string) ([]Account, error)
+	ListAlertRules(ctx context.Context, arg ListAlertRulesParams) ([]AlertRule, error)
+	ListAlertRulesByAccount(ctx context.Context, accountID int64) ([]AlertRule, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListBalanceAdjustments(ctx context.Context, accountID int64) ([]BalanceAdjustment, error)
+	ListCategoryRules(ctx context.Context, username string) ([]CategoryRule, error)
+	ListDailyTotals(ctx context.Context, businessDate pgtype.Date) ([]DailyTotal, error)
+	ListDigestRecipients(ctx context.Context, arg ListDigestRecipientsParams) ([]string, error)
+	ListDisputesByAccount(ctx context.Context, arg ListDisputesByAccountParams) ([]Dispute, error)
+	ListDisputesByOpener(ctx context.Context, arg ListDisputesByOpenerParams) ([]Dispute, error)
+	ListDisputesByStatus(ctx context.Context, arg ListDisputesByStatusParams) ([]Dispute, error)
+	// Lists the unpaid installments due on or before the date, after_id pages through them by id.
+	ListDueLoanInstallments(ctx context.Context, arg ListDueLoanInstallmentsParams) ([]LoanInstallment, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesAfter(ctx context.Context, arg ListEntriesAfterParams) ([]ListEntriesAfterRow, error)
+	ListEntriesByOwner(ctx context.Context, owner string) ([]Entry, error)
+	ListExternalStatementLinesByStatus(ctx context.Context, arg ListExternalStatementLinesByStatusParams) ([]ExternalStatementLine, error)
+	ListFraudRuleHits(ctx context.Context, transferID int64) ([]FraudRuleHit, error)
+	ListLedgerAccounts(ctx context.Context) ([]LedgerAccount, error)
+	ListLedgerEntries(ctx context.Context, arg ListLedgerEntriesParams) ([]LedgerEntry, error)
+	ListLoanInstallments(ctx context.Context, loanID int64) ([]LoanInstallment, error)
+	ListLoans(ctx context.Context, accountID int64) ([]Loan, error)
+	ListNotificationPreferences(ctx context.Context, username string) ([]NotificationPreference, error)
+	ListPendingDigestItems(ctx context.Context, username string) ([]NotificationDigestItem, error)
+	ListPendingOutboxEvents(ctx context.Context, limit int32) ([]Outbox, error)
+	ListPendingTransferApprovals(ctx context.Context, arg ListPendingTransferApprovalsParams) ([]TransferApproval, error)
+	ListPotEntries(ctx context.Context, potID int64) ([]PotEntry, error)
+	ListRecentEntries(ctx context.Context, arg ListRecentEntriesParams) ([]ListRecentEntriesRow, error)
+	ListReconciliationCandidates(ctx context.Context, arg ListReconciliationCandidatesParams) ([]ListReconciliationCandidatesRow, error)
+	ListSavingsPots(ctx context.Context, accountID int64) ([]SavingsPot, error)
+	ListSessionsByUsername(ctx context.Context, username string) ([]Session, error)
+	// The statement goes to the account holders, so the counterparty is given by its account number only
+	// and the internal reason of a balance adjustment is left out.
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListTransfersByOwner(ctx context.Context, owner string) ([]Transfer, error)
+	ListTransfersByOwnerPage(ctx context.Context, arg ListTransfersByOwnerPageParams) ([]Transfer, error)
+	ListTransfersByStatus(ctx context.Context, arg ListTransfersByStatusParams) ([]Transfer, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListVerificationEmailsByUsername(ctx context.Context, username string) ([]VerificationEmail, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, owner string) ([]Webhook, error)
+	ListWebhooksForEvent(ctx context.Context, arg ListWebhooksForEventParams) ([]Webhook, error)
+	LockPostings(ctx context.Context) error
+	LockUser(ctx context.Context, arg LockUserParams) (User, error)
+	MarkDigestItemsSent(ctx context.Context, arg MarkDigestItemsSentParams) error
+	MarkLoanInstallmentOverdue(ctx context.Context, arg MarkLoanInstallmentOverdueParams) (LoanInstallment, error)
+	MarkOutboxEventSent(ctx context.Context, id int64) error
+	MatchExternalStatementLine(ctx context.Context, arg MatchExternalStatementLineParams) (ExternalStatementLine, error)
+	NotifyAccountActivity(ctx context.Context, accountID string) error
+	PayLoanInstallment(ctx context.Context, arg PayLoanInstallmentParams) (LoanInstallment, error)
+	PayOffLoan(ctx context.Context, id int64) (Loan, error)
+	RearmAlertRule(ctx context.Context, id int64) error
+	RepayLoan(ctx context.Context, arg RepayLoanParams) (Loan, error)
+	ResolveDispute(ctx context.Context, arg ResolveDisputeParams) (Dispute, error)
+	ReviewHeldTransfer(ctx context.Context, arg ReviewHeldTransferParams) (Transfer, error)
+	SetEntryCategory(ctx context.Context, arg SetEntryCategoryParams) (EntryCategory, error)
+	StartDisputeInvestigation(ctx context.Context, arg StartDisputeInvestigationParams) (Dispute, error)
+	// Marks the rule as triggered unless it already was. A rule triggered before rearm_before counts as rearmed,
+	// an empty rearm_before keeps the rule silent until RearmAlertRule is called.
+	TriggerAlertRule(ctx context.Context, arg TriggerAlertRuleParams) (int64, error)
+	UnlockUser(ctx context.Context, username string) (User, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountInvitationStatus(ctx context.Context, arg UpdateAccountInvitationStatusParams) (AccountInvitation, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerificationEmail(ctx context.Context, arg UpdateVerificationEmailParams) (VerificationEmail, error)
+	UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) (WebhookDelivery, error)
+	UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) (NotificationPreference, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStatementTx(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	deposit, err := testStore.CashOperationTx(ctx, CashOperationTxParams{
+		Kind:        util.CashDeposit,
+		AccountID:   account.ID,
+		Amount:      10,
+		PerformedBy: banker.Username,
+	})
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	arg := ImportStatementTxParams{
+		Format:          util.StatementFormatMT940,
+		Reference:       util.RandomString(12),
+		ExternalAccount: util.RandomString(22),
+		Currency:        account.Currency,
+		FromDate:        today,
+		ToDate:          today,
+		Lines: []ImportStatementLine{
+			{BookingDate: today, ValueDate: today, Amount: -deposit.ClearingEntry.Amount, Reference: "cash"},
+			{BookingDate: today, ValueDate: today, Amount: 1, Reference: "fee"},
+		},
+		ImportedBy:  banker.Username,
+		MatchWindow: 1,
+		Match: func(lines []ExternalStatementLine, entries []ListReconciliationCandidatesRow) map[int64]int64 {
+			for _, entry := range entries {
+				if entry.CashOperationID == deposit.Operation.ID {
+					return map[int64]int64{lines[0].ID: entry.ID}
+				}
+			}
+			return nil
+		},
+	}
+
+	result, err := testStore.ImportStatementTx(ctx, arg)
+	require.NoError(t, err)
+	require.Equal(t, deposit.Operation.ClearingAccountID, result.Statement.ClearingAccountID)
+	require.Len(t, result.Lines, 2)
+
+	require.Equal(t, util.StatementLineMatched, result.Lines[0].Status)
+	require.Equal(t, deposit.ClearingEntry.ID, result.Lines[0].EntryID.Int64)
+	require.False(t, result.Lines[0].ResolvedBy.Valid)
+	require.Equal(t, util.StatementLineUnmatched, result.Lines[1].Status)
+	require.False(t, result.Lines[1].EntryID.Valid)
+
+	_, err = testStore.ImportStatementTx(ctx, arg)
+	require.ErrorIs(t, err, ErrStatementAlreadyImported)
+
+	// an entry that is matched is not a candidate any more
+	candidates, err := testStore.ListReconciliationCandidates(ctx, ListReconciliationCandidatesParams{
+		LedgerAccountID: deposit.Operation.ClearingAccountID,
+		FromDate:        BusinessDate(today),
+		ToDate:          BusinessDate(today),
+	})
+	require.NoError(t, err)
+	for _, candidate := range candidates {
+		require.NotEqual(t, deposit.ClearingEntry.ID, candidate.ID)
+	}
+}
@@ -0,0 +1,46 @@
+package db
+
+import "context"
+
+type readYourWritesKey struct{}
+
+// WithReadYourWrites returns a context whose reads always go to the primary database,
+// so that the caller observes its own writes regardless of the replication lag.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+// IsReadYourWrites reports whether reads made with ctx must go to the primary database.
+func IsReadYourWrites(ctx context.Context) bool {
+	v, _ := ctx.Value(readYourWritesKey{}).(bool)
+	return v
+}
+
+// reader returns the queries to use for a read-only query.
+func (store *SQLStore) reader(ctx context.Context) *Queries {
+	if store.replica == nil || IsReadYourWrites(ctx) {
+		return store.Queries
+	}
+
+	return store.replica
+}
+
+func (store *SQLStore) GetAccount(ctx context.Context, id int64) (Account, error) {
+	return store.reader(ctx).GetAccount(ctx, id)
+}
+
+func (store *SQLStore) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
+	return store.reader(ctx).ListAccounts(ctx, arg)
+}
+
+func (store *SQLStore) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
+	return store.reader(ctx).ListEntries(ctx, arg)
+}
+
+func (store *SQLStore) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
+	return store.reader(ctx).ListTransfers(ctx, arg)
+}
+
+func (store *SQLStore) GetUser(ctx context.Context, username string) (User, error) {
+	return store.reader(ctx).GetUser(ctx, username)
+}
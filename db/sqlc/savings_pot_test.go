@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSavingsPot(t *testing.T, account Account) SavingsPot {
+	pot, err := testStore.CreateSavingsPot(context.Background(), CreateSavingsPotParams{
+		AccountID:    account.ID,
+		Name:         util.RandomString(8),
+		TargetAmount: util.RandomMoney(),
+		Deadline:     pgtype.Date{Time: time.Now().AddDate(0, 3, 0), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PotOpen, pot.Status)
+	require.Zero(t, pot.Balance)
+
+	return pot
+}
+
+func TestMovePotMoneyTx(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	pot := createRandomSavingsPot(t, account)
+
+	result, err := testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: account.Balance})
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, result.Pot.Balance)
+	require.Equal(t, account.Balance, result.Account.Balance)
+	require.Equal(t, account.Balance, result.Account.PotBalance)
+	require.Equal(t, account.Balance, result.Entry.Amount)
+
+	// the whole balance is in the pot, nothing is available
+	_, err = testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: 1})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testStore.CashOperationTx(ctx, CashOperationTxParams{Kind: util.CashWithdrawal, AccountID: account.ID, Amount: 1, PerformedBy: account.Owner})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: -account.Balance - 1})
+	require.ErrorIs(t, err, ErrInsufficientPotBalance)
+
+	other := createRandomAccount(t)
+	_, err = testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: other.ID, PotID: pot.ID, Amount: 1})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	entries, err := testStore.ListPotEntries(ctx, pot.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// the move changed the available balance, so the alerts of the account are evaluated again
+	var published []Outbox
+	_, err = testStore.RelayOutboxTx(ctx, RelayOutboxTxParams{
+		Limit: 1000,
+		Publish: func(event Outbox) error {
+			if event.AggregateType == AggregateAccount && event.AggregateID == strconv.FormatInt(account.ID, 10) {
+				published = append(published, event)
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, published, 1)
+	require.Equal(t, EventAccountPosted, published[0].EventType)
+
+	var payload AccountPostedEvent
+	require.NoError(t, json.Unmarshal(published[0].Payload, &payload))
+	require.Zero(t, payload.Amount)
+}
+
+func TestCloseSavingsPotTx(t *testing.T) {
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	pot := createRandomSavingsPot(t, account)
+
+	_, err := testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: 10})
+	require.NoError(t, err)
+
+	result, err := testStore.CloseSavingsPotTx(ctx, CloseSavingsPotTxParams{AccountID: account.ID, PotID: pot.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.PotClosed, result.Pot.Status)
+	require.True(t, result.Pot.ClosedAt.Valid)
+	require.Zero(t, result.Pot.Balance)
+	require.Equal(t, int64(-10), result.Entry.Amount)
+	require.Zero(t, result.Account.PotBalance)
+	require.Equal(t, account.Balance, result.Account.Balance)
+
+	_, err = testStore.MovePotMoneyTx(ctx, MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: 1})
+	require.ErrorIs(t, err, ErrPotClosed)
+
+	_, err = testStore.CloseSavingsPotTx(ctx, CloseSavingsPotTxParams{AccountID: account.ID, PotID: pot.ID})
+	require.ErrorIs(t, err, ErrPotClosed)
+}
@@ -12,6 +12,35 @@ import (
 	"github.com/google/uuid"
 )
 
+const blockSessions = `-- name: BlockSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE username = $1
+`
+
+func (q *Queries) BlockSessions(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, blockSessions, username)
+	return err
+}
+
+const countSessionsFromIP = `-- name: CountSessionsFromIP :one
+SELECT COUNT(*) FROM sessions
+WHERE username = $1 AND client_ip = $2 AND created_at < $3
+`
+
+type CountSessionsFromIPParams struct {
+	Username  string    `json:"username"`
+	ClientIp  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) CountSessionsFromIP(ctx context.Context, arg CountSessionsFromIPParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSessionsFromIP, arg.Username, arg.ClientIp, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createSession = `-- name: CreateSession :one
 INSERT INTO sessions (
   id,
@@ -60,6 +89,44 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 	return i, err
 }
 
+const eraseSessions = `-- name: EraseSessions :exec
+UPDATE sessions
+SET
+  refresh_token = '',
+  user_agent = '',
+  client_ip = '',
+  is_blocked = true
+WHERE username = $1
+`
+
+func (q *Queries) EraseSessions(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, eraseSessions, username)
+	return err
+}
+
+const getLatestSession = `-- name: GetLatestSession :one
+SELECT id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at FROM sessions
+WHERE username = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestSession(ctx context.Context, username string) (Session, error) {
+	row := q.db.QueryRow(ctx, getLatestSession, username)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.RefreshToken,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.IsBlocked,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getSession = `-- name: GetSession :one
 SELECT id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at FROM sessions
 WHERE id = $1 LIMIT 1
@@ -80,3 +147,38 @@ func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	)
 	return i, err
 }
+
+const listSessionsByUsername = `-- name: ListSessionsByUsername :many
+SELECT id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at FROM sessions
+WHERE username = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListSessionsByUsername(ctx context.Context, username string) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listSessionsByUsername, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.RefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,16 +11,44 @@ type Store interface {
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	AcceptInvitationTx(ctx context.Context, arg AcceptInvitationTxParams) (AcceptInvitationTxResult, error)
+	RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error)
+	EraseUserTx(ctx context.Context, arg EraseUserTxParams) (EraseUserTxResult, error)
+	ReviewTransferTx(ctx context.Context, arg ReviewTransferTxParams) (TransferTxResult, error)
+	LockUserTx(ctx context.Context, arg LockUserTxParams) (LockUserTxResult, error)
+	AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error)
+	CashOperationTx(ctx context.Context, arg CashOperationTxParams) (CashOperationTxResult, error)
+	CloseBusinessDayTx(ctx context.Context, arg CloseBusinessDayTxParams) (CloseBusinessDayTxResult, error)
+	ImportStatementTx(ctx context.Context, arg ImportStatementTxParams) (ImportStatementTxResult, error)
+	DecideTransferApprovalTx(ctx context.Context, arg DecideTransferApprovalTxParams) (DecideTransferApprovalTxResult, error)
+	ExpireTransferApprovalsTx(ctx context.Context, arg ExpireTransferApprovalsTxParams) ([]TransferApproval, error)
+	OpenDisputeTx(ctx context.Context, arg OpenDisputeTxParams) (Dispute, error)
+	StartDisputeInvestigationTx(ctx context.Context, arg StartDisputeInvestigationTxParams) (Dispute, error)
+	ResolveDisputeTx(ctx context.Context, arg ResolveDisputeTxParams) (ResolveDisputeTxResult, error)
+	MovePotMoneyTx(ctx context.Context, arg MovePotMoneyTxParams) (MovePotMoneyTxResult, error)
+	CloseSavingsPotTx(ctx context.Context, arg CloseSavingsPotTxParams) (CloseSavingsPotTxResult, error)
+	DisburseLoanTx(ctx context.Context, arg DisburseLoanTxParams) (DisburseLoanTxResult, error)
+	CollectLoanInstallmentTx(ctx context.Context, arg CollectLoanInstallmentTxParams) (CollectLoanInstallmentTxResult, error)
+	AuditTx(ctx context.Context, change func(store Store) (CreateAuditEventParams, error)) error
 }
 
 type SQLStore struct {
 	*Queries
-	connPool *pgxpool.Pool
+	conn    txBeginner
+	replica *Queries
 }
 
-func NewStore(connPool *pgxpool.Pool) Store {
-	return &SQLStore{
-		connPool: connPool,
-		Queries:  New(connPool),
+// NewStore creates a store on top of the primary connection pool.
+// If replicaPool is not nil, read-only queries are routed to it unless the context requires read-your-writes.
+func NewStore(connPool *pgxpool.Pool, replicaPool *pgxpool.Pool) Store {
+	store := &SQLStore{
+		conn:    connPool,
+		Queries: New(connPool),
 	}
+
+	if replicaPool != nil {
+		store.replica = New(replicaPool)
+	}
+
+	return store
 }
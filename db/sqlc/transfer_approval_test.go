@@ -0,0 +1,168 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTransferApproval(t *testing.T, expiresAt time.Time) TransferApproval {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	arg := CreateTransferApprovalParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        util.RandomMoney(),
+		Currency:      account1.Currency,
+		InitiatedBy:   account1.Owner,
+		ExpiresAt:     expiresAt,
+	}
+
+	approval, err := testStore.CreateTransferApproval(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.FromAccountID, approval.FromAccountID)
+	require.Equal(t, arg.Amount, approval.Amount)
+	require.Equal(t, arg.InitiatedBy, approval.InitiatedBy)
+	require.Equal(t, util.TransferApprovalPending, approval.Status)
+	require.False(t, approval.TransferID.Valid)
+
+	return approval
+}
+
+func TestDecideTransferApprovalTx(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	approval := createRandomTransferApproval(t, time.Now().Add(time.Hour))
+
+	_, err := testStore.DecideTransferApprovalTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  approval.InitiatedBy,
+		Approve:    true,
+	})
+	require.ErrorIs(t, err, ErrSelfApproval)
+
+	result, err := testStore.DecideTransferApprovalTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  banker.Username,
+		Approve:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalApproved, result.Approval.Status)
+	require.Equal(t, banker.Username, result.Approval.DecidedBy.String)
+	require.True(t, result.Approval.DecidedAt.Valid)
+	require.Equal(t, result.Transfer.Transfer.ID, result.Approval.TransferID.Int64)
+	require.Equal(t, approval.Amount, result.Transfer.Transfer.Amount)
+	require.Equal(t, util.TransferCompleted, result.Transfer.Transfer.Status)
+	require.Equal(t, -approval.Amount, result.Transfer.FromEntry.Amount)
+
+	_, err = testStore.DecideTransferApprovalTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  banker.Username,
+		Approve:    false,
+	})
+	require.ErrorIs(t, err, ErrTransferApprovalNotPending)
+}
+
+func TestDecideTransferApprovalTxScreen(t *testing.T) {
+	banker := createRandomUser(t)
+	approval := createRandomTransferApproval(t, time.Now().Add(time.Hour))
+
+	review := func(ctx context.Context, q Querier) (string, []TransferRuleHit, error) {
+		return util.TransferHeld, []TransferRuleHit{{Rule: "fake", Decision: util.FraudReview, Reason: "fake reason"}}, nil
+	}
+
+	result, err := testStore.DecideTransferApprovalTx(context.Background(), DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  banker.Username,
+		Approve:    true,
+		Screen:     review,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalApproved, result.Approval.Status)
+	require.Equal(t, result.Transfer.Transfer.ID, result.Approval.TransferID.Int64)
+
+	// a review verdict holds the approved transfer, no money moves
+	require.Equal(t, util.TransferHeld, result.Transfer.Transfer.Status)
+	require.Zero(t, result.Transfer.FromEntry.ID)
+}
+
+func TestDecideTransferApprovalTxInitiatorCannotSpend(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	stranger := createRandomUser(t)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	// the initiator was removed from the account after asking for the approval
+	approval, err := testStore.CreateTransferApproval(ctx, CreateTransferApprovalParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        util.RandomMoney(),
+		Currency:      account1.Currency,
+		InitiatedBy:   stranger.Username,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.DecideTransferApprovalTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  banker.Username,
+		Approve:    true,
+	})
+	require.ErrorIs(t, err, ErrInitiatorCannotSpend)
+
+	current, err := testStore.GetTransferApproval(ctx, approval.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalPending, current.Status)
+}
+
+func TestRejectTransferApprovalTx(t *testing.T) {
+	banker := createRandomUser(t)
+	approval := createRandomTransferApproval(t, time.Now().Add(time.Hour))
+
+	result, err := testStore.DecideTransferApprovalTx(context.Background(), DecideTransferApprovalTxParams{
+		ApprovalID: approval.ID,
+		DecidedBy:  banker.Username,
+		Approve:    false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalRejected, result.Approval.Status)
+	require.False(t, result.Approval.TransferID.Valid)
+	require.Zero(t, result.Transfer.Transfer.ID)
+}
+
+func TestExpireTransferApprovalsTx(t *testing.T) {
+	ctx := context.Background()
+	banker := createRandomUser(t)
+	overdue := createRandomTransferApproval(t, time.Now().Add(-time.Minute))
+	pending := createRandomTransferApproval(t, time.Now().Add(time.Hour))
+
+	_, err := testStore.DecideTransferApprovalTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: overdue.ID,
+		DecidedBy:  banker.Username,
+		Approve:    true,
+	})
+	require.ErrorIs(t, err, ErrTransferApprovalExpired)
+
+	expired, err := testStore.ExpireTransferApprovalsTx(ctx, ExpireTransferApprovalsTxParams{
+		Now:       time.Now(),
+		BatchSize: 1000,
+	})
+	require.NoError(t, err)
+
+	var expiredIDs []int64
+	for _, approval := range expired {
+		require.Equal(t, util.TransferApprovalExpired, approval.Status)
+		expiredIDs = append(expiredIDs, approval.ID)
+	}
+	require.Contains(t, expiredIDs, overdue.ID)
+	require.NotContains(t, expiredIDs, pending.ID)
+
+	approval, err := testStore.GetTransferApproval(ctx, pending.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalPending, approval.Status)
+}
@@ -7,26 +7,76 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countCompletedTransfersBetween = `-- name: CountCompletedTransfersBetween :one
+SELECT COUNT(*) FROM transfers
+WHERE from_account_id = $1 AND to_account_id = $2 AND status = 'completed'
+`
+
+type CountCompletedTransfersBetweenParams struct {
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+}
+
+func (q *Queries) CountCompletedTransfersBetween(ctx context.Context, arg CountCompletedTransfersBetweenParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCompletedTransfersBetween, arg.FromAccountID, arg.ToAccountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCompletedTransfersSince = `-- name: CountCompletedTransfersSince :one
+SELECT COUNT(*) FROM transfers
+WHERE from_account_id = $1 AND created_at >= $2 AND status = 'completed'
+`
+
+type CountCompletedTransfersSinceParams struct {
+	FromAccountID int64     `json:"from_account_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (q *Queries) CountCompletedTransfersSince(ctx context.Context, arg CountCompletedTransfersSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCompletedTransfersSince, arg.FromAccountID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id, 
   to_account_id,
-  amount
+  amount,
+  status,
+  memo,
+  initiated_by
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+  $1, $2, $3, $4, $5, $6
+) RETURNING id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64       `json:"from_account_id"`
+	ToAccountID   int64       `json:"to_account_id"`
+	Amount        int64       `json:"amount"`
+	Status        string      `json:"status"`
+	Memo          string      `json:"memo"`
+	InitiatedBy   pgtype.Text `json:"initiated_by"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Status,
+		arg.Memo,
+		arg.InitiatedBy,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +84,17 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.Memo,
+		&i.InitiatedBy,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,12 +107,39 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.Memo,
+		&i.InitiatedBy,
+	)
+	return i, err
+}
+
+const getTransferAmountStats = `-- name: GetTransferAmountStats :one
+SELECT
+  COUNT(*)::bigint AS transfer_count, COALESCE(AVG(amount), 0)::bigint AS average_amount
+FROM transfers
+WHERE from_account_id = $1 AND status = 'completed'
+`
+
+type GetTransferAmountStatsRow struct {
+	TransferCount int64 `json:"transfer_count"`
+	AverageAmount int64 `json:"average_amount"`
+}
+
+func (q *Queries) GetTransferAmountStats(ctx context.Context, fromAccountID int64) (GetTransferAmountStatsRow, error) {
+	row := q.db.QueryRow(ctx, getTransferAmountStats, fromAccountID)
+	var i GetTransferAmountStatsRow
+	err := row.Scan(
+		&i.TransferCount,
+		&i.AverageAmount,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by FROM transfers
 WHERE 
   from_account_id = $1 OR
   to_account_id = $2
@@ -93,6 +175,11 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Status,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.Memo,
+			&i.InitiatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -103,3 +190,168 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const listTransfersByOwner = `-- name: ListTransfersByOwner :many
+SELECT id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by FROM transfers
+WHERE
+  from_account_id IN (SELECT id FROM accounts WHERE owner = $1) OR
+  to_account_id IN (SELECT id FROM accounts WHERE owner = $1)
+ORDER BY id
+`
+
+func (q *Queries) ListTransfersByOwner(ctx context.Context, owner string) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByOwner, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.Memo,
+			&i.InitiatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfersByOwnerPage = `-- name: ListTransfersByOwnerPage :many
+SELECT id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by FROM transfers
+WHERE
+  from_account_id IN (SELECT id FROM accounts WHERE owner = $1) OR
+  to_account_id IN (SELECT id FROM accounts WHERE owner = $1)
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListTransfersByOwnerPageParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListTransfersByOwnerPage(ctx context.Context, arg ListTransfersByOwnerPageParams) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByOwnerPage, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.Memo,
+			&i.InitiatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfersByStatus = `-- name: ListTransfersByStatus :many
+SELECT id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by FROM transfers
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListTransfersByStatusParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListTransfersByStatus(ctx context.Context, arg ListTransfersByStatusParams) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByStatus, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.Memo,
+			&i.InitiatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewHeldTransfer = `-- name: ReviewHeldTransfer :one
+UPDATE transfers
+SET
+  status = $2,
+  reviewed_by = $3,
+  reviewed_at = now()
+WHERE id = $1 AND status = 'held'
+RETURNING id, from_account_id, to_account_id, amount, created_at, status, reviewed_by, reviewed_at, memo, initiated_by
+`
+
+type ReviewHeldTransferParams struct {
+	ID         int64       `json:"id"`
+	Status     string      `json:"status"`
+	ReviewedBy pgtype.Text `json:"reviewed_by"`
+}
+
+func (q *Queries) ReviewHeldTransfer(ctx context.Context, arg ReviewHeldTransferParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, reviewHeldTransfer, arg.ID, arg.Status, arg.ReviewedBy)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.Memo,
+		&i.InitiatedBy,
+	)
+	return i, err
+}
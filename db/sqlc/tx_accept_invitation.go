@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+type AcceptInvitationTxParams struct {
+	InvitationID int64 `json:"invitation_id"`
+}
+
+type AcceptInvitationTxResult struct {
+	Invitation AccountInvitation `json:"invitation"`
+	Holder     AccountHolder     `json:"holder"`
+}
+
+// AcceptInvitationTx marks a pending invitation as accepted and adds the invitee to the account holders.
+func (store *SQLStore) AcceptInvitationTx(ctx context.Context, arg AcceptInvitationTxParams) (AcceptInvitationTxResult, error) {
+	var result AcceptInvitationTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Invitation, err = q.UpdateAccountInvitationStatus(ctx, UpdateAccountInvitationStatusParams{
+			ID:     arg.InvitationID,
+			Status: util.InvitationAccepted,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Holder, err = q.CreateAccountHolder(ctx, CreateAccountHolderParams{
+			AccountID: result.Invitation.AccountID,
+			Username:  result.Invitation.Invitee,
+			Role:      result.Invitation.Role,
+		})
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AdjustBalanceTxParams struct {
+	AccountID  int64  `json:"account_id"`
+	Amount     int64  `json:"amount"`
+	Reason     string `json:"reason"`
+	AdjustedBy string `json:"adjusted_by"`
+}
+
+type AdjustBalanceTxResult struct {
+	Account     Account           `json:"account"`
+	Entry       Entry             `json:"entry"`
+	Adjustment  BalanceAdjustment `json:"adjustment"`
+	LedgerEntry LedgerEntry       `json:"ledger_entry"`
+}
+
+// AdjustBalanceTx posts a manual entry made by a banker to the account and records the reason for it.
+// The opposite entry goes to the manual adjustments ledger account in the currency of the account.
+// A debit larger than the available balance of the account fails with ErrInsufficientFunds.
+func (store *SQLStore) AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error) {
+	var result AdjustBalanceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// a debit can't take the money set aside in savings pots
+		if arg.Amount < 0 && account.Balance-account.PotBalance < -arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: arg.AccountID,
+			Amount:    arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addAccountPostedEvent(ctx, q, result.Account, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		// the bank pays for a credit and gains a debit, so the ledger stays balanced
+		result.LedgerEntry, err = postLedgerEntry(ctx, q, postLedgerEntryParams{
+			Code:        util.LedgerCodeManualAdjustments,
+			Currency:    result.Account.Currency,
+			Amount:      -arg.Amount,
+			EntryID:     pgtype.Int8{Int64: result.Entry.ID, Valid: true},
+			Description: arg.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Adjustment, err = q.CreateBalanceAdjustment(ctx, CreateBalanceAdjustmentParams{
+			AccountID:     arg.AccountID,
+			EntryID:       result.Entry.ID,
+			Amount:        arg.Amount,
+			Reason:        arg.Reason,
+			AdjustedBy:    arg.AdjustedBy,
+			LedgerEntryID: pgtype.Int8{Int64: result.LedgerEntry.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		// delivered to the listeners when the transaction commits
+		return q.NotifyAccountActivity(ctx, strconv.FormatInt(arg.AccountID, 10))
+	})
+
+	return result, err
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrInsufficientFunds is returned when a debit exceeds the available balance of the account,
+// the balance without the money set aside in savings pots.
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+type CashOperationTxParams struct {
+	Kind        string `json:"kind"`
+	AccountID   int64  `json:"account_id"`
+	Amount      int64  `json:"amount"`
+	PerformedBy string `json:"performed_by"`
+}
+
+type CashOperationTxResult struct {
+	Operation     CashOperation `json:"operation"`
+	Account       Account       `json:"account"`
+	Entry         Entry         `json:"entry"`
+	ClearingEntry LedgerEntry   `json:"clearing_entry"`
+}
+
+// CashOperationTx deposits cash to or withdraws cash from the account. The opposite entry is posted
+// to the bank's cash clearing ledger account in the currency of the account, so the books stay balanced.
+// The amount must be positive.
+func (store *SQLStore) CashOperationTx(ctx context.Context, arg CashOperationTxParams) (CashOperationTxResult, error) {
+	var result CashOperationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		amount := arg.Amount
+		if arg.Kind == util.CashWithdrawal {
+			if account.Balance-account.PotBalance < arg.Amount {
+				return ErrInsufficientFunds
+			}
+
+			amount = -arg.Amount
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{AccountID: account.ID, Amount: amount})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     account.ID,
+			Amount: amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addAccountPostedEvent(ctx, q, result.Account, amount)
+		if err != nil {
+			return err
+		}
+
+		result.ClearingEntry, err = postLedgerEntry(ctx, q, postLedgerEntryParams{
+			Code:        util.LedgerCodeCashClearing,
+			Currency:    account.Currency,
+			Amount:      -amount,
+			EntryID:     pgtype.Int8{Int64: result.Entry.ID, Valid: true},
+			Description: "cash " + arg.Kind,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Operation, err = q.CreateCashOperation(ctx, CreateCashOperationParams{
+			Kind:              arg.Kind,
+			AccountID:         account.ID,
+			ClearingAccountID: result.ClearingEntry.LedgerAccountID,
+			Amount:            arg.Amount,
+			Currency:          account.Currency,
+			BalanceAfter:      result.Account.Balance,
+			EntryID:           result.Entry.ID,
+			ClearingEntryID:   result.ClearingEntry.ID,
+			PerformedBy:       arg.PerformedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		// delivered to the listeners when the transaction commits
+		return q.NotifyAccountActivity(ctx, strconv.FormatInt(account.ID, 10))
+	})
+
+	return result, err
+}
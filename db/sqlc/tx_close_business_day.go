@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrBusinessDayNotOver is returned when closing today or a day in the future.
+	ErrBusinessDayNotOver = errors.New("business day is not over yet")
+	// ErrBusinessDayAlreadyClosed is returned when closing a day on or before the last closed day.
+	ErrBusinessDayAlreadyClosed = errors.New("business day is already closed")
+)
+
+type CloseBusinessDayTxParams struct {
+	// BusinessDate is the UTC day to close. The time of day is ignored.
+	BusinessDate time.Time `json:"business_date"`
+}
+
+type CloseBusinessDayTxResult struct {
+	BusinessDay BusinessDay  `json:"business_day"`
+	Snapshots   int64        `json:"snapshots"`
+	Totals      []DailyTotal `json:"totals"`
+}
+
+// BusinessDate returns the UTC day of t as a date.
+func BusinessDate(t time.Time) pgtype.Date {
+	t = t.UTC()
+	return pgtype.Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// CloseBusinessDayTx freezes the business day, writes the closing balance of every account
+// and the totals of the day per currency. Once the day is closed, no entries can be booked on it.
+// New postings are blocked until the transaction ends, so the snapshots can't miss an entry
+// committed while the day is being closed.
+func (store *SQLStore) CloseBusinessDayTx(ctx context.Context, arg CloseBusinessDayTxParams) (CloseBusinessDayTxResult, error) {
+	var result CloseBusinessDayTxResult
+
+	businessDate := BusinessDate(arg.BusinessDate)
+	if !businessDate.Time.Before(BusinessDate(time.Now()).Time) {
+		return result, ErrBusinessDayNotOver
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockPostings(ctx)
+		if err != nil {
+			return err
+		}
+
+		last, err := q.GetLastClosedBusinessDay(ctx)
+		if err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil && !last.BusinessDate.Time.Before(businessDate.Time) {
+			return ErrBusinessDayAlreadyClosed
+		}
+
+		result.BusinessDay, err = q.CreateBusinessDay(ctx, businessDate)
+		if err != nil {
+			return err
+		}
+
+		result.Snapshots, err = q.CreateAccountBalanceSnapshots(ctx, businessDate)
+		if err != nil {
+			return err
+		}
+
+		postings, err := q.GetDailyPostingTotals(ctx, businessDate)
+		if err != nil {
+			return err
+		}
+
+		balances, err := q.GetSnapshotTotals(ctx, businessDate)
+		if err != nil {
+			return err
+		}
+
+		totals := make(map[string]*CreateDailyTotalParams)
+		total := func(currency string) *CreateDailyTotalParams {
+			if _, ok := totals[currency]; !ok {
+				totals[currency] = &CreateDailyTotalParams{BusinessDate: businessDate, Currency: currency}
+			}
+
+			return totals[currency]
+		}
+
+		for _, posting := range postings {
+			t := total(posting.Currency)
+			t.TotalDebits = posting.TotalDebits
+			t.TotalCredits = posting.TotalCredits
+			t.EntryCount = posting.EntryCount
+		}
+
+		for _, balance := range balances {
+			total(balance.Currency).ClosingBalance = balance.ClosingBalance
+		}
+
+		currencies := make([]string, 0, len(totals))
+		for currency := range totals {
+			currencies = append(currencies, currency)
+		}
+		slices.Sort(currencies)
+
+		for _, currency := range currencies {
+			dailyTotal, err := q.CreateDailyTotal(ctx, *totals[currency])
+			if err != nil {
+				return err
+			}
+
+			result.Totals = append(result.Totals, dailyTotal)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
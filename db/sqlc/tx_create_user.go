@@ -4,7 +4,6 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
 }
 
 type CreateUserTxResult struct {
@@ -21,7 +20,12 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		event := UserCreatedEvent{
+			Username: result.User.Username,
+			Email:    result.User.Email,
+		}
+
+		return addOutboxEvent(ctx, q, AggregateUser, result.User.Username, EventUserCreated, event)
 	})
 
 	return result, err
@@ -0,0 +1,220 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrDisputeNotOpen is returned when starting the investigation of a dispute that is no longer open.
+	ErrDisputeNotOpen = errors.New("dispute is not open")
+	// ErrDisputeNotInvestigating is returned when resolving a dispute that is not under investigation.
+	ErrDisputeNotInvestigating = errors.New("dispute is not under investigation")
+)
+
+type OpenDisputeTxParams struct {
+	TransferID int64  `json:"transfer_id"`
+	AccountID  int64  `json:"account_id"`
+	OpenedBy   string `json:"opened_by"`
+	Reason     string `json:"reason"`
+	Evidence   string `json:"evidence"`
+}
+
+// OpenDisputeTx opens a dispute on a transfer. No money moves until the dispute is resolved.
+// The customer is notified through the stored event.
+func (store *SQLStore) OpenDisputeTx(ctx context.Context, arg OpenDisputeTxParams) (Dispute, error) {
+	var dispute Dispute
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetTransfer(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		dispute, err = q.CreateDispute(ctx, CreateDisputeParams{
+			TransferID: arg.TransferID,
+			AccountID:  arg.AccountID,
+			OpenedBy:   arg.OpenedBy,
+			Reason:     arg.Reason,
+			Evidence:   arg.Evidence,
+		})
+		if err != nil {
+			return err
+		}
+
+		return addDisputeEvent(ctx, q, dispute)
+	})
+
+	return dispute, err
+}
+
+type StartDisputeInvestigationTxParams struct {
+	DisputeID  int64  `json:"dispute_id"`
+	AssignedTo string `json:"assigned_to"`
+}
+
+// StartDisputeInvestigationTx assigns an open dispute to the banker who investigates it.
+func (store *SQLStore) StartDisputeInvestigationTx(ctx context.Context, arg StartDisputeInvestigationTxParams) (Dispute, error) {
+	var dispute Dispute
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		current, err := q.GetDisputeForUpdate(ctx, arg.DisputeID)
+		if err != nil {
+			return err
+		}
+
+		if current.Status != util.DisputeOpen {
+			return ErrDisputeNotOpen
+		}
+
+		dispute, err = q.StartDisputeInvestigation(ctx, StartDisputeInvestigationParams{
+			ID:         current.ID,
+			AssignedTo: pgtype.Text{String: arg.AssignedTo, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		return addDisputeEvent(ctx, q, dispute)
+	})
+
+	return dispute, err
+}
+
+type ResolveDisputeTxParams struct {
+	DisputeID int64 `json:"dispute_id"`
+	// Status is util.DisputeResolvedCustomer or util.DisputeResolvedMerchant.
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+type ResolveDisputeTxResult struct {
+	Dispute Dispute `json:"dispute"`
+	// CreditEntry and ReversalEntry are only posted when the dispute is resolved in favour of the customer.
+	CreditEntry   Entry `json:"credit_entry"`
+	ReversalEntry Entry `json:"reversal_entry"`
+}
+
+// ResolveDisputeTx closes a dispute under investigation. A resolution in favour of the customer
+// posts the provisional credit to the customer and the final reversal from the payee of the transfer,
+// both against the dispute suspense account, which nets to zero. A resolution in favour of the merchant
+// moves no money.
+//
+// The reversal never overdraws the payee: if the available balance can't cover it, ErrInsufficientFunds
+// is returned and the dispute stays under investigation until the banker resolves it again.
+func (store *SQLStore) ResolveDisputeTx(ctx context.Context, arg ResolveDisputeTxParams) (ResolveDisputeTxResult, error) {
+	var result ResolveDisputeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		dispute, err := q.GetDisputeForUpdate(ctx, arg.DisputeID)
+		if err != nil {
+			return err
+		}
+
+		if dispute.Status != util.DisputeInvestigating {
+			return ErrDisputeNotInvestigating
+		}
+
+		resolution := ResolveDisputeParams{
+			ID:             dispute.ID,
+			Status:         arg.Status,
+			ResolutionNote: pgtype.Text{String: arg.Note, Valid: true},
+		}
+
+		if arg.Status == util.DisputeResolvedCustomer {
+			err = reverseDisputedTransfer(ctx, q, dispute, &result)
+			if err != nil {
+				return err
+			}
+
+			resolution.CreditEntryID = pgtype.Int8{Int64: result.CreditEntry.ID, Valid: true}
+			resolution.ReversalEntryID = pgtype.Int8{Int64: result.ReversalEntry.ID, Valid: true}
+		}
+
+		result.Dispute, err = q.ResolveDispute(ctx, resolution)
+		if err != nil {
+			return err
+		}
+
+		return addDisputeEvent(ctx, q, result.Dispute)
+	})
+
+	return result, err
+}
+
+// reverseDisputedTransfer posts the final reversal from the payee and the provisional credit to the customer.
+func reverseDisputedTransfer(ctx context.Context, q *Queries, dispute Dispute, result *ResolveDisputeTxResult) error {
+	transfer, err := q.GetTransfer(ctx, dispute.TransferID)
+	if err != nil {
+		return err
+	}
+
+	// the payee is debited first so an insufficient balance aborts before the customer is credited
+	result.ReversalEntry, err = postDisputeEntry(ctx, q, transfer.ToAccountID, -transfer.Amount,
+		fmt.Sprintf("reversal for dispute %d", dispute.ID))
+	if err != nil {
+		return err
+	}
+
+	result.CreditEntry, err = postDisputeEntry(ctx, q, dispute.AccountID, transfer.Amount,
+		fmt.Sprintf("provisional credit for dispute %d", dispute.ID))
+	return err
+}
+
+// postDisputeEntry posts an entry to the account against the dispute suspense account.
+// Debits beyond the available balance of the account are rejected with ErrInsufficientFunds.
+func postDisputeEntry(ctx context.Context, q *Queries, accountID int64, amount int64, description string) (Entry, error) {
+	account, err := q.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if amount < 0 && account.Balance-account.PotBalance < -amount {
+		return Entry{}, ErrInsufficientFunds
+	}
+
+	entry, err := q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: account.ID,
+		Amount:    amount,
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	_, err = postLedgerEntry(ctx, q, postLedgerEntryParams{
+		Code:        util.LedgerCodeDisputeSuspense,
+		Currency:    account.Currency,
+		Amount:      -amount,
+		EntryID:     pgtype.Int8{Int64: entry.ID, Valid: true},
+		Description: description,
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: amount,
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	err = addAccountPostedEvent(ctx, q, account, amount)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	// delivered to the listeners when the transaction commits
+	return entry, q.NotifyAccountActivity(ctx, strconv.FormatInt(account.ID, 10))
+}
+
+// addDisputeEvent stores the event that notifies the customer of the current status of the dispute.
+func addDisputeEvent(ctx context.Context, q *Queries, dispute Dispute) error {
+	return addOutboxEvent(ctx, q, AggregateDispute, strconv.FormatInt(dispute.ID, 10), EventDisputeStatusChanged, dispute)
+}
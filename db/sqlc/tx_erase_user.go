@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+// ErrAccountsNotSettled is returned when the user to erase still owns an account with a non-zero balance.
+var ErrAccountsNotSettled = errors.New("user still owns accounts with a non-zero balance")
+
+type EraseUserTxParams struct {
+	Username string `json:"username"`
+}
+
+type EraseUserTxResult struct {
+	User User
+}
+
+// EraseUserTx pseudonymizes the personal data of the user in users, sessions, verification_emails,
+// the user's audit events, outbox events and webhook deliveries, drops the user's data exports, notification preferences,
+// alert rules, category rules and webhooks, the accounts the user holds and the user's pending invitations.
+// The username is kept as the key of the ledger, so accounts, entries and transfers stay intact. Erasing an already erased user returns ErrRecordNotFound.
+func (store *SQLStore) EraseUserTx(ctx context.Context, arg EraseUserTxParams) (EraseUserTxResult, error) {
+	var result EraseUserTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		accounts, err := q.ListAccountsByOwner(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			if account.Balance != 0 {
+				return ErrAccountsNotSettled
+			}
+		}
+
+		pseudonym := fmt.Sprintf("erased-%s@erased.invalid", util.RandomString(16))
+
+		result.User, err = q.EraseUser(ctx, EraseUserParams{
+			Username: arg.Username,
+			Email:    pseudonym,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.EraseSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.EraseVerificationEmails(ctx, EraseVerificationEmailsParams{
+			Username: arg.Username,
+			Email:    pseudonym,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = eraseUserEvents(ctx, q, arg.Username, pseudonym)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteNotificationPreferences(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteAlertRulesByUsername(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteCategoryRulesByUsername(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteWebhookDeliveriesByOwner(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteWebhooksByOwner(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteAccountHoldersByUsername(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeletePendingAccountInvitationsByUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteDataExports(ctx, arg.Username)
+	})
+
+	return result, err
+}
+
+// eraseUserEvents replaces the personal data the user's events were recorded with,
+// including the client address and user agent of the audit events the user made.
+// Audit events are append-only, erasure is the one change their trigger lets through.
+func eraseUserEvents(ctx context.Context, q *Queries, username string, email string) error {
+	err := q.AllowAuditEventErasure(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = q.EraseAuditEventFields(ctx, EraseAuditEventFieldsParams{
+		Fields:      util.AuditErasedFields,
+		ErasedValue: util.AuditErasedValue,
+		Username:    username,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = q.EraseAuditEventClients(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	err = q.EraseUserOutboxEmails(ctx, EraseUserOutboxEmailsParams{
+		Email:    email,
+		Username: username,
+	})
+	if err != nil {
+		return err
+	}
+
+	return q.EraseUserWebhookDeliveryEmails(ctx, EraseUserWebhookDeliveryEmailsParams{
+		Email:    email,
+		Username: username,
+	})
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrStatementAlreadyImported is returned when a statement of an external account is imported twice.
+var ErrStatementAlreadyImported = errors.New("statement is already imported")
+
+// ImportStatementLine is a line of the imported statement. Credits on the external account are positive.
+type ImportStatementLine struct {
+	BookingDate  time.Time `json:"booking_date"`
+	ValueDate    time.Time `json:"value_date"`
+	Amount       int64     `json:"amount"`
+	Reference    string    `json:"reference"`
+	EndToEndID   string    `json:"end_to_end_id"`
+	Counterparty string    `json:"counterparty"`
+	Description  string    `json:"description"`
+}
+
+type ImportStatementTxParams struct {
+	Format          string                `json:"format"`
+	Reference       string                `json:"reference"`
+	ExternalAccount string                `json:"external_account"`
+	Currency        string                `json:"currency"`
+	FromDate        time.Time             `json:"from_date"`
+	ToDate          time.Time             `json:"to_date"`
+	OpeningBalance  int64                 `json:"opening_balance"`
+	ClosingBalance  int64                 `json:"closing_balance"`
+	Lines           []ImportStatementLine `json:"lines"`
+	ImportedBy      string                `json:"imported_by"`
+	// MatchWindow is how many days around the statement period the entries to match are looked for.
+	MatchWindow int `json:"match_window"`
+	// Match pairs the lines with the unmatched ledger entries of the clearing account
+	// and returns the matched ledger entry id by line id.
+	Match func(lines []ExternalStatementLine, entries []ListReconciliationCandidatesRow) map[int64]int64 `json:"-"`
+}
+
+type ImportStatementTxResult struct {
+	Statement ExternalStatement       `json:"statement"`
+	Lines     []ExternalStatementLine `json:"lines"`
+}
+
+// ImportStatementTx stores the statement of an external account and matches its lines
+// against the ledger entries of the cash clearing account in the same currency, which mirrors the external account.
+// Lines that can't be matched are left unmatched for a banker to resolve.
+func (store *SQLStore) ImportStatementTx(ctx context.Context, arg ImportStatementTxParams) (ImportStatementTxResult, error) {
+	var result ImportStatementTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetExternalStatementByReference(ctx, GetExternalStatementByReferenceParams{
+			ExternalAccount: arg.ExternalAccount,
+			Reference:       arg.Reference,
+		})
+		if err == nil {
+			return ErrStatementAlreadyImported
+		}
+
+		if !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+
+		clearing, err := q.GetLedgerAccountByCode(ctx, GetLedgerAccountByCodeParams{
+			Code:     util.LedgerCodeCashClearing,
+			Currency: arg.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Statement, err = q.CreateExternalStatement(ctx, CreateExternalStatementParams{
+			Format:            arg.Format,
+			Reference:         arg.Reference,
+			ExternalAccount:   arg.ExternalAccount,
+			Currency:          arg.Currency,
+			ClearingAccountID: clearing.ID,
+			FromDate:          BusinessDate(arg.FromDate),
+			ToDate:            BusinessDate(arg.ToDate),
+			OpeningBalance:    arg.OpeningBalance,
+			ClosingBalance:    arg.ClosingBalance,
+			ImportedBy:        arg.ImportedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, line := range arg.Lines {
+			created, err := q.CreateExternalStatementLine(ctx, CreateExternalStatementLineParams{
+				StatementID:  result.Statement.ID,
+				BookingDate:  BusinessDate(line.BookingDate),
+				ValueDate:    BusinessDate(line.ValueDate),
+				Amount:       line.Amount,
+				Reference:    line.Reference,
+				EndToEndID:   line.EndToEndID,
+				Counterparty: line.Counterparty,
+				Description:  line.Description,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Lines = append(result.Lines, created)
+		}
+
+		entries, err := q.ListReconciliationCandidates(ctx, ListReconciliationCandidatesParams{
+			LedgerAccountID: clearing.ID,
+			FromDate:        BusinessDate(arg.FromDate.AddDate(0, 0, -arg.MatchWindow)),
+			ToDate:          BusinessDate(arg.ToDate.AddDate(0, 0, arg.MatchWindow)),
+		})
+		if err != nil {
+			return err
+		}
+
+		matches := arg.Match(result.Lines, entries)
+
+		for i, line := range result.Lines {
+			entryID, ok := matches[line.ID]
+			if !ok {
+				continue
+			}
+
+			result.Lines[i], err = q.MatchExternalStatementLine(ctx, MatchExternalStatementLineParams{
+				ID:      line.ID,
+				Status:  util.StatementLineMatched,
+				EntryID: pgtype.Int8{Int64: entryID, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,294 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/loan"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DisburseLoanTxParams struct {
+	AccountID     int64  `json:"account_id"`
+	Principal     int64  `json:"principal"`
+	AnnualRateBps int32  `json:"annual_rate_bps"`
+	TermMonths    int32  `json:"term_months"`
+	Method        string `json:"method"`
+	DisbursedBy   string `json:"disbursed_by"`
+	// Schedule is the amortization schedule of the principal built by loan.Schedule.
+	Schedule []loan.Installment `json:"schedule"`
+}
+
+type DisburseLoanTxResult struct {
+	Loan         Loan              `json:"loan"`
+	Installments []LoanInstallment `json:"installments"`
+	Account      Account           `json:"account"`
+	Entry        Entry             `json:"entry"`
+}
+
+// DisburseLoanTx credits the principal to the account and stores the loan with its schedule.
+// The opposite entry goes to the loans receivable ledger account in the currency of the account.
+func (store *SQLStore) DisburseLoanTx(ctx context.Context, arg DisburseLoanTxParams) (DisburseLoanTxResult, error) {
+	var result DisburseLoanTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: arg.AccountID,
+			Amount:    arg.Principal,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     arg.AccountID,
+			Amount: arg.Principal,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addAccountPostedEvent(ctx, q, result.Account, arg.Principal)
+		if err != nil {
+			return err
+		}
+
+		// the bank gains a claim on the customer for the money it credits
+		_, err = postLedgerEntry(ctx, q, postLedgerEntryParams{
+			Code:        util.LedgerCodeLoansReceivable,
+			Currency:    result.Account.Currency,
+			Amount:      -arg.Principal,
+			EntryID:     pgtype.Int8{Int64: result.Entry.ID, Valid: true},
+			Description: "loan disbursement",
+		})
+		if err != nil {
+			return err
+		}
+
+		var interest int64
+		for _, installment := range arg.Schedule {
+			interest += installment.Interest
+		}
+
+		result.Loan, err = q.CreateLoan(ctx, CreateLoanParams{
+			AccountID:            arg.AccountID,
+			Principal:            arg.Principal,
+			Currency:             result.Account.Currency,
+			AnnualRateBps:        arg.AnnualRateBps,
+			TermMonths:           arg.TermMonths,
+			Method:               arg.Method,
+			OutstandingPrincipal: arg.Principal,
+			OutstandingInterest:  interest,
+			DisbursementEntryID:  result.Entry.ID,
+			DisbursedBy:          arg.DisbursedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Installments = make([]LoanInstallment, len(arg.Schedule))
+		for i, installment := range arg.Schedule {
+			result.Installments[i], err = q.CreateLoanInstallment(ctx, CreateLoanInstallmentParams{
+				LoanID:    result.Loan.ID,
+				Seq:       installment.Seq,
+				DueDate:   pgtype.Date{Time: installment.DueDate, Valid: true},
+				Principal: installment.Principal,
+				Interest:  installment.Interest,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// delivered to the listeners when the transaction commits
+		return q.NotifyAccountActivity(ctx, strconv.FormatInt(arg.AccountID, 10))
+	})
+
+	return result, err
+}
+
+type CollectLoanInstallmentTxParams struct {
+	InstallmentID int64 `json:"installment_id"`
+	// LateFee is added to the installment the first time it can't be collected.
+	LateFee int64 `json:"late_fee"`
+}
+
+type CollectLoanInstallmentTxResult struct {
+	Installment LoanInstallment `json:"installment"`
+	Loan        Loan            `json:"loan"`
+	Account     Account         `json:"account"`
+	// Collected is false when the installment stays unpaid. Transfer and Entry are empty then.
+	Collected bool `json:"collected"`
+	// Transfer moves the installment from the account to the loan servicing account in its currency.
+	Transfer Transfer `json:"transfer"`
+	// Entry sweeps the installment from the loan servicing account into the ledger.
+	Entry Entry `json:"entry"`
+}
+
+// CollectLoanInstallmentTx transfers the installment with its late fee from the available balance of the account
+// to the loan servicing account in its currency and sweeps it from there into the ledger: the principal goes
+// to the loans receivable ledger account, the interest and the fee to the income accounts.
+// Installments are collected in order, so while an earlier one is unpaid, or the available balance
+// doesn't cover the installment, it is marked overdue and charged the late fee instead.
+// The loan is paid off with its last installment.
+func (store *SQLStore) CollectLoanInstallmentTx(ctx context.Context, arg CollectLoanInstallmentTxParams) (CollectLoanInstallmentTxResult, error) {
+	var result CollectLoanInstallmentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		installment, err := q.GetLoanInstallment(ctx, arg.InstallmentID)
+		if err != nil {
+			return err
+		}
+
+		current, err := q.GetLoan(ctx, installment.LoanID)
+		if err != nil {
+			return err
+		}
+
+		// the account is locked before the loan, the same order as every other posting to the account
+		result.Account, err = q.GetAccountForUpdate(ctx, current.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.Loan, err = q.GetLoanForUpdate(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Installment, err = q.GetLoanInstallmentForUpdate(ctx, arg.InstallmentID)
+		if err != nil {
+			return err
+		}
+
+		if result.Installment.Status == util.InstallmentPaid {
+			return nil
+		}
+
+		first, err := q.GetFirstUnpaidLoanInstallment(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		installment = result.Installment
+		amount := installment.Principal + installment.Interest + installment.LateFee
+
+		if first.ID != installment.ID || result.Account.Balance-result.Account.PotBalance < amount {
+			if installment.Status == util.InstallmentScheduled {
+				result.Installment, err = q.MarkLoanInstallmentOverdue(ctx, MarkLoanInstallmentOverdueParams{
+					ID:      installment.ID,
+					LateFee: arg.LateFee,
+				})
+			}
+
+			return err
+		}
+
+		description := fmt.Sprintf("loan %d installment %d", current.ID, installment.Seq)
+
+		servicing, err := q.GetAccountByOwnerAndCurrency(ctx, GetAccountByOwnerAndCurrencyParams{
+			Owner:    util.LoanServicingOwner,
+			Currency: result.Account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		// collected by transfer, so the installment shows up in the transfer history of the account
+		// and its holders are notified like for any other transfer
+		transfer, err := transferTx(ctx, q, TransferTxParams{
+			FromAccountID: result.Account.ID,
+			ToAccountID:   servicing.ID,
+			Amount:        amount,
+			Memo:          description,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer = transfer.Transfer
+		result.Account = transfer.FromAccount
+
+		// the installment is swept from the servicing account into the ledger
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: servicing.ID,
+			Amount:    -amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		servicing, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     servicing.ID,
+			Amount: -amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addAccountPostedEvent(ctx, q, servicing, -amount)
+		if err != nil {
+			return err
+		}
+
+		postings := []struct {
+			code   string
+			amount int64
+		}{
+			{util.LedgerCodeLoansReceivable, installment.Principal},
+			{util.LedgerCodeInterestIncome, installment.Interest},
+			{util.LedgerCodeFeeIncome, installment.LateFee},
+		}
+
+		for _, posting := range postings {
+			if posting.amount == 0 {
+				continue
+			}
+
+			_, err = postLedgerEntry(ctx, q, postLedgerEntryParams{
+				Code:        posting.code,
+				Currency:    result.Account.Currency,
+				Amount:      posting.amount,
+				EntryID:     pgtype.Int8{Int64: result.Entry.ID, Valid: true},
+				Description: description,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Installment, err = q.PayLoanInstallment(ctx, PayLoanInstallmentParams{
+			ID:      installment.ID,
+			EntryID: pgtype.Int8{Int64: transfer.FromEntry.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Loan, err = q.RepayLoan(ctx, RepayLoanParams{
+			ID:        current.ID,
+			Principal: installment.Principal,
+			Interest:  installment.Interest,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetFirstUnpaidLoanInstallment(ctx, current.ID)
+		if err == ErrRecordNotFound {
+			result.Loan, err = q.PayOffLoan(ctx, current.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Collected = true
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type LockUserTxParams struct {
+	Username string `json:"username"`
+	Reason   string `json:"reason"`
+}
+
+type LockUserTxResult struct {
+	User User
+}
+
+// LockUserTx locks the user and blocks all of the user's sessions, so no new access tokens can be issued.
+func (store *SQLStore) LockUserTx(ctx context.Context, arg LockUserTxParams) (LockUserTxResult, error) {
+	var result LockUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.LockUser(ctx, LockUserParams{
+			Username:   arg.Username,
+			LockReason: pgtype.Text{String: arg.Reason, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.BlockSessions(ctx, arg.Username)
+	})
+
+	return result, err
+}
@@ -0,0 +1,55 @@
+package db
+
+import "context"
+
+type RelayOutboxTxParams struct {
+	Limit int32
+	// Publish is called for every pending event in the order the events were created.
+	// The event is marked as sent only if Publish succeeds.
+	Publish func(event Outbox) error
+}
+
+type RelayOutboxTxResult struct {
+	Sent   []Outbox
+	Failed []Outbox
+}
+
+// RelayOutboxTx publishes the pending outbox events.
+// The pending rows stay locked until the transaction ends, so concurrent relays don't publish the same event twice
+// or reorder the events. Once an event of an aggregate fails, the later events of that aggregate are left pending
+// to keep the per aggregate order.
+func (store *SQLStore) RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error) {
+	var result RelayOutboxTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		events, err := q.ListPendingOutboxEvents(ctx, arg.Limit)
+		if err != nil {
+			return err
+		}
+
+		blocked := make(map[string]bool)
+
+		for _, event := range events {
+			aggregate := event.AggregateType + ":" + event.AggregateID
+			if blocked[aggregate] {
+				continue
+			}
+
+			if err := arg.Publish(event); err != nil {
+				blocked[aggregate] = true
+				result.Failed = append(result.Failed, event)
+				continue
+			}
+
+			err = q.MarkOutboxEventSent(ctx, event.ID)
+			if err != nil {
+				return err
+			}
+
+			result.Sent = append(result.Sent, event)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
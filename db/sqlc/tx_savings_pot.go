@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+var (
+	// ErrPotClosed is returned when moving money into or out of a closed savings pot.
+	ErrPotClosed = errors.New("savings pot is closed")
+	// ErrInsufficientPotBalance is returned when moving more money out of a savings pot than it holds.
+	ErrInsufficientPotBalance = errors.New("insufficient savings pot balance")
+)
+
+type MovePotMoneyTxParams struct {
+	AccountID int64 `json:"account_id"`
+	PotID     int64 `json:"pot_id"`
+	// Amount is moved from the main balance into the pot, a negative amount moves money back.
+	Amount int64 `json:"amount"`
+}
+
+type MovePotMoneyTxResult struct {
+	Pot     SavingsPot `json:"pot"`
+	Account Account    `json:"account"`
+	Entry   PotEntry   `json:"entry"`
+}
+
+// MovePotMoneyTx moves money between the main balance of the account and one of its savings pots.
+// The balance of the account doesn't change, only the part of it that is available.
+// A pot of another account is reported as ErrRecordNotFound.
+func (store *SQLStore) MovePotMoneyTx(ctx context.Context, arg MovePotMoneyTxParams) (MovePotMoneyTxResult, error) {
+	var result MovePotMoneyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, pot, err := lockSavingsPot(ctx, q, arg.AccountID, arg.PotID)
+		if err != nil {
+			return err
+		}
+
+		if pot.Status != util.PotOpen {
+			return ErrPotClosed
+		}
+
+		if arg.Amount > 0 && account.Balance-account.PotBalance < arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		if pot.Balance+arg.Amount < 0 {
+			return ErrInsufficientPotBalance
+		}
+
+		result.Pot, result.Account, result.Entry, err = movePotMoney(ctx, q, pot, arg.Amount)
+		return err
+	})
+
+	return result, err
+}
+
+type CloseSavingsPotTxParams struct {
+	AccountID int64 `json:"account_id"`
+	PotID     int64 `json:"pot_id"`
+}
+
+type CloseSavingsPotTxResult struct {
+	Pot     SavingsPot `json:"pot"`
+	Account Account    `json:"account"`
+	// Entry is the sweep of the pot back to the main balance, empty if the pot was empty.
+	Entry PotEntry `json:"entry"`
+}
+
+// CloseSavingsPotTx sweeps the money of the pot back to the main balance and closes the pot.
+func (store *SQLStore) CloseSavingsPotTx(ctx context.Context, arg CloseSavingsPotTxParams) (CloseSavingsPotTxResult, error) {
+	var result CloseSavingsPotTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, pot, err := lockSavingsPot(ctx, q, arg.AccountID, arg.PotID)
+		if err != nil {
+			return err
+		}
+
+		if pot.Status != util.PotOpen {
+			return ErrPotClosed
+		}
+
+		result.Account = account
+		if pot.Balance != 0 {
+			_, result.Account, result.Entry, err = movePotMoney(ctx, q, pot, -pot.Balance)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Pot, err = q.CloseSavingsPot(ctx, pot.ID)
+		return err
+	})
+
+	return result, err
+}
+
+// lockSavingsPot locks the account before the pot, the same order as every other posting to the account.
+func lockSavingsPot(ctx context.Context, q *Queries, accountID int64, potID int64) (Account, SavingsPot, error) {
+	account, err := q.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return Account{}, SavingsPot{}, err
+	}
+
+	pot, err := q.GetSavingsPotForUpdate(ctx, potID)
+	if err != nil {
+		return Account{}, SavingsPot{}, err
+	}
+
+	if pot.AccountID != account.ID {
+		return Account{}, SavingsPot{}, ErrRecordNotFound
+	}
+
+	return account, pot, nil
+}
+
+func movePotMoney(ctx context.Context, q *Queries, pot SavingsPot, amount int64) (SavingsPot, Account, PotEntry, error) {
+	entry, err := q.CreatePotEntry(ctx, CreatePotEntryParams{
+		PotID:     pot.ID,
+		AccountID: pot.AccountID,
+		Amount:    amount,
+	})
+	if err != nil {
+		return SavingsPot{}, Account{}, PotEntry{}, err
+	}
+
+	pot, err = q.AddSavingsPotBalance(ctx, AddSavingsPotBalanceParams{
+		ID:     pot.ID,
+		Amount: amount,
+	})
+	if err != nil {
+		return SavingsPot{}, Account{}, PotEntry{}, err
+	}
+
+	account, err := q.AddAccountPotBalance(ctx, AddAccountPotBalanceParams{
+		ID:     pot.AccountID,
+		Amount: amount,
+	})
+	if err != nil {
+		return SavingsPot{}, Account{}, PotEntry{}, err
+	}
+
+	// nothing is posted, but the available balance changed, so the low balance alerts are evaluated again
+	err = addAccountPostedEvent(ctx, q, account, 0)
+	if err != nil {
+		return SavingsPot{}, Account{}, PotEntry{}, err
+	}
+
+	return pot, account, entry, nil
+}
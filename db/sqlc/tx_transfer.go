@@ -1,11 +1,45 @@
 package db
 
-import "context"
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrTransferNotHeld is returned when reviewing a transfer that is not waiting for a review.
+	ErrTransferNotHeld = errors.New("transfer is not held for review")
+	// ErrSelfReview is returned when the initiator of a held transfer tries to review it.
+	ErrSelfReview = errors.New("transfer cannot be reviewed by its initiator")
+)
+
+// TransferRuleHit is a fraud rule that fired on the transfer.
+type TransferRuleHit struct {
+	Rule     string `json:"rule"`
+	Decision string `json:"decision"`
+	Reason   string `json:"reason"`
+}
+
+// TransferScreen decides the status of a new transfer and returns the fraud rule hits behind the decision.
+// It runs inside the transaction of the transfer, reading through q, once both accounts are locked,
+// so transfers from the same account are screened one after the other.
+type TransferScreen func(ctx context.Context, q Querier) (status string, hits []TransferRuleHit, err error)
 
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	// Status defaults to completed. Held and denied transfers are recorded without moving money.
+	Status   string            `json:"status"`
+	RuleHits []TransferRuleHit `json:"rule_hits"`
+	// Screen, if set, replaces Status and RuleHits.
+	Screen TransferScreen `json:"-"`
+	Memo   string         `json:"memo"`
+	// InitiatedBy is the user who makes the transfer, empty for transfers made by the bank.
+	InitiatedBy string `json:"initiated_by"`
 }
 
 type TransferTxResult struct {
@@ -16,46 +50,198 @@ type TransferTxResult struct {
 	ToEntry     Entry    `json:"to_entry"`
 }
 
+// TransferTx records the transfer and moves the money if it is completed. A completed transfer larger
+// than the available balance of the from account fails with ErrInsufficientFunds.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = transferTx(ctx, q, arg)
+		return err
+	})
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+	return result, err
+}
+
+// transferTx records the transfer with its fraud rule hits and moves the money if it is completed.
+// It must be called with the queries of a transaction.
+func transferTx(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	var err error
+
+	status, hits := arg.Status, arg.RuleHits
+	if arg.Screen != nil {
+		_, err = lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
 		if err != nil {
-			return err
+			return result, err
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{AccountID: arg.FromAccountID, Amount: -arg.Amount})
+		status, hits, err = arg.Screen(ctx, q)
 		if err != nil {
-			return err
+			return result, err
 		}
+	}
+
+	if status == "" {
+		status = util.TransferCompleted
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Status:        status,
+		Memo:          arg.Memo,
+		InitiatedBy:   pgtype.Text{String: arg.InitiatedBy, Valid: arg.InitiatedBy != ""},
+	})
+	if err != nil {
+		return result, err
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{AccountID: arg.ToAccountID, Amount: arg.Amount})
+	for _, hit := range hits {
+		_, err = q.CreateFraudRuleHit(ctx, CreateFraudRuleHitParams{
+			TransferID: result.Transfer.ID,
+			Rule:       hit.Rule,
+			Decision:   hit.Decision,
+			Reason:     hit.Reason,
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if status != util.TransferCompleted {
+		return result, nil
+	}
+
+	err = moveMoney(ctx, q, &result)
+	return result, err
+}
+
+type ReviewTransferTxParams struct {
+	TransferID int64  `json:"transfer_id"`
+	ReviewedBy string `json:"reviewed_by"`
+	// Release moves the money of the held transfer, otherwise the transfer is rejected.
+	Release bool `json:"release"`
+}
+
+// ReviewTransferTx releases or rejects a held transfer. The initiator of the transfer can't review it.
+// The available balance is checked on release, so a transfer the account can no longer cover stays held.
+func (store *SQLStore) ReviewTransferTx(ctx context.Context, arg ReviewTransferTxParams) (TransferTxResult, error) {
+	status := util.TransferRejected
+	if arg.Release {
+		status = util.TransferCompleted
+	}
+
+	var result TransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		transfer, err := q.GetTransfer(ctx, arg.TransferID)
 		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return ErrTransferNotHeld
+			}
 			return err
 		}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+		if transfer.InitiatedBy.Valid && transfer.InitiatedBy.String == arg.ReviewedBy {
+			return ErrSelfReview
 		}
 
+		result.Transfer, err = q.ReviewHeldTransfer(ctx, ReviewHeldTransferParams{
+			ID:         arg.TransferID,
+			Status:     status,
+			ReviewedBy: pgtype.Text{String: arg.ReviewedBy, Valid: true},
+		})
 		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return ErrTransferNotHeld
+			}
 			return err
 		}
 
-		return nil
+		if !arg.Release {
+			return nil
+		}
+
+		return moveMoney(ctx, q, &result)
 	})
 
 	return result, err
 }
 
+// moveMoney posts the entries of the transfer and updates the balances of both accounts.
+func moveMoney(ctx context.Context, q *Queries, result *TransferTxResult) error {
+	transfer := result.Transfer
+
+	// both rows are locked in id order, the same order addMoney updates them in,
+	// so the available balance can't change between the check and the debit
+	from, err := lockAccounts(ctx, q, transfer.FromAccountID, transfer.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	if from.Balance-from.PotBalance < transfer.Amount {
+		return ErrInsufficientFunds
+	}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  transfer.FromAccountID,
+		Amount:     -transfer.Amount,
+		TransferID: pgtype.Int8{Int64: transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  transfer.ToAccountID,
+		Amount:     transfer.Amount,
+		TransferID: pgtype.Int8{Int64: transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	if transfer.FromAccountID < transfer.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, transfer.FromAccountID, -transfer.Amount, transfer.ToAccountID, transfer.Amount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, transfer.ToAccountID, transfer.Amount, transfer.FromAccountID, -transfer.Amount)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// delivered to the listeners when the transaction commits
+	for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+		err = q.NotifyAccountActivity(ctx, strconv.FormatInt(accountID, 10))
+		if err != nil {
+			return err
+		}
+	}
+
+	return addOutboxEvent(ctx, q, AggregateTransfer, strconv.FormatInt(transfer.ID, 10), EventTransferCompleted, transfer)
+}
+
+// lockAccounts locks the rows of both accounts in id order and returns the first one.
+func lockAccounts(ctx context.Context, q *Queries, fromAccountID, toAccountID int64) (Account, error) {
+	if fromAccountID > toAccountID {
+		if _, err := q.GetAccountForUpdate(ctx, toAccountID); err != nil {
+			return Account{}, err
+		}
+
+		return q.GetAccountForUpdate(ctx, fromAccountID)
+	}
+
+	from, err := q.GetAccountForUpdate(ctx, fromAccountID)
+	if err != nil {
+		return Account{}, err
+	}
+
+	_, err = q.GetAccountForUpdate(ctx, toAccountID)
+	return from, err
+}
+
 func addMoney(ctx context.Context, q *Queries, accountID1 int64, amount1 int64, accountID2, amount2 int64) (account1 Account, account2 Account, err error) {
 	account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 		ID:     accountID1,
@@ -69,6 +255,15 @@ func addMoney(ctx context.Context, q *Queries, accountID1 int64, amount1 int64,
 		ID:     accountID2,
 		Amount: amount2,
 	})
+	if err != nil {
+		return
+	}
+
+	err = addAccountPostedEvent(ctx, q, account1, amount1)
+	if err != nil {
+		return
+	}
 
+	err = addAccountPostedEvent(ctx, q, account2, amount2)
 	return
 }
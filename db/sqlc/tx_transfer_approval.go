@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrTransferApprovalNotPending is returned when deciding on a transfer approval that was already decided or expired.
+	ErrTransferApprovalNotPending = errors.New("transfer approval is not pending")
+	// ErrTransferApprovalExpired is returned when deciding on a transfer approval after its deadline.
+	ErrTransferApprovalExpired = errors.New("transfer approval has expired")
+	// ErrSelfApproval is returned when the initiator of a transfer tries to decide on it.
+	ErrSelfApproval = errors.New("transfer approval cannot be decided by its initiator")
+	// ErrInitiatorCannotSpend is returned when approving a transfer whose initiator was blocked
+	// or can no longer spend from the account since the approval was requested.
+	ErrInitiatorCannotSpend = errors.New("initiator can no longer spend from the account")
+)
+
+type DecideTransferApprovalTxParams struct {
+	ApprovalID int64  `json:"approval_id"`
+	DecidedBy  string `json:"decided_by"`
+	// Approve executes the transfer, otherwise the approval is rejected.
+	Approve bool `json:"approve"`
+	// Screen, if set, screens the approved transfer like any other transfer,
+	// so it can still be held for review or denied.
+	Screen TransferScreen `json:"-"`
+}
+
+type DecideTransferApprovalTxResult struct {
+	Approval TransferApproval `json:"approval"`
+	// Transfer is only set when the approval is approved.
+	Transfer TransferTxResult `json:"transfer"`
+}
+
+// DecideTransferApprovalTx approves or rejects a pending transfer approval.
+// An approved transfer is executed like any other transfer, in the same transaction, as long as
+// its initiator is not blocked and can still spend from the account.
+func (store *SQLStore) DecideTransferApprovalTx(ctx context.Context, arg DecideTransferApprovalTxParams) (DecideTransferApprovalTxResult, error) {
+	var result DecideTransferApprovalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		approval, err := q.GetTransferApprovalForUpdate(ctx, arg.ApprovalID)
+		if err != nil {
+			return err
+		}
+
+		if approval.Status != util.TransferApprovalPending {
+			return ErrTransferApprovalNotPending
+		}
+
+		if !approval.ExpiresAt.After(time.Now()) {
+			return ErrTransferApprovalExpired
+		}
+
+		if approval.InitiatedBy == arg.DecidedBy {
+			return ErrSelfApproval
+		}
+
+		decision := DecideTransferApprovalParams{
+			ID:        approval.ID,
+			Status:    util.TransferApprovalRejected,
+			DecidedBy: pgtype.Text{String: arg.DecidedBy, Valid: true},
+		}
+
+		if arg.Approve {
+			err = checkInitiatorCanSpend(ctx, q, approval)
+			if err != nil {
+				return err
+			}
+
+			result.Transfer, err = transferTx(ctx, q, TransferTxParams{
+				FromAccountID: approval.FromAccountID,
+				ToAccountID:   approval.ToAccountID,
+				Amount:        approval.Amount,
+				Status:        util.TransferCompleted,
+				Screen:        arg.Screen,
+				Memo:          approval.Memo,
+				InitiatedBy:   approval.InitiatedBy,
+			})
+			if err != nil {
+				return err
+			}
+
+			decision.Status = util.TransferApprovalApproved
+			decision.TransferID = pgtype.Int8{Int64: result.Transfer.Transfer.ID, Valid: true}
+		}
+
+		result.Approval, err = q.DecideTransferApproval(ctx, decision)
+		return err
+	})
+
+	return result, err
+}
+
+// checkInitiatorCanSpend checks that the initiator of the approval is not blocked and still owns
+// or co-owns the account the transfer is paid from.
+func checkInitiatorCanSpend(ctx context.Context, q *Queries, approval TransferApproval) error {
+	blocked, err := q.IsUserBlocked(ctx, approval.InitiatedBy)
+	if err != nil {
+		return err
+	}
+
+	if blocked {
+		return ErrInitiatorCannotSpend
+	}
+
+	// both accounts are locked in id order, like the transfer locks them
+	account, err := lockAccounts(ctx, q, approval.FromAccountID, approval.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	if account.Owner == approval.InitiatedBy {
+		return nil
+	}
+
+	holder, err := q.GetAccountHolder(ctx, GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  approval.InitiatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return ErrInitiatorCannotSpend
+		}
+		return err
+	}
+
+	if !util.HolderRoleAllows(holder.Role, util.CoOwnerHolderRole) {
+		return ErrInitiatorCannotSpend
+	}
+
+	return nil
+}
+
+type ExpireTransferApprovalsTxParams struct {
+	Now       time.Time `json:"now"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// ExpireTransferApprovalsTx expires a batch of the pending transfer approvals that are past their deadline.
+// An event is stored for every expired approval, so that the initiator is notified.
+func (store *SQLStore) ExpireTransferApprovalsTx(ctx context.Context, arg ExpireTransferApprovalsTxParams) ([]TransferApproval, error) {
+	var approvals []TransferApproval
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		approvals, err = q.ExpireTransferApprovals(ctx, ExpireTransferApprovalsParams{
+			Now:       arg.Now,
+			BatchSize: arg.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, approval := range approvals {
+			err = addOutboxEvent(ctx, q, AggregateTransferApproval, strconv.FormatInt(approval.ID, 10), EventTransferApprovalExpired, approval)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return approvals, err
+}
@@ -43,7 +43,12 @@ func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParam
 			return err
 		}
 
-		return nil
+		event := EmailVerifiedEvent{
+			Username: result.User.Username,
+			Email:    result.User.Email,
+		}
+
+		return addOutboxEvent(ctx, q, AggregateUser, result.User.Username, EventEmailVerified, event)
 	})
 
 	return result, err
@@ -19,7 +19,7 @@ INSERT INTO users (
   email
 ) VALUES (
   $1, $2, $3, $4
-) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role
+) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason
 `
 
 type CreateUserParams struct {
@@ -46,12 +46,51 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
+	)
+	return i, err
+}
+
+const eraseUser = `-- name: EraseUser :one
+UPDATE users
+SET
+  hashed_password = '',
+  full_name = 'erased',
+  email = $2,
+  is_email_verified = false,
+  erased_at = now()
+WHERE username = $1 AND erased_at IS NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason
+`
+
+type EraseUserParams struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) EraseUser(ctx context.Context, arg EraseUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, eraseUser, arg.Username, arg.Email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role FROM users
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -67,6 +106,140 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
+	)
+	return i, err
+}
+
+const isUserBlocked = `-- name: IsUserBlocked :one
+SELECT (locked_at IS NOT NULL OR erased_at IS NOT NULL)::bool AS blocked FROM users
+WHERE username = $1 LIMIT 1
+`
+
+// access tokens outlive a lock or an erasure, so they are checked on every request
+func (q *Queries) IsUserBlocked(ctx context.Context, username string) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserBlocked, username)
+	var blocked bool
+	err := row.Scan(&blocked)
+	return blocked, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason FROM users
+WHERE
+  ($1::varchar IS NULL OR
+    username ILIKE '%' || $1 || '%' OR
+    email ILIKE '%' || $1 || '%' OR
+    full_name ILIKE '%' || $1 || '%') AND
+  ($2::varchar IS NULL OR role = $2)
+ORDER BY username
+LIMIT $3
+OFFSET $4
+`
+
+type ListUsersParams struct {
+	Search pgtype.Text `json:"search"`
+	Role   pgtype.Text `json:"role"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.Search,
+		arg.Role,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.Role,
+			&i.ErasedAt,
+			&i.LockedAt,
+			&i.LockReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUser = `-- name: LockUser :one
+UPDATE users
+SET
+  locked_at = now(),
+  lock_reason = $2
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason
+`
+
+type LockUserParams struct {
+	Username   string      `json:"username"`
+	LockReason pgtype.Text `json:"lock_reason"`
+}
+
+func (q *Queries) LockUser(ctx context.Context, arg LockUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, lockUser, arg.Username, arg.LockReason)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
+	)
+	return i, err
+}
+
+const unlockUser = `-- name: UnlockUser :one
+UPDATE users
+SET
+  locked_at = NULL,
+  lock_reason = NULL
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason
+`
+
+func (q *Queries) UnlockUser(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, unlockUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
 	)
 	return i, err
 }
@@ -81,7 +254,7 @@ SET
   email = COALESCE($6, email),
   is_email_verified = COALESCE($7, is_email_verified)
 WHERE username = $1
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, role, erased_at, locked_at, lock_reason
 `
 
 type UpdateUserParams struct {
@@ -114,6 +287,9 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.ErasedAt,
+		&i.LockedAt,
+		&i.LockReason,
 	)
 	return i, err
 }
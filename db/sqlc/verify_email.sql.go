@@ -42,6 +42,25 @@ func (q *Queries) CreateVerificationEmail(ctx context.Context, arg CreateVerific
 	return i, err
 }
 
+const eraseVerificationEmails = `-- name: EraseVerificationEmails :exec
+UPDATE verification_emails
+SET
+  email = $2,
+  secret_code = '',
+  is_used = true
+WHERE username = $1
+`
+
+type EraseVerificationEmailsParams struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) EraseVerificationEmails(ctx context.Context, arg EraseVerificationEmailsParams) error {
+	_, err := q.db.Exec(ctx, eraseVerificationEmails, arg.Username, arg.Email)
+	return err
+}
+
 const getVerificationEmail = `-- name: GetVerificationEmail :one
 SELECT id, username, email, secret_code, is_used, created_at, expired_at FROM verification_emails
 WHERE id = $1 LIMIT 1
@@ -62,6 +81,40 @@ func (q *Queries) GetVerificationEmail(ctx context.Context, id int64) (Verificat
 	return i, err
 }
 
+const listVerificationEmailsByUsername = `-- name: ListVerificationEmailsByUsername :many
+SELECT id, username, email, secret_code, is_used, created_at, expired_at FROM verification_emails
+WHERE username = $1
+ORDER BY id
+`
+
+func (q *Queries) ListVerificationEmailsByUsername(ctx context.Context, username string) ([]VerificationEmail, error) {
+	rows, err := q.db.Query(ctx, listVerificationEmailsByUsername, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VerificationEmail{}
+	for rows.Next() {
+		var i VerificationEmail
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Email,
+			&i.SecretCode,
+			&i.IsUsed,
+			&i.CreatedAt,
+			&i.ExpiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateVerificationEmail = `-- name: UpdateVerificationEmail :one
 UPDATE verification_emails
 SET 
@@ -21,7 +21,10 @@ CREATE TABLE "users" (
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "erased_at" timestamptz,
+  "locked_at" timestamptz,
+  "lock_reason" varchar
 );
 
 CREATE TABLE "verification_emails" (
@@ -39,14 +42,37 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "number" varchar UNIQUE NOT NULL,
+  "pot_balance" bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE "account_holders" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE TABLE "account_invitations" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "inviter" varchar NOT NULL,
+  "invitee" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "responded_at" timestamptz
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "business_date" date NOT NULL DEFAULT ((now() AT TIME ZONE 'UTC')::date),
+  "transfer_id" bigint
 );
 
 CREATE TABLE "transfers" (
@@ -54,6 +80,310 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "status" varchar NOT NULL DEFAULT 'completed',
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "memo" varchar NOT NULL DEFAULT '',
+  "initiated_by" varchar
+);
+
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "aggregate_type" varchar NOT NULL,
+  "aggregate_id" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE TABLE "webhooks" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "secret" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "webhook_id" bigint NOT NULL,
+  "event_id" bigint NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "response_status" integer,
+  "last_error" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "delivered_at" timestamptz
+);
+
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "actor_role" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "diff" jsonb NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "data_exports" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "download_token_hash" varchar,
+  "archive" bytea,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz,
+  "expires_at" timestamptz
+);
+
+CREATE TABLE "fraud_rule_hits" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "rule" varchar NOT NULL,
+  "decision" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "balance_adjustments" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "reason" varchar NOT NULL,
+  "adjusted_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "ledger_entry_id" bigint
+);
+
+CREATE TABLE "cash_operations" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "clearing_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "balance_after" bigint NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "clearing_entry_id" bigint NOT NULL,
+  "performed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "ledger_accounts" (
+  "id" bigserial PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "name" varchar NOT NULL,
+  "type" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "balance" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "ledger_entries" (
+  "id" bigserial PRIMARY KEY,
+  "ledger_account_id" bigint NOT NULL,
+  "entry_id" bigint,
+  "amount" bigint NOT NULL,
+  "description" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "business_date" date NOT NULL DEFAULT ((now() AT TIME ZONE 'UTC')::date)
+);
+
+CREATE TABLE "business_days" (
+  "business_date" date PRIMARY KEY,
+  "closed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_balance_snapshots" (
+  "business_date" date NOT NULL,
+  "account_id" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  PRIMARY KEY ("business_date", "account_id")
+);
+
+CREATE TABLE "daily_totals" (
+  "business_date" date NOT NULL,
+  "currency" varchar NOT NULL,
+  "total_debits" bigint NOT NULL,
+  "total_credits" bigint NOT NULL,
+  "entry_count" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  PRIMARY KEY ("business_date", "currency")
+);
+
+CREATE TABLE "external_statements" (
+  "id" bigserial PRIMARY KEY,
+  "format" varchar NOT NULL,
+  "reference" varchar NOT NULL,
+  "external_account" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "clearing_account_id" bigint NOT NULL,
+  "from_date" date NOT NULL,
+  "to_date" date NOT NULL,
+  "opening_balance" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  "imported_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "external_statement_lines" (
+  "id" bigserial PRIMARY KEY,
+  "statement_id" bigint NOT NULL,
+  "booking_date" date NOT NULL,
+  "value_date" date NOT NULL,
+  "amount" bigint NOT NULL,
+  "reference" varchar NOT NULL,
+  "end_to_end_id" varchar NOT NULL,
+  "counterparty" varchar NOT NULL,
+  "description" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'unmatched',
+  "entry_id" bigint UNIQUE,
+  "resolved_by" varchar,
+  "resolution_note" varchar,
+  "resolved_at" timestamptz
+);
+
+CREATE TABLE "transfer_approvals" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "decided_by" varchar,
+  "decided_at" timestamptz,
+  "transfer_id" bigint UNIQUE,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "memo" varchar NOT NULL DEFAULT ''
+);
+
+CREATE TABLE "disputes" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "account_id" bigint NOT NULL,
+  "opened_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "evidence" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "assigned_to" varchar,
+  "resolution_note" varchar,
+  "credit_entry_id" bigint,
+  "reversal_entry_id" bigint,
+  "resolved_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "notification_preferences" (
+  "username" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "channel" varchar NOT NULL DEFAULT 'email',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "event_type")
+);
+
+CREATE TABLE "notification_digest_items" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "transfer_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "alert_rules" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "kind" varchar NOT NULL,
+  "threshold" bigint NOT NULL,
+  "triggered_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "savings_pots" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "target_amount" bigint NOT NULL,
+  "deadline" date NOT NULL,
+  "balance" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pot_entries" (
+  "id" bigserial PRIMARY KEY,
+  "pot_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "category_rules" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "category" varchar NOT NULL,
+  "counterparty_account_id" bigint,
+  "memo_contains" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("counterparty_account_id" IS NOT NULL OR "memo_contains" <> '')
+);
+
+CREATE TABLE "entry_categories" (
+  "entry_id" bigint PRIMARY KEY,
+  "category" varchar NOT NULL,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate_bps" integer NOT NULL,
+  "term_months" integer NOT NULL,
+  "method" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "outstanding_principal" bigint NOT NULL,
+  "outstanding_interest" bigint NOT NULL,
+  "disbursement_entry_id" bigint NOT NULL,
+  "disbursed_by" varchar NOT NULL,
+  "paid_off_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_installments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "seq" integer NOT NULL,
+  "due_date" date NOT NULL,
+  "principal" bigint NOT NULL,
+  "interest" bigint NOT NULL,
+  "late_fee" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'scheduled',
+  "entry_id" bigint,
+  "paid_at" timestamptz,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -61,6 +391,10 @@ CREATE INDEX ON "accounts" ("owner");
 
 CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
 
+CREATE INDEX ON "account_holders" ("username");
+
+CREATE INDEX ON "account_invitations" ("invitee", "status");
+
 CREATE INDEX ON "entries" ("account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id");
@@ -69,18 +403,342 @@ CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "outbox" ("id") WHERE "sent_at" IS NULL;
+
+CREATE INDEX ON "webhooks" ("owner");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("webhook_id", "event_id");
+
+CREATE INDEX ON "audit_events" ("actor", "created_at");
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id", "created_at");
+
+CREATE INDEX ON "audit_events" ("created_at");
+
+CREATE INDEX ON "data_exports" ("username");
+
+CREATE INDEX ON "transfers" ("status");
+
+CREATE INDEX ON "fraud_rule_hits" ("transfer_id");
+
+CREATE INDEX ON "balance_adjustments" ("account_id");
+
+CREATE INDEX ON "cash_operations" ("account_id");
+
+CREATE UNIQUE INDEX ON "ledger_accounts" ("code", "currency");
+
+CREATE INDEX ON "ledger_entries" ("ledger_account_id");
+
+CREATE INDEX ON "entries" ("business_date");
+
+CREATE INDEX ON "account_balance_snapshots" ("account_id");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+CREATE UNIQUE INDEX ON "external_statements" ("external_account", "reference");
+
+CREATE INDEX ON "external_statement_lines" ("statement_id");
+
+CREATE INDEX ON "external_statement_lines" ("status");
+
+CREATE INDEX ON "transfer_approvals" ("status", "expires_at");
+
+CREATE INDEX ON "disputes" ("status", "created_at");
+
+CREATE INDEX ON "disputes" ("opened_by");
+
+CREATE UNIQUE INDEX ON "notification_digest_items" ("username", "event_type", "transfer_id");
+
+CREATE INDEX ON "notification_digest_items" ("username", "sent_at");
+
+CREATE INDEX ON "alert_rules" ("account_id");
+
+CREATE INDEX ON "alert_rules" ("username");
+
+CREATE INDEX ON "savings_pots" ("account_id");
+
+CREATE INDEX ON "pot_entries" ("pot_id");
+
+CREATE INDEX ON "category_rules" ("username");
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE UNIQUE INDEX ON "loan_installments" ("loan_id", "seq");
+
+CREATE INDEX ON "loan_installments" ("status", "due_date");
+
+COMMENT ON COLUMN "account_holders"."role" IS 'owner, co_owner or view_only';
+
+COMMENT ON COLUMN "account_invitations"."status" IS 'pending, accepted or declined';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
 COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
 
+COMMENT ON COLUMN "outbox"."aggregate_type" IS 'kind of entity the event belongs to, see the Aggregate constants in db/sqlc/outbox.go';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'null until the relay has published the event';
+
+COMMENT ON COLUMN "webhooks"."secret" IS 'key of the HMAC-SHA256 payload signature';
+
+COMMENT ON COLUMN "webhook_deliveries"."event_id" IS 'id of the outbox event';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending, succeeded or failed';
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'empty for anonymous calls';
+
+COMMENT ON COLUMN "audit_events"."diff" IS 'changed fields with their before and after values';
+
+COMMENT ON COLUMN "data_exports"."status" IS 'pending, ready or failed';
+
+COMMENT ON COLUMN "data_exports"."download_token_hash" IS 'SHA-256 of the download token emailed to the user, set once the archive is ready';
+
+COMMENT ON COLUMN "data_exports"."archive" IS 'ZIP archive of the user data as JSON files';
+
+COMMENT ON COLUMN "data_exports"."expires_at" IS 'the archive can not be downloaded after this time';
+
+COMMENT ON COLUMN "users"."erased_at" IS 'personal data has been pseudonymized on request';
+
+COMMENT ON COLUMN "transfers"."status" IS 'completed, held, denied or rejected. Money moves only for completed transfers';
+
+COMMENT ON COLUMN "transfers"."reviewed_by" IS 'banker who released or rejected the held transfer';
+
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'user who made the transfer, null for transfers made by the bank and those made before it was recorded';
+
+COMMENT ON COLUMN "fraud_rule_hits"."decision" IS 'review or deny';
+
+COMMENT ON COLUMN "users"."locked_at" IS 'a locked user can not log in or renew access tokens';
+
+COMMENT ON COLUMN "balance_adjustments"."amount" IS 'can be negative or positive';
+
+COMMENT ON COLUMN "balance_adjustments"."adjusted_by" IS 'banker who made the adjustment';
+
+COMMENT ON COLUMN "cash_operations"."kind" IS 'deposit or withdrawal';
+
+COMMENT ON COLUMN "cash_operations"."clearing_account_id" IS 'cash clearing ledger account in the currency of the account';
+
+COMMENT ON COLUMN "cash_operations"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "cash_operations"."balance_after" IS 'balance of the account printed on the receipt';
+
+COMMENT ON COLUMN "cash_operations"."clearing_entry_id" IS 'the opposite ledger entry on the clearing account';
+
+COMMENT ON COLUMN "cash_operations"."performed_by" IS 'banker who handled the cash';
+
+COMMENT ON COLUMN "ledger_accounts"."type" IS 'asset, liability, equity, revenue or expense';
+
+COMMENT ON COLUMN "ledger_accounts"."balance" IS 'credits are positive and debits are negative, like the balances of customer accounts';
+
+COMMENT ON COLUMN "ledger_entries"."entry_id" IS 'the opposite entry on a customer account, if any';
+
+COMMENT ON COLUMN "ledger_entries"."amount" IS 'credits are positive and debits are negative';
+
+COMMENT ON COLUMN "entries"."business_date" IS 'UTC day the entry is booked on';
+
+COMMENT ON COLUMN "ledger_entries"."business_date" IS 'UTC day the entry is booked on';
+
+COMMENT ON COLUMN "business_days"."business_date" IS 'a closed day, no entries can be booked on it or before it';
+
+COMMENT ON COLUMN "daily_totals"."closing_balance" IS 'sum of the closing balances of the accounts in the currency';
+
+COMMENT ON COLUMN "entries"."transfer_id" IS 'the transfer that posted the entry, if any';
+
+COMMENT ON COLUMN "accounts"."number" IS 'IBAN-like account number with mod-97 check digits, e.g. XS51SIMP012345678901';
+
+COMMENT ON COLUMN "external_statements"."format" IS 'camt053 or mt940';
+
+COMMENT ON COLUMN "external_statements"."reference" IS 'id of the statement at the external bank';
+
+COMMENT ON COLUMN "external_statements"."clearing_account_id" IS 'cash clearing ledger account mirroring the external account';
+
+COMMENT ON COLUMN "external_statement_lines"."amount" IS 'credits on the external account are positive';
+
+COMMENT ON COLUMN "external_statement_lines"."status" IS 'unmatched, matched or resolved';
+
+COMMENT ON COLUMN "external_statement_lines"."entry_id" IS 'the clearing ledger entry the line matches';
+
+COMMENT ON COLUMN "external_statement_lines"."resolved_by" IS 'banker who resolved the line by hand, empty for automatic matches';
+
+COMMENT ON COLUMN "transfer_approvals"."initiated_by" IS 'user who requested the transfer';
+
+COMMENT ON COLUMN "transfer_approvals"."status" IS 'pending, approved, rejected or expired';
+
+COMMENT ON COLUMN "transfer_approvals"."decided_by" IS 'banker who approved or rejected the transfer, never the initiator';
+
+COMMENT ON COLUMN "transfer_approvals"."transfer_id" IS 'the transfer executed on approval';
+
+COMMENT ON COLUMN "disputes"."account_id" IS 'the account the disputed transfer was paid from';
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, investigating, resolved_customer or resolved_merchant';
+
+COMMENT ON COLUMN "disputes"."assigned_to" IS 'banker investigating the dispute';
+
+COMMENT ON COLUMN "disputes"."credit_entry_id" IS 'provisional credit to the customer, only for resolutions in favour of the customer';
+
+COMMENT ON COLUMN "disputes"."reversal_entry_id" IS 'final reversal debited from the payee, only for resolutions in favour of the customer';
+
+COMMENT ON COLUMN "notification_preferences"."event_type" IS 'money_in or money_out';
+
+COMMENT ON COLUMN "notification_preferences"."min_amount" IS 'smaller transfers are batched into the digest instead of notified one by one';
+
+COMMENT ON COLUMN "notification_digest_items"."sent_at" IS 'empty until the digest with the item is sent';
+
+COMMENT ON COLUMN "alert_rules"."username" IS 'the holder of the account who receives the alert';
+
+COMMENT ON COLUMN "alert_rules"."kind" IS 'low_balance, large_debit or daily_spend';
+
+COMMENT ON COLUMN "alert_rules"."threshold" IS 'must be positive';
+
+COMMENT ON COLUMN "alert_rules"."triggered_at" IS 'set when the alert is sent and cleared when the rule is rearmed, so the alert is not repeated';
+
+COMMENT ON COLUMN "accounts"."pot_balance" IS 'part of the balance set aside in savings pots, the available balance is balance - pot_balance';
+
+COMMENT ON COLUMN "savings_pots"."status" IS 'open or closed';
+
+COMMENT ON COLUMN "pot_entries"."amount" IS 'positive when moved from the main balance into the pot, negative when moved back';
+
+COMMENT ON COLUMN "category_rules"."counterparty_account_id" IS 'matches transfers with this account, any counterparty if empty';
+
+COMMENT ON COLUMN "category_rules"."memo_contains" IS 'matches transfers whose memo contains the text ignoring case, any memo if empty';
+
+COMMENT ON TABLE "entry_categories" IS 'categories set by the user, they take precedence over the category rules';
+
+COMMENT ON COLUMN "loans"."annual_rate_bps" IS 'annual interest rate in basis points';
+
+COMMENT ON COLUMN "loans"."method" IS 'annuity or linear';
+
+COMMENT ON COLUMN "loans"."status" IS 'active or paid_off';
+
+COMMENT ON COLUMN "loans"."disbursed_by" IS 'banker who disbursed the loan';
+
+COMMENT ON COLUMN "loan_installments"."late_fee" IS 'charged once when the installment could not be collected on the due date';
+
+COMMENT ON COLUMN "loan_installments"."status" IS 'scheduled, overdue or paid';
+
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "verification_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("inviter") REFERENCES "users" ("username");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("invitee") REFERENCES "users" ("username");
+
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "webhooks" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id");
+
+ALTER TABLE "data_exports" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "fraud_rule_hits" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("adjusted_by") REFERENCES "users" ("username");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("clearing_entry_id") REFERENCES "ledger_entries" ("id");
+
+ALTER TABLE "cash_operations" ADD FOREIGN KEY ("performed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "ledger_entries" ADD FOREIGN KEY ("ledger_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "ledger_entries" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("ledger_entry_id") REFERENCES "ledger_entries" ("id");
+
+ALTER TABLE "account_balance_snapshots" ADD FOREIGN KEY ("business_date") REFERENCES "business_days" ("business_date");
+
+ALTER TABLE "account_balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "daily_totals" ADD FOREIGN KEY ("business_date") REFERENCES "business_days" ("business_date");
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "external_statements" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "ledger_accounts" ("id");
+
+ALTER TABLE "external_statements" ADD FOREIGN KEY ("imported_by") REFERENCES "users" ("username");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("statement_id") REFERENCES "external_statements" ("id");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("entry_id") REFERENCES "ledger_entries" ("id");
+
+ALTER TABLE "external_statement_lines" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("opened_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("assigned_to") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("credit_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("reversal_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "notification_digest_items" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "savings_pots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pot_entries" ADD FOREIGN KEY ("pot_id") REFERENCES "savings_pots" ("id");
+
+ALTER TABLE "pot_entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("counterparty_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "entry_categories" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "entry_categories" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursement_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
@@ -0,0 +1,84 @@
+// Package fraud screens transfers before they are committed.
+package fraud
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+// Transfer is the transfer being screened.
+type Transfer struct {
+	// Username is the user who initiates the transfer.
+	Username      string
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+}
+
+// Result is the decision of a single rule. Reason explains a review or deny decision.
+type Result struct {
+	Decision string
+	Reason   string
+}
+
+// Rule is a fraud check. Rules only read data through q, so they can be evaluated in any order.
+type Rule interface {
+	Name() string
+	Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error)
+}
+
+// Verdict is the most severe decision of all rules, with the rules that did not allow the transfer.
+type Verdict struct {
+	Decision string
+	Hits     []db.TransferRuleHit
+}
+
+type Engine struct {
+	rules []Rule
+}
+
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// Evaluate runs all rules against the transfer, reading through q. A transfer no rule objects to is allowed.
+func (engine *Engine) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Verdict, error) {
+	verdict := Verdict{Decision: util.FraudAllow}
+
+	for _, rule := range engine.rules {
+		result, err := rule.Evaluate(ctx, q, transfer)
+		if err != nil {
+			return Verdict{}, fmt.Errorf("failed to evaluate fraud rule %s: %w", rule.Name(), err)
+		}
+
+		if result.Decision == util.FraudAllow {
+			continue
+		}
+
+		verdict.Decision = util.MoreSevereFraudDecision(verdict.Decision, result.Decision)
+		verdict.Hits = append(verdict.Hits, db.TransferRuleHit{
+			Rule:     rule.Name(),
+			Decision: result.Decision,
+			Reason:   result.Reason,
+		})
+	}
+
+	return verdict, nil
+}
+
+// Screen returns the screen of the transfer for db.TransferTxParams. The rules are evaluated inside
+// the transaction of the transfer, after its accounts are locked, so concurrent transfers from
+// the same account can't slip past a rule together.
+func (engine *Engine) Screen(transfer Transfer) db.TransferScreen {
+	return func(ctx context.Context, q db.Querier) (string, []db.TransferRuleHit, error) {
+		verdict, err := engine.Evaluate(ctx, q, transfer)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return util.TransferStatusForDecision(verdict.Decision), verdict.Hits, nil
+	}
+}
@@ -0,0 +1,87 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+type staticRule struct {
+	name   string
+	result Result
+	err    error
+}
+
+func (rule staticRule) Name() string {
+	return rule.name
+}
+
+func (rule staticRule) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error) {
+	return rule.result, rule.err
+}
+
+func TestEngineEvaluate(t *testing.T) {
+	transfer := Transfer{Username: util.RandomOwner(), FromAccountID: 1, ToAccountID: 2, Amount: 100}
+
+	verdict, err := NewEngine().Evaluate(context.Background(), nil, transfer)
+	require.NoError(t, err)
+	require.Equal(t, util.FraudAllow, verdict.Decision)
+	require.Empty(t, verdict.Hits)
+
+	engine := NewEngine(
+		staticRule{name: "a", result: Result{Decision: util.FraudReview, Reason: "a"}},
+		staticRule{name: "b", result: Result{Decision: util.FraudAllow}},
+		staticRule{name: "c", result: Result{Decision: util.FraudDeny, Reason: "c"}},
+		staticRule{name: "d", result: Result{Decision: util.FraudReview, Reason: "d"}},
+	)
+
+	verdict, err = engine.Evaluate(context.Background(), nil, transfer)
+	require.NoError(t, err)
+	require.Equal(t, util.FraudDeny, verdict.Decision)
+	require.Equal(t, []db.TransferRuleHit{
+		{Rule: "a", Decision: util.FraudReview, Reason: "a"},
+		{Rule: "c", Decision: util.FraudDeny, Reason: "c"},
+		{Rule: "d", Decision: util.FraudReview, Reason: "d"},
+	}, verdict.Hits)
+
+	engine = NewEngine(staticRule{name: "broken", err: errors.New("boom")})
+	_, err = engine.Evaluate(context.Background(), nil, transfer)
+	require.Error(t, err)
+}
+
+func TestDefaultRules(t *testing.T) {
+	require.Empty(t, DefaultRules(util.Config{}))
+
+	config := util.Config{
+		FraudVelocityWindow:        1,
+		FraudVelocityMaxTransfers:  1,
+		FraudNewRecipientThreshold: 1,
+		FraudHistoryFactor:         1,
+		FraudNewIPWindow:           1,
+		FraudNewIPThreshold:        1,
+	}
+	require.Len(t, DefaultRules(config), 4)
+}
+
+func TestEngineScreen(t *testing.T) {
+	transfer := Transfer{Username: util.RandomOwner(), FromAccountID: 1, ToAccountID: 2, Amount: 100}
+
+	status, hits, err := NewEngine().Screen(transfer)(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferCompleted, status)
+	require.Empty(t, hits)
+
+	engine := NewEngine(staticRule{name: "a", result: Result{Decision: util.FraudReview, Reason: "a"}})
+	status, hits, err = engine.Screen(transfer)(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, util.TransferHeld, status)
+	require.Equal(t, []db.TransferRuleHit{{Rule: "a", Decision: util.FraudReview, Reason: "a"}}, hits)
+
+	engine = NewEngine(staticRule{name: "broken", err: errors.New("boom")})
+	_, _, err = engine.Screen(transfer)(context.Background(), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,191 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+var allow = Result{Decision: util.FraudAllow}
+
+// historyRuleMinTransfers is how many completed transfers make the average amount of an account meaningful.
+const historyRuleMinTransfers = 5
+
+// DefaultRules builds the rules enabled in the config. A rule is disabled while its limit is zero.
+func DefaultRules(config util.Config) []Rule {
+	var rules []Rule
+
+	if config.FraudVelocityMaxTransfers > 0 && config.FraudVelocityWindow > 0 {
+		rules = append(rules, NewVelocityRule(config.FraudVelocityWindow, config.FraudVelocityMaxTransfers))
+	}
+
+	if config.FraudNewRecipientThreshold > 0 {
+		rules = append(rules, NewNewRecipientRule(config.FraudNewRecipientThreshold))
+	}
+
+	if config.FraudHistoryFactor > 0 {
+		rules = append(rules, NewHistoryRule(config.FraudHistoryFactor, historyRuleMinTransfers))
+	}
+
+	if config.FraudNewIPThreshold > 0 && config.FraudNewIPWindow > 0 {
+		rules = append(rules, NewNewIPRule(config.FraudNewIPWindow, config.FraudNewIPThreshold))
+	}
+
+	return rules
+}
+
+// VelocityRule denies a transfer when the account has already completed MaxTransfers transfers within Window.
+// Held and denied attempts don't count, so a burst of blocked transfers doesn't lock the account out.
+type VelocityRule struct {
+	Window       time.Duration
+	MaxTransfers int64
+}
+
+func NewVelocityRule(window time.Duration, maxTransfers int64) *VelocityRule {
+	return &VelocityRule{Window: window, MaxTransfers: maxTransfers}
+}
+
+func (rule *VelocityRule) Name() string {
+	return "velocity"
+}
+
+func (rule *VelocityRule) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error) {
+	count, err := q.CountCompletedTransfersSince(ctx, db.CountCompletedTransfersSinceParams{
+		FromAccountID: transfer.FromAccountID,
+		CreatedAt:     time.Now().Add(-rule.Window),
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	if count < rule.MaxTransfers {
+		return allow, nil
+	}
+
+	return Result{
+		Decision: util.FraudDeny,
+		Reason:   fmt.Sprintf("%d completed transfers within %s", count, rule.Window),
+	}, nil
+}
+
+// NewRecipientRule holds the first transfer to a recipient when it is at least Threshold.
+type NewRecipientRule struct {
+	Threshold int64
+}
+
+func NewNewRecipientRule(threshold int64) *NewRecipientRule {
+	return &NewRecipientRule{Threshold: threshold}
+}
+
+func (rule *NewRecipientRule) Name() string {
+	return "new_recipient"
+}
+
+func (rule *NewRecipientRule) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error) {
+	if transfer.Amount < rule.Threshold {
+		return allow, nil
+	}
+
+	count, err := q.CountCompletedTransfersBetween(ctx, db.CountCompletedTransfersBetweenParams{
+		FromAccountID: transfer.FromAccountID,
+		ToAccountID:   transfer.ToAccountID,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	if count > 0 {
+		return allow, nil
+	}
+
+	return Result{
+		Decision: util.FraudReview,
+		Reason:   fmt.Sprintf("first transfer to account %d is %d, the threshold is %d", transfer.ToAccountID, transfer.Amount, rule.Threshold),
+	}, nil
+}
+
+// HistoryRule holds a transfer more than Factor times the average of the account's completed transfers.
+// Accounts with fewer than MinHistory transfers have no meaningful average and are not checked.
+type HistoryRule struct {
+	Factor     int64
+	MinHistory int64
+}
+
+func NewHistoryRule(factor int64, minHistory int64) *HistoryRule {
+	return &HistoryRule{Factor: factor, MinHistory: minHistory}
+}
+
+func (rule *HistoryRule) Name() string {
+	return "amount_history"
+}
+
+func (rule *HistoryRule) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error) {
+	stats, err := q.GetTransferAmountStats(ctx, transfer.FromAccountID)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if stats.TransferCount < rule.MinHistory || transfer.Amount <= rule.Factor*stats.AverageAmount {
+		return allow, nil
+	}
+
+	return Result{
+		Decision: util.FraudReview,
+		Reason:   fmt.Sprintf("amount %d is more than %d times the average of %d", transfer.Amount, rule.Factor, stats.AverageAmount),
+	}, nil
+}
+
+// NewIPRule holds a transfer of at least Threshold when the user's latest login, within Window,
+// came from an IP address the user had never logged in from before.
+type NewIPRule struct {
+	Window    time.Duration
+	Threshold int64
+}
+
+func NewNewIPRule(window time.Duration, threshold int64) *NewIPRule {
+	return &NewIPRule{Window: window, Threshold: threshold}
+}
+
+func (rule *NewIPRule) Name() string {
+	return "new_ip"
+}
+
+func (rule *NewIPRule) Evaluate(ctx context.Context, q db.Querier, transfer Transfer) (Result, error) {
+	if transfer.Amount < rule.Threshold {
+		return allow, nil
+	}
+
+	session, err := q.GetLatestSession(ctx, transfer.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return allow, nil
+		}
+		return Result{}, err
+	}
+
+	if time.Since(session.CreatedAt) > rule.Window {
+		return allow, nil
+	}
+
+	count, err := q.CountSessionsFromIP(ctx, db.CountSessionsFromIPParams{
+		Username:  transfer.Username,
+		ClientIp:  session.ClientIp,
+		CreatedAt: session.CreatedAt,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	if count > 0 {
+		return allow, nil
+	}
+
+	return Result{
+		Decision: util.FraudReview,
+		Reason:   fmt.Sprintf("transfer of %d right after the first login from %s", transfer.Amount, session.ClientIp),
+	}, nil
+}
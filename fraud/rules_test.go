@@ -0,0 +1,205 @@
+package fraud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func randomTransfer(amount int64) Transfer {
+	return Transfer{
+		Username:      util.RandomOwner(),
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1001, 2000),
+		Amount:        amount,
+	}
+}
+
+func TestVelocityRule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		count    int64
+		decision string
+	}{
+		{name: "BelowLimit", count: 2, decision: util.FraudAllow},
+		{name: "AtLimit", count: 3, decision: util.FraudDeny},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transfer := randomTransfer(10)
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				CountCompletedTransfersSince(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, arg db.CountCompletedTransfersSinceParams) (int64, error) {
+					require.Equal(t, transfer.FromAccountID, arg.FromAccountID)
+					require.WithinDuration(t, time.Now().Add(-time.Minute), arg.CreatedAt, time.Second)
+					return tc.count, nil
+				})
+
+			result, err := NewVelocityRule(time.Minute, 3).Evaluate(context.Background(), store, transfer)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+}
+
+func TestNewRecipientRule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		amount     int64
+		buildStubs func(store *mockdb.MockStore)
+		decision   string
+	}{
+		{
+			name:   "BelowThreshold",
+			amount: 99,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountCompletedTransfersBetween(gomock.Any(), gomock.Any()).Times(0)
+			},
+			decision: util.FraudAllow,
+		},
+		{
+			name:   "KnownRecipient",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountCompletedTransfersBetween(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+			},
+			decision: util.FraudAllow,
+		},
+		{
+			name:   "NewRecipient",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountCompletedTransfersBetween(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+			},
+			decision: util.FraudReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			result, err := NewNewRecipientRule(100).Evaluate(context.Background(), store, randomTransfer(tc.amount))
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+}
+
+func TestHistoryRule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   int64
+		stats    db.GetTransferAmountStatsRow
+		decision string
+	}{
+		{
+			name:     "ShortHistory",
+			amount:   10000,
+			stats:    db.GetTransferAmountStatsRow{TransferCount: 4, AverageAmount: 10},
+			decision: util.FraudAllow,
+		},
+		{
+			name:     "UsualAmount",
+			amount:   100,
+			stats:    db.GetTransferAmountStatsRow{TransferCount: 5, AverageAmount: 10},
+			decision: util.FraudAllow,
+		},
+		{
+			name:     "UnusualAmount",
+			amount:   101,
+			stats:    db.GetTransferAmountStatsRow{TransferCount: 5, AverageAmount: 10},
+			decision: util.FraudReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transfer := randomTransfer(tc.amount)
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetTransferAmountStats(gomock.Any(), gomock.Eq(transfer.FromAccountID)).Times(1).Return(tc.stats, nil)
+
+			result, err := NewHistoryRule(10, 5).Evaluate(context.Background(), store, transfer)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+}
+
+func TestNewIPRule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		decision   string
+	}{
+		{
+			name: "NoSession",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrRecordNotFound)
+			},
+			decision: util.FraudAllow,
+		},
+		{
+			name: "OldLogin",
+			buildStubs: func(store *mockdb.MockStore) {
+				session := db.Session{ClientIp: "10.0.0.1", CreatedAt: time.Now().Add(-2 * time.Hour)}
+				store.EXPECT().GetLatestSession(gomock.Any(), gomock.Any()).Times(1).Return(session, nil)
+				store.EXPECT().CountSessionsFromIP(gomock.Any(), gomock.Any()).Times(0)
+			},
+			decision: util.FraudAllow,
+		},
+		{
+			name: "KnownIP",
+			buildStubs: func(store *mockdb.MockStore) {
+				session := db.Session{ClientIp: "10.0.0.1", CreatedAt: time.Now()}
+				store.EXPECT().GetLatestSession(gomock.Any(), gomock.Any()).Times(1).Return(session, nil)
+				store.EXPECT().CountSessionsFromIP(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			decision: util.FraudAllow,
+		},
+		{
+			name: "NewIP",
+			buildStubs: func(store *mockdb.MockStore) {
+				session := db.Session{ClientIp: "10.0.0.1", CreatedAt: time.Now()}
+				store.EXPECT().GetLatestSession(gomock.Any(), gomock.Any()).Times(1).Return(session, nil)
+				store.EXPECT().CountSessionsFromIP(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+			},
+			decision: util.FraudReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			result, err := NewNewIPRule(time.Hour, 100).Evaluate(context.Background(), store, randomTransfer(100))
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+}
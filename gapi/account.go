@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getAccount loads the account by its id or, if the id is zero, by its account number.
+func (server *Server) getAccount(ctx context.Context, accountID int64, accountNumber string) (db.Account, error) {
+	var account db.Account
+	var err error
+	if accountID != 0 {
+		account, err = server.store.GetAccount(ctx, accountID)
+	} else {
+		accountNumber = util.NormalizeAccountNumber(accountNumber)
+		account, err = server.store.GetAccountByNumber(ctx, accountNumber)
+	}
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			if accountID != 0 {
+				return db.Account{}, status.Errorf(codes.NotFound, "account [%d] does not exist", accountID)
+			}
+
+			return db.Account{}, status.Errorf(codes.NotFound, "account [%s] does not exist", accountNumber)
+		}
+
+		return db.Account{}, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	return account, nil
+}
+
+// authorizeAccount checks that the user holds at least requiredRole on the account,
+// owners hold every role.
+func (server *Server) authorizeAccount(ctx context.Context, account db.Account, username string, requiredRole string) error {
+	if account.Owner == username {
+		return nil
+	}
+
+	holder, err := server.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  username,
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return status.Errorf(codes.Internal, "failed to get account holder: %s", err)
+	}
+
+	if err != nil || !util.HolderRoleAllows(holder.Role, requiredRole) {
+		return status.Errorf(codes.PermissionDenied, "account [%d] doesn't belong to the authenticated user", account.ID)
+	}
+
+	return nil
+}
+
+// validateAccountRef checks that the account is given either by its id or by its account number.
+func validateAccountRef(id int64, number string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if number == "" {
+		if err := val.ValidateID(id); err != nil {
+			violations = append(violations, fieldViolation("id", err))
+		}
+
+		return
+	}
+
+	if id != 0 {
+		violations = append(violations, fieldViolation("number", fmt.Errorf("must not be given together with id")))
+	}
+
+	if err := val.ValidateAccountNumber(number); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	return
+}
@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isAccountHolder reports whether the user owns the account or already holds a role on it.
+func (server *Server) isAccountHolder(ctx context.Context, account db.Account, username string) (bool, error) {
+	if account.Owner == username {
+		return true, nil
+	}
+
+	_, err := server.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return false, nil
+		}
+
+		return false, status.Errorf(codes.Internal, "failed to get account holder: %s", err)
+	}
+
+	return true, nil
+}
+
+// getPendingInvitation loads the invitation and checks that it is still pending and addressed to the caller.
+func (server *Server) getPendingInvitation(ctx context.Context, authPayload *token.Payload, id int64) (db.AccountInvitation, error) {
+	invitation, err := server.store.GetAccountInvitation(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.AccountInvitation{}, status.Errorf(codes.NotFound, "invitation [%d] does not exist", id)
+		}
+
+		return db.AccountInvitation{}, status.Errorf(codes.Internal, "failed to get invitation: %s", err)
+	}
+
+	if invitation.Invitee != authPayload.Username {
+		return db.AccountInvitation{}, status.Errorf(codes.PermissionDenied, "invitation [%d] doesn't belong to the authenticated user", id)
+	}
+
+	if invitation.Status != util.InvitationPending {
+		return db.AccountInvitation{}, status.Errorf(codes.FailedPrecondition, "invitation is already %s", invitation.Status)
+	}
+
+	return invitation, nil
+}
+
+func validateInvitationID(id int64) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authorizeBanker authenticates the caller of the admin service, which is open to bankers only.
+func (server *Server) authorizeBanker(ctx context.Context) (*token.Payload, error) {
+	accessibleRoles := []string{util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	return authPayload, nil
+}
+
+// getTargetUser loads the user an admin call acts on.
+// It is read from the primary, the admin acts on its current state, e.g. whether it is already locked.
+func (server *Server) getTargetUser(ctx context.Context, username string) (db.User, error) {
+	user, err := server.store.GetUser(db.WithReadYourWrites(ctx), username)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return db.User{}, status.Errorf(codes.NotFound, "user [%s] does not exist", username)
+		}
+
+		return db.User{}, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	return user, nil
+}
+
+// validateTargetAccount checks that exactly one of the account id and the account number is given.
+func validateTargetAccount(accountID int64, accountNumber string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if accountNumber == "" {
+		if err := val.ValidateID(accountID); err != nil {
+			violations = append(violations, fieldViolation("account_id", err))
+		}
+
+		return
+	}
+
+	if accountID != 0 {
+		violations = append(violations, fieldViolation("account_number", fmt.Errorf("must not be given together with account_id")))
+	}
+
+	if err := val.ValidateAccountNumber(accountNumber); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	return
+}
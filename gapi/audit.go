@@ -0,0 +1,86 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+// auditedChange is the audited target of a change and its state before and after the change.
+type auditedChange struct {
+	targetID string
+	before   any
+	after    any
+}
+
+// auditTx makes the change and appends it to the audit log in one transaction, so a change that can't be audited fails.
+// change must make its queries on the store it is given. actor is nil for anonymous calls.
+func (server *Server) auditTx(
+	ctx context.Context,
+	actor *token.Payload,
+	action string,
+	targetType string,
+	change func(store db.Store) (auditedChange, error),
+) error {
+	return server.store.AuditTx(ctx, func(store db.Store) (db.CreateAuditEventParams, error) {
+		audited, err := change(store)
+		if err != nil {
+			return db.CreateAuditEventParams{}, err
+		}
+
+		return server.newAuditEvent(ctx, actor, action, targetType, audited)
+	})
+}
+
+// recordAudit appends a call that changes nothing in the database, e.g. a banker viewing the data of a user, to the audit log.
+// The caller fails the call if it can't be audited.
+func (server *Server) recordAudit(
+	ctx context.Context,
+	actor *token.Payload,
+	action string,
+	targetType string,
+	targetID string,
+	before any,
+	after any,
+) error {
+	arg, err := server.newAuditEvent(ctx, actor, action, targetType, auditedChange{targetID: targetID, before: before, after: after})
+	if err != nil {
+		return err
+	}
+
+	_, err = server.store.CreateAuditEvent(ctx, arg)
+	return err
+}
+
+func (server *Server) newAuditEvent(
+	ctx context.Context,
+	actor *token.Payload,
+	action string,
+	targetType string,
+	audited auditedChange,
+) (db.CreateAuditEventParams, error) {
+	diff, err := util.AuditDiff(audited.before, audited.after)
+	if err != nil {
+		return db.CreateAuditEventParams{}, err
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	arg := db.CreateAuditEventParams{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   audited.targetID,
+		Diff:       diff,
+		ClientIp:   mtdt.ClientIP,
+		UserAgent:  mtdt.UserAgent,
+	}
+
+	if actor != nil {
+		arg.Actor = actor.Username
+		arg.ActorRole = actor.Role
+	}
+
+	return arg, nil
+}
@@ -49,5 +49,15 @@ func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []strin
 		return nil, fmt.Errorf("permission denied: %s", err)
 	}
 
+	// locking or erasing a user blocks the sessions, the access tokens already issued are rejected here
+	blocked, err := server.store.IsUserBlocked(ctx, payload.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user: %s", err)
+	}
+
+	if blocked {
+		return nil, fmt.Errorf("user [%s] is locked", payload.Username)
+	}
+
 	return payload, nil
 }
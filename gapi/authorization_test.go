@@ -20,6 +20,7 @@ func TestAuthMiddleware(t *testing.T) {
 		name          string
 		roles         []string
 		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, payload *token.Payload, err error)
 	}{
 		{
@@ -89,6 +90,20 @@ func TestAuthMiddleware(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{
+			name:  "UserLocked",
+			roles: []string{util.DepositorRole, util.BankerRole},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().IsUserBlocked(gomock.Any(), gomock.Eq(username)).Times(1).Return(true, nil)
+			},
+			checkResponse: func(t *testing.T, payload *token.Payload, err error) {
+				require.Empty(t, payload)
+				require.Error(t, err)
+			},
+		},
 		{
 			name:  "PermissionDenied",
 			roles: []string{util.BankerRole},
@@ -108,6 +123,9 @@ func TestAuthMiddleware(t *testing.T) {
 			defer storeCtrl.Finish()
 
 			store := mockdb.NewMockStore(storeCtrl)
+			if tc.buildStubs != nil {
+				tc.buildStubs(store)
+			}
 
 			taskCtrl := gomock.NewController(t)
 			defer taskCtrl.Finish()
@@ -3,6 +3,7 @@ package gapi
 import (
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,3 +18,423 @@ func convertUser(dbUser db.User) *pb.User {
 		IsEmailVerified:   dbUser.IsEmailVerified,
 	}
 }
+
+func convertWebhook(webhook db.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:         webhook.ID,
+		Owner:      webhook.Owner,
+		Url:        webhook.Url,
+		EventTypes: webhook.EventTypes,
+		CreatedAt:  timestamppb.New(webhook.CreatedAt),
+	}
+}
+
+func convertWebhookDelivery(delivery db.WebhookDelivery) *pb.WebhookDelivery {
+	res := &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus.Int32,
+		LastError:      delivery.LastError.String,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+
+	if delivery.DeliveredAt.Valid {
+		res.DeliveredAt = timestamppb.New(delivery.DeliveredAt.Time)
+	}
+
+	return res
+}
+
+func convertAuditEvent(event db.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:         event.ID,
+		Actor:      event.Actor,
+		ActorRole:  event.ActorRole,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Diff:       string(event.Diff),
+		ClientIp:   event.ClientIp,
+		UserAgent:  event.UserAgent,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}
+}
+
+func convertDataExport(export db.DataExport) *pb.DataExport {
+	res := &pb.DataExport{
+		Id:        export.ID,
+		Username:  export.Username,
+		Status:    export.Status,
+		CreatedAt: timestamppb.New(export.CreatedAt),
+	}
+
+	if export.CompletedAt.Valid {
+		res.CompletedAt = timestamppb.New(export.CompletedAt.Time)
+	}
+
+	if export.ExpiresAt.Valid {
+		res.ExpiresAt = timestamppb.New(export.ExpiresAt.Time)
+	}
+
+	return res
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	res := &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		Status:        transfer.Status,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+		ReviewedBy:    transfer.ReviewedBy.String,
+		Memo:          transfer.Memo,
+	}
+
+	if transfer.ReviewedAt.Valid {
+		res.ReviewedAt = timestamppb.New(transfer.ReviewedAt.Time)
+	}
+
+	return res
+}
+
+func convertTransferApproval(approval db.TransferApproval) *pb.TransferApproval {
+	res := &pb.TransferApproval{
+		Id:            approval.ID,
+		FromAccountId: approval.FromAccountID,
+		ToAccountId:   approval.ToAccountID,
+		Amount:        approval.Amount,
+		Currency:      approval.Currency,
+		InitiatedBy:   approval.InitiatedBy,
+		Status:        approval.Status,
+		DecidedBy:     approval.DecidedBy.String,
+		TransferId:    approval.TransferID.Int64,
+		ExpiresAt:     timestamppb.New(approval.ExpiresAt),
+		CreatedAt:     timestamppb.New(approval.CreatedAt),
+		Memo:          approval.Memo,
+	}
+
+	if approval.DecidedAt.Valid {
+		res.DecidedAt = timestamppb.New(approval.DecidedAt.Time)
+	}
+
+	return res
+}
+
+func convertDispute(dispute db.Dispute) *pb.Dispute {
+	res := &pb.Dispute{
+		Id:              dispute.ID,
+		TransferId:      dispute.TransferID,
+		AccountId:       dispute.AccountID,
+		OpenedBy:        dispute.OpenedBy,
+		Reason:          dispute.Reason,
+		Evidence:        dispute.Evidence,
+		Status:          dispute.Status,
+		AssignedTo:      dispute.AssignedTo.String,
+		ResolutionNote:  dispute.ResolutionNote.String,
+		CreditEntryId:   dispute.CreditEntryID.Int64,
+		ReversalEntryId: dispute.ReversalEntryID.Int64,
+		UpdatedAt:       timestamppb.New(dispute.UpdatedAt),
+		CreatedAt:       timestamppb.New(dispute.CreatedAt),
+	}
+
+	if dispute.ResolvedAt.Valid {
+		res.ResolvedAt = timestamppb.New(dispute.ResolvedAt.Time)
+	}
+
+	return res
+}
+
+func convertLoan(loan db.Loan) *pb.Loan {
+	res := &pb.Loan{
+		Id:                   loan.ID,
+		AccountId:            loan.AccountID,
+		Principal:            loan.Principal,
+		Currency:             loan.Currency,
+		AnnualRateBps:        loan.AnnualRateBps,
+		TermMonths:           loan.TermMonths,
+		Method:               loan.Method,
+		Status:               loan.Status,
+		OutstandingPrincipal: loan.OutstandingPrincipal,
+		OutstandingInterest:  loan.OutstandingInterest,
+		DisbursedBy:          loan.DisbursedBy,
+		CreatedAt:            timestamppb.New(loan.CreatedAt),
+	}
+
+	if loan.PaidOffAt.Valid {
+		res.PaidOffAt = timestamppb.New(loan.PaidOffAt.Time)
+	}
+
+	return res
+}
+
+func convertLoanInstallment(installment db.LoanInstallment) *pb.LoanInstallment {
+	res := &pb.LoanInstallment{
+		Id:        installment.ID,
+		LoanId:    installment.LoanID,
+		Seq:       installment.Seq,
+		DueDate:   installment.DueDate.Time.Format(util.BusinessDateLayout),
+		Principal: installment.Principal,
+		Interest:  installment.Interest,
+		LateFee:   installment.LateFee,
+		Status:    installment.Status,
+	}
+
+	if installment.PaidAt.Valid {
+		res.PaidAt = timestamppb.New(installment.PaidAt.Time)
+	}
+
+	return res
+}
+
+func convertSavingsPot(pot db.SavingsPot) *pb.SavingsPot {
+	res := &pb.SavingsPot{
+		Id:           pot.ID,
+		AccountId:    pot.AccountID,
+		Name:         pot.Name,
+		TargetAmount: pot.TargetAmount,
+		Deadline:     pot.Deadline.Time.Format(util.BusinessDateLayout),
+		Balance:      pot.Balance,
+		Status:       pot.Status,
+		CreatedAt:    timestamppb.New(pot.CreatedAt),
+	}
+
+	if pot.ClosedAt.Valid {
+		res.ClosedAt = timestamppb.New(pot.ClosedAt.Time)
+	}
+
+	return res
+}
+
+func convertPotEntry(entry db.PotEntry) *pb.PotEntry {
+	return &pb.PotEntry{
+		Id:        entry.ID,
+		PotId:     entry.PotID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func convertAlertRule(rule db.AlertRule) *pb.AlertRule {
+	res := &pb.AlertRule{
+		Id:        rule.ID,
+		AccountId: rule.AccountID,
+		Username:  rule.Username,
+		Kind:      rule.Kind,
+		Threshold: rule.Threshold,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+
+	if rule.TriggeredAt.Valid {
+		res.TriggeredAt = timestamppb.New(rule.TriggeredAt.Time)
+	}
+
+	return res
+}
+
+func convertCategoryRule(rule db.CategoryRule) *pb.CategoryRule {
+	return &pb.CategoryRule{
+		Id:                    rule.ID,
+		Category:              rule.Category,
+		CounterpartyAccountId: rule.CounterpartyAccountID.Int64,
+		MemoContains:          rule.MemoContains,
+		CreatedAt:             timestamppb.New(rule.CreatedAt),
+	}
+}
+
+func convertSpendingTotal(row db.GetSpendingByCategoryRow) *pb.SpendingTotal {
+	return &pb.SpendingTotal{
+		PeriodStart:  timestamppb.New(row.PeriodStart),
+		Category:     row.Category,
+		Currency:     row.Currency,
+		TotalDebits:  row.TotalDebits,
+		TotalCredits: row.TotalCredits,
+		EntryCount:   row.EntryCount,
+	}
+}
+
+func convertNotificationPreference(preference db.NotificationPreference) *pb.NotificationPreference {
+	return &pb.NotificationPreference{
+		EventType: preference.EventType,
+		MinAmount: preference.MinAmount,
+		Channel:   preference.Channel,
+		UpdatedAt: timestamppb.New(preference.UpdatedAt),
+		CreatedAt: timestamppb.New(preference.CreatedAt),
+	}
+}
+
+func convertFraudRuleHit(hit db.FraudRuleHit) *pb.FraudRuleHit {
+	return &pb.FraudRuleHit{
+		Rule:      hit.Rule,
+		Decision:  hit.Decision,
+		Reason:    hit.Reason,
+		CreatedAt: timestamppb.New(hit.CreatedAt),
+	}
+}
+
+func convertAdminUser(dbUser db.User) *pb.AdminUser {
+	res := &pb.AdminUser{
+		User:       convertUser(dbUser),
+		IsLocked:   dbUser.LockedAt.Valid,
+		LockReason: dbUser.LockReason.String,
+	}
+
+	if dbUser.LockedAt.Valid {
+		res.LockedAt = timestamppb.New(dbUser.LockedAt.Time)
+	}
+
+	if dbUser.ErasedAt.Valid {
+		res.ErasedAt = timestamppb.New(dbUser.ErasedAt.Time)
+	}
+
+	return res
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:         account.ID,
+		Owner:      account.Owner,
+		Balance:    account.Balance,
+		Currency:   account.Currency,
+		CreatedAt:  timestamppb.New(account.CreatedAt),
+		Number:     account.Number,
+		PotBalance: account.PotBalance,
+	}
+}
+
+func convertBalanceAdjustment(adjustment db.BalanceAdjustment) *pb.BalanceAdjustment {
+	return &pb.BalanceAdjustment{
+		Id:         adjustment.ID,
+		AccountId:  adjustment.AccountID,
+		EntryId:    adjustment.EntryID,
+		Amount:     adjustment.Amount,
+		Reason:     adjustment.Reason,
+		AdjustedBy: adjustment.AdjustedBy,
+		CreatedAt:  timestamppb.New(adjustment.CreatedAt),
+	}
+}
+
+func convertCashReceipt(operation db.CashOperation) *pb.CashReceipt {
+	return &pb.CashReceipt{
+		Id:            operation.ID,
+		ReceiptNumber: util.CashReceiptNumber(operation.Kind, operation.ID, operation.CreatedAt),
+		Kind:          operation.Kind,
+		AccountId:     operation.AccountID,
+		Amount:        operation.Amount,
+		Currency:      operation.Currency,
+		BalanceAfter:  operation.BalanceAfter,
+		PerformedBy:   operation.PerformedBy,
+		CreatedAt:     timestamppb.New(operation.CreatedAt),
+	}
+}
+
+func convertLedgerAccount(account db.LedgerAccount) *pb.LedgerAccount {
+	return &pb.LedgerAccount{
+		Id:        account.ID,
+		Code:      account.Code,
+		Name:      account.Name,
+		Type:      account.Type,
+		Currency:  account.Currency,
+		Balance:   account.Balance,
+		CreatedAt: timestamppb.New(account.CreatedAt),
+	}
+}
+
+func convertBusinessDay(day db.BusinessDay, totals []db.DailyTotal) *pb.BusinessDay {
+	res := &pb.BusinessDay{
+		BusinessDate: day.BusinessDate.Time.Format(util.BusinessDateLayout),
+		ClosedAt:     timestamppb.New(day.ClosedAt),
+		Totals:       make([]*pb.DailyTotal, len(totals)),
+	}
+
+	for i, total := range totals {
+		res.Totals[i] = &pb.DailyTotal{
+			Currency:       total.Currency,
+			TotalDebits:    total.TotalDebits,
+			TotalCredits:   total.TotalCredits,
+			EntryCount:     total.EntryCount,
+			ClosingBalance: total.ClosingBalance,
+		}
+	}
+
+	return res
+}
+
+func convertExternalStatement(statement db.ExternalStatement, lines []db.ExternalStatementLine) *pb.ExternalStatement {
+	res := &pb.ExternalStatement{
+		Id:                statement.ID,
+		Format:            statement.Format,
+		Reference:         statement.Reference,
+		ExternalAccount:   statement.ExternalAccount,
+		Currency:          statement.Currency,
+		ClearingAccountId: statement.ClearingAccountID,
+		FromDate:          statement.FromDate.Time.Format(util.BusinessDateLayout),
+		ToDate:            statement.ToDate.Time.Format(util.BusinessDateLayout),
+		OpeningBalance:    statement.OpeningBalance,
+		ClosingBalance:    statement.ClosingBalance,
+		ImportedBy:        statement.ImportedBy,
+		CreatedAt:         timestamppb.New(statement.CreatedAt),
+	}
+
+	for _, line := range lines {
+		res.Lines = append(res.Lines, convertExternalStatementLine(line))
+	}
+
+	return res
+}
+
+func convertExternalStatementLine(line db.ExternalStatementLine) *pb.ExternalStatementLine {
+	res := &pb.ExternalStatementLine{
+		Id:             line.ID,
+		StatementId:    line.StatementID,
+		BookingDate:    line.BookingDate.Time.Format(util.BusinessDateLayout),
+		ValueDate:      line.ValueDate.Time.Format(util.BusinessDateLayout),
+		Amount:         line.Amount,
+		Reference:      line.Reference,
+		EndToEndId:     line.EndToEndID,
+		Counterparty:   line.Counterparty,
+		Description:    line.Description,
+		Status:         line.Status,
+		EntryId:        line.EntryID.Int64,
+		ResolvedBy:     line.ResolvedBy.String,
+		ResolutionNote: line.ResolutionNote.String,
+	}
+
+	if line.ResolvedAt.Valid {
+		res.ResolvedAt = timestamppb.New(line.ResolvedAt.Time)
+	}
+
+	return res
+}
+
+func convertAccountHolder(holder db.AccountHolder) *pb.AccountHolder {
+	return &pb.AccountHolder{
+		AccountId: holder.AccountID,
+		Username:  holder.Username,
+		Role:      holder.Role,
+		CreatedAt: timestamppb.New(holder.CreatedAt),
+	}
+}
+
+func convertAccountInvitation(invitation db.AccountInvitation) *pb.AccountInvitation {
+	res := &pb.AccountInvitation{
+		Id:        invitation.ID,
+		AccountId: invitation.AccountID,
+		Inviter:   invitation.Inviter,
+		Invitee:   invitation.Invitee,
+		Role:      invitation.Role,
+		Status:    invitation.Status,
+		CreatedAt: timestamppb.New(invitation.CreatedAt),
+	}
+
+	if invitation.RespondedAt.Valid {
+		res.RespondedAt = timestamppb.New(invitation.RespondedAt.Time)
+	}
+
+	return res
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+// DataExportArchivePath is where the gateway serves the archives of user data exports.
+const DataExportArchivePath = "/v1/data_exports/{id}/archive"
+
+// DataExportArchive serves the ZIP archive of a finished data export.
+// The link is emailed to the user, so it is authorized by the download token of the export
+// instead of an access token, and stops working once the export expires.
+func (server *Server) DataExportArchive(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, "invalid data export id", http.StatusBadRequest)
+		return
+	}
+
+	export, err := server.store.GetDataExport(r.Context(), id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			http.Error(w, "data export not found", http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, "failed to get data export", http.StatusInternalServerError)
+		return
+	}
+
+	tokenHash := util.HashSecret(r.URL.Query().Get("token"))
+	if !export.DownloadTokenHash.Valid || subtle.ConstantTimeCompare([]byte(tokenHash), []byte(export.DownloadTokenHash.String)) != 1 {
+		http.Error(w, "data export not found", http.StatusNotFound)
+		return
+	}
+
+	if export.Status != util.DataExportReady {
+		http.Error(w, fmt.Sprintf("data export is %s", export.Status), http.StatusConflict)
+		return
+	}
+
+	if !export.ExpiresAt.Valid || time.Now().After(export.ExpiresAt.Time) {
+		http.Error(w, "data export has expired", http.StatusGone)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="simplebank-%s-%d.zip"`, export.Username, export.ID))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(export.Archive)
+}
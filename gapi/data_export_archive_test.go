@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataExportArchive(t *testing.T) {
+	token, err := util.NewSecret(32)
+	require.NoError(t, err)
+
+	export := db.DataExport{
+		ID:                util.RandomInt(1, 1000),
+		Username:          util.RandomOwner(),
+		Status:            util.DataExportReady,
+		DownloadTokenHash: pgtype.Text{String: util.HashSecret(token), Valid: true},
+		Archive:           []byte("PK archive"),
+		ExpiresAt:         pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+
+	testCases := []struct {
+		name           string
+		token          string
+		export         func() db.DataExport
+		expectedStatus int
+	}{
+		{
+			name:           "OK",
+			token:          token,
+			export:         func() db.DataExport { return export },
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "WrongToken",
+			token:          util.RandomString(32),
+			export:         func() db.DataExport { return export },
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "StoredHash",
+			token:          export.DownloadTokenHash.String,
+			export:         func() db.DataExport { return export },
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "Failed",
+			token: token,
+			export: func() db.DataExport {
+				failed := export
+				failed.Status = util.DataExportFailed
+				return failed
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			// the token is generated once the archive is ready
+			name:  "Pending",
+			token: token,
+			export: func() db.DataExport {
+				pending := export
+				pending.Status = util.DataExportPending
+				pending.DownloadTokenHash = pgtype.Text{}
+				return pending
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "Expired",
+			token: token,
+			export: func() db.DataExport {
+				expired := export
+				expired.ExpiresAt.Time = time.Now().Add(-time.Minute)
+				return expired
+			},
+			expectedStatus: http.StatusGone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetDataExport(gomock.Any(), gomock.Eq(export.ID)).Times(1).Return(tc.export(), nil)
+
+			server := newTestServer(t, store, nil)
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET "+DataExportArchivePath, server.DataExportArchive)
+
+			url := fmt.Sprintf("/v1/data_exports/%d/archive?token=%s", export.ID, tc.token)
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			recorder := httptest.NewRecorder()
+
+			mux.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				require.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+				require.Equal(t, export.Archive, recorder.Body.Bytes())
+			}
+		})
+	}
+}
@@ -53,6 +53,11 @@ func (r *ResponseRecorder) Write(body []byte) (int, error) {
 	return r.ResponseWriter.Write(body)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush streamed responses.
+func (r *ResponseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func HttpLogger(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
@@ -66,9 +71,10 @@ func HttpLogger(handler http.Handler) http.Handler {
 
 		dt := time.Since(startTime)
 
+		// the query is left out, it can carry credentials such as the access token of an event stream
 		logger.Str("protocol", "http").
 			Str("method", r.Method).
-			Str("path", r.RequestURI).
+			Str("path", r.URL.Path).
 			Int("status_code", recorder.StatusCode).
 			Str("status_text", http.StatusText(recorder.StatusCode)).
 			Dur("duration", dt).
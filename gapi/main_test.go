@@ -6,11 +6,13 @@ import (
 	"testing"
 	"time"
 
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/token"
 	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/Drolfothesgnir/simplebank/worker"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -20,7 +22,24 @@ func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDist
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store, taskDistributor)
+	// the users the tests authorize are not locked, unless a test expects otherwise before creating the server
+	if mockStore, ok := store.(*mockdb.MockStore); ok {
+		mockStore.EXPECT().IsUserBlocked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+		// audited changes run on the mock itself, so the tests expect the queries of the change and its audit event
+		mockStore.EXPECT().AuditTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, change func(store db.Store) (db.CreateAuditEventParams, error)) error {
+				event, err := change(mockStore)
+				if err != nil {
+					return err
+				}
+
+				_, err = mockStore.CreateAuditEvent(ctx, event)
+				return err
+			})
+	}
+
+	server, err := NewServer(config, store, taskDistributor, nil)
 	require.NoError(t, err)
 	return server
 }
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientHost(t *testing.T) {
+	testCases := []struct {
+		addr string
+		host string
+	}{
+		{addr: "203.0.113.7:51234", host: "203.0.113.7"},
+		{addr: "[2001:db8::1]:443", host: "2001:db8::1"},
+		{addr: "203.0.113.7", host: "203.0.113.7"},
+		{addr: "2001:db8::1", host: "2001:db8::1"},
+		{addr: "203.0.113.7, 10.0.0.1", host: "203.0.113.7"},
+		{addr: "", host: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.addr, func(t *testing.T) {
+			require.Equal(t, tc.host, clientHost(tc.addr))
+		})
+	}
+}
+
+func TestExtractMetadataClientIP(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(xForwardedForHeader, "198.51.100.2:8080"))
+	require.Equal(t, "198.51.100.2", server.extractMetadata(ctx).ClientIP)
+
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}})
+	require.Equal(t, "203.0.113.7", server.extractMetadata(ctx).ClientIP)
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const readYourWritesHeader = "x-read-your-writes"
+
+func isReadYourWritesValue(value string) bool {
+	return strings.EqualFold(value, "true")
+}
+
+// GrpcReadYourWrites routes every read of the call to the primary database
+// when the client sends the read-your-writes metadata.
+func GrpcReadYourWrites(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(readYourWritesHeader); len(values) > 0 && isReadYourWritesValue(values[0]) {
+			ctx = db.WithReadYourWrites(ctx)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// HttpReadYourWrites does the same as GrpcReadYourWrites for requests coming through the gateway.
+func HttpReadYourWrites(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isReadYourWritesValue(r.Header.Get(readYourWritesHeader)) {
+			r = r.WithContext(db.WithReadYourWrites(r.Context()))
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
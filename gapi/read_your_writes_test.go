@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGrpcReadYourWrites(t *testing.T) {
+	testCases := []struct {
+		name     string
+		md       metadata.MD
+		expected bool
+	}{
+		{
+			name:     "Enabled",
+			md:       metadata.MD{readYourWritesHeader: []string{"true"}},
+			expected: true,
+		},
+		{
+			name:     "Disabled",
+			md:       metadata.MD{readYourWritesHeader: []string{"false"}},
+			expected: false,
+		},
+		{
+			name:     "NoMetadata",
+			md:       metadata.MD{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return db.IsReadYourWrites(ctx), nil
+			}
+
+			resp, err := GrpcReadYourWrites(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestHttpReadYourWrites(t *testing.T) {
+	var got bool
+	handler := HttpReadYourWrites(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = db.IsReadYourWrites(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/users", nil)
+	request.Header.Set(readYourWritesHeader, "true")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	require.True(t, got)
+
+	request = httptest.NewRequest(http.MethodGet, "/v1/users", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	require.False(t, got)
+}
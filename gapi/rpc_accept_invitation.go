@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AcceptInvitation makes the invitee a holder of the account with the invited role.
+func (server *Server) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateInvitationID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	invitation, err := server.getPendingInvitation(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var result db.AcceptInvitationTxResult
+	err = server.auditTx(ctx, authPayload, "invitation.accept", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.AcceptInvitationTx(ctx, db.AcceptInvitationTxParams{InvitationID: invitation.ID})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), before: invitation, after: result.Invitation}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "user [%s] already holds account [%d]", invitation.Invitee, invitation.AccountID)
+		}
+
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "invitation [%d] is no longer pending", invitation.ID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation: %s", err)
+	}
+
+	return &pb.AcceptInvitationResponse{
+		Invitation: convertAccountInvitation(result.Invitation),
+		Holder:     convertAccountHolder(result.Holder),
+	}, nil
+}
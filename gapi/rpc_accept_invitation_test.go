@@ -0,0 +1,123 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAcceptInvitationAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	invitee, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(owner.Username)
+
+	invitation := db.AccountInvitation{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Inviter:   owner.Username,
+		Invitee:   invitee.Username,
+		Role:      util.ViewerHolderRole,
+		Status:    util.InvitationPending,
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.AcceptInvitationResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				accepted := invitation
+				accepted.Status = util.InvitationAccepted
+				holder := db.AccountHolder{AccountID: account.ID, Username: invitee.Username, Role: util.ViewerHolderRole}
+
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().
+					AcceptInvitationTx(gomock.Any(), gomock.Eq(db.AcceptInvitationTxParams{InvitationID: invitation.ID})).
+					Times(1).
+					Return(db.AcceptInvitationTxResult{Invitation: accepted, Holder: holder}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptInvitationResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.InvitationAccepted, res.GetInvitation().GetStatus())
+				require.Equal(t, invitee.Username, res.GetHolder().GetUsername())
+				require.Equal(t, util.ViewerHolderRole, res.GetHolder().GetRole())
+			},
+		},
+		{
+			name:     "NotTheInvitee",
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name:     "AlreadyAnswered",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				declined := invitation
+				declined.Status = util.InvitationDeclined
+
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(declined, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(db.AccountInvitation{}, db.ErrRecordNotFound)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name:     "InternalError",
+			username: invitee.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountInvitation(gomock.Any(), gomock.Eq(invitation.ID)).Times(1).Return(invitation, nil)
+				store.EXPECT().AcceptInvitationTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AcceptInvitationTxResult{}, sql.ErrTxDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, tc.username, util.DepositorRole, time.Minute)
+			res, err := server.AcceptInvitation(ctx, &pb.AcceptInvitationRequest{Id: invitation.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdjustBalance posts a manual correction to an account. A debit can't take more than the available balance.
+func (server *Server) AdjustBalance(ctx context.Context, req *pb.AdminAdjustBalanceRequest) (*pb.AdminAdjustBalanceResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminAdjustBalanceRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the balance before the change is audited, so it is read from the primary
+	account, err := server.getAccount(db.WithReadYourWrites(ctx), req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	var result db.AdjustBalanceTxResult
+	err = server.auditTx(ctx, authPayload, "account.adjust_balance", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.AdjustBalanceTx(ctx, db.AdjustBalanceTxParams{
+			AccountID:  account.ID,
+			Amount:     req.GetAmount(),
+			Reason:     strings.TrimSpace(req.GetReason()),
+			AdjustedBy: authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]any{"balance": account.Balance}
+		after := map[string]any{
+			"balance":       result.Account.Balance,
+			"adjustment_id": result.Adjustment.ID,
+			"reason":        result.Adjustment.Reason,
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "account [%d] has insufficient funds for the adjustment", account.ID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to adjust balance: %s", err)
+	}
+
+	res := &pb.AdminAdjustBalanceResponse{
+		Account:    convertAccount(result.Account),
+		Adjustment: convertBalanceAdjustment(result.Adjustment),
+	}
+
+	return res, nil
+}
+
+func validateAdminAdjustBalanceRequest(req *pb.AdminAdjustBalanceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if req.GetAmount() == 0 {
+		violations = append(violations, fieldViolation("amount", fmt.Errorf("must not be zero")))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return
+}
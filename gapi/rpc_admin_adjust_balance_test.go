@@ -0,0 +1,186 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdjustBalanceAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    depositor.Username,
+		Balance:  100,
+		Currency: util.USD,
+	}
+
+	reason := "refund of a duplicated card fee"
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdminAdjustBalanceRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: 25, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AdjustBalanceTxParams{
+					AccountID:  account.ID,
+					Amount:     25,
+					Reason:     reason,
+					AdjustedBy: banker.Username,
+				}
+
+				adjusted := account
+				adjusted.Balance += 25
+
+				result := db.AdjustBalanceTxResult{
+					Account: adjusted,
+					Adjustment: db.BalanceAdjustment{
+						ID:         util.RandomInt(1, 1000),
+						AccountID:  account.ID,
+						Amount:     25,
+						Reason:     reason,
+						AdjustedBy: banker.Username,
+					},
+				}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "account.adjust_balance", arg.Action)
+						require.Equal(t, util.AuditTargetAccount, arg.TargetType)
+						require.Contains(t, string(arg.Diff), reason)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(125), res.GetAccount().GetBalance())
+				require.Equal(t, reason, res.GetAdjustment().GetReason())
+				require.Equal(t, banker.Username, res.GetAdjustment().GetAdjustedBy())
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: 25},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ZeroAmount",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: 0, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: -101, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AdjustBalanceTxResult{}, db.ErrInsufficientFunds)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "DepositorCannotAdjust",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: 25, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req:  &pb.AdminAdjustBalanceRequest{AccountId: account.ID, Amount: 25, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminAdjustBalanceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.AdjustBalance(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DepositCash(ctx context.Context, req *pb.AdminCashOperationRequest) (*pb.AdminCashOperationResponse, error) {
+	return server.cashOperation(ctx, req, util.CashDeposit)
+}
+
+func (server *Server) WithdrawCash(ctx context.Context, req *pb.AdminCashOperationRequest) (*pb.AdminCashOperationResponse, error) {
+	return server.cashOperation(ctx, req, util.CashWithdrawal)
+}
+
+// cashOperation moves cash handled at the counter into or out of a customer account
+// against the bank's cash clearing ledger account and returns the receipt.
+func (server *Server) cashOperation(ctx context.Context, req *pb.AdminCashOperationRequest, kind string) (*pb.AdminCashOperationResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminCashOperationRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the balance before the change is audited, so it is read from the primary
+	account, err := server.getAccount(db.WithReadYourWrites(ctx), req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	var result db.CashOperationTxResult
+	err = server.auditTx(ctx, authPayload, "cash."+kind, util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.CashOperationTx(ctx, db.CashOperationTxParams{
+			Kind:        kind,
+			AccountID:   account.ID,
+			Amount:      req.GetAmount(),
+			PerformedBy: authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]any{"balance": account.Balance}
+		after := map[string]any{
+			"balance":        result.Account.Balance,
+			"receipt_number": convertCashReceipt(result.Operation).ReceiptNumber,
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "account [%d] has insufficient funds", account.ID)
+		}
+
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "no clearing account for currency [%s]", account.Currency)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to post cash %s: %s", kind, err)
+	}
+
+	return &pb.AdminCashOperationResponse{Receipt: convertCashReceipt(result.Operation)}, nil
+}
+
+func validateAdminCashOperationRequest(req *pb.AdminCashOperationRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if req.GetAmount() <= 0 {
+		violations = append(violations, fieldViolation("amount", fmt.Errorf("must be a positive integer")))
+	}
+
+	return
+}
@@ -0,0 +1,231 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCashOperationAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	account := db.Account{
+		ID:       util.RandomInt(10, 1000),
+		Owner:    depositor.Username,
+		Balance:  100,
+		Currency: util.USD,
+		Number:   util.RandomAccountNumber(),
+	}
+
+	clearingAccountID := util.RandomInt(1, 1000)
+
+	createdAt := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		kind          string
+		req           *pb.AdminCashOperationRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminCashOperationResponse, err error)
+	}{
+		{
+			name: "Deposit",
+			kind: util.CashDeposit,
+			req:  &pb.AdminCashOperationRequest{AccountId: account.ID, Amount: 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CashOperationTxParams{
+					Kind:        util.CashDeposit,
+					AccountID:   account.ID,
+					Amount:      50,
+					PerformedBy: banker.Username,
+				}
+
+				result := db.CashOperationTxResult{
+					Operation: db.CashOperation{
+						ID:                42,
+						Kind:              util.CashDeposit,
+						AccountID:         account.ID,
+						ClearingAccountID: clearingAccountID,
+						Amount:            50,
+						Currency:          util.USD,
+						BalanceAfter:      150,
+						PerformedBy:       banker.Username,
+						CreatedAt:         createdAt,
+					},
+					Account: db.Account{ID: account.ID, Balance: 150},
+				}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "cash.deposit", arg.Action)
+						require.Equal(t, banker.Username, arg.Actor)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.NoError(t, err)
+				receipt := res.GetReceipt()
+				require.Equal(t, "DEP-20240131-000042", receipt.GetReceiptNumber())
+				require.Equal(t, int64(50), receipt.GetAmount())
+				require.Equal(t, int64(150), receipt.GetBalanceAfter())
+				require.Equal(t, banker.Username, receipt.GetPerformedBy())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			kind: util.CashWithdrawal,
+			req:  &pb.AdminCashOperationRequest{AccountId: account.ID, Amount: 500},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CashOperationTxResult{}, db.ErrInsufficientFunds)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ByAccountNumber",
+			kind: util.CashDeposit,
+			req:  &pb.AdminCashOperationRequest{AccountNumber: strings.ToLower(account.Number), Amount: 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Eq(account.Number)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CashOperationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CashOperationTxParams) (db.CashOperationTxResult, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						return db.CashOperationTxResult{Operation: db.CashOperation{AccountID: account.ID}}, nil
+					})
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetReceipt().GetAccountId())
+			},
+		},
+		{
+			name: "MistypedAccountNumber",
+			kind: util.CashDeposit,
+			req:  &pb.AdminCashOperationRequest{AccountNumber: account.Number[:2] + "00" + account.Number[4:], Amount: 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoClearingAccount",
+			kind: util.CashDeposit,
+			req:  &pb.AdminCashOperationRequest{AccountId: account.ID, Amount: 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CashOperationTxResult{}, db.ErrRecordNotFound)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NegativeAmount",
+			kind: util.CashWithdrawal,
+			req:  &pb.AdminCashOperationRequest{AccountId: account.ID, Amount: -50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorCannotDeposit",
+			kind: util.CashDeposit,
+			req:  &pb.AdminCashOperationRequest{AccountId: account.ID, Amount: 50},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CashOperationTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminCashOperationResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+
+			var res *pb.AdminCashOperationResponse
+			var err error
+			if tc.kind == util.CashDeposit {
+				res, err = server.DepositCash(ctx, tc.req)
+			} else {
+				res, err = server.WithdrawCash(ctx, tc.req)
+			}
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/fraud"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ApproveTransferApproval(ctx context.Context, req *pb.AdminDecideTransferApprovalRequest) (*pb.AdminDecideTransferApprovalResponse, error) {
+	return server.decideTransferApproval(ctx, req, true)
+}
+
+func (server *Server) RejectTransferApproval(ctx context.Context, req *pb.AdminDecideTransferApprovalRequest) (*pb.AdminDecideTransferApprovalResponse, error) {
+	return server.decideTransferApproval(ctx, req, false)
+}
+
+// decideTransferApproval lets a banker other than the initiator approve or reject a transfer above the approval threshold.
+// An approved transfer is screened by the fraud rules and executed right away, unless the rules hold or deny it.
+func (server *Server) decideTransferApproval(ctx context.Context, req *pb.AdminDecideTransferApprovalRequest, approve bool) (*pb.AdminDecideTransferApprovalResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminDecideTransferApprovalRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.DecideTransferApprovalTxParams{
+		ApprovalID: req.GetId(),
+		DecidedBy:  authPayload.Username,
+		Approve:    approve,
+	}
+
+	if approve {
+		approval, err := server.store.GetTransferApproval(ctx, req.GetId())
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "transfer approval [%d] does not exist", req.GetId())
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to get transfer approval: %s", err)
+		}
+
+		// the verdict may have changed since the approval was requested, so the transfer is screened
+		// again when it's executed and a review verdict holds it instead of completing it
+		arg.Screen = server.fraudEngine.Screen(fraud.Transfer{
+			Username:      approval.InitiatedBy,
+			FromAccountID: approval.FromAccountID,
+			ToAccountID:   approval.ToAccountID,
+			Amount:        approval.Amount,
+		})
+	}
+
+	action := "transfer_approval.reject"
+	if approve {
+		action = "transfer_approval.approve"
+	}
+
+	var result db.DecideTransferApprovalTxResult
+	err = server.auditTx(ctx, authPayload, action, util.AuditTargetApproval, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.DecideTransferApprovalTx(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]string{"status": util.TransferApprovalPending}
+		after := map[string]string{"status": result.Approval.Status}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Approval.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			return nil, status.Errorf(codes.NotFound, "transfer approval [%d] does not exist", req.GetId())
+		case errors.Is(err, db.ErrTransferApprovalNotPending), errors.Is(err, db.ErrTransferApprovalExpired),
+			errors.Is(err, db.ErrInitiatorCannotSpend), errors.Is(err, db.ErrInsufficientFunds):
+			return nil, status.Errorf(codes.FailedPrecondition, "transfer approval [%d]: %s", req.GetId(), err)
+		case errors.Is(err, db.ErrSelfApproval):
+			return nil, status.Errorf(codes.PermissionDenied, "transfer approval [%d]: %s", req.GetId(), err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to decide transfer approval: %s", err)
+	}
+
+	res := &pb.AdminDecideTransferApprovalResponse{Approval: convertTransferApproval(result.Approval)}
+	if approve {
+		res.Transfer = convertTransfer(result.Transfer.Transfer)
+	}
+
+	return res, nil
+}
+
+func validateAdminDecideTransferApprovalRequest(req *pb.AdminDecideTransferApprovalRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
@@ -0,0 +1,242 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecideTransferApproval(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	approval := db.TransferApproval{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1001, 2000),
+		Amount:        util.RandomMoney(),
+		Currency:      util.USD,
+		InitiatedBy:   depositor.Username,
+		ExpiresAt:     time.Now().Add(time.Hour),
+		CreatedAt:     time.Now(),
+	}
+
+	requireCode := func(t *testing.T, err error, code codes.Code) {
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, code, st.Code())
+	}
+
+	testCases := []struct {
+		name          string
+		approve       bool
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error)
+	}{
+		{
+			name:    "Approve",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.DecideTransferApprovalTxParams{ApprovalID: approval.ID, DecidedBy: banker.Username, Approve: true}
+				transfer := db.Transfer{
+					ID:            util.RandomInt(1, 1000),
+					FromAccountID: approval.FromAccountID,
+					ToAccountID:   approval.ToAccountID,
+					Amount:        approval.Amount,
+					Status:        util.TransferCompleted,
+				}
+				approved := approval
+				approved.Status = util.TransferApprovalApproved
+				approved.DecidedBy = pgtype.Text{String: banker.Username, Valid: true}
+				approved.DecidedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+				approved.TransferID = pgtype.Int8{Int64: transfer.ID, Valid: true}
+
+				store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(approval, nil)
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, got db.DecideTransferApprovalTxParams) (db.DecideTransferApprovalTxResult, error) {
+						// the approved transfer is screened again when it's executed
+						require.NotNil(t, got.Screen)
+						got.Screen = nil
+						require.Equal(t, arg, got)
+						return db.DecideTransferApprovalTxResult{Approval: approved, Transfer: db.TransferTxResult{Transfer: transfer}}, nil
+					})
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "transfer_approval.approve", arg.Action)
+						require.Equal(t, util.AuditTargetApproval, arg.TargetType)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.TransferApprovalApproved, res.GetApproval().GetStatus())
+				require.Equal(t, banker.Username, res.GetApproval().GetDecidedBy())
+				require.Equal(t, res.GetApproval().GetTransferId(), res.GetTransfer().GetId())
+				require.Equal(t, util.TransferCompleted, res.GetTransfer().GetStatus())
+			},
+		},
+		{
+			name:    "Reject",
+			approve: false,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.DecideTransferApprovalTxParams{ApprovalID: approval.ID, DecidedBy: banker.Username, Approve: false}
+				rejected := approval
+				rejected.Status = util.TransferApprovalRejected
+
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.DecideTransferApprovalTxResult{Approval: rejected}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.TransferApprovalRejected, res.GetApproval().GetStatus())
+				require.Nil(t, res.GetTransfer())
+			},
+		},
+		{
+			name:    "SelfApproval",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(approval, nil)
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DecideTransferApprovalTxResult{}, db.ErrSelfApproval)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name:    "Expired",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(approval, nil)
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DecideTransferApprovalTxResult{}, db.ErrTransferApprovalExpired)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:    "InitiatorCannotSpend",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(approval, nil)
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DecideTransferApprovalTxResult{}, db.ErrInitiatorCannotSpend)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:    "NotPending",
+			approve: false,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DecideTransferApprovalTxResult{}, db.ErrTransferApprovalNotPending)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:    "NotFound",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(db.TransferApproval{}, db.ErrRecordNotFound)
+				store.EXPECT().DecideTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name:    "DepositorCannotDecide",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DecideTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDecideTransferApprovalResponse, err error) {
+				requireCode(t, err, codes.PermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			req := &pb.AdminDecideTransferApprovalRequest{Id: approval.ID}
+
+			var res *pb.AdminDecideTransferApprovalResponse
+			var err error
+			if tc.approve {
+				res, err = server.ApproveTransferApproval(ctx, req)
+			} else {
+				res, err = server.RejectTransferApproval(ctx, req)
+			}
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/loan"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxLoanAnnualRateBps = 10000
+	maxLoanTermMonths    = 360
+)
+
+// DisburseLoan credits a loan to a customer account and schedules its monthly installments,
+// which the loan collector takes from the account on their due dates.
+func (server *Server) DisburseLoan(ctx context.Context, req *pb.AdminDisburseLoanRequest) (*pb.AdminDisburseLoanResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminDisburseLoanRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := loan.Schedule(req.GetPrincipal(), req.GetAnnualRateBps(), req.GetTermMonths(), req.GetMethod(), time.Now().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build loan schedule: %s", err)
+	}
+
+	var result db.DisburseLoanTxResult
+	err = server.auditTx(ctx, authPayload, "loan.disburse", util.AuditTargetLoan, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.DisburseLoanTx(ctx, db.DisburseLoanTxParams{
+			AccountID:     account.ID,
+			Principal:     req.GetPrincipal(),
+			AnnualRateBps: req.GetAnnualRateBps(),
+			TermMonths:    req.GetTermMonths(),
+			Method:        req.GetMethod(),
+			DisbursedBy:   authPayload.Username,
+			Schedule:      schedule,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		after := map[string]any{
+			"account_id":      account.ID,
+			"principal":       result.Loan.Principal,
+			"annual_rate_bps": result.Loan.AnnualRateBps,
+			"term_months":     result.Loan.TermMonths,
+			"method":          result.Loan.Method,
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Loan.ID, 10), after: after}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to disburse loan: %s", err)
+	}
+
+	res := &pb.AdminDisburseLoanResponse{
+		Loan:         convertLoan(result.Loan),
+		Installments: make([]*pb.LoanInstallment, len(result.Installments)),
+		Account:      convertAccount(result.Account),
+	}
+
+	for i, installment := range result.Installments {
+		res.Installments[i] = convertLoanInstallment(installment)
+	}
+
+	return res, nil
+}
+
+func validateAdminDisburseLoanRequest(req *pb.AdminDisburseLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if req.GetPrincipal() <= 0 {
+		violations = append(violations, fieldViolation("principal", fmt.Errorf("must be a positive integer")))
+	}
+
+	if req.GetAnnualRateBps() < 0 || req.GetAnnualRateBps() > maxLoanAnnualRateBps {
+		violations = append(violations, fieldViolation("annual_rate_bps", fmt.Errorf("must be between 0 and %d", maxLoanAnnualRateBps)))
+	}
+
+	if req.GetTermMonths() < 1 || req.GetTermMonths() > maxLoanTermMonths {
+		violations = append(violations, fieldViolation("term_months", fmt.Errorf("must be between 1 and %d", maxLoanTermMonths)))
+	}
+
+	if !util.IsSupportedLoanMethod(req.GetMethod()) {
+		violations = append(violations, fieldViolation("method", fmt.Errorf("must be %s or %s", util.LoanAnnuity, util.LoanLinear)))
+	}
+
+	return
+}
@@ -0,0 +1,198 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisburseLoanAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    depositor.Username,
+		Balance:  100,
+		Currency: util.USD,
+	}
+
+	validRequest := func() *pb.AdminDisburseLoanRequest {
+		return &pb.AdminDisburseLoanRequest{
+			AccountId:     account.ID,
+			Principal:     1200,
+			AnnualRateBps: 600,
+			TermMonths:    12,
+			Method:        util.LoanLinear,
+		}
+	}
+
+	bankerAuth := func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+	}
+
+	requireCode := func(t *testing.T, err error, code codes.Code) {
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, code, st.Code())
+	}
+
+	testCases := []struct {
+		name          string
+		req           func() *pb.AdminDisburseLoanRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					DisburseLoanTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.DisburseLoanTxParams) (db.DisburseLoanTxResult, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, banker.Username, arg.DisbursedBy)
+						require.Len(t, arg.Schedule, 12)
+
+						credited := account
+						credited.Balance += arg.Principal
+
+						result := db.DisburseLoanTxResult{
+							Loan: db.Loan{
+								ID:                   util.RandomInt(1, 1000),
+								AccountID:            account.ID,
+								Principal:            arg.Principal,
+								Currency:             account.Currency,
+								AnnualRateBps:        arg.AnnualRateBps,
+								TermMonths:           arg.TermMonths,
+								Method:               arg.Method,
+								Status:               util.LoanActive,
+								OutstandingPrincipal: arg.Principal,
+								DisbursedBy:          arg.DisbursedBy,
+							},
+							Account: credited,
+						}
+
+						for _, installment := range arg.Schedule {
+							result.Loan.OutstandingInterest += installment.Interest
+							result.Installments = append(result.Installments, db.LoanInstallment{
+								LoanID:    result.Loan.ID,
+								Seq:       installment.Seq,
+								Principal: installment.Principal,
+								Interest:  installment.Interest,
+								Status:    util.InstallmentScheduled,
+							})
+						}
+
+						return result, nil
+					})
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "loan.disburse", arg.Action)
+						require.Equal(t, util.AuditTargetLoan, arg.TargetType)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1300), res.GetAccount().GetBalance())
+				require.Equal(t, int64(1200), res.GetLoan().GetOutstandingPrincipal())
+				require.Equal(t, int64(42), res.GetLoan().GetOutstandingInterest())
+				require.Len(t, res.GetInstallments(), 12)
+				require.Equal(t, int64(100), res.GetInstallments()[0].GetPrincipal())
+				require.Equal(t, int64(6), res.GetInstallments()[0].GetInterest())
+			},
+		},
+		{
+			name: "UnsupportedMethod",
+			req: func() *pb.AdminDisburseLoanRequest {
+				req := validRequest()
+				req.Method = "balloon"
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DisburseLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error) {
+				requireCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidTerms",
+			req: func() *pb.AdminDisburseLoanRequest {
+				req := validRequest()
+				req.Principal = 0
+				req.AnnualRateBps = -1
+				req.TermMonths = maxLoanTermMonths + 1
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DisburseLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error) {
+				requireCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "DepositorCannotDisburse",
+			req:  validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DisburseLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error) {
+				requireCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req:  validRequest,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().DisburseLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminDisburseLoanResponse, err error) {
+				requireCode(t, err, codes.NotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.DisburseLoan(ctx, tc.req())
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
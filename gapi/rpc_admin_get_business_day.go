@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetBusinessDay(ctx context.Context, req *pb.AdminGetBusinessDayRequest) (*pb.AdminGetBusinessDayResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminGetBusinessDayRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	date, _ := time.Parse(util.BusinessDateLayout, req.GetBusinessDate())
+	businessDate := db.BusinessDate(date)
+
+	day, err := server.store.GetBusinessDay(ctx, businessDate)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "business day %s is not closed", req.GetBusinessDate())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get business day: %s", err)
+	}
+
+	totals, err := server.store.ListDailyTotals(ctx, businessDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list daily totals: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "admin.get_business_day", util.AuditTargetLedger, req.GetBusinessDate(), nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	return &pb.AdminGetBusinessDayResponse{BusinessDay: convertBusinessDay(day, totals)}, nil
+}
+
+func validateAdminGetBusinessDayRequest(req *pb.AdminGetBusinessDayRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateBusinessDate(req.GetBusinessDate()); err != nil {
+		violations = append(violations, fieldViolation("business_date", err))
+	}
+
+	return
+}
@@ -0,0 +1,123 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBusinessDayAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	businessDate := db.BusinessDate(time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC))
+	day := db.BusinessDay{BusinessDate: businessDate, ClosedAt: time.Now()}
+	totals := []db.DailyTotal{
+		{BusinessDate: businessDate, Currency: util.EUR, ClosingBalance: 100},
+		{BusinessDate: businessDate, Currency: util.USD, TotalDebits: 40, TotalCredits: 40, EntryCount: 2, ClosingBalance: 520},
+	}
+
+	testCases := []struct {
+		name          string
+		businessDate  string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminGetBusinessDayResponse, err error)
+	}{
+		{
+			name:         "OK",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBusinessDay(gomock.Any(), gomock.Eq(businessDate)).Times(1).Return(day, nil)
+				store.EXPECT().ListDailyTotals(gomock.Any(), gomock.Eq(businessDate)).Times(1).Return(totals, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminGetBusinessDayResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "2024-03-09", res.GetBusinessDay().GetBusinessDate())
+				require.Len(t, res.GetBusinessDay().GetTotals(), 2)
+
+				usd := res.GetBusinessDay().GetTotals()[1]
+				require.Equal(t, util.USD, usd.GetCurrency())
+				require.Equal(t, int64(40), usd.GetTotalDebits())
+				require.Equal(t, int64(2), usd.GetEntryCount())
+				require.Equal(t, int64(520), usd.GetClosingBalance())
+			},
+		},
+		{
+			name:         "NotClosed",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBusinessDay(gomock.Any(), gomock.Any()).Times(1).Return(db.BusinessDay{}, db.ErrRecordNotFound)
+				store.EXPECT().ListDailyTotals(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminGetBusinessDayResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name:         "InvalidDate",
+			businessDate: "2024-02-30",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBusinessDay(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminGetBusinessDayResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:         "DepositorCannotView",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBusinessDay(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminGetBusinessDayResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+
+			res, err := server.GetBusinessDay(ctx, &pb.AdminGetBusinessDayRequest{BusinessDate: tc.businessDate})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetCashReceipt(ctx context.Context, req *pb.AdminGetCashReceiptRequest) (*pb.AdminGetCashReceiptResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminGetCashReceiptRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	operation, err := server.store.GetCashOperation(ctx, req.GetId())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "cash operation [%d] does not exist", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get cash operation: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "cash.view_receipt", util.AuditTargetAccount, strconv.FormatInt(operation.AccountID, 10), nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	return &pb.AdminGetCashReceiptResponse{Receipt: convertCashReceipt(operation)}, nil
+}
+
+func validateAdminGetCashReceiptRequest(req *pb.AdminGetCashReceiptRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLoan returns a loan with its outstanding principal and interest and its schedule.
+func (server *Server) GetLoan(ctx context.Context, req *pb.AdminGetLoanRequest) (*pb.AdminGetLoanResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)})
+	}
+
+	loan, err := server.store.GetLoan(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "loan [%d] does not exist", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get loan: %s", err)
+	}
+
+	installments, err := server.store.ListLoanInstallments(ctx, loan.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list loan installments: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "loan.view", util.AuditTargetLoan, strconv.FormatInt(loan.ID, 10), nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminGetLoanResponse{
+		Loan:         convertLoan(loan),
+		Installments: make([]*pb.LoanInstallment, len(installments)),
+	}
+
+	for i, installment := range installments {
+		res.Installments[i] = convertLoanInstallment(installment)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTrialBalance(ctx context.Context, req *pb.AdminGetTrialBalanceRequest) (*pb.AdminGetTrialBalanceResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountTotals, err := server.store.GetAccountBalanceTotals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account balances: %s", err)
+	}
+
+	ledgerAccounts, err := server.store.ListLedgerAccounts(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list ledger accounts: %s", err)
+	}
+
+	postingTotals, err := server.store.GetPostingTotals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get posting totals: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "admin.get_trial_balance", util.AuditTargetLedger, "", nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	return &pb.AdminGetTrialBalanceResponse{
+		Balances: buildTrialBalances(accountTotals, ledgerAccounts, postingTotals),
+	}, nil
+}
+
+// buildTrialBalances lists the balance of every account on its debit or credit side, per currency.
+// Customer accounts are summed up into a single customer deposits line, since the bank owes their balances.
+// The books are balanced when both the balances and the posted entries have equal debits and credits.
+func buildTrialBalances(
+	accountTotals []db.GetAccountBalanceTotalsRow,
+	ledgerAccounts []db.LedgerAccount,
+	postingTotals []db.GetPostingTotalsRow,
+) []*pb.TrialBalance {
+	balances := make(map[string]*pb.TrialBalance)
+	get := func(currency string) *pb.TrialBalance {
+		balance, ok := balances[currency]
+		if !ok {
+			balance = &pb.TrialBalance{Currency: currency}
+			balances[currency] = balance
+		}
+
+		return balance
+	}
+
+	addLine := func(currency, code, name, accountType string, amount int64) {
+		balance := get(currency)
+		line := &pb.TrialBalanceLine{Code: code, Name: name, Type: accountType}
+		if amount < 0 {
+			line.Debit = -amount
+		} else {
+			line.Credit = amount
+		}
+
+		balance.Lines = append(balance.Lines, line)
+		balance.TotalDebits += line.Debit
+		balance.TotalCredits += line.Credit
+	}
+
+	for _, totals := range accountTotals {
+		addLine(totals.Currency, util.LedgerCodeCustomerDeposits, "Customer deposits", util.LedgerLiability, totals.CustomerBalance)
+	}
+
+	for _, account := range ledgerAccounts {
+		addLine(account.Currency, account.Code, account.Name, account.Type, account.Balance)
+	}
+
+	for _, totals := range postingTotals {
+		balance := get(totals.Currency)
+		balance.PostedDebits = totals.TotalDebits
+		balance.PostedCredits = totals.TotalCredits
+	}
+
+	res := make([]*pb.TrialBalance, 0, len(balances))
+	for _, balance := range balances {
+		balance.Balanced = balance.TotalDebits == balance.TotalCredits && balance.PostedDebits == balance.PostedCredits
+		res = append(res, balance)
+	}
+
+	slices.SortFunc(res, func(a, b *pb.TrialBalance) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+
+	return res
+}
@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTrialBalanceAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	// 500 USD cash was deposited and a customer was credited 20 USD by a manual adjustment
+	accountTotals := []db.GetAccountBalanceTotalsRow{
+		{Currency: util.USD, CustomerBalance: 520},
+		{Currency: util.EUR, CustomerBalance: 0},
+	}
+
+	ledgerAccounts := []db.LedgerAccount{
+		{Code: util.LedgerCodeCashClearing, Name: "Cash clearing", Type: util.LedgerAsset, Currency: util.USD, Balance: -500},
+		{Code: util.LedgerCodeFeeIncome, Name: "Fee income", Type: util.LedgerRevenue, Currency: util.USD},
+		{Code: util.LedgerCodeManualAdjustments, Name: "Manual adjustments", Type: util.LedgerExpense, Currency: util.USD, Balance: -20},
+	}
+
+	postingTotals := []db.GetPostingTotalsRow{
+		{Currency: util.USD, TotalDebits: 540, TotalCredits: 540},
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		store.EXPECT().GetAccountBalanceTotals(gomock.Any()).Times(1).Return(accountTotals, nil)
+		store.EXPECT().ListLedgerAccounts(gomock.Any()).Times(1).Return(ledgerAccounts, nil)
+		store.EXPECT().GetPostingTotals(gomock.Any()).Times(1).Return(postingTotals, nil)
+		store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+
+		server := newTestServer(t, store, nil)
+		ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+
+		res, err := server.GetTrialBalance(ctx, &pb.AdminGetTrialBalanceRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.GetBalances(), 2)
+
+		eur := res.GetBalances()[0]
+		require.Equal(t, util.EUR, eur.GetCurrency())
+		require.True(t, eur.GetBalanced())
+
+		usd := res.GetBalances()[1]
+		require.Equal(t, util.USD, usd.GetCurrency())
+		require.Len(t, usd.GetLines(), 4)
+		require.Equal(t, int64(520), usd.GetTotalDebits())
+		require.Equal(t, int64(520), usd.GetTotalCredits())
+		require.Equal(t, int64(540), usd.GetPostedDebits())
+		require.True(t, usd.GetBalanced())
+	})
+
+	t.Run("Unbalanced", func(t *testing.T) {
+		ledgerAccounts := []db.LedgerAccount{
+			{Code: util.LedgerCodeCashClearing, Name: "Cash clearing", Type: util.LedgerAsset, Currency: util.USD, Balance: -500},
+			{Code: util.LedgerCodeManualAdjustments, Name: "Manual adjustments", Type: util.LedgerExpense, Currency: util.USD},
+		}
+
+		balances := buildTrialBalances(accountTotals, ledgerAccounts, postingTotals)
+		require.Len(t, balances, 2)
+		require.Equal(t, util.USD, balances[1].GetCurrency())
+		require.Equal(t, int64(500), balances[1].GetTotalDebits())
+		require.Equal(t, int64(520), balances[1].GetTotalCredits())
+		require.False(t, balances[1].GetBalanced())
+	})
+
+	t.Run("DepositorCannotView", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		store.EXPECT().GetAccountBalanceTotals(gomock.Any()).Times(0)
+
+		server := newTestServer(t, store, nil)
+		ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+
+		_, err := server.GetTrialBalance(ctx, &pb.AdminGetTrialBalanceRequest{})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
@@ -0,0 +1,170 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/reconcile"
+	"github.com/Drolfothesgnir/simplebank/statement"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImportStatement stores the statements of external accounts and matches their lines against
+// the ledger entries of the cash clearing accounts. Statements that were already imported are skipped,
+// so a file can be uploaded again after a partial failure.
+func (server *Server) ImportStatement(ctx context.Context, req *pb.AdminImportStatementRequest) (*pb.AdminImportStatementResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminImportStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var statements []statement.Statement
+	switch req.GetFormat() {
+	case util.StatementFormatCamt053:
+		statements, err = statement.ParseCamt053(bytes.NewReader(req.GetContent()))
+	case util.StatementFormatMT940:
+		statements, err = statement.ParseMT940(bytes.NewReader(req.GetContent()))
+	}
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("content", err)})
+	}
+
+	for _, stmt := range statements {
+		if !util.IsSupportedCurrency(stmt.Currency) {
+			err := fmt.Errorf("statement %s is in unsupported currency %q", stmt.ID, stmt.Currency)
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("content", err)})
+		}
+	}
+
+	res := &pb.AdminImportStatementResponse{}
+	for _, stmt := range statements {
+		var result db.ImportStatementTxResult
+		err = server.auditTx(ctx, authPayload, "reconciliation.import_statement", util.AuditTargetStatement, func(store db.Store) (auditedChange, error) {
+			var err error
+			result, err = store.ImportStatementTx(ctx, newImportStatementTxParams(req.GetFormat(), stmt, authPayload.Username))
+			if err != nil {
+				return auditedChange{}, err
+			}
+
+			matched := 0
+			for _, line := range result.Lines {
+				if line.Status == util.StatementLineMatched {
+					matched++
+				}
+			}
+
+			after := map[string]any{
+				"reference":        result.Statement.Reference,
+				"external_account": result.Statement.ExternalAccount,
+				"lines":            len(result.Lines),
+				"matched":          matched,
+			}
+
+			return auditedChange{targetID: strconv.FormatInt(result.Statement.ID, 10), after: after}, nil
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrStatementAlreadyImported) {
+				res.Skipped = append(res.Skipped, stmt.ID)
+				continue
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to import statement %s: %s", stmt.ID, err)
+		}
+
+		res.Statements = append(res.Statements, convertExternalStatement(result.Statement, result.Lines))
+	}
+
+	return res, nil
+}
+
+func newImportStatementTxParams(format string, stmt statement.Statement, importedBy string) db.ImportStatementTxParams {
+	arg := db.ImportStatementTxParams{
+		Format:          format,
+		Reference:       stmt.ID,
+		ExternalAccount: stmt.Account.Account,
+		Currency:        stmt.Currency,
+		FromDate:        stmt.From,
+		ToDate:          stmt.To,
+		OpeningBalance:  stmt.OpeningBalance,
+		ClosingBalance:  stmt.ClosingBalance,
+		ImportedBy:      importedBy,
+		MatchWindow:     reconcile.DateTolerance,
+		Match:           matchStatementLines,
+	}
+
+	for _, entry := range stmt.Entries {
+		arg.Lines = append(arg.Lines, db.ImportStatementLine{
+			BookingDate:  entry.BookingDate,
+			ValueDate:    entry.ValueDate,
+			Amount:       entry.Amount,
+			Reference:    entry.Reference,
+			EndToEndID:   entry.EndToEndID,
+			Counterparty: entry.Counterparty.Name,
+			Description:  entry.Description,
+		})
+	}
+
+	return arg
+}
+
+// matchStatementLines matches the lines by the references the bank gives its entries:
+// the reference of the customer entry opposite the clearing entry and the cash receipt number.
+func matchStatementLines(lines []db.ExternalStatementLine, entries []db.ListReconciliationCandidatesRow) map[int64]int64 {
+	reconcileLines := make([]reconcile.Line, len(lines))
+	for i, line := range lines {
+		reconcileLines[i] = reconcile.Line{
+			ID:          line.ID,
+			Amount:      line.Amount,
+			BookingDate: line.BookingDate.Time,
+			References:  []string{line.Reference, line.EndToEndID, line.Description},
+		}
+	}
+
+	reconcileEntries := make([]reconcile.Entry, len(entries))
+	for i, entry := range entries {
+		var references []string
+		if entry.EntryID.Valid {
+			references = append(references, statement.EntryReference(entry.EntryID.Int64))
+		}
+
+		if entry.CashOperationID != 0 {
+			references = append(references, util.CashReceiptNumber(entry.CashOperationKind, entry.CashOperationID, entry.CreatedAt))
+		}
+
+		reconcileEntries[i] = reconcile.Entry{
+			ID:           entry.ID,
+			Amount:       entry.Amount,
+			BusinessDate: entry.BusinessDate.Time,
+			References:   references,
+		}
+	}
+
+	return reconcile.Match(reconcileLines, reconcileEntries)
+}
+
+func validateAdminImportStatementRequest(req *pb.AdminImportStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.IsSupportedStatementFormat(req.GetFormat()) {
+		violations = append(violations, fieldViolation("format", fmt.Errorf("must be %s or %s", util.StatementFormatCamt053, util.StatementFormatMT940)))
+	}
+
+	if err := val.ValidateStatementContent(req.GetContent()); err != nil {
+		violations = append(violations, fieldViolation("content", err))
+	}
+
+	return
+}
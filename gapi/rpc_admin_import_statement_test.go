@@ -0,0 +1,190 @@
+package gapi
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportStatementAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	content, err := os.ReadFile("../statement/testdata/statement.mt940")
+	require.NoError(t, err)
+
+	importStatement := func(_ context.Context, arg db.ImportStatementTxParams) (db.ImportStatementTxResult, error) {
+		result := db.ImportStatementTxResult{
+			Statement: db.ExternalStatement{
+				ID:              1,
+				Format:          arg.Format,
+				Reference:       arg.Reference,
+				ExternalAccount: arg.ExternalAccount,
+				Currency:        arg.Currency,
+				OpeningBalance:  arg.OpeningBalance,
+				ClosingBalance:  arg.ClosingBalance,
+				ImportedBy:      arg.ImportedBy,
+			},
+		}
+
+		for i, line := range arg.Lines {
+			result.Lines = append(result.Lines, db.ExternalStatementLine{
+				ID:          int64(i + 1),
+				StatementID: 1,
+				Amount:      line.Amount,
+				Reference:   line.Reference,
+				Status:      util.StatementLineUnmatched,
+			})
+		}
+		result.Lines[0].Status = util.StatementLineMatched
+		result.Lines[0].EntryID = pgtype.Int8{Int64: 42, Valid: true}
+
+		return result, nil
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdminImportStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminImportStatementResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.AdminImportStatementRequest{Format: util.StatementFormatMT940, Content: content},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStatementTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(ctx context.Context, arg db.ImportStatementTxParams) (db.ImportStatementTxResult, error) {
+						require.Equal(t, util.EUR, arg.Currency)
+						require.Equal(t, banker.Username, arg.ImportedBy)
+						require.Len(t, arg.Lines, 2)
+						require.NotNil(t, arg.Match)
+						return importStatement(ctx, arg)
+					})
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminImportStatementResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetSkipped())
+				require.Len(t, res.GetStatements(), 1)
+
+				stmt := res.GetStatements()[0]
+				require.Equal(t, "DE89370400440532013000", stmt.GetExternalAccount())
+				require.Len(t, stmt.GetLines(), 2)
+				require.Equal(t, util.StatementLineMatched, stmt.GetLines()[0].GetStatus())
+				require.Equal(t, int64(42), stmt.GetLines()[0].GetEntryId())
+				require.Equal(t, util.StatementLineUnmatched, stmt.GetLines()[1].GetStatus())
+			},
+		},
+		{
+			name: "AlreadyImported",
+			req:  &pb.AdminImportStatementRequest{Format: util.StatementFormatMT940, Content: content},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStatementTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ImportStatementTxResult{}, db.ErrStatementAlreadyImported)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminImportStatementResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetStatements())
+				require.Len(t, res.GetSkipped(), 1)
+			},
+		},
+		{
+			name: "UnsupportedFormat",
+			req:  &pb.AdminImportStatementRequest{Format: "bai2", Content: content},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminImportStatementResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MalformedContent",
+			req:  &pb.AdminImportStatementRequest{Format: util.StatementFormatCamt053, Content: content},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminImportStatementResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorCannotImport",
+			req:  &pb.AdminImportStatementRequest{Format: util.StatementFormatMT940, Content: content},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStatementTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminImportStatementResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+
+			res, err := server.ImportStatement(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestMatchStatementLines(t *testing.T) {
+	day := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+	createdAt := day.Add(10 * time.Hour)
+
+	lines := []db.ExternalStatementLine{
+		{ID: 1, Amount: 50000, BookingDate: db.BusinessDate(day), Description: "EREF+" + util.CashReceiptNumber(util.CashDeposit, 42, createdAt)},
+		{ID: 2, Amount: -25050, BookingDate: db.BusinessDate(day), Reference: "E170"},
+		{ID: 3, Amount: 999, BookingDate: db.BusinessDate(day)},
+	}
+	entries := []db.ListReconciliationCandidatesRow{
+		{ID: 17, EntryID: pgtype.Int8{Int64: 170, Valid: true}, Amount: 25050, CreatedAt: createdAt, BusinessDate: db.BusinessDate(day)},
+		{ID: 18, Amount: -50000, CreatedAt: createdAt, BusinessDate: db.BusinessDate(day), CashOperationID: 42, CashOperationKind: util.CashDeposit},
+	}
+
+	matches := matchStatementLines(lines, entries)
+	require.Equal(t, map[int64]int64{1: 18, 2: 17}, matches)
+}
@@ -0,0 +1,100 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateLedgerAccount(ctx context.Context, req *pb.AdminCreateLedgerAccountRequest) (*pb.AdminCreateLedgerAccountResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminCreateLedgerAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var account db.LedgerAccount
+	err = server.auditTx(ctx, authPayload, "ledger_account.create", util.AuditTargetLedgerAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		account, err = store.CreateLedgerAccount(ctx, db.CreateLedgerAccountParams{
+			Code:     req.GetCode(),
+			Name:     req.GetName(),
+			Type:     req.GetType(),
+			Currency: req.GetCurrency(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: fmt.Sprintf("%s/%s", account.Code, account.Currency), after: account}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "ledger account [%s] already exists in [%s]", req.GetCode(), req.GetCurrency())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create ledger account: %s", err)
+	}
+
+	return &pb.AdminCreateLedgerAccountResponse{Account: convertLedgerAccount(account)}, nil
+}
+
+func (server *Server) ListLedgerAccounts(ctx context.Context, req *pb.AdminListLedgerAccountsRequest) (*pb.AdminListLedgerAccountsResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := server.store.ListLedgerAccounts(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list ledger accounts: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "admin.list_ledger_accounts", util.AuditTargetLedgerAccount, "", nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminListLedgerAccountsResponse{}
+	for _, account := range accounts {
+		res.Accounts = append(res.Accounts, convertLedgerAccount(account))
+	}
+
+	return res, nil
+}
+
+func validateAdminCreateLedgerAccountRequest(req *pb.AdminCreateLedgerAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateLedgerCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	} else if req.GetCode() == util.LedgerCodeCustomerDeposits {
+		violations = append(violations, fieldViolation("code", fmt.Errorf("is reserved")))
+	}
+
+	if err := val.ValidateLedgerAccountName(req.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if !util.IsSupportedLedgerAccountType(req.GetType()) {
+		violations = append(violations, fieldViolation("type", fmt.Errorf("unsupported ledger account type")))
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		violations = append(violations, fieldViolation("currency", fmt.Errorf("unsupported currency")))
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListDisputes lists the disputes in a status, oldest first, so that bankers work the queue in order.
+func (server *Server) ListDisputes(ctx context.Context, req *pb.AdminListDisputesRequest) (*pb.AdminListDisputesResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminListDisputesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	disputes, err := server.store.ListDisputesByStatus(ctx, db.ListDisputesByStatusParams{
+		Status: req.GetStatus(),
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list disputes: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "dispute.list", util.AuditTargetDispute, "", nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminListDisputesResponse{}
+	for _, dispute := range disputes {
+		res.Disputes = append(res.Disputes, convertDispute(dispute))
+	}
+
+	return res, nil
+}
+
+func validateAdminListDisputesRequest(req *pb.AdminListDisputesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.IsSupportedDisputeStatus(req.GetStatus()) {
+		violations = append(violations, fieldViolation("status", fmt.Errorf("unsupported dispute status")))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
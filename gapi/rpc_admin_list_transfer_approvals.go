@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTransferApprovals lists the transfers waiting for a banker decision, oldest first.
+func (server *Server) ListTransferApprovals(ctx context.Context, req *pb.AdminListTransferApprovalsRequest) (*pb.AdminListTransferApprovalsResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminListTransferApprovalsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	approvals, err := server.store.ListPendingTransferApprovals(ctx, db.ListPendingTransferApprovalsParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfer approvals: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "transfer_approval.list", util.AuditTargetApproval, "", nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminListTransferApprovalsResponse{}
+	for _, approval := range approvals {
+		res.Approvals = append(res.Approvals, convertTransferApproval(approval))
+	}
+
+	return res, nil
+}
+
+func validateAdminListTransferApprovalsRequest(req *pb.AdminListTransferApprovalsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
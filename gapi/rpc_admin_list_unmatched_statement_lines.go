@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListUnmatchedStatementLines lists the queue of statement lines waiting for a banker, oldest first.
+func (server *Server) ListUnmatchedStatementLines(ctx context.Context, req *pb.AdminListUnmatchedStatementLinesRequest) (*pb.AdminListUnmatchedStatementLinesResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminListUnmatchedStatementLinesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	lines, err := server.store.ListExternalStatementLinesByStatus(ctx, db.ListExternalStatementLinesByStatusParams{
+		Status: util.StatementLineUnmatched,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list statement lines: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "reconciliation.list_unmatched_lines", util.AuditTargetStatementLine, "", nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminListUnmatchedStatementLinesResponse{}
+	for _, line := range lines {
+		res.Lines = append(res.Lines, convertExternalStatementLine(line))
+	}
+
+	return res, nil
+}
+
+func validateAdminListUnmatchedStatementLinesRequest(req *pb.AdminListUnmatchedStatementLinesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListUsers(ctx context.Context, req *pb.AdminListUsersRequest) (*pb.AdminListUsersResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminListUsersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListUsersParams{
+		Search: pgtype.Text{
+			String: req.GetQuery(),
+			Valid:  req.Query != nil,
+		},
+		Role: pgtype.Text{
+			String: req.GetRole(),
+			Valid:  req.Role != nil,
+		},
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	users, err := server.store.ListUsers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %s", err)
+	}
+
+	err = server.recordAudit(ctx, authPayload, "admin.list_users", util.AuditTargetUser, "", nil, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	res := &pb.AdminListUsersResponse{}
+	for _, user := range users {
+		res.Users = append(res.Users, convertAdminUser(user))
+	}
+
+	return res, nil
+}
+
+func validateAdminListUsersRequest(req *pb.AdminListUsersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Query != nil {
+		if err := val.ValidateSearchQuery(*req.Query); err != nil {
+			violations = append(violations, fieldViolation("query", err))
+		}
+	}
+
+	if req.Role != nil && !util.IsSupportedRole(*req.Role) {
+		violations = append(violations, fieldViolation("role", fmt.Errorf("unsupported role")))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
@@ -0,0 +1,122 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) LockUser(ctx context.Context, req *pb.AdminLockUserRequest) (*pb.AdminLockUserResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminLockUserRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if req.GetUsername() == authPayload.Username {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot lock yourself")
+	}
+
+	before, err := server.getTargetUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if before.LockedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] is already locked", before.Username)
+	}
+
+	var result db.LockUserTxResult
+	err = server.auditTx(ctx, authPayload, "user.lock", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.LockUserTx(ctx, db.LockUserTxParams{
+			Username: req.GetUsername(),
+			Reason:   req.GetReason(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: result.User.Username, before: before, after: result.User}, nil
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.GetUsername())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to lock user: %s", err)
+	}
+
+	return &pb.AdminLockUserResponse{User: convertAdminUser(result.User)}, nil
+}
+
+func (server *Server) UnlockUser(ctx context.Context, req *pb.AdminUnlockUserRequest) (*pb.AdminUnlockUserResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminUnlockUserRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	before, err := server.getTargetUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if !before.LockedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] is not locked", before.Username)
+	}
+
+	var user db.User
+	err = server.auditTx(ctx, authPayload, "user.unlock", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		user, err = store.UnlockUser(ctx, req.GetUsername())
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: user.Username, before: before, after: user}, nil
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.GetUsername())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to unlock user: %s", err)
+	}
+
+	return &pb.AdminUnlockUserResponse{User: convertAdminUser(user)}, nil
+}
+
+func validateAdminLockUserRequest(req *pb.AdminLockUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return
+}
+
+func validateAdminUnlockUserRequest(req *pb.AdminUnlockUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	return
+}
@@ -0,0 +1,190 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLockUserAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	user, _ := createRandomUser(t, util.DepositorRole)
+
+	reason := "suspected account takeover"
+
+	locked := user
+	locked.LockedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	locked.LockReason = pgtype.Text{String: reason, Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdminLockUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminLockUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.LockUserTxParams{Username: user.Username, Reason: reason}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, username string) (db.User, error) {
+						require.True(t, db.IsReadYourWrites(ctx))
+						return user, nil
+					})
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.LockUserTxResult{User: locked}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "user.lock", arg.Action)
+						require.Equal(t, banker.Username, arg.Actor)
+						require.Equal(t, user.Username, arg.TargetID)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetUser().GetIsLocked())
+				require.Equal(t, reason, res.GetUser().GetLockReason())
+				require.Equal(t, user.Username, res.GetUser().GetUser().GetUsername())
+			},
+		},
+		{
+			name: "CreateAuditEventError",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(1).Return(db.LockUserTxResult{User: locked}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, errors.New("error"))
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				// the lock is rolled back with its audit event
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: "  "},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorCannotLock",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "CannotLockYourself",
+			req:  &pb.AdminLockUserRequest{Username: banker.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AlreadyLocked",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(locked, nil)
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req:  &pb.AdminLockUserRequest{Username: user.Username, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().LockUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminLockUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.LockUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveDispute closes a dispute under investigation. A resolution in favour of the customer
+// credits the customer and reverses the transfer on the payee's account.
+func (server *Server) ResolveDispute(ctx context.Context, req *pb.AdminResolveDisputeRequest) (*pb.AdminResolveDisputeResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminResolveDisputeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	resolved, _ := util.DisputeResolution(req.GetFavour())
+
+	var result db.ResolveDisputeTxResult
+	err = server.auditTx(ctx, authPayload, "dispute.resolve", util.AuditTargetDispute, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.ResolveDisputeTx(ctx, db.ResolveDisputeTxParams{
+			DisputeID: req.GetId(),
+			Status:    resolved,
+			Note:      strings.TrimSpace(req.GetNote()),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]string{"status": util.DisputeInvestigating}
+		after := map[string]string{"status": result.Dispute.Status}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Dispute.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "dispute [%d] does not exist", req.GetId())
+		}
+
+		if errors.Is(err, db.ErrDisputeNotInvestigating) {
+			return nil, status.Errorf(codes.FailedPrecondition, "dispute [%d] is not under investigation", req.GetId())
+		}
+
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "the payee can't cover the amount of dispute [%d]", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to resolve dispute: %s", err)
+	}
+
+	return &pb.AdminResolveDisputeResponse{Dispute: convertDispute(result.Dispute)}, nil
+}
+
+func validateAdminResolveDisputeRequest(req *pb.AdminResolveDisputeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if _, ok := util.DisputeResolution(req.GetFavour()); !ok {
+		violations = append(violations, fieldViolation("favour", fmt.Errorf("must be %s or %s", util.DisputeFavourCustomer, util.DisputeFavourMerchant)))
+	}
+
+	if err := val.ValidateReason(req.GetNote()); err != nil {
+		violations = append(violations, fieldViolation("note", err))
+	}
+
+	return
+}
@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveDispute(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	dispute := db.Dispute{
+		ID:         util.RandomInt(1, 1000),
+		TransferID: util.RandomInt(1, 1000),
+		AccountID:  util.RandomInt(1, 1000),
+		OpenedBy:   depositor.Username,
+		Status:     util.DisputeInvestigating,
+		AssignedTo: pgtype.Text{String: banker.Username, Valid: true},
+	}
+
+	bankerAuth := func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdminResolveDisputeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error)
+	}{
+		{
+			name: "CustomerFavour",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: util.DisputeFavourCustomer, Note: "card was stolen"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ResolveDisputeTxParams{DisputeID: dispute.ID, Status: util.DisputeResolvedCustomer, Note: "card was stolen"}
+				resolved := dispute
+				resolved.Status = util.DisputeResolvedCustomer
+				resolved.CreditEntryID = pgtype.Int8{Int64: 1, Valid: true}
+				resolved.ReversalEntryID = pgtype.Int8{Int64: 2, Valid: true}
+
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.ResolveDisputeTxResult{Dispute: resolved}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "dispute.resolve", arg.Action)
+						require.Equal(t, util.AuditTargetDispute, arg.TargetType)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.DisputeResolvedCustomer, res.GetDispute().GetStatus())
+				require.NotZero(t, res.GetDispute().GetCreditEntryId())
+				require.NotZero(t, res.GetDispute().GetReversalEntryId())
+			},
+		},
+		{
+			name: "MerchantFavour",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: util.DisputeFavourMerchant, Note: "signed receipt"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ResolveDisputeTxParams{DisputeID: dispute.ID, Status: util.DisputeResolvedMerchant, Note: "signed receipt"}
+				resolved := dispute
+				resolved.Status = util.DisputeResolvedMerchant
+
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.ResolveDisputeTxResult{Dispute: resolved}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.DisputeResolvedMerchant, res.GetDispute().GetStatus())
+				require.Zero(t, res.GetDispute().GetCreditEntryId())
+				require.Zero(t, res.GetDispute().GetReversalEntryId())
+			},
+		},
+		{
+			name: "NotInvestigating",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: util.DisputeFavourCustomer, Note: "card was stolen"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ResolveDisputeTxResult{}, db.ErrDisputeNotInvestigating)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: util.DisputeFavourCustomer, Note: "card was stolen"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ResolveDisputeTxResult{}, db.ErrInsufficientFunds)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InvalidFavour",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: "bank", Note: "card was stolen"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: bankerAuth,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "DepositorCannotResolve",
+			req:  &pb.AdminResolveDisputeRequest{Id: dispute.ID, Favour: util.DisputeFavourCustomer, Note: "card was stolen"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AdminResolveDisputeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.ResolveDispute(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
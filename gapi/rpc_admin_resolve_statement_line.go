@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveStatementLine takes an unmatched line off the queue. With an entry the line is matched to it,
+// the entry must be an unmatched ledger entry of the mirrored clearing account with the opposite amount.
+// Without an entry the note explains why the line has no counterpart in the books.
+func (server *Server) ResolveStatementLine(ctx context.Context, req *pb.AdminResolveStatementLineRequest) (*pb.AdminResolveStatementLineResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminResolveStatementLineRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	line, err := server.store.GetExternalStatementLine(ctx, req.GetLineId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "statement line [%d] does not exist", req.GetLineId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get statement line: %s", err)
+	}
+
+	if line.Status != util.StatementLineUnmatched {
+		return nil, status.Errorf(codes.FailedPrecondition, "statement line [%d] is already %s", line.ID, line.Status)
+	}
+
+	arg := db.MatchExternalStatementLineParams{
+		ID:             line.ID,
+		Status:         util.StatementLineResolved,
+		ResolvedBy:     pgtype.Text{String: authPayload.Username, Valid: true},
+		ResolutionNote: pgtype.Text{String: strings.TrimSpace(req.GetNote()), Valid: true},
+	}
+
+	if req.EntryId != nil {
+		err = server.checkStatementLineEntry(ctx, line, req.GetEntryId())
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Status = util.StatementLineMatched
+		arg.EntryID = pgtype.Int8{Int64: req.GetEntryId(), Valid: true}
+	}
+
+	var resolved db.ExternalStatementLine
+	err = server.auditTx(ctx, authPayload, "reconciliation.resolve_line", util.AuditTargetStatementLine, func(store db.Store) (auditedChange, error) {
+		var err error
+		resolved, err = store.MatchExternalStatementLine(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(line.ID, 10), before: line, after: resolved}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "statement line [%d] is already resolved", line.ID)
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "ledger entry [%d] is already matched", req.GetEntryId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to resolve statement line: %s", err)
+	}
+
+	return &pb.AdminResolveStatementLineResponse{Line: convertExternalStatementLine(resolved)}, nil
+}
+
+// checkStatementLineEntry checks that the ledger entry is a counterpart of the line in the clearing account.
+func (server *Server) checkStatementLineEntry(ctx context.Context, line db.ExternalStatementLine, entryID int64) error {
+	entry, err := server.store.GetLedgerEntry(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "ledger entry [%d] does not exist", entryID)
+		}
+
+		return status.Errorf(codes.Internal, "failed to get ledger entry: %s", err)
+	}
+
+	stmt, err := server.store.GetExternalStatement(ctx, line.StatementID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get statement: %s", err)
+	}
+
+	if entry.LedgerAccountID != stmt.ClearingAccountID {
+		return status.Errorf(codes.FailedPrecondition, "ledger entry [%d] is not booked on clearing account [%d]", entry.ID, stmt.ClearingAccountID)
+	}
+
+	if entry.Amount != -line.Amount {
+		return status.Errorf(codes.FailedPrecondition, "ledger entry [%d] amount doesn't offset the statement line", entry.ID)
+	}
+
+	return nil
+}
+
+func validateAdminResolveStatementLineRequest(req *pb.AdminResolveStatementLineRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetLineId()); err != nil {
+		violations = append(violations, fieldViolation("line_id", err))
+	}
+
+	if req.EntryId != nil {
+		if err := val.ValidateID(req.GetEntryId()); err != nil {
+			violations = append(violations, fieldViolation("entry_id", err))
+		}
+	}
+
+	if err := val.ValidateReason(req.GetNote()); err != nil {
+		violations = append(violations, fieldViolation("note", err))
+	}
+
+	return
+}
@@ -0,0 +1,201 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveStatementLineAPI(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	stmt := db.ExternalStatement{ID: 3, ClearingAccountID: 7, Currency: util.EUR}
+	line := db.ExternalStatementLine{ID: 11, StatementID: stmt.ID, Amount: 500, Status: util.StatementLineUnmatched}
+	entry := db.LedgerEntry{ID: 21, LedgerAccountID: stmt.ClearingAccountID, Amount: -500}
+	entryID := entry.ID
+	note := "booked by hand after the bank corrected the reference"
+
+	authorizeBanker := func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+	}
+
+	requireCode := func(code codes.Code) func(t *testing.T, res *pb.AdminResolveStatementLineResponse, err error) {
+		return func(t *testing.T, res *pb.AdminResolveStatementLineResponse, err error) {
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, code, st.Code())
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AdminResolveStatementLineRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AdminResolveStatementLineResponse, err error)
+	}{
+		{
+			name: "MatchToEntry",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, EntryId: &entryID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Eq(line.ID)).Times(1).Return(line, nil)
+				store.EXPECT().GetLedgerEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().GetExternalStatement(gomock.Any(), gomock.Eq(stmt.ID)).Times(1).Return(stmt, nil)
+
+				arg := db.MatchExternalStatementLineParams{
+					ID:             line.ID,
+					Status:         util.StatementLineMatched,
+					EntryID:        pgtype.Int8{Int64: entry.ID, Valid: true},
+					ResolvedBy:     pgtype.Text{String: banker.Username, Valid: true},
+					ResolutionNote: pgtype.Text{String: note, Valid: true},
+				}
+				matched := line
+				matched.Status = util.StatementLineMatched
+				matched.EntryID = arg.EntryID
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Eq(arg)).Times(1).Return(matched, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			buildContext: authorizeBanker,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveStatementLineResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.StatementLineMatched, res.GetLine().GetStatus())
+				require.Equal(t, entry.ID, res.GetLine().GetEntryId())
+			},
+		},
+		{
+			name: "ResolveWithoutEntry",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Eq(line.ID)).Times(1).Return(line, nil)
+				store.EXPECT().GetLedgerEntry(gomock.Any(), gomock.Any()).Times(0)
+
+				resolved := line
+				resolved.Status = util.StatementLineResolved
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg db.MatchExternalStatementLineParams) (db.ExternalStatementLine, error) {
+						require.Equal(t, util.StatementLineResolved, arg.Status)
+						require.False(t, arg.EntryID.Valid)
+						return resolved, nil
+					})
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			buildContext: authorizeBanker,
+			checkResponse: func(t *testing.T, res *pb.AdminResolveStatementLineResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.StatementLineResolved, res.GetLine().GetStatus())
+			},
+		},
+		{
+			name: "LineNotFound",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).Return(db.ExternalStatementLine{}, db.ErrRecordNotFound)
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.NotFound),
+		},
+		{
+			name: "AlreadyMatched",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				matched := line
+				matched.Status = util.StatementLineMatched
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).Return(matched, nil)
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.FailedPrecondition),
+		},
+		{
+			name: "EntryOfOtherAccount",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, EntryId: &entryID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				other := entry
+				other.LedgerAccountID = stmt.ClearingAccountID + 1
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).Return(line, nil)
+				store.EXPECT().GetLedgerEntry(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+				store.EXPECT().GetExternalStatement(gomock.Any(), gomock.Any()).Times(1).Return(stmt, nil)
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.FailedPrecondition),
+		},
+		{
+			name: "AmountMismatch",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, EntryId: &entryID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				other := entry
+				other.Amount = line.Amount
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).Return(line, nil)
+				store.EXPECT().GetLedgerEntry(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+				store.EXPECT().GetExternalStatement(gomock.Any(), gomock.Any()).Times(1).Return(stmt, nil)
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.FailedPrecondition),
+		},
+		{
+			name: "EntryAlreadyMatched",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, EntryId: &entryID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).Return(line, nil)
+				store.EXPECT().GetLedgerEntry(gomock.Any(), gomock.Any()).Times(1).Return(entry, nil)
+				store.EXPECT().GetExternalStatement(gomock.Any(), gomock.Any()).Times(1).Return(stmt, nil)
+				store.EXPECT().MatchExternalStatementLine(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ExternalStatementLine{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.AlreadyExists),
+		},
+		{
+			name: "MissingNote",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext:  authorizeBanker,
+			checkResponse: requireCode(codes.InvalidArgument),
+		},
+		{
+			name: "DepositorCannotResolve",
+			req:  &pb.AdminResolveStatementLineRequest{LineId: line.ID, Note: note},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetExternalStatementLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: requireCode(codes.PermissionDenied),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+
+			res, err := server.ResolveStatementLine(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetUserRole grants or revokes the banker role. Bankers can't change their own role,
+// so the bank can't be left without a banker by mistake.
+func (server *Server) SetUserRole(ctx context.Context, req *pb.AdminSetUserRoleRequest) (*pb.AdminSetUserRoleResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminSetUserRoleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if req.GetUsername() == authPayload.Username {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change your own role")
+	}
+
+	before, err := server.getTargetUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if before.ErasedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] has been erased", before.Username)
+	}
+
+	if before.Role == req.GetRole() {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] already has role [%s]", before.Username, before.Role)
+	}
+
+	var user db.User
+	err = server.auditTx(ctx, authPayload, "user.set_role", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		user, err = store.UpdateUser(ctx, db.UpdateUserParams{
+			Username: req.GetUsername(),
+			Role:     pgtype.Text{String: req.GetRole(), Valid: true},
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: user.Username, before: before, after: user}, nil
+	})
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.GetUsername())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
+	}
+
+	return &pb.AdminSetUserRoleResponse{User: convertAdminUser(user)}, nil
+}
+
+func validateAdminSetUserRoleRequest(req *pb.AdminSetUserRoleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if !util.IsSupportedRole(req.GetRole()) {
+		violations = append(violations, fieldViolation("role", fmt.Errorf("unsupported role")))
+	}
+
+	return
+}
@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartDisputeInvestigation assigns an open dispute to the calling banker.
+func (server *Server) StartDisputeInvestigation(ctx context.Context, req *pb.AdminStartDisputeInvestigationRequest) (*pb.AdminStartDisputeInvestigationResponse, error) {
+	authPayload, err := server.authorizeBanker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdminStartDisputeInvestigationRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var dispute db.Dispute
+	err = server.auditTx(ctx, authPayload, "dispute.investigate", util.AuditTargetDispute, func(store db.Store) (auditedChange, error) {
+		var err error
+		dispute, err = store.StartDisputeInvestigationTx(ctx, db.StartDisputeInvestigationTxParams{
+			DisputeID:  req.GetId(),
+			AssignedTo: authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]string{"status": util.DisputeOpen}
+		after := map[string]string{"status": dispute.Status, "assigned_to": dispute.AssignedTo.String}
+
+		return auditedChange{targetID: strconv.FormatInt(dispute.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "dispute [%d] does not exist", req.GetId())
+		}
+
+		if errors.Is(err, db.ErrDisputeNotOpen) {
+			return nil, status.Errorf(codes.FailedPrecondition, "dispute [%d] is not open", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to start dispute investigation: %s", err)
+	}
+
+	return &pb.AdminStartDisputeInvestigationResponse{Dispute: convertDispute(dispute)}, nil
+}
+
+func validateAdminStartDisputeInvestigationRequest(req *pb.AdminStartDisputeInvestigationRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
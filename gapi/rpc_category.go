@@ -0,0 +1,257 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCategoryRule adds a rule that categorizes the entries of the user's accounts by the counterparty
+// or the memo of their transfers. A rule needs at least one of them.
+func (server *Server) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleRequest) (*pb.CreateCategoryRuleResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateCategoryRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateCategoryRuleParams{
+		Username:     authPayload.Username,
+		Category:     req.GetCategory(),
+		MemoContains: req.GetMemoContains(),
+	}
+
+	if req.GetCounterpartyAccountId() != 0 {
+		account, err := server.getAccount(ctx, req.GetCounterpartyAccountId(), "")
+		if err != nil {
+			return nil, err
+		}
+
+		arg.CounterpartyAccountID = pgtype.Int8{Int64: account.ID, Valid: true}
+	}
+
+	var rule db.CategoryRule
+	err = server.auditTx(ctx, authPayload, "category_rule.create", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		rule, err = store.CreateCategoryRule(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: authPayload.Username, after: rule}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create category rule: %s", err)
+	}
+
+	return &pb.CreateCategoryRuleResponse{Rule: convertCategoryRule(rule)}, nil
+}
+
+func (server *Server) ListCategoryRules(ctx context.Context, req *pb.ListCategoryRulesRequest) (*pb.ListCategoryRulesResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	rules, err := server.store.ListCategoryRules(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list category rules: %s", err)
+	}
+
+	res := &pb.ListCategoryRulesResponse{}
+	for _, rule := range rules {
+		res.Rules = append(res.Rules, convertCategoryRule(rule))
+	}
+
+	return res, nil
+}
+
+func (server *Server) DeleteCategoryRule(ctx context.Context, req *pb.DeleteCategoryRuleRequest) (*pb.DeleteCategoryRuleResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)})
+	}
+
+	// rules of other users are reported as missing too
+	var deleted int64
+	err = server.auditTx(ctx, authPayload, "category_rule.delete", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		deleted, err = store.DeleteCategoryRule(ctx, db.DeleteCategoryRuleParams{
+			ID:       req.GetId(),
+			Username: authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		if deleted == 0 {
+			return auditedChange{}, db.ErrRecordNotFound
+		}
+
+		return auditedChange{targetID: authPayload.Username, before: req}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "category rule [%d] does not exist", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to delete category rule: %s", err)
+	}
+
+	return &pb.DeleteCategoryRuleResponse{}, nil
+}
+
+// SetEntryCategory overrides the category of an entry. The owner and the co-owners of the account can
+// categorize its entries, viewers only see them.
+func (server *Server) SetEntryCategory(ctx context.Context, req *pb.SetEntryCategoryRequest) (*pb.SetEntryCategoryResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateID(req.GetEntryId()); err != nil {
+		violations = append(violations, fieldViolation("entry_id", err))
+	}
+
+	if err := val.ValidateCategory(req.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getEntryAccount(ctx, authPayload, req.GetEntryId(), util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var category db.EntryCategory
+	err = server.auditTx(ctx, authPayload, "entry.categorize", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		category, err = store.SetEntryCategory(ctx, db.SetEntryCategoryParams{
+			EntryID:   req.GetEntryId(),
+			Category:  req.GetCategory(),
+			UpdatedBy: authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), after: category}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set entry category: %s", err)
+	}
+
+	return &pb.SetEntryCategoryResponse{EntryId: category.EntryID, Category: category.Category}, nil
+}
+
+// ClearEntryCategory drops the category set by the user, so the category rules apply to the entry again.
+func (server *Server) ClearEntryCategory(ctx context.Context, req *pb.ClearEntryCategoryRequest) (*pb.ClearEntryCategoryResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateID(req.GetEntryId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("entry_id", err)})
+	}
+
+	account, err := server.getEntryAccount(ctx, authPayload, req.GetEntryId(), util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted int64
+	err = server.auditTx(ctx, authPayload, "entry.uncategorize", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		deleted, err = store.DeleteEntryCategory(ctx, req.GetEntryId())
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		if deleted == 0 {
+			return auditedChange{}, db.ErrRecordNotFound
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: req}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "entry [%d] has no category set by the user", req.GetEntryId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to clear entry category: %s", err)
+	}
+
+	return &pb.ClearEntryCategoryResponse{}, nil
+}
+
+// getEntryAccount returns the account of the entry if the authenticated user holds at least requiredRole on it.
+func (server *Server) getEntryAccount(ctx context.Context, authPayload *token.Payload, entryID int64, requiredRole string) (db.Account, error) {
+	entry, err := server.store.GetEntry(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.Account{}, status.Errorf(codes.NotFound, "entry [%d] does not exist", entryID)
+		}
+
+		return db.Account{}, status.Errorf(codes.Internal, "failed to get entry: %s", err)
+	}
+
+	account, err := server.getAccount(ctx, entry.AccountID, "")
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, requiredRole)
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	return account, nil
+}
+
+func validateCreateCategoryRuleRequest(req *pb.CreateCategoryRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCategory(req.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	if err := val.ValidateMemo(req.GetMemoContains()); err != nil {
+		violations = append(violations, fieldViolation("memo_contains", err))
+	}
+
+	if req.GetCounterpartyAccountId() < 0 {
+		violations = append(violations, fieldViolation("counterparty_account_id", errors.New("must not be negative")))
+	}
+
+	if req.GetCounterpartyAccountId() == 0 && req.GetMemoContains() == "" {
+		violations = append(violations, fieldViolation("memo_contains", errors.New("a rule needs a counterparty account or a memo")))
+	}
+
+	return
+}
@@ -0,0 +1,224 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateCategoryRule(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	counterparty := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateCategoryRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error)
+	}{
+		{
+			name: "Counterparty",
+			req:  &pb.CreateCategoryRuleRequest{Category: "rent", CounterpartyAccountId: counterparty.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateCategoryRuleParams{
+					Username:              user.Username,
+					Category:              "rent",
+					CounterpartyAccountID: pgtype.Int8{Int64: counterparty.ID, Valid: true},
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(counterparty.ID)).Times(1).Return(counterparty, nil)
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CategoryRule{ID: 1, Username: user.Username, Category: "rent", CounterpartyAccountID: arg.CounterpartyAccountID}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "rent", res.GetRule().GetCategory())
+				require.Equal(t, counterparty.ID, res.GetRule().GetCounterpartyAccountId())
+			},
+		},
+		{
+			name: "Memo",
+			req:  &pb.CreateCategoryRuleRequest{Category: "groceries", MemoContains: "market"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateCategoryRuleParams{Username: user.Username, Category: "groceries", MemoContains: "market"}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateCategoryRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CategoryRule{Category: "groceries", MemoContains: "market"}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "market", res.GetRule().GetMemoContains())
+			},
+		},
+		{
+			name: "NoCondition",
+			req:  &pb.CreateCategoryRuleRequest{Category: "rent"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateCategoryRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidCategory",
+			req:  &pb.CreateCategoryRuleRequest{Category: "Eating Out!", MemoContains: "cafe"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateCategoryRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "CounterpartyNotFound",
+			req:  &pb.CreateCategoryRuleRequest{Category: "rent", CounterpartyAccountId: counterparty.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(counterparty.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateCategoryRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			res, err := server.CreateCategoryRule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestSetEntryCategory(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: user.Username, Currency: util.USD}
+	entry := db.Entry{ID: util.RandomInt(1, 1000), AccountID: account.ID, Amount: -20}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.SetEntryCategoryResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SetEntryCategoryParams{EntryID: entry.ID, Category: "travel", UpdatedBy: user.Username}
+				store.EXPECT().GetEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().SetEntryCategory(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.EntryCategory{EntryID: entry.ID, Category: "travel"}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetEntryCategoryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, entry.ID, res.GetEntryId())
+				require.Equal(t, "travel", res.GetCategory())
+			},
+		},
+		{
+			name: "CoOwner",
+			buildStubs: func(store *mockdb.MockStore) {
+				other := account
+				other.Owner = util.RandomOwner()
+				holder := db.AccountHolder{AccountID: account.ID, Username: user.Username, Role: util.CoOwnerHolderRole}
+				store.EXPECT().GetEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(other, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Eq(db.GetAccountHolderParams{AccountID: account.ID, Username: user.Username})).Times(1).Return(holder, nil)
+				store.EXPECT().SetEntryCategory(gomock.Any(), gomock.Any()).Times(1).Return(db.EntryCategory{EntryID: entry.ID, Category: "travel"}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetEntryCategoryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "travel", res.GetCategory())
+			},
+		},
+		{
+			name: "Viewer",
+			buildStubs: func(store *mockdb.MockStore) {
+				other := account
+				other.Owner = util.RandomOwner()
+				holder := db.AccountHolder{AccountID: account.ID, Username: user.Username, Role: util.ViewerHolderRole}
+				store.EXPECT().GetEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(other, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().SetEntryCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetEntryCategoryResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "EntryOfAnotherUser",
+			buildStubs: func(store *mockdb.MockStore) {
+				other := account
+				other.Owner = util.RandomOwner()
+				store.EXPECT().GetEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(other, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().SetEntryCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetEntryCategoryResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "EntryNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(db.Entry{}, db.ErrRecordNotFound)
+				store.EXPECT().SetEntryCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetEntryCategoryResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			res, err := server.SetEntryCategory(ctx, &pb.SetEntryCategoryRequest{EntryId: entry.ID, Category: "travel"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
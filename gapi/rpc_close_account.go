@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CloseAccount deletes an account of the user. Only an empty account without any history can be closed.
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAccountRef(req.GetId(), req.GetNumber())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the balance decides whether the account can be closed, so it is read from the primary
+	account, err := server.getAccount(db.WithReadYourWrites(ctx), req.GetId(), req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.OwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Balance != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "account [%d] has a non-zero balance", account.ID)
+	}
+
+	err = server.auditTx(ctx, authPayload, "account.delete", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		err := store.DeleteAccount(ctx, account.ID)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: account}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.FailedPrecondition, "account [%d] can't be closed: %s", account.ID, accountHistory(pgErr))
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to close account: %s", err)
+	}
+
+	return &pb.CloseAccountResponse{}, nil
+}
+
+// accountHistory tells what keeps an account from being deleted by the foreign key it violates,
+// the violation names the table that still references the account.
+func accountHistory(pgErr *pgconn.PgError) string {
+	if pgErr.ConstraintName == "entries_account_id_fkey" {
+		return "it has entries"
+	}
+
+	if pgErr.TableName == "" {
+		return "it is still in use"
+	}
+
+	return fmt.Sprintf("it has %s", strings.ReplaceAll(pgErr.TableName, "_", " "))
+}
@@ -0,0 +1,135 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCloseAccountAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	coOwner, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(owner.Username)
+	account.Balance = 0
+
+	testCases := []struct {
+		name          string
+		account       db.Account
+		user          db.User
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CloseAccountResponse, err error)
+	}{
+		{
+			name:    "OK",
+			account: account,
+			user:    owner,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "account.delete", arg.Action)
+						return db.AuditEvent{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "CoOwnerCannotClose",
+			account: account,
+			user:    coOwner,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountHolder{AccountID: account.ID, Username: coOwner.Username, Role: util.CoOwnerHolderRole}, nil)
+				store.EXPECT().DeleteAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NonZeroBalance",
+			account: func() db.Account {
+				funded := account
+				funded.Balance = 10
+				return funded
+			}(),
+			user: owner,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:    "HasEntries",
+			account: account,
+			user:    owner,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(&pgconn.PgError{Code: db.ForeignKeyViolation, ConstraintName: "entries_account_id_fkey", TableName: "entries"})
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+				require.Contains(t, status.Convert(err).Message(), "it has entries")
+			},
+		},
+		{
+			name:    "HasSavingsPots",
+			account: account,
+			user:    owner,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(&pgconn.PgError{Code: db.ForeignKeyViolation, ConstraintName: "savings_pots_account_id_fkey", TableName: "savings_pots"})
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+				require.Contains(t, status.Convert(err).Message(), "it has savings pots")
+				require.NotContains(t, status.Convert(err).Message(), "entries")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, id int64) (db.Account, error) {
+					require.True(t, db.IsReadYourWrites(ctx))
+					return tc.account, nil
+				})
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, tc.user.Username, tc.user.Role, time.Minute)
+			res, err := server.CloseAccount(ctx, &pb.CloseAccountRequest{Id: account.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// CloseSavingsPot sweeps the money of the pot back to the main balance and closes it.
+func (server *Server) CloseSavingsPot(ctx context.Context, req *pb.CloseSavingsPotRequest) (*pb.CloseSavingsPotResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCloseSavingsPotRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var result db.CloseSavingsPotTxResult
+	err = server.auditTx(ctx, authPayload, "savings_pot.close", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.CloseSavingsPotTx(ctx, db.CloseSavingsPotTxParams{
+			AccountID: account.ID,
+			PotID:     req.GetPotId(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Pot.ID, 10), after: result.Pot}, nil
+	})
+	if err != nil {
+		return nil, savingsPotError(err, req.GetPotId())
+	}
+
+	res := &pb.CloseSavingsPotResponse{
+		Pot:     convertSavingsPot(result.Pot),
+		Account: convertAccount(result.Account),
+	}
+
+	if result.Entry.ID != 0 {
+		res.Entry = convertPotEntry(result.Entry)
+	}
+
+	return res, nil
+}
+
+func validateCloseSavingsPotRequest(req *pb.CloseSavingsPotRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateID(req.GetPotId()); err != nil {
+		violations = append(violations, fieldViolation("pot_id", err))
+	}
+
+	return
+}
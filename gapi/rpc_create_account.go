@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("currency", err)})
+	}
+
+	number, err := util.NewAccountNumber()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate account number: %s", err)
+	}
+
+	var account db.Account
+	err = server.auditTx(ctx, authPayload, "account.create", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		var err error
+		account, err = store.CreateAccount(ctx, db.CreateAccountParams{
+			Owner:    authPayload.Username,
+			Currency: req.GetCurrency(),
+			Balance:  0,
+			Number:   number,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), after: account}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case db.ForeignKeyViolation:
+				return nil, status.Errorf(codes.NotFound, "user [%s] doesn't exist", authPayload.Username)
+			case db.UniqueViolation:
+				// a clash of the random account numbers is an internal error the client can retry
+				if pgErr.ConstraintName != "accounts_number_key" {
+					return nil, status.Errorf(codes.AlreadyExists, "user [%s] already has account with currency [%s]", authPayload.Username, req.GetCurrency())
+				}
+			}
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create account: %s", err)
+	}
+
+	return &pb.CreateAccountResponse{Account: convertAccount(account)}, nil
+}
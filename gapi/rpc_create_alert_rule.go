@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAlertRule lets any holder of the account set up an alert for themselves.
+func (server *Server) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateAlertRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule db.AlertRule
+	err = server.auditTx(ctx, authPayload, "alert_rule.create", util.AuditTargetAlertRule, func(store db.Store) (auditedChange, error) {
+		var err error
+		rule, err = store.CreateAlertRule(ctx, db.CreateAlertRuleParams{
+			AccountID: account.ID,
+			Username:  authPayload.Username,
+			Kind:      req.GetKind(),
+			Threshold: req.GetThreshold(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(rule.ID, 10), after: rule}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create alert rule: %s", err)
+	}
+
+	return &pb.CreateAlertRuleResponse{Rule: convertAlertRule(rule)}, nil
+}
+
+func validateCreateAlertRuleRequest(req *pb.CreateAlertRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if !util.IsSupportedAlertKind(req.GetKind()) {
+		violations = append(violations, fieldViolation("kind", fmt.Errorf("must be %s, %s or %s", util.AlertLowBalance, util.AlertLargeDebit, util.AlertDailySpend)))
+	}
+
+	if req.GetThreshold() <= 0 {
+		violations = append(violations, fieldViolation("threshold", fmt.Errorf("must be a positive integer")))
+	}
+
+	return
+}
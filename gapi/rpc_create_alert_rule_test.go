@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateAlertRuleAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(util.RandomOwner())
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateAlertRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateAlertRuleResponse, err error)
+	}{
+		{
+			name: "Viewer",
+			req:  &pb.CreateAlertRuleRequest{AccountId: account.ID, Kind: util.AlertLowBalance, Threshold: 100},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: user.Username, Role: util.ViewerHolderRole}
+				arg := db.CreateAlertRuleParams{AccountID: account.ID, Username: user.Username, Kind: util.AlertLowBalance, Threshold: 100}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().
+					CreateAlertRule(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AlertRule{ID: 1, AccountID: account.ID, Username: user.Username, Kind: util.AlertLowBalance, Threshold: 100}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAlertRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetRule().GetUsername())
+				require.Equal(t, util.AlertLowBalance, res.GetRule().GetKind())
+				require.Nil(t, res.GetRule().GetTriggeredAt())
+			},
+		},
+		{
+			name: "NotAccountHolder",
+			req:  &pb.CreateAlertRuleRequest{AccountId: account.ID, Kind: util.AlertLowBalance, Threshold: 100},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAlertRuleResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "InvalidKind",
+			req:  &pb.CreateAlertRuleRequest{AccountId: account.ID, Kind: "weather", Threshold: 100},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAlertRuleResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidThreshold",
+			req:  &pb.CreateAlertRuleRequest{AccountId: account.ID, Kind: util.AlertLargeDebit},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAlertRuleResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			res, err := server.CreateAlertRule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateInvitation lets the owner of the account invite another user as a co-owner or a viewer.
+func (server *Server) CreateInvitation(ctx context.Context, req *pb.CreateInvitationRequest) (*pb.CreateInvitationResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateInvitationRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.OwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	isHolder, err := server.isAccountHolder(ctx, account, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if isHolder {
+		return nil, status.Errorf(codes.AlreadyExists, "user [%s] already holds account [%d]", req.GetUsername(), account.ID)
+	}
+
+	var invitation db.AccountInvitation
+	err = server.auditTx(ctx, authPayload, "invitation.create", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		invitation, err = store.CreateAccountInvitation(ctx, db.CreateAccountInvitationParams{
+			AccountID: account.ID,
+			Inviter:   authPayload.Username,
+			Invitee:   req.GetUsername(),
+			Role:      req.GetRole(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), after: invitation}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "user [%s] doesn't exist", req.GetUsername())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create invitation: %s", err)
+	}
+
+	return &pb.CreateInvitationResponse{Invitation: convertAccountInvitation(invitation)}, nil
+}
+
+func validateCreateInvitationRequest(req *pb.CreateInvitationRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if !util.IsInvitableHolderRole(req.GetRole()) {
+		violations = append(violations, fieldViolation("role", fmt.Errorf("must be %s or %s", util.CoOwnerHolderRole, util.ViewerHolderRole)))
+	}
+
+	return
+}
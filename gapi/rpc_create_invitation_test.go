@@ -0,0 +1,123 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateInvitationAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	invitee, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(owner.Username)
+
+	invitation := db.AccountInvitation{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Inviter:   owner.Username,
+		Invitee:   invitee.Username,
+		Role:      util.CoOwnerHolderRole,
+		Status:    util.InvitationPending,
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		req           *pb.CreateInvitationRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateInvitationResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: owner.Username,
+			req:      &pb.CreateInvitationRequest{AccountId: account.ID, Username: invitee.Username, Role: util.CoOwnerHolderRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(db.GetAccountHolderParams{AccountID: account.ID, Username: invitee.Username})).
+					Times(1).
+					Return(db.AccountHolder{}, db.ErrRecordNotFound)
+
+				arg := db.CreateAccountInvitationParams{
+					AccountID: account.ID,
+					Inviter:   owner.Username,
+					Invitee:   invitee.Username,
+					Role:      util.CoOwnerHolderRole,
+				}
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Eq(arg)).Times(1).Return(invitation, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvitationResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, invitation.ID, res.GetInvitation().GetId())
+				require.Equal(t, util.CoOwnerHolderRole, res.GetInvitation().GetRole())
+				require.Nil(t, res.GetInvitation().GetRespondedAt())
+			},
+		},
+		{
+			name:     "OwnerRole",
+			username: owner.Username,
+			req:      &pb.CreateInvitationRequest{AccountId: account.ID, Username: invitee.Username, Role: util.OwnerHolderRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:     "NotAnOwner",
+			username: invitee.Username,
+			req:      &pb.CreateInvitationRequest{AccountId: account.ID, Username: owner.Username, Role: util.CoOwnerHolderRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: invitee.Username, Role: util.CoOwnerHolderRole}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name:     "AlreadyHolder",
+			username: owner.Username,
+			req:      &pb.CreateInvitationRequest{AccountId: account.ID, Username: invitee.Username, Role: util.ViewerHolderRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: invitee.Username, Role: util.ViewerHolderRole}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().CreateAccountInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvitationResponse, err error) {
+				requireStatusCode(t, err, codes.AlreadyExists)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, tc.username, util.DepositorRole, time.Minute)
+			res, err := server.CreateInvitation(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
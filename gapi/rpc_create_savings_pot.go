@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSavingsPot opens a savings pot on an account the user owns or co-owns.
+func (server *Server) CreateSavingsPot(ctx context.Context, req *pb.CreateSavingsPotRequest) (*pb.CreateSavingsPotResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateSavingsPotRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, _ := time.Parse(util.BusinessDateLayout, req.GetDeadline())
+	var pot db.SavingsPot
+	err = server.auditTx(ctx, authPayload, "savings_pot.create", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		pot, err = store.CreateSavingsPot(ctx, db.CreateSavingsPotParams{
+			AccountID:    account.ID,
+			Name:         req.GetName(),
+			TargetAmount: req.GetTargetAmount(),
+			Deadline:     pgtype.Date{Time: deadline, Valid: true},
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(pot.ID, 10), after: pot}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create savings pot: %s", err)
+	}
+
+	return &pb.CreateSavingsPotResponse{Pot: convertSavingsPot(pot)}, nil
+}
+
+func validateCreateSavingsPotRequest(req *pb.CreateSavingsPotRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateStringLength(req.GetName(), 1, 64); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if req.GetTargetAmount() <= 0 {
+		violations = append(violations, fieldViolation("target_amount", fmt.Errorf("must be a positive integer")))
+	}
+
+	if err := val.ValidateBusinessDate(req.GetDeadline()); err != nil {
+		violations = append(violations, fieldViolation("deadline", err))
+	} else if deadline, _ := time.Parse(util.BusinessDateLayout, req.GetDeadline()); deadline.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+		violations = append(violations, fieldViolation("deadline", fmt.Errorf("must not be in the past")))
+	}
+
+	return
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateSavingsPotAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(user.Username)
+	deadline := time.Now().UTC().AddDate(0, 3, 0).Format(util.BusinessDateLayout)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateSavingsPotRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateSavingsPotResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateSavingsPotRequest{AccountId: account.ID, Name: "holiday", TargetAmount: 500, Deadline: deadline},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateSavingsPot(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateSavingsPotParams) (db.SavingsPot, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, deadline, arg.Deadline.Time.Format(util.BusinessDateLayout))
+						return db.SavingsPot{ID: 1, AccountID: arg.AccountID, Name: arg.Name, TargetAmount: arg.TargetAmount, Deadline: arg.Deadline, Status: util.PotOpen}, nil
+					})
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateSavingsPotResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "holiday", res.GetPot().GetName())
+				require.Equal(t, deadline, res.GetPot().GetDeadline())
+				require.Equal(t, util.PotOpen, res.GetPot().GetStatus())
+			},
+		},
+		{
+			name: "PastDeadline",
+			req:  &pb.CreateSavingsPotRequest{AccountId: account.ID, Name: "holiday", TargetAmount: 500, Deadline: "2020-01-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateSavingsPot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateSavingsPotResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NotAccountHolder",
+			req:  &pb.CreateSavingsPotRequest{AccountId: account.ID, Name: "holiday", TargetAmount: 500, Deadline: deadline},
+			buildStubs: func(store *mockdb.MockStore) {
+				other := account
+				other.Owner = util.RandomOwner()
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(other, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateSavingsPot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateSavingsPotResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			res, err := server.CreateSavingsPot(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/fraud"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultTransferApprovalTTL = 24 * time.Hour
+
+// CreateTransfer moves money between two accounts in the same currency. The user must own or co-own
+// the source account. Transfers flagged by the fraud checks are held for a banker's review and come back
+// with held set, no money moves until the review. Transfers above the approval threshold wait for
+// a banker's approval before they are made.
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetFromAccountNumber(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, fromAccount, authPayload.Username, util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), req.GetToAccountNumber(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if toAccount.ID == fromAccount.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "can't transfer from account [%d] to itself", fromAccount.ID)
+	}
+
+	transfer := fraud.Transfer{
+		Username:      authPayload.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.GetAmount(),
+	}
+
+	// denied transfers are recorded as usual without asking for an approval. Otherwise the transfer
+	// is screened again when a banker approves it, so a review verdict then holds it for a fraud review
+	if server.needsApproval(req.GetAmount()) {
+		verdict, err := server.fraudEngine.Evaluate(ctx, server.store, transfer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to screen transfer: %s", err)
+		}
+
+		if verdict.Decision != util.FraudDeny {
+			approval, err := server.requestTransferApproval(ctx, authPayload, fromAccount, toAccount, req.GetAmount(), req.GetMemo())
+			if err != nil {
+				return nil, err
+			}
+
+			return &pb.CreateTransferResponse{Approval: convertTransferApproval(approval)}, nil
+		}
+	}
+
+	// the fraud rules are evaluated inside the transaction of the transfer,
+	// held and denied transfers are recorded too, so that bankers can see every rule hit
+	var result db.TransferTxResult
+	err = server.auditTx(ctx, authPayload, "transfer.create", util.AuditTargetTransfer, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        req.GetAmount(),
+			Screen:        server.fraudEngine.Screen(transfer),
+			Memo:          req.GetMemo(),
+			InitiatedBy:   authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Transfer.ID, 10), after: result.Transfer}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "account [%d] has insufficient funds", fromAccount.ID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create transfer: %s", err)
+	}
+
+	if result.Transfer.Status == util.TransferDenied {
+		return nil, status.Errorf(codes.PermissionDenied, "transfer [%d] was denied by the fraud checks", result.Transfer.ID)
+	}
+
+	res := &pb.CreateTransferResponse{
+		Transfer: convertTransfer(result.Transfer),
+		Held:     result.Transfer.Status == util.TransferHeld,
+	}
+
+	return res, nil
+}
+
+// needsApproval tells if the transfer amount is above the approval threshold.
+func (server *Server) needsApproval(amount int64) bool {
+	return server.config.TransferApprovalThreshold > 0 && amount > server.config.TransferApprovalThreshold
+}
+
+// requestTransferApproval stores the transfer as pending until a banker other than the initiator
+// approves or rejects it. Pending transfers expire after TransferApprovalTTL.
+func (server *Server) requestTransferApproval(
+	ctx context.Context,
+	authPayload *token.Payload,
+	fromAccount db.Account,
+	toAccount db.Account,
+	amount int64,
+	memo string,
+) (db.TransferApproval, error) {
+	ttl := server.config.TransferApprovalTTL
+	if ttl <= 0 {
+		ttl = defaultTransferApprovalTTL
+	}
+
+	var approval db.TransferApproval
+	err := server.auditTx(ctx, authPayload, "transfer.request_approval", util.AuditTargetApproval, func(store db.Store) (auditedChange, error) {
+		var err error
+		approval, err = store.CreateTransferApproval(ctx, db.CreateTransferApprovalParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        amount,
+			Currency:      fromAccount.Currency,
+			InitiatedBy:   authPayload.Username,
+			ExpiresAt:     time.Now().Add(ttl),
+			Memo:          memo,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(approval.ID, 10), after: approval}, nil
+	})
+	if err != nil {
+		return db.TransferApproval{}, status.Errorf(codes.Internal, "failed to request transfer approval: %s", err)
+	}
+
+	return approval, nil
+}
+
+// validAccount loads the account by its id or, if the id is zero, by its account number,
+// and checks that it has the given currency.
+func (server *Server) validAccount(ctx context.Context, accountID int64, accountNumber string, currency string) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID, accountNumber)
+	if err != nil {
+		return db.Account{}, err
+	}
+
+	if account.Currency != currency {
+		return db.Account{}, status.Errorf(codes.FailedPrecondition, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+
+	return account, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = append(violations, validateTransferAccount("from_account", req.GetFromAccountId(), req.GetFromAccountNumber())...)
+	violations = append(violations, validateTransferAccount("to_account", req.GetToAccountId(), req.GetToAccountNumber())...)
+
+	if req.GetAmount() <= 0 {
+		violations = append(violations, fieldViolation("amount", fmt.Errorf("must be a positive integer")))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateMemo(req.GetMemo()); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+
+	return
+}
+
+// validateTransferAccount checks that a side of the transfer is given either by its id or by its account number.
+func validateTransferAccount(side string, accountID int64, accountNumber string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if accountNumber == "" {
+		if err := val.ValidateID(accountID); err != nil {
+			violations = append(violations, fieldViolation(side+"_id", err))
+		}
+
+		return
+	}
+
+	if accountID != 0 {
+		violations = append(violations, fieldViolation(side+"_number", fmt.Errorf("must not be given together with %s_id", side)))
+	}
+
+	if err := val.ValidateAccountNumber(accountNumber); err != nil {
+		violations = append(violations, fieldViolation(side+"_number", err))
+	}
+
+	return
+}
@@ -3,14 +3,11 @@ package gapi
 import (
 	"context"
 	"errors"
-	"time"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/pb"
 	"github.com/Drolfothesgnir/simplebank/util"
 	"github.com/Drolfothesgnir/simplebank/val"
-	"github.com/Drolfothesgnir/simplebank/worker"
-	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgconn"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -37,24 +34,20 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		Email:          req.GetEmail(),
 	}
 
-	cb := func(user db.User) error {
-		payload := &worker.PayloadSendVerifyEmail{Username: user.Username}
-
-		opts := []asynq.Option{
-			asynq.MaxRetry(10),
-			asynq.ProcessIn(10 * time.Second),
-			asynq.Queue(worker.QueueCritical),
-		}
-
-		return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, payload, opts...)
-	}
-
 	arg := db.CreateUserTxParams{
 		CreateUserParams: createUserParams,
-		AfterCreate:      cb,
 	}
 
-	txResult, err := server.store.CreateUserTx(ctx, arg)
+	var txResult db.CreateUserTxResult
+	err = server.auditTx(ctx, nil, "user.create", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		txResult, err = store.CreateUserTx(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: txResult.User.Username, after: txResult.User}, nil
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
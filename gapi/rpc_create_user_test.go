@@ -11,7 +11,6 @@ import (
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/pb"
 	"github.com/Drolfothesgnir/simplebank/util"
-	"github.com/Drolfothesgnir/simplebank/worker"
 	mockwk "github.com/Drolfothesgnir/simplebank/worker/mock"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
@@ -39,7 +38,6 @@ func createRandomUser(t *testing.T, role string) (user db.User, password string)
 type eqCreateUserTxParamsMatcher struct {
 	arg      db.CreateUserTxParams
 	password string
-	user     db.User
 }
 
 func (expected eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
@@ -56,20 +54,15 @@ func (expected eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
 
 	expected.arg.HashedPassword = actualArg.HashedPassword
 
-	if !reflect.DeepEqual(expected.arg.CreateUserParams, actualArg.CreateUserParams) {
-		return false
-	}
-
-	err = actualArg.AfterCreate(expected.user)
-	return err == nil
+	return reflect.DeepEqual(expected.arg.CreateUserParams, actualArg.CreateUserParams)
 }
 
 func (e eqCreateUserTxParamsMatcher) String() string {
 	return fmt.Sprintf("matches arg %v and password %v", e.arg, e.password)
 }
 
-func EqCreateUserTxParams(arg db.CreateUserTxParams, password string, user db.User) gomock.Matcher {
-	return eqCreateUserTxParamsMatcher{arg, password, user}
+func EqCreateUserTxParams(arg db.CreateUserTxParams, password string) gomock.Matcher {
+	return eqCreateUserTxParamsMatcher{arg, password}
 }
 
 func TestCreateUser(t *testing.T) {
@@ -105,11 +98,11 @@ func TestCreateUser(t *testing.T) {
 					User: user,
 				}
 
-				store.EXPECT().CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password, user)).Times(1).Return(res, nil)
+				store.EXPECT().CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password)).Times(1).Return(res, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
 
-				payload := &worker.PayloadSendVerifyEmail{Username: user.Username}
-
-				taskDistributor.EXPECT().DistributeTaskSendVerifyEmail(gomock.Any(), payload, gomock.Any()).Times(1).Return(nil)
+				// the verification email is sent by the outbox relay after the transaction commits
+				taskDistributor.EXPECT().DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.NoError(t, err)
@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/Drolfothesgnir/simplebank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	eventTypes := slices.Clone(req.GetEventTypes())
+	slices.Sort(eventTypes)
+
+	arg := db.CreateWebhookParams{
+		Owner:      authPayload.Username,
+		Url:        req.GetUrl(),
+		EventTypes: slices.Compact(eventTypes),
+		Secret:     req.GetSecret(),
+	}
+
+	var webhook db.Webhook
+	err = server.auditTx(ctx, authPayload, "webhook.create", util.AuditTargetWebhook, func(store db.Store) (auditedChange, error) {
+		var err error
+		webhook, err = store.CreateWebhook(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(webhook.ID, 10), after: webhook}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %s", err)
+	}
+
+	return &pb.CreateWebhookResponse{Webhook: convertWebhook(webhook)}, nil
+}
+
+func validateCreateWebhookRequest(req *pb.CreateWebhookRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateWebhookURL(req.GetUrl()); err != nil {
+		violations = append(violations, fieldViolation("url", err))
+	}
+
+	if len(req.GetEventTypes()) == 0 {
+		violations = append(violations, fieldViolation("event_types", fmt.Errorf("must contain at least one event type")))
+	}
+
+	for _, eventType := range req.GetEventTypes() {
+		if !worker.IsWebhookEventType(eventType) {
+			violations = append(violations, fieldViolation("event_types", fmt.Errorf("unsupported event type: %s", eventType)))
+		}
+	}
+
+	if err := val.ValidateWebhookSecret(req.GetSecret()); err != nil {
+		violations = append(violations, fieldViolation("secret", err))
+	}
+
+	return
+}
@@ -0,0 +1,264 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	secret := util.RandomString(32)
+
+	webhook := db.Webhook{
+		ID:         util.RandomInt(1, 1000),
+		Owner:      user.Username,
+		Url:        "https://example.com/hooks",
+		EventTypes: []string{db.EventTransferCompleted},
+		Secret:     secret,
+	}
+
+	testCases := []struct {
+		name          string
+		body          *pb.CreateWebhookRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateWebhookResponse, err error)
+	}{
+		{
+			name: "OK",
+			body: &pb.CreateWebhookRequest{
+				Url:        webhook.Url,
+				EventTypes: []string{db.EventTransferCompleted, db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateWebhookParams{
+					Owner:      user.Username,
+					Url:        webhook.Url,
+					EventTypes: []string{db.EventTransferCompleted},
+					Secret:     secret,
+				}
+
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Eq(arg)).Times(1).Return(webhook, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, webhook.ID, res.GetWebhook().GetId())
+				require.Equal(t, webhook.Url, res.GetWebhook().GetUrl())
+				require.Equal(t, webhook.EventTypes, res.GetWebhook().GetEventTypes())
+			},
+		},
+		{
+			name: "InvalidURL",
+			body: &pb.CreateWebhookRequest{
+				Url:        "ftp://example.com/hooks",
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PlainHTTP",
+			body: &pb.CreateWebhookRequest{
+				Url:        "http://example.com/hooks",
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "LoopbackURL",
+			body: &pb.CreateWebhookRequest{
+				Url:        "https://127.0.0.1:8080/hooks",
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "LocalhostURL",
+			body: &pb.CreateWebhookRequest{
+				Url:        "https://localhost/hooks",
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MetadataURL",
+			body: &pb.CreateWebhookRequest{
+				Url:        "https://169.254.169.254/latest/meta-data",
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "UnsupportedEventType",
+			body: &pb.CreateWebhookRequest{
+				Url:        webhook.Url,
+				EventTypes: []string{db.EventUserCreated},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ShortSecret",
+			body: &pb.CreateWebhookRequest{
+				Url:        webhook.Url,
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     "short",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: &pb.CreateWebhookRequest{
+				Url:        webhook.Url,
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			body: &pb.CreateWebhookRequest{
+				Url:        webhook.Url,
+				EventTypes: []string{db.EventTransferCompleted},
+				Secret:     secret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Times(1).Return(db.Webhook{}, sql.ErrConnDone)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWebhookResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.CreateWebhook(ctx, tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeclineInvitation turns down a pending invitation addressed to the authenticated user.
+func (server *Server) DeclineInvitation(ctx context.Context, req *pb.DeclineInvitationRequest) (*pb.DeclineInvitationResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateInvitationID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	invitation, err := server.getPendingInvitation(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var declined db.AccountInvitation
+	err = server.auditTx(ctx, authPayload, "invitation.decline", util.AuditTargetInvitation, func(store db.Store) (auditedChange, error) {
+		var err error
+		declined, err = store.UpdateAccountInvitationStatus(ctx, db.UpdateAccountInvitationStatusParams{
+			ID:     invitation.ID,
+			Status: util.InvitationDeclined,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(invitation.ID, 10), before: invitation, after: declined}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "invitation [%d] is no longer pending", invitation.ID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to decline invitation: %s", err)
+	}
+
+	return &pb.DeclineInvitationResponse{Invitation: convertAccountInvitation(declined)}, nil
+}
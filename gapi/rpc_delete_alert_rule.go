@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteAlertRule deletes one of the user's own alert rules on the account.
+func (server *Server) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteAlertRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted int64
+	err = server.auditTx(ctx, authPayload, "alert_rule.delete", util.AuditTargetAlertRule, func(store db.Store) (auditedChange, error) {
+		var err error
+		deleted, err = store.DeleteAlertRule(ctx, db.DeleteAlertRuleParams{
+			ID:        req.GetId(),
+			AccountID: account.ID,
+			Username:  authPayload.Username,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		// rules of other holders are reported as missing too
+		if deleted == 0 {
+			return auditedChange{}, db.ErrRecordNotFound
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(req.GetId(), 10)}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "alert rule [%d] does not exist", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to delete alert rule: %s", err)
+	}
+
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+func validateDeleteAlertRuleRequest(req *pb.DeleteAlertRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
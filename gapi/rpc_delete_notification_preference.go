@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteNotificationPreference(ctx context.Context, req *pb.DeleteNotificationPreferenceRequest) (*pb.DeleteNotificationPreferenceResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if !util.IsSupportedNotificationEventType(req.GetEventType()) {
+		violations := []*errdetails.BadRequest_FieldViolation{
+			fieldViolation("event_type", fmt.Errorf("unsupported event type")),
+		}
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.DeleteNotificationPreferenceParams{
+		Username:  authPayload.Username,
+		EventType: req.GetEventType(),
+	}
+
+	var deleted int64
+	err = server.auditTx(ctx, authPayload, "notification_preference.delete", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		deleted, err = store.DeleteNotificationPreference(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		if deleted == 0 {
+			return auditedChange{}, db.ErrRecordNotFound
+		}
+
+		return auditedChange{targetID: authPayload.Username, before: arg}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no notification preference for %s", req.GetEventType())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to delete notification preference: %s", err)
+	}
+
+	return &pb.DeleteNotificationPreferenceResponse{}, nil
+}
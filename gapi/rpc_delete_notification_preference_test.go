@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteNotificationPreference(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		eventType     string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name:      "OK",
+			eventType: util.NotificationMoneyOut,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.DeleteNotificationPreferenceParams{Username: user.Username, EventType: util.NotificationMoneyOut}
+				store.EXPECT().DeleteNotificationPreference(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1).Return(db.AuditEvent{}, nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "NotFound",
+			eventType: util.NotificationMoneyOut,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteNotificationPreference(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name:      "UnsupportedEventType",
+			eventType: "salary",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteNotificationPreference(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			_, err := server.DeleteNotificationPreference(ctx, &pb.DeleteNotificationPreferenceRequest{EventType: tc.eventType})
+			tc.checkResponse(t, err)
+		})
+	}
+}
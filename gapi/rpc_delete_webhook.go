@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	webhook, err := server.getWebhook(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.auditTx(ctx, authPayload, "webhook.delete", util.AuditTargetWebhook, func(store db.Store) (auditedChange, error) {
+		err := store.DeleteWebhook(ctx, webhook.ID)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(webhook.ID, 10), before: webhook}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %s", err)
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+func validateDeleteWebhookRequest(req *pb.DeleteWebhookRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.EraseUserResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateEraseUserRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if authPayload.Role != util.BankerRole && authPayload.Username != req.GetUsername() {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot erase other user")
+	}
+
+	var txResult db.EraseUserTxResult
+	err = server.auditTx(ctx, authPayload, "user.erase", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		txResult, err = store.EraseUserTx(ctx, db.EraseUserTxParams{Username: req.GetUsername()})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		// the diff would copy the erased personal data into the audit log
+		return auditedChange{targetID: txResult.User.Username}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist or is already erased", req.GetUsername())
+		}
+
+		if errors.Is(err, db.ErrAccountsNotSettled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to erase user: %s", err)
+	}
+
+	return &pb.EraseUserResponse{User: convertUser(txResult.User)}, nil
+}
+
+func validateEraseUserRequest(req *pb.EraseUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	return
+}
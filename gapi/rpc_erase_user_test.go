@@ -0,0 +1,127 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEraseUser(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+
+	erased := user
+	erased.FullName = "erased"
+	erased.Email = fmt.Sprintf("erased-%s@erased.invalid", util.RandomString(16))
+	erased.HashedPassword = ""
+	erased.ErasedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		body          *pb.EraseUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.EraseUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			body: &pb.EraseUserRequest{Username: user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.EraseUserTxParams{Username: user.Username}
+				store.EXPECT().EraseUserTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.EraseUserTxResult{User: erased}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "user.erase", arg.Action)
+						require.NotContains(t, string(arg.Diff), user.Email)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.EraseUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.Equal(t, erased.Email, res.GetUser().GetEmail())
+			},
+		},
+		{
+			name: "OtherDepositorCannotErase",
+			body: &pb.EraseUserRequest{Username: user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().EraseUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.EraseUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountsNotSettled",
+			body: &pb.EraseUserRequest{Username: user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().EraseUserTx(gomock.Any(), gomock.Any()).Times(1).Return(db.EraseUserTxResult{}, db.ErrAccountsNotSettled)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, other.Username, util.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.EraseUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AlreadyErased",
+			body: &pb.EraseUserRequest{Username: user.Username},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().EraseUserTx(gomock.Any(), gomock.Any()).Times(1).Return(db.EraseUserTxResult{}, db.ErrRecordNotFound)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.EraseUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.EraseUser(ctx, tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/statement"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportStatement returns the entries booked on the account between two business dates
+// as an ISO 20022 camt.053 statement. Any holder of the account can export it.
+func (server *Server) ExportStatement(ctx context.Context, req *pb.ExportStatementRequest) (*httpbody.HttpBody, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateExportStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := server.store.GetUser(ctx, account.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account owner: %s", err)
+	}
+
+	from, _ := time.Parse(util.BusinessDateLayout, req.GetFromDate())
+	to, _ := time.Parse(util.BusinessDateLayout, req.GetToDate())
+
+	openingBalance, err := statement.OpeningBalance(ctx, server.store, account.ID, from)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get opening balance: %s", err)
+	}
+
+	entries, err := server.store.ListStatementEntries(ctx, db.ListStatementEntriesParams{
+		AccountID: account.ID,
+		FromDate:  db.BusinessDate(from),
+		ToDate:    db.BusinessDate(to),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list statement entries: %s", err)
+	}
+
+	now := time.Now()
+	stmt := statement.Statement{
+		ID:             fmt.Sprintf("%d-%s-%s", account.ID, from.Format("20060102"), to.Format("20060102")),
+		CreatedAt:      now,
+		Account:        statement.Party{Name: owner.FullName, Account: account.Number},
+		Currency:       account.Currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		Entries:        make([]statement.Entry, len(entries)),
+	}
+
+	for i, entry := range entries {
+		stmt.Entries[i] = statement.NewEntry(entry)
+		stmt.ClosingBalance += entry.Amount
+	}
+
+	var buf bytes.Buffer
+	err = statement.WriteCamt053(&buf, fmt.Sprintf("STMT-%d", now.UnixNano()), now, stmt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write statement: %s", err)
+	}
+
+	return &httpbody.HttpBody{ContentType: "application/xml", Data: buf.Bytes()}, nil
+}
+
+func validateExportStatementRequest(req *pb.ExportStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	fromErr := val.ValidateBusinessDate(req.GetFromDate())
+	if fromErr != nil {
+		violations = append(violations, fieldViolation("from_date", fromErr))
+	}
+
+	toErr := val.ValidateBusinessDate(req.GetToDate())
+	if toErr != nil {
+		violations = append(violations, fieldViolation("to_date", toErr))
+	}
+
+	if fromErr != nil || toErr != nil {
+		return
+	}
+
+	from, _ := time.Parse(util.BusinessDateLayout, req.GetFromDate())
+	to, _ := time.Parse(util.BusinessDateLayout, req.GetToDate())
+	if to.Before(from) {
+		violations = append(violations, fieldViolation("to_date", fmt.Errorf("must not be before from_date")))
+	} else if to.Sub(from) >= statement.MaxDays*24*time.Hour {
+		violations = append(violations, fieldViolation("to_date", fmt.Errorf("a statement can cover at most %d days", statement.MaxDays)))
+	}
+
+	return
+}
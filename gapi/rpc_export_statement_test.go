@@ -0,0 +1,167 @@
+package gapi
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/statement"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+)
+
+// camt053Statement picks the balances and entries out of an exported statement.
+type camt053Statement struct {
+	Balances []struct {
+		Amount string `xml:"Amt"`
+	} `xml:"BkToCstmrStmt>Stmt>Bal"`
+	Entries []struct {
+		CreditDebit string `xml:"CdtDbtInd"`
+		Code        string `xml:"BkTxCd>Prtry>Cd"`
+		EndToEndID  string `xml:"NtryDtls>TxDtls>Refs>EndToEndId"`
+	} `xml:"BkToCstmrStmt>Stmt>Ntry"`
+}
+
+func TestExportStatementAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(owner.Username)
+	account.Balance = 10_000
+
+	from := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	entries := []db.ListStatementEntriesRow{
+		{
+			ID:                        101,
+			AccountID:                 account.ID,
+			Amount:                    2_500,
+			BusinessDate:              db.BusinessDate(from),
+			TransferID:                pgtype.Int8{Int64: 7, Valid: true},
+			CounterpartyAccountNumber: "XS71SIMP577474894439",
+		},
+		{
+			ID:                102,
+			AccountID:         account.ID,
+			Amount:            -1_000,
+			BusinessDate:      db.BusinessDate(to),
+			CashOperationKind: util.CashWithdrawal,
+		},
+	}
+
+	authFor := func(user db.User) func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return func(t *testing.T, tokenMaker token.Maker) context.Context {
+			return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ExportStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *httpbody.HttpBody, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ExportStatementRequest{AccountId: account.ID, FromDate: "2024-03-04", ToDate: "2024-03-05"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+				store.EXPECT().
+					GetAccountBalanceSnapshot(gomock.Any(), gomock.Eq(db.GetAccountBalanceSnapshotParams{
+						AccountID:    account.ID,
+						BusinessDate: db.BusinessDate(from.AddDate(0, 0, -1)),
+					})).
+					Times(1).
+					Return(db.AccountBalanceSnapshot{ClosingBalance: 8_500}, nil)
+				store.EXPECT().
+					ListStatementEntries(gomock.Any(), gomock.Eq(db.ListStatementEntriesParams{
+						AccountID: account.ID,
+						FromDate:  db.BusinessDate(from),
+						ToDate:    db.BusinessDate(to),
+					})).
+					Times(1).
+					Return(entries, nil)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "application/xml", res.GetContentType())
+
+				var got camt053Statement
+				require.NoError(t, xml.Unmarshal(res.GetData(), &got))
+
+				require.Len(t, got.Balances, 2)
+				require.Equal(t, "85.00", got.Balances[0].Amount)
+				require.Equal(t, "100.00", got.Balances[1].Amount)
+
+				require.Len(t, got.Entries, 2)
+				require.Equal(t, statement.CodeTransfer, got.Entries[0].Code)
+				require.Equal(t, "T7", got.Entries[0].EndToEndID)
+				require.Equal(t, "CASH_WITHDRAWAL", got.Entries[1].Code)
+				require.Equal(t, "DBIT", got.Entries[1].CreditDebit)
+			},
+		},
+		{
+			name: "InvalidPeriod",
+			req:  &pb.ExportStatementRequest{AccountId: account.ID, FromDate: "2024-03-05", ToDate: "2024-03-04"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "PeriodTooLong",
+			req:  &pb.ExportStatementRequest{AccountId: account.ID, FromDate: "2023-01-01", ToDate: "2024-03-04"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NotAHolder",
+			req:  &pb.ExportStatementRequest{AccountId: account.ID, FromDate: "2024-03-04", ToDate: "2024-03-05"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(other),
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.ExportStatement(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
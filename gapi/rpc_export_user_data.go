@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/Drolfothesgnir/simplebank/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateExportUserDataRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if authPayload.Role != util.BankerRole && authPayload.Username != req.GetUsername() {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot export other user's data")
+	}
+
+	user, err := server.store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.GetUsername())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if user.ErasedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] has been erased", user.Username)
+	}
+
+	var export db.DataExport
+	err = server.auditTx(ctx, authPayload, "user.export_data", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		export, err = store.CreateDataExport(ctx, user.Username)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: user.Username}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create data export: %s", err)
+	}
+
+	payload := &worker.PayloadExportUserData{ExportID: export.ID}
+	opts := []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Queue(worker.QueueDefault),
+		asynq.TaskID(fmt.Sprintf("data-export:%d", export.ID)),
+	}
+
+	err = server.taskDistributor.DistributeTaskExportUserData(ctx, payload, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule data export: %s", err)
+	}
+
+	return &pb.ExportUserDataResponse{DataExport: convertDataExport(export)}, nil
+}
+
+func validateExportUserDataRequest(req *pb.ExportUserDataRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	return
+}
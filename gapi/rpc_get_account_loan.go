@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAccountLoan returns a loan of an account the user owns or holds in any role
+// with the schedule of its installments.
+func (server *Server) GetAccountLoan(ctx context.Context, req *pb.GetAccountLoanRequest) (*pb.GetAccountLoanResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetAccountLoanRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	loan, err := server.store.GetLoan(ctx, req.GetLoanId())
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get loan: %s", err)
+	}
+
+	// a loan of another account is reported as missing
+	if err != nil || loan.AccountID != account.ID {
+		return nil, status.Errorf(codes.NotFound, "loan [%d] does not exist", req.GetLoanId())
+	}
+
+	installments, err := server.store.ListLoanInstallments(ctx, loan.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list loan installments: %s", err)
+	}
+
+	res := &pb.GetAccountLoanResponse{
+		Loan:         convertLoan(loan),
+		Installments: make([]*pb.LoanInstallment, len(installments)),
+	}
+
+	for i, installment := range installments {
+		res.Installments[i] = convertLoanInstallment(installment)
+	}
+
+	return res, nil
+}
+
+func validateGetAccountLoanRequest(req *pb.GetAccountLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateID(req.GetLoanId()); err != nil {
+		violations = append(violations, fieldViolation("loan_id", err))
+	}
+
+	return
+}
@@ -0,0 +1,129 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetAccountLoanAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+
+	account := randomAccount(owner.Username)
+
+	loan := db.Loan{
+		ID:                   util.RandomInt(1, 1000),
+		AccountID:            account.ID,
+		Principal:            120_00,
+		Currency:             account.Currency,
+		TermMonths:           12,
+		Method:               util.LoanAnnuity,
+		Status:               util.LoanActive,
+		OutstandingPrincipal: 120_00,
+	}
+
+	installments := []db.LoanInstallment{
+		{ID: 1, LoanID: loan.ID, Seq: 1, Principal: 10_00},
+		{ID: 2, LoanID: loan.ID, Seq: 2, Principal: 10_00},
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		req           *pb.GetAccountLoanRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetAccountLoanResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: owner.Username,
+			req:      &pb.GetAccountLoanRequest{AccountId: account.ID, LoanId: loan.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(loan, nil)
+				store.EXPECT().ListLoanInstallments(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(installments, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountLoanResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, loan.ID, res.GetLoan().GetId())
+				require.Len(t, res.GetInstallments(), len(installments))
+			},
+		},
+		{
+			name:     "LoanOfAnotherAccount",
+			username: owner.Username,
+			req:      &pb.GetAccountLoanRequest{AccountId: account.ID, LoanId: loan.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				otherLoan := loan
+				otherLoan.AccountID = account.ID + 1
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(otherLoan, nil)
+				store.EXPECT().ListLoanInstallments(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountLoanResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: owner.Username,
+			req:      &pb.GetAccountLoanRequest{AccountId: account.ID, LoanId: loan.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(db.Loan{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountLoanResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name:     "Unrelated",
+			username: other.Username,
+			req:      &pb.GetAccountLoanRequest{AccountId: account.ID, LoanId: loan.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountLoanResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name:     "InvalidLoanID",
+			username: owner.Username,
+			req:      &pb.GetAccountLoanRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountLoanResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, tc.username, util.DepositorRole, time.Minute)
+			res, err := server.GetAccountLoan(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
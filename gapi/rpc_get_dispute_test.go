@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetDisputeAPI(t *testing.T) {
+	owner, _ := createRandomUser(t, util.DepositorRole)
+	viewer, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+
+	account := randomAccount(owner.Username)
+
+	dispute := db.Dispute{
+		ID:         util.RandomInt(1, 1000),
+		TransferID: util.RandomInt(1, 1000),
+		AccountID:  account.ID,
+		OpenedBy:   owner.Username,
+		Reason:     "not authorized",
+		Status:     util.DisputeOpen,
+	}
+
+	authFor := func(user db.User) func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return func(t *testing.T, tokenMaker token.Maker) context.Context {
+			return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetDisputeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetDisputeResponse, err error)
+	}{
+		{
+			name: "Owner",
+			req:  &pb.GetDisputeRequest{Id: dispute.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *pb.GetDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dispute.ID, res.GetDispute().GetId())
+				require.Equal(t, util.DisputeOpen, res.GetDispute().GetStatus())
+			},
+		},
+		{
+			name: "Viewer",
+			req:  &pb.GetDisputeRequest{Id: dispute.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(db.GetAccountHolderParams{AccountID: account.ID, Username: viewer.Username})).
+					Times(1).
+					Return(db.AccountHolder{AccountID: account.ID, Username: viewer.Username, Role: util.ViewerHolderRole}, nil)
+			},
+			setupAuth: authFor(viewer),
+			checkResponse: func(t *testing.T, res *pb.GetDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dispute.ID, res.GetDispute().GetId())
+			},
+		},
+		{
+			name: "Unrelated",
+			req:  &pb.GetDisputeRequest{Id: dispute.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+			},
+			setupAuth: authFor(other),
+			checkResponse: func(t *testing.T, res *pb.GetDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.GetDisputeRequest{Id: dispute.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(db.Dispute{}, db.ErrRecordNotFound)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *pb.GetDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidID",
+			req:  &pb.GetDisputeRequest{Id: 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(owner),
+			checkResponse: func(t *testing.T, res *pb.GetDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.GetDispute(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAnalyticsDays is the longest range a single analytics request can cover.
+const maxAnalyticsDays = 366
+
+// Periods the spending totals are grouped by.
+const (
+	analyticsPeriodWeek  = "week"
+	analyticsPeriodMonth = "month"
+)
+
+// GetSpendingAnalytics returns the debits and credits of the accounts the user owns by category
+// and by week or month, from the first day of the range to the last day included.
+func (server *Server) GetSpendingAnalytics(ctx context.Context, req *pb.GetSpendingAnalyticsRequest) (*pb.GetSpendingAnalyticsResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetSpendingAnalyticsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	from, _ := time.Parse(util.BusinessDateLayout, req.GetFromDate())
+	to, _ := time.Parse(util.BusinessDateLayout, req.GetToDate())
+
+	rows, err := server.store.GetSpendingByCategory(ctx, db.GetSpendingByCategoryParams{
+		Username: authPayload.Username,
+		FromTime: from,
+		ToTime:   to.AddDate(0, 0, 1),
+		Period:   req.GetPeriod(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spending totals: %s", err)
+	}
+
+	res := &pb.GetSpendingAnalyticsResponse{}
+	for _, row := range rows {
+		res.Totals = append(res.Totals, convertSpendingTotal(row))
+	}
+
+	return res, nil
+}
+
+func validateGetSpendingAnalyticsRequest(req *pb.GetSpendingAnalyticsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetPeriod() != analyticsPeriodWeek && req.GetPeriod() != analyticsPeriodMonth {
+		violations = append(violations, fieldViolation("period", fmt.Errorf("must be %s or %s", analyticsPeriodWeek, analyticsPeriodMonth)))
+	}
+
+	if err := val.ValidateBusinessDate(req.GetFromDate()); err != nil {
+		violations = append(violations, fieldViolation("from_date", err))
+	}
+
+	if err := val.ValidateBusinessDate(req.GetToDate()); err != nil {
+		violations = append(violations, fieldViolation("to_date", err))
+	}
+
+	if violations != nil {
+		return
+	}
+
+	from, _ := time.Parse(util.BusinessDateLayout, req.GetFromDate())
+	to, _ := time.Parse(util.BusinessDateLayout, req.GetToDate())
+	if to.Before(from) {
+		violations = append(violations, fieldViolation("to_date", errors.New("must not be before from_date")))
+	} else if to.Sub(from) >= maxAnalyticsDays*24*time.Hour {
+		violations = append(violations, fieldViolation("to_date", fmt.Errorf("the range can cover at most %d days", maxAnalyticsDays)))
+	}
+
+	return
+}
@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetSpendingAnalytics(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	periodStart := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetSpendingAnalyticsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetSpendingAnalyticsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.GetSpendingAnalyticsRequest{Period: "month", FromDate: "2026-03-01", ToDate: "2026-03-31"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetSpendingByCategoryParams{
+					Username: user.Username,
+					FromTime: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+					ToTime:   time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+					Period:   "month",
+				}
+				rows := []db.GetSpendingByCategoryRow{
+					{PeriodStart: periodStart, Category: "groceries", Currency: util.USD, TotalDebits: 120, EntryCount: 3},
+					{PeriodStart: periodStart, Category: "uncategorized", Currency: util.USD, TotalCredits: 500, EntryCount: 1},
+				}
+				store.EXPECT().GetSpendingByCategory(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rows, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSpendingAnalyticsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetTotals(), 2)
+				require.Equal(t, "groceries", res.GetTotals()[0].GetCategory())
+				require.Equal(t, int64(120), res.GetTotals()[0].GetTotalDebits())
+				require.True(t, periodStart.Equal(res.GetTotals()[0].GetPeriodStart().AsTime()))
+			},
+		},
+		{
+			name: "InvalidPeriod",
+			req:  &pb.GetSpendingAnalyticsRequest{Period: "year", FromDate: "2026-03-01", ToDate: "2026-03-31"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSpendingByCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSpendingAnalyticsResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ToDateBeforeFromDate",
+			req:  &pb.GetSpendingAnalyticsRequest{Period: "week", FromDate: "2026-03-31", ToDate: "2026-03-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSpendingByCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSpendingAnalyticsResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "RangeTooLong",
+			req:  &pb.GetSpendingAnalyticsRequest{Period: "month", FromDate: "2024-01-01", ToDate: "2026-01-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSpendingByCategory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSpendingAnalyticsResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+			res, err := server.GetSpendingAnalytics(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
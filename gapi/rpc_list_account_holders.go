@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAccountHolders lists the holders of the account to anyone who holds it.
+func (server *Server) ListAccountHolders(ctx context.Context, req *pb.ListAccountHoldersRequest) (*pb.ListAccountHoldersResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	holders, err := server.store.ListAccountHolders(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account holders: %s", err)
+	}
+
+	res := &pb.ListAccountHoldersResponse{}
+	for _, holder := range holders {
+		res.Holders = append(res.Holders, convertAccountHolder(holder))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	accessibleRoles := []string{util.BankerRole}
+	_, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListAuditEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListAuditEventsParams{
+		Actor: pgtype.Text{
+			String: req.GetActor(),
+			Valid:  req.Actor != nil,
+		},
+		TargetType: pgtype.Text{
+			String: req.GetTargetType(),
+			Valid:  req.TargetType != nil,
+		},
+		TargetID: pgtype.Text{
+			String: req.GetTargetId(),
+			Valid:  req.TargetId != nil,
+		},
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	if req.FromTime != nil {
+		arg.FromTime = pgtype.Timestamptz{Time: req.FromTime.AsTime(), Valid: true}
+	}
+
+	if req.ToTime != nil {
+		arg.ToTime = pgtype.Timestamptz{Time: req.ToTime.AsTime(), Valid: true}
+	}
+
+	events, err := server.store.ListAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %s", err)
+	}
+
+	res := &pb.ListAuditEventsResponse{}
+	for _, event := range events {
+		res.Events = append(res.Events, convertAuditEvent(event))
+	}
+
+	return res, nil
+}
+
+func validateListAuditEventsRequest(req *pb.ListAuditEventsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Actor != nil {
+		if err := val.ValidateUsername(*req.Actor); err != nil {
+			violations = append(violations, fieldViolation("actor", err))
+		}
+	}
+
+	if req.TargetType != nil && !util.IsSupportedAuditTarget(*req.TargetType) {
+		violations = append(violations, fieldViolation("target_type", fmt.Errorf("unsupported target type")))
+	}
+
+	if req.FromTime != nil && req.ToTime != nil && req.FromTime.AsTime().After(req.ToTime.AsTime()) {
+		violations = append(violations, fieldViolation("to_time", fmt.Errorf("must not be before from_time")))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
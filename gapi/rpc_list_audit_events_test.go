@@ -0,0 +1,138 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	fromTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	toTime := time.Now().Truncate(time.Second)
+	targetType := util.AuditTargetUser
+
+	event := db.AuditEvent{
+		ID:         util.RandomInt(1, 1000),
+		Actor:      depositor.Username,
+		ActorRole:  depositor.Role,
+		Action:     "user.update",
+		TargetType: util.AuditTargetUser,
+		TargetID:   depositor.Username,
+		Diff:       []byte(`{"email":{"before":"a@example.com","after":"b@example.com"}}`),
+		CreatedAt:  toTime,
+	}
+
+	testCases := []struct {
+		name          string
+		body          *pb.ListAuditEventsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAuditEventsResponse, err error)
+	}{
+		{
+			name: "OK",
+			body: &pb.ListAuditEventsRequest{
+				Actor:      &depositor.Username,
+				TargetType: &targetType,
+				FromTime:   timestamppb.New(fromTime),
+				ToTime:     timestamppb.New(toTime),
+				PageId:     2,
+				PageSize:   5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAuditEventsParams{
+					Actor:      pgtype.Text{String: depositor.Username, Valid: true},
+					TargetType: pgtype.Text{String: util.AuditTargetUser, Valid: true},
+					FromTime:   pgtype.Timestamptz{Time: fromTime.UTC(), Valid: true},
+					ToTime:     pgtype.Timestamptz{Time: toTime.UTC(), Valid: true},
+					Limit:      5,
+					Offset:     5,
+				}
+
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.AuditEvent{event}, nil)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEvents(), 1)
+				got := res.GetEvents()[0]
+				require.Equal(t, event.ID, got.GetId())
+				require.Equal(t, event.Action, got.GetAction())
+				require.Equal(t, string(event.Diff), got.GetDiff())
+			},
+		},
+		{
+			name: "DepositorCannotListAuditEvents",
+			body: &pb.ListAuditEventsRequest{
+				PageId:   1,
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidTimeRange",
+			body: &pb.ListAuditEventsRequest{
+				FromTime: timestamppb.New(toTime),
+				ToTime:   timestamppb.New(fromTime),
+				PageId:   1,
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.ListAuditEvents(ctx, tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListHeldTransfers(ctx context.Context, req *pb.ListHeldTransfersRequest) (*pb.ListHeldTransfersResponse, error) {
+	accessibleRoles := []string{util.BankerRole}
+	_, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListHeldTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListTransfersByStatusParams{
+		Status: util.TransferHeld,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	transfers, err := server.store.ListTransfersByStatus(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list held transfers: %s", err)
+	}
+
+	res := &pb.ListHeldTransfersResponse{}
+	for _, transfer := range transfers {
+		hits, err := server.store.ListFraudRuleHits(ctx, transfer.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list fraud rule hits: %s", err)
+		}
+
+		held := &pb.HeldTransfer{Transfer: convertTransfer(transfer)}
+		for _, hit := range hits {
+			held.RuleHits = append(held.RuleHits, convertFraudRuleHit(hit))
+		}
+
+		res.Transfers = append(res.Transfers, held)
+	}
+
+	return res, nil
+}
+
+func validateListHeldTransfersRequest(req *pb.ListHeldTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return
+}
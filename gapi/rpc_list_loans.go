@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListLoans lists the loans of an account the user owns or holds in any role
+// with their outstanding principal and interest.
+func (server *Server) ListLoans(ctx context.Context, req *pb.ListLoansRequest) (*pb.ListLoansResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	loans, err := server.store.ListLoans(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list loans: %s", err)
+	}
+
+	res := &pb.ListLoansResponse{}
+	for _, loan := range loans {
+		res.Loans = append(res.Loans, convertLoan(loan))
+	}
+
+	return res, nil
+}
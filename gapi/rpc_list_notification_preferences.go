@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListNotificationPreferences(ctx context.Context, req *pb.ListNotificationPreferencesRequest) (*pb.ListNotificationPreferencesResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	preferences, err := server.store.ListNotificationPreferences(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notification preferences: %s", err)
+	}
+
+	res := &pb.ListNotificationPreferencesResponse{}
+	for _, preference := range preferences {
+		res.Preferences = append(res.Preferences, convertNotificationPreference(preference))
+	}
+
+	return res, nil
+}
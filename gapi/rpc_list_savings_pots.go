@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSavingsPots lists the savings pots of an account the user owns or holds in any role.
+func (server *Server) ListSavingsPots(ctx context.Context, req *pb.ListSavingsPotsRequest) (*pb.ListSavingsPotsResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.ViewerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	pots, err := server.store.ListSavingsPots(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list savings pots: %s", err)
+	}
+
+	res := &pb.ListSavingsPotsResponse{}
+	for _, pot := range pots {
+		res.Pots = append(res.Pots, convertSavingsPot(pot))
+	}
+
+	return res, nil
+}
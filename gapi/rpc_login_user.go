@@ -20,7 +20,8 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, invalidArgumentError(violations)
 	}
 
-	user, err := server.store.GetUser(ctx, req.GetUsername())
+	// the user is read from the primary, so a password that was just changed or a lock just set takes effect at once
+	user, err := server.store.GetUser(db.WithReadYourWrites(ctx), req.GetUsername())
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.GetUsername())
@@ -29,11 +30,17 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, status.Error(codes.Internal, "failed to get user")
 	}
 
+	// the password is checked first, so the lock of an account is not revealed to whoever guesses its username,
+	// an erased user has no password hash and never gets past it
 	err = util.CheckPassword(req.GetPassword(), user.HashedPassword)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Invalid password")
 	}
 
+	if user.LockedAt.Valid {
+		return nil, status.Errorf(codes.PermissionDenied, "user [%s] is locked", req.GetUsername())
+	}
+
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create access token")
@@ -56,8 +63,18 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		ExpiresAt:    refreshPayload.ExpiredAt,
 	}
 
-	session, err := server.store.CreateSession(ctx, sessionParams)
+	// the login is audited on behalf of the user it authenticates, without the tokens of the session
+	var session db.Session
+	err = server.auditTx(ctx, accessPayload, "session.create", util.AuditTargetSession, func(store db.Store) (auditedChange, error) {
+		var err error
+		session, err = store.CreateSession(ctx, sessionParams)
+		if err != nil {
+			return auditedChange{}, err
+		}
 
+		after := map[string]any{"id": session.ID, "expires_at": session.ExpiresAt}
+		return auditedChange{targetID: session.ID.String(), after: after}, nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create session")
 	}
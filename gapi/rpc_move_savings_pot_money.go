@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// MoveSavingsPotMoney moves money from the main balance into the pot or back. Only the available balance,
+// which excludes the money already in pots, can be moved into a pot.
+func (server *Server) MoveSavingsPotMoney(ctx context.Context, req *pb.MoveSavingsPotMoneyRequest) (*pb.MoveSavingsPotMoneyResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateMoveSavingsPotMoneyRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := req.GetAmount()
+	if req.GetDirection() == util.PotMoveOut {
+		amount = -amount
+	}
+
+	var result db.MovePotMoneyTxResult
+	err = server.auditTx(ctx, authPayload, "savings_pot.move", util.AuditTargetSavingsPot, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.MovePotMoneyTx(ctx, db.MovePotMoneyTxParams{
+			AccountID: account.ID,
+			PotID:     req.GetPotId(),
+			Amount:    amount,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Pot.ID, 10), after: result.Entry}, nil
+	})
+	if err != nil {
+		return nil, savingsPotError(err, req.GetPotId())
+	}
+
+	res := &pb.MoveSavingsPotMoneyResponse{
+		Pot:     convertSavingsPot(result.Pot),
+		Account: convertAccount(result.Account),
+		Entry:   convertPotEntry(result.Entry),
+	}
+
+	return res, nil
+}
+
+func validateMoveSavingsPotMoneyRequest(req *pb.MoveSavingsPotMoneyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateID(req.GetPotId()); err != nil {
+		violations = append(violations, fieldViolation("pot_id", err))
+	}
+
+	if req.GetDirection() != util.PotMoveIn && req.GetDirection() != util.PotMoveOut {
+		violations = append(violations, fieldViolation("direction", fmt.Errorf("must be %s or %s", util.PotMoveIn, util.PotMoveOut)))
+	}
+
+	if req.GetAmount() <= 0 {
+		violations = append(violations, fieldViolation("amount", fmt.Errorf("must be a positive integer")))
+	}
+
+	return
+}
@@ -0,0 +1,157 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMoveSavingsPotMoneyAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(user.Username)
+	pot := db.SavingsPot{ID: util.RandomInt(1, 1000), AccountID: account.ID, Name: "holiday", TargetAmount: 500, Status: util.PotOpen}
+
+	authFor := func(user db.User) func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return func(t *testing.T, tokenMaker token.Maker) context.Context {
+			return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.MoveSavingsPotMoneyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error)
+	}{
+		{
+			name: "In",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveIn, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: 30}
+				moved := pot
+				moved.Balance = 30
+				result := db.MovePotMoneyTxResult{
+					Pot:     moved,
+					Account: db.Account{ID: account.ID, Balance: account.Balance, PotBalance: 30},
+					Entry:   db.PotEntry{ID: 1, PotID: pot.ID, AccountID: account.ID, Amount: 30},
+				}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: authFor(user),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(30), res.GetPot().GetBalance())
+				require.Equal(t, int64(30), res.GetAccount().GetPotBalance())
+				require.Equal(t, int64(30), res.GetEntry().GetAmount())
+			},
+		},
+		{
+			name: "Out",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveOut, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.MovePotMoneyTxParams{AccountID: account.ID, PotID: pot.ID, Amount: -30}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MovePotMoneyTxResult{Pot: pot}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: authFor(user),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveIn, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MovePotMoneyTxResult{}, db.ErrInsufficientFunds)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(user),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "PotNotFound",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveIn, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MovePotMoneyTxResult{}, db.ErrRecordNotFound)
+			},
+			setupAuth: authFor(user),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "ViewerCannotMove",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveIn, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				holder := db.AccountHolder{AccountID: account.ID, Username: other.Username, Role: util.ViewerHolderRole}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(holder, nil)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(other),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "InvalidDirection",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: "sideways", Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: authFor(user),
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.MoveSavingsPotMoneyRequest{AccountId: account.ID, PotId: pot.ID, Direction: util.PotMoveIn, Amount: 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().MovePotMoneyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.MoveSavingsPotMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.MoveSavingsPotMoney(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenDispute lets a holder who can spend from the account dispute a completed transfer paid from it.
+// A transfer can only be disputed once.
+func (server *Server) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.OpenDisputeResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateOpenDisputeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer [%d] does not exist", req.GetTransferId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	account, err := server.getAccount(ctx, transfer.FromAccountID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, util.CoOwnerHolderRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.Status != util.TransferCompleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer [%d] is %s, only completed transfers can be disputed", transfer.ID, transfer.Status)
+	}
+
+	var dispute db.Dispute
+	err = server.auditTx(ctx, authPayload, "dispute.open", util.AuditTargetDispute, func(store db.Store) (auditedChange, error) {
+		var err error
+		dispute, err = store.OpenDisputeTx(ctx, db.OpenDisputeTxParams{
+			TransferID: transfer.ID,
+			AccountID:  account.ID,
+			OpenedBy:   authPayload.Username,
+			Reason:     req.GetReason(),
+			Evidence:   req.GetEvidence(),
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(dispute.ID, 10), after: dispute}, nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "transfer [%d] is already disputed", transfer.ID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to open dispute: %s", err)
+	}
+
+	return &pb.OpenDisputeResponse{Dispute: convertDispute(dispute)}, nil
+}
+
+func validateOpenDisputeRequest(req *pb.OpenDisputeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	if err := val.ValidateDisputeReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	if err := val.ValidateDisputeEvidence(req.GetEvidence()); err != nil {
+		violations = append(violations, fieldViolation("evidence", err))
+	}
+
+	return
+}
@@ -0,0 +1,150 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestOpenDisputeAPI(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	other, _ := createRandomUser(t, util.DepositorRole)
+	account := randomAccount(user.Username)
+	payee := randomAccount(other.Username)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: account.ID,
+		ToAccountID:   payee.ID,
+		Amount:        util.RandomMoney(),
+		Status:        util.TransferCompleted,
+	}
+
+	dispute := db.Dispute{
+		ID:         util.RandomInt(1, 1000),
+		TransferID: transfer.ID,
+		AccountID:  account.ID,
+		OpenedBy:   user.Username,
+		Reason:     "not authorized",
+		Evidence:   "I was abroad",
+		Status:     util.DisputeOpen,
+	}
+
+	req := &pb.OpenDisputeRequest{
+		TransferId: transfer.ID,
+		Reason:     dispute.Reason,
+		Evidence:   dispute.Evidence,
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		req           *pb.OpenDisputeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.OpenDisputeResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: user.Username,
+			req:      req,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.OpenDisputeTxParams{
+					TransferID: transfer.ID,
+					AccountID:  account.ID,
+					OpenedBy:   user.Username,
+					Reason:     dispute.Reason,
+					Evidence:   dispute.Evidence,
+				}
+
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().OpenDisputeTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(dispute, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.OpenDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dispute.ID, res.GetDispute().GetId())
+				require.Equal(t, util.DisputeOpen, res.GetDispute().GetStatus())
+				require.Zero(t, res.GetDispute().GetCreditEntryId())
+			},
+		},
+		{
+			name:     "PayeeCannotDispute",
+			username: other.Username,
+			req:      req,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().OpenDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.OpenDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name:     "NotCompleted",
+			username: user.Username,
+			req:      req,
+			buildStubs: func(store *mockdb.MockStore) {
+				held := transfer
+				held.Status = util.TransferHeld
+
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(held, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().OpenDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.OpenDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:     "AlreadyDisputed",
+			username: user.Username,
+			req:      req,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().OpenDisputeTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Dispute{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			checkResponse: func(t *testing.T, res *pb.OpenDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.AlreadyExists)
+			},
+		},
+		{
+			name:     "MissingEvidence",
+			username: user.Username,
+			req:      &pb.OpenDisputeRequest{TransferId: transfer.ID, Reason: dispute.Reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.OpenDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, tc.username, util.DepositorRole, time.Minute)
+			res, err := server.OpenDispute(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
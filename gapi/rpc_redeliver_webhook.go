@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"github.com/Drolfothesgnir/simplebank/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRedeliverWebhookRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	delivery, err := server.store.GetWebhookDelivery(ctx, req.GetDeliveryId())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery [%d] does not exist", req.GetDeliveryId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %s", err)
+	}
+
+	_, err = server.getWebhook(ctx, authPayload, delivery.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.recordAudit(ctx, authPayload, "webhook.redeliver", util.AuditTargetWebhook, strconv.FormatInt(delivery.WebhookID, 10), nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %s", err)
+	}
+
+	// a manual redelivery is a single attempt, the automatic retries are over by now
+	payload := &worker.PayloadDeliverWebhook{DeliveryID: delivery.ID}
+	opts := []asynq.Option{
+		asynq.MaxRetry(0),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	err = server.taskDistributor.DistributeTaskDeliverWebhook(ctx, payload, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook: %s", err)
+	}
+
+	return &pb.RedeliverWebhookResponse{Delivery: convertWebhookDelivery(delivery)}, nil
+}
+
+func validateRedeliverWebhookRequest(req *pb.RedeliverWebhookRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetDeliveryId()); err != nil {
+		violations = append(violations, fieldViolation("delivery_id", err))
+	}
+
+	return
+}
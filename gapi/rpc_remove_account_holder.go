@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RemoveAccountHolder lets an owner remove any holder, and any holder remove themselves.
+func (server *Server) RemoveAccountHolder(ctx context.Context, req *pb.RemoveAccountHolderRequest) (*pb.RemoveAccountHolderResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRemoveAccountHolderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccount(ctx, req.GetAccountId(), req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	requiredRole := util.OwnerHolderRole
+	if req.GetUsername() == authPayload.Username {
+		requiredRole = util.ViewerHolderRole
+	}
+
+	err = server.authorizeAccount(ctx, account, authPayload.Username, requiredRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUsername() == account.Owner {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot remove the account owner")
+	}
+
+	arg := db.DeleteAccountHolderParams{
+		AccountID: account.ID,
+		Username:  req.GetUsername(),
+	}
+
+	err = server.auditTx(ctx, authPayload, "account_holder.delete", util.AuditTargetAccount, func(store db.Store) (auditedChange, error) {
+		err := store.DeleteAccountHolder(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: strconv.FormatInt(account.ID, 10), before: arg}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove account holder: %s", err)
+	}
+
+	return &pb.RemoveAccountHolderResponse{}, nil
+}
+
+func validateRemoveAccountHolderRequest(req *pb.RemoveAccountHolderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateTargetAccount(req.GetAccountId(), req.GetAccountNumber())
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	return
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReleaseTransfer(ctx context.Context, req *pb.ReviewTransferRequest) (*pb.ReviewTransferResponse, error) {
+	return server.reviewTransfer(ctx, req, true)
+}
+
+func (server *Server) RejectTransfer(ctx context.Context, req *pb.ReviewTransferRequest) (*pb.ReviewTransferResponse, error) {
+	return server.reviewTransfer(ctx, req, false)
+}
+
+// reviewTransfer lets a banker other than the initiator release or reject a transfer held by the fraud checks.
+func (server *Server) reviewTransfer(ctx context.Context, req *pb.ReviewTransferRequest, release bool) (*pb.ReviewTransferResponse, error) {
+	accessibleRoles := []string{util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ReviewTransferTxParams{
+		TransferID: req.GetId(),
+		ReviewedBy: authPayload.Username,
+		Release:    release,
+	}
+
+	action := "transfer.reject"
+	if release {
+		action = "transfer.release"
+	}
+
+	var result db.TransferTxResult
+	err = server.auditTx(ctx, authPayload, action, util.AuditTargetTransfer, func(store db.Store) (auditedChange, error) {
+		var err error
+		result, err = store.ReviewTransferTx(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		before := map[string]string{"status": util.TransferHeld}
+		after := map[string]string{"status": result.Transfer.Status}
+
+		return auditedChange{targetID: strconv.FormatInt(result.Transfer.ID, 10), before: before, after: after}, nil
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrTransferNotHeld) {
+			return nil, status.Errorf(codes.FailedPrecondition, "transfer [%d] is not held for review", req.GetId())
+		}
+
+		if errors.Is(err, db.ErrSelfReview) {
+			return nil, status.Errorf(codes.PermissionDenied, "transfer [%d]: %s", req.GetId(), err)
+		}
+
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "transfer [%d] can't be released: the account has insufficient funds", req.GetId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to review transfer: %s", err)
+	}
+
+	return &pb.ReviewTransferResponse{Transfer: convertTransfer(result.Transfer)}, nil
+}
+
+func validateReviewTransferRequest(req *pb.ReviewTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return
+}
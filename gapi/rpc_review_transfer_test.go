@@ -0,0 +1,162 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReviewTransfer(t *testing.T) {
+	banker, _ := createRandomUser(t, util.BankerRole)
+	depositor, _ := createRandomUser(t, util.DepositorRole)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1001, 2000),
+		Amount:        util.RandomMoney(),
+		ReviewedBy:    pgtype.Text{String: banker.Username, Valid: true},
+		ReviewedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		release       bool
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReviewTransferResponse, err error)
+	}{
+		{
+			name:    "Release",
+			release: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReviewTransferTxParams{TransferID: transfer.ID, ReviewedBy: banker.Username, Release: true}
+				released := transfer
+				released.Status = util.TransferCompleted
+
+				store.EXPECT().ReviewTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{Transfer: released}, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "transfer.release", arg.Action)
+						require.Equal(t, banker.Username, arg.Actor)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, transfer.ID, res.GetTransfer().GetId())
+				require.Equal(t, util.TransferCompleted, res.GetTransfer().GetStatus())
+				require.Equal(t, banker.Username, res.GetTransfer().GetReviewedBy())
+			},
+		},
+		{
+			name:    "Reject",
+			release: false,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReviewTransferTxParams{TransferID: transfer.ID, ReviewedBy: banker.Username, Release: false}
+				rejected := transfer
+				rejected.Status = util.TransferRejected
+
+				store.EXPECT().ReviewTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{Transfer: rejected}, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.TransferRejected, res.GetTransfer().GetStatus())
+			},
+		},
+		{
+			name:    "DepositorCannotReview",
+			release: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReviewTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name:    "SelfReview",
+			release: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReviewTransferTxParams{TransferID: transfer.ID, ReviewedBy: banker.Username, Release: true}
+				store.EXPECT().ReviewTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{}, db.ErrSelfReview)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name:    "NotHeld",
+			release: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReviewTransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrTransferNotHeld)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			req := &pb.ReviewTransferRequest{Id: transfer.ID}
+
+			var res *pb.ReviewTransferResponse
+			var err error
+			if tc.release {
+				res, err = server.ReleaseTransfer(ctx, req)
+			} else {
+				res, err = server.RejectTransfer(ctx, req)
+			}
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
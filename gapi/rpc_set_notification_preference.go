@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetNotificationPreference subscribes the user to an event type or updates the subscription.
+// An empty channel defaults to email.
+func (server *Server) SetNotificationPreference(ctx context.Context, req *pb.SetNotificationPreferenceRequest) (*pb.SetNotificationPreferenceResponse, error) {
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetNotificationPreferenceRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	channel := req.GetChannel()
+	if channel == "" {
+		channel = util.NotificationChannelEmail
+	}
+
+	var preference db.NotificationPreference
+	err = server.auditTx(ctx, authPayload, "notification_preference.set", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		preference, err = store.UpsertNotificationPreference(ctx, db.UpsertNotificationPreferenceParams{
+			Username:  authPayload.Username,
+			EventType: req.GetEventType(),
+			MinAmount: req.GetMinAmount(),
+			Channel:   channel,
+		})
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: authPayload.Username, after: preference}, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set notification preference: %s", err)
+	}
+
+	return &pb.SetNotificationPreferenceResponse{Preference: convertNotificationPreference(preference)}, nil
+}
+
+func validateSetNotificationPreferenceRequest(req *pb.SetNotificationPreferenceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !util.IsSupportedNotificationEventType(req.GetEventType()) {
+		violations = append(violations, fieldViolation("event_type", fmt.Errorf("must be %s or %s", util.NotificationMoneyIn, util.NotificationMoneyOut)))
+	}
+
+	if req.GetMinAmount() < 0 {
+		violations = append(violations, fieldViolation("min_amount", fmt.Errorf("must not be negative")))
+	}
+
+	if req.GetChannel() != "" && !util.IsSupportedNotificationChannel(req.GetChannel()) {
+		violations = append(violations, fieldViolation("channel", fmt.Errorf("unsupported channel")))
+	}
+
+	return
+}
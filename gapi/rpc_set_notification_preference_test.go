@@ -0,0 +1,118 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetNotificationPreference(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+
+	preference := db.NotificationPreference{
+		Username:  user.Username,
+		EventType: util.NotificationMoneyIn,
+		MinAmount: 100,
+		Channel:   util.NotificationChannelEmail,
+		UpdatedAt: time.Now(),
+		CreatedAt: time.Now(),
+	}
+
+	userAuth := func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return setAuthorizationHeader(t, tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetNotificationPreferenceRequest
+		buildStubs    func(store *mockdb.MockStore)
+		setupAuth     func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SetNotificationPreferenceResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.SetNotificationPreferenceRequest{EventType: util.NotificationMoneyIn, MinAmount: 100},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertNotificationPreferenceParams{
+					Username:  user.Username,
+					EventType: util.NotificationMoneyIn,
+					MinAmount: 100,
+					Channel:   util.NotificationChannelEmail,
+				}
+				store.EXPECT().UpsertNotificationPreference(gomock.Any(), gomock.Eq(arg)).Times(1).Return(preference, nil)
+				store.EXPECT().
+					CreateAuditEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+						require.Equal(t, "notification_preference.set", arg.Action)
+						require.Equal(t, util.AuditTargetUser, arg.TargetType)
+						require.Equal(t, user.Username, arg.TargetID)
+						return db.AuditEvent{}, nil
+					})
+			},
+			setupAuth: userAuth,
+			checkResponse: func(t *testing.T, res *pb.SetNotificationPreferenceResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.NotificationMoneyIn, res.GetPreference().GetEventType())
+				require.Equal(t, int64(100), res.GetPreference().GetMinAmount())
+				require.Equal(t, util.NotificationChannelEmail, res.GetPreference().GetChannel())
+			},
+		},
+		{
+			name: "InvalidArguments",
+			req:  &pb.SetNotificationPreferenceRequest{EventType: "salary", MinAmount: -1, Channel: "pigeon"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertNotificationPreference(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: userAuth,
+			checkResponse: func(t *testing.T, res *pb.SetNotificationPreferenceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.SetNotificationPreferenceRequest{EventType: util.NotificationMoneyIn, MinAmount: 100},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertNotificationPreference(gomock.Any(), gomock.Any()).Times(0)
+			},
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.SetNotificationPreferenceResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.setupAuth(t, server.tokenMaker)
+			res, err := server.SetNotificationPreference(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
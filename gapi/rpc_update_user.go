@@ -33,6 +33,19 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
 	}
 
+	before, err := server.store.GetUser(db.WithReadYourWrites(ctx), req.GetUsername())
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "user [%s] does not exist", req.Username)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if before.ErasedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "user [%s] has been erased", before.Username)
+	}
+
 	arg := db.UpdateUserParams{
 		Username: req.Username,
 		FullName: pgtype.Text{String: req.GetFullName(), Valid: req.FullName != nil},
@@ -50,7 +63,16 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		arg.PasswordChangedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
 	}
 
-	user, err := server.store.UpdateUser(ctx, arg)
+	var user db.User
+	err = server.auditTx(ctx, authPayload, "user.update", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		user, err = store.UpdateUser(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{targetID: user.Username, before: before, after: user}, nil
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
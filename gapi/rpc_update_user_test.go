@@ -42,6 +42,8 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+
 				arg := db.UpdateUserParams{
 					Username: user.Username,
 					FullName: pgtype.Text{String: newUser.FullName, Valid: true},
@@ -49,6 +51,7 @@ func TestUpdateUser(t *testing.T) {
 				}
 
 				store.EXPECT().UpdateUser(gomock.Any(), gomock.Eq(arg)).Times(1).Return(newUser, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
 
 			},
 			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -93,6 +96,8 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+
 				arg := db.UpdateUserParams{
 					Username: user.Username,
 					FullName: pgtype.Text{String: newUser.FullName, Valid: true},
@@ -100,6 +105,7 @@ func TestUpdateUser(t *testing.T) {
 				}
 
 				store.EXPECT().UpdateUser(gomock.Any(), gomock.Eq(arg)).Times(1).Return(newUser, nil)
+				store.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Times(1)
 
 			},
 			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -211,6 +217,7 @@ func TestUpdateUser(t *testing.T) {
 					ConstraintName: "users_email_key",
 				}
 
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
 				store.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, err)
 
 			},
@@ -232,7 +239,8 @@ func TestUpdateUser(t *testing.T) {
 				Email:    &newUser.Email,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
 
 			},
 			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
@@ -253,6 +261,7 @@ func TestUpdateUser(t *testing.T) {
 				Email:    &newUser.Email,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
 				store.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, sql.ErrConnDone)
 
 			},
@@ -2,6 +2,7 @@ package gapi
 
 import (
 	"context"
+	"github.com/Drolfothesgnir/simplebank/util"
 	"time"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
@@ -44,7 +45,20 @@ func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReques
 		EmailID: email.ID,
 	}
 
-	txResult, err := server.store.VerifyEmailTx(ctx, arg)
+	var txResult db.VerifyEmailTxResult
+	err = server.auditTx(ctx, nil, "user.verify_email", util.AuditTargetUser, func(store db.Store) (auditedChange, error) {
+		var err error
+		txResult, err = store.VerifyEmailTx(ctx, arg)
+		if err != nil {
+			return auditedChange{}, err
+		}
+
+		return auditedChange{
+			targetID: txResult.User.Username,
+			before:   map[string]bool{"is_email_verified": false},
+			after:    map[string]bool{"is_email_verified": txResult.User.IsEmailVerified},
+		}, nil
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user's data")
 	}
@@ -0,0 +1,313 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/Drolfothesgnir/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	activityPageSize          = 100
+	activityHeartbeatInterval = 15 * time.Second
+	// entry ids are taken before commit, so an entry can become visible after the entries with greater ids,
+	// the entries created within the window are listed again to catch such late commits
+	activityRescanWindow = time.Minute
+)
+
+func (server *Server) WatchAccountActivity(req *pb.WatchAccountActivityRequest, stream grpc.ServerStreamingServer[pb.WatchAccountActivityResponse]) error {
+	ctx := stream.Context()
+
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		return unauthenticatedError(err)
+	}
+
+	send := func(activity *pb.AccountActivity) error {
+		return stream.Send(&pb.WatchAccountActivityResponse{Activity: activity})
+	}
+
+	return server.watchAccountActivity(ctx, authPayload.Username, req, send, nil)
+}
+
+// watchAccountActivity sends the activity of the requested accounts until ctx is done.
+// If heartbeat is not nil, it is called once the stream is ready and then every activityHeartbeatInterval.
+func (server *Server) watchAccountActivity(
+	ctx context.Context,
+	username string,
+	req *pb.WatchAccountActivityRequest,
+	send func(*pb.AccountActivity) error,
+	heartbeat func() error,
+) error {
+	// notifications are sent by the primary on commit, reading from a lagging replica could miss the new entries
+	ctx = db.WithReadYourWrites(ctx)
+
+	violations := validateWatchAccountActivityRequest(req)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	watcher := &activityWatcher{
+		server:   server,
+		send:     send,
+		accounts: make(map[int64]*watchedAccount),
+	}
+
+	accountIDs, err := watcher.accountIDs(ctx, username, req.GetAccountIds(), req.GetAccountNumbers())
+	if err != nil {
+		return err
+	}
+
+	// subscribe before catching up, so entries committed meanwhile are not missed
+	wake, cancel := server.activityListener.Subscribe(accountIDs)
+	defer cancel()
+
+	for _, id := range accountIDs {
+		account := &watchedAccount{
+			lastEntryID: req.GetLastEntryId(),
+			sent:        make(map[int64]time.Time),
+		}
+
+		if account.lastEntryID == 0 {
+			account.lastEntryID, err = server.store.GetLastEntryID(ctx, id)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get last entry: %s", err)
+			}
+
+			// a new watch starts from now, the recent entries are history and must not be sent by the rescan
+			err = watcher.markRecentEntriesSent(ctx, id, account)
+			if err != nil {
+				return err
+			}
+		}
+
+		watcher.accounts[id] = account
+	}
+
+	var heartbeats <-chan time.Time
+	if heartbeat != nil {
+		// the first heartbeat tells the client the stream is ready
+		if err := heartbeat(); err != nil {
+			return err
+		}
+
+		ticker := time.NewTicker(activityHeartbeatInterval)
+		defer ticker.Stop()
+		heartbeats = ticker.C
+	}
+
+	for {
+		if err := watcher.sendNewEntries(ctx); err != nil {
+			return err
+		}
+
+		// a heartbeat also looks for entries whose notification might have been lost
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-heartbeats:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// activityWatcher tracks the entries sent for every watched account.
+type activityWatcher struct {
+	server   *Server
+	send     func(*pb.AccountActivity) error
+	accounts map[int64]*watchedAccount
+}
+
+// watchedAccount is the position of a watcher in the entries of an account.
+type watchedAccount struct {
+	lastEntryID int64
+	// sent holds the creation time of the entries sent within activityRescanWindow
+	sent map[int64]time.Time
+}
+
+// accountIDs returns the requested accounts after checking the user holds them,
+// or all accounts the user owns or holds if none were requested.
+func (watcher *activityWatcher) accountIDs(ctx context.Context, username string, requestedIDs []int64, requestedNumbers []string) ([]int64, error) {
+	store := watcher.server.store
+
+	if len(requestedIDs) == 0 && len(requestedNumbers) == 0 {
+		var ids []int64
+		for offset := int32(0); ; offset += activityPageSize {
+			// the accounts the user holds are listed with the owned ones
+			accounts, err := store.ListAccounts(ctx, db.ListAccountsParams{
+				Owner:  username,
+				Limit:  activityPageSize,
+				Offset: offset,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+			}
+
+			for _, account := range accounts {
+				ids = append(ids, account.ID)
+			}
+
+			if len(accounts) < activityPageSize {
+				return ids, nil
+			}
+		}
+	}
+
+	var accounts []db.Account
+	for _, id := range requestedIDs {
+		account, err := watcher.server.getAccount(ctx, id, "")
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	for _, number := range requestedNumbers {
+		account, err := watcher.server.getAccount(ctx, 0, number)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	ids := make([]int64, len(accounts))
+	for i, account := range accounts {
+		ids[i] = account.ID
+
+		err := watcher.server.authorizeAccount(ctx, account, username, util.ViewerHolderRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// sendNewEntries sends the entries created after the last sent ones and the recent entries committed late,
+// each with the balance of the account right after it.
+// Entries of a resumed watch can be sent twice, clients tell them apart by the entry id.
+func (watcher *activityWatcher) sendNewEntries(ctx context.Context) error {
+	store := watcher.server.store
+	since := time.Now().Add(-activityRescanWindow)
+
+	for accountID, account := range watcher.accounts {
+		for id, createdAt := range account.sent {
+			if createdAt.Before(since) {
+				delete(account.sent, id)
+			}
+		}
+
+		recent, err := store.ListRecentEntries(ctx, db.ListRecentEntriesParams{
+			AccountID: accountID,
+			ID:        account.lastEntryID,
+			CreatedAt: since,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list entries: %s", err)
+		}
+
+		for _, entry := range recent {
+			if _, ok := account.sent[entry.ID]; ok {
+				continue
+			}
+
+			if err := watcher.sendEntry(account, db.ListEntriesAfterRow(entry)); err != nil {
+				return err
+			}
+		}
+
+		for {
+			entries, err := store.ListEntriesAfter(ctx, db.ListEntriesAfterParams{
+				AccountID: accountID,
+				ID:        account.lastEntryID,
+				Limit:     activityPageSize,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list entries: %s", err)
+			}
+
+			for _, entry := range entries {
+				if err := watcher.sendEntry(account, entry); err != nil {
+					return err
+				}
+
+				account.lastEntryID = entry.ID
+			}
+
+			if len(entries) < activityPageSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (watcher *activityWatcher) sendEntry(account *watchedAccount, entry db.ListEntriesAfterRow) error {
+	activity := &pb.AccountActivity{
+		AccountId: entry.AccountID,
+		EntryId:   entry.ID,
+		Amount:    entry.Amount,
+		Balance:   entry.Balance,
+		Currency:  entry.Currency,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+
+	if err := watcher.send(activity); err != nil {
+		return err
+	}
+
+	account.sent[entry.ID] = entry.CreatedAt
+	return nil
+}
+
+// markRecentEntriesSent records the recent entries up to the last entry of the account as already sent.
+func (watcher *activityWatcher) markRecentEntriesSent(ctx context.Context, accountID int64, account *watchedAccount) error {
+	entries, err := watcher.server.store.ListRecentEntries(ctx, db.ListRecentEntriesParams{
+		AccountID: accountID,
+		ID:        account.lastEntryID,
+		CreatedAt: time.Now().Add(-activityRescanWindow),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	for _, entry := range entries {
+		account.sent[entry.ID] = entry.CreatedAt
+	}
+
+	return nil
+}
+
+func validateWatchAccountActivityRequest(req *pb.WatchAccountActivityRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	for _, id := range req.GetAccountIds() {
+		if err := val.ValidateID(id); err != nil {
+			violations = append(violations, fieldViolation("account_ids", err))
+		}
+	}
+
+	for _, number := range req.GetAccountNumbers() {
+		if err := val.ValidateAccountNumber(number); err != nil {
+			violations = append(violations, fieldViolation("account_numbers", err))
+		}
+	}
+
+	if req.GetLastEntryId() < 0 {
+		violations = append(violations, fieldViolation("last_entry_id", fmt.Errorf("must not be negative")))
+	}
+
+	return
+}
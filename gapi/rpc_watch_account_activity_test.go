@@ -0,0 +1,262 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeActivityListener wakes up the subscriber when the test asks for it.
+type fakeActivityListener struct {
+	wake       chan struct{}
+	canceled   bool
+	accountIDs []int64
+}
+
+func (listener *fakeActivityListener) Subscribe(accountIDs []int64) (<-chan struct{}, func()) {
+	listener.accountIDs = accountIDs
+	return listener.wake, func() { listener.canceled = true }
+}
+
+// fakeActivityStream collects the sent responses.
+type fakeActivityStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.WatchAccountActivityResponse
+}
+
+func (stream *fakeActivityStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *fakeActivityStream) Send(res *pb.WatchAccountActivityResponse) error {
+	stream.sent <- res
+	return nil
+}
+
+// expectRecentEntries expects a rescan of the recent entries up to lastEntryID.
+func expectRecentEntries(store *mockdb.MockStore, accountID, lastEntryID int64, entries ...db.ListRecentEntriesRow) *gomock.Call {
+	return store.EXPECT().
+		ListRecentEntries(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.ListRecentEntriesParams) ([]db.ListRecentEntriesRow, error) {
+			if arg.AccountID != accountID || arg.ID != lastEntryID {
+				return nil, fmt.Errorf("unexpected rescan of account %d up to entry %d", arg.AccountID, arg.ID)
+			}
+			if time.Since(arg.CreatedAt) < activityRescanWindow {
+				return nil, fmt.Errorf("rescan starts at %s, within the window", arg.CreatedAt)
+			}
+			return entries, nil
+		})
+}
+
+func TestWatchAccountActivity(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    user.Username,
+		Balance:  util.RandomMoney(),
+		Currency: util.USD,
+	}
+
+	lastEntryID := util.RandomInt(1, 1000)
+	now := time.Now()
+	missed := db.ListEntriesAfterRow{ID: lastEntryID + 1, AccountID: account.ID, Amount: 10, CreatedAt: now, Currency: account.Currency, Balance: account.Balance + 10}
+	incoming := db.ListEntriesAfterRow{ID: lastEntryID + 3, AccountID: account.ID, Amount: 20, CreatedAt: now, Currency: account.Currency, Balance: account.Balance + 30}
+	// the entry between them commits after the stream has sent the incoming one
+	late := db.ListEntriesAfterRow{ID: lastEntryID + 2, AccountID: account.ID, Amount: -5, CreatedAt: now, Currency: account.Currency, Balance: account.Balance + 5}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).AnyTimes().Return(account, nil)
+	gomock.InOrder(
+		expectRecentEntries(store, account.ID, lastEntryID),
+		store.EXPECT().
+			ListEntriesAfter(gomock.Any(), gomock.Eq(db.ListEntriesAfterParams{AccountID: account.ID, ID: lastEntryID, Limit: activityPageSize})).
+			Return([]db.ListEntriesAfterRow{missed, incoming}, nil),
+		expectRecentEntries(store, account.ID, incoming.ID,
+			db.ListRecentEntriesRow(missed), db.ListRecentEntriesRow(late), db.ListRecentEntriesRow(incoming)),
+		store.EXPECT().
+			ListEntriesAfter(gomock.Any(), gomock.Eq(db.ListEntriesAfterParams{AccountID: account.ID, ID: incoming.ID, Limit: activityPageSize})).
+			Return([]db.ListEntriesAfterRow{}, nil),
+	)
+	store.EXPECT().GetLastEntryID(gomock.Any(), gomock.Any()).Times(0)
+
+	listener := &fakeActivityListener{wake: make(chan struct{}, 1)}
+	server := newTestServer(t, store, nil)
+	server.activityListener = listener
+
+	ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+	ctx, stop := context.WithCancel(ctx)
+
+	stream := &fakeActivityStream{ctx: ctx, sent: make(chan *pb.WatchAccountActivityResponse, 3)}
+	req := &pb.WatchAccountActivityRequest{AccountIds: []int64{account.ID}, LastEntryId: lastEntryID}
+
+	done := make(chan error)
+	go func() {
+		done <- server.WatchAccountActivity(req, stream)
+	}()
+
+	// entries after the last seen one are sent on connect, each with its running balance
+	res := <-stream.sent
+	require.Equal(t, missed.ID, res.GetActivity().GetEntryId())
+	require.Equal(t, missed.Balance, res.GetActivity().GetBalance())
+
+	res = <-stream.sent
+	require.Equal(t, incoming.ID, res.GetActivity().GetEntryId())
+	require.Equal(t, incoming.Amount, res.GetActivity().GetAmount())
+	require.Equal(t, incoming.Balance, res.GetActivity().GetBalance())
+
+	// the rescan sends the late entry only
+	listener.wake <- struct{}{}
+	res = <-stream.sent
+	require.Equal(t, late.ID, res.GetActivity().GetEntryId())
+	require.Equal(t, late.Balance, res.GetActivity().GetBalance())
+
+	stop()
+	require.NoError(t, <-done)
+	require.True(t, listener.canceled)
+	require.Empty(t, stream.sent)
+}
+
+func TestWatchAccountActivityFromNow(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: user.Username, Currency: util.USD}
+
+	lastEntryID := util.RandomInt(1, 1000)
+	old := db.ListRecentEntriesRow{ID: lastEntryID, AccountID: account.ID, Amount: 10, CreatedAt: time.Now()}
+	incoming := db.ListEntriesAfterRow{ID: lastEntryID + 1, AccountID: account.ID, Amount: 20, CreatedAt: time.Now()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).AnyTimes().Return(account, nil)
+	store.EXPECT().GetLastEntryID(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(lastEntryID, nil)
+	gomock.InOrder(
+		// the recent entries are history for a new watch
+		expectRecentEntries(store, account.ID, lastEntryID, old),
+		expectRecentEntries(store, account.ID, lastEntryID, old),
+		store.EXPECT().
+			ListEntriesAfter(gomock.Any(), gomock.Eq(db.ListEntriesAfterParams{AccountID: account.ID, ID: lastEntryID, Limit: activityPageSize})).
+			Return([]db.ListEntriesAfterRow{incoming}, nil),
+	)
+
+	listener := &fakeActivityListener{wake: make(chan struct{}, 1)}
+	server := newTestServer(t, store, nil)
+	server.activityListener = listener
+
+	ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+	ctx, stop := context.WithCancel(ctx)
+
+	stream := &fakeActivityStream{ctx: ctx, sent: make(chan *pb.WatchAccountActivityResponse, 2)}
+	req := &pb.WatchAccountActivityRequest{AccountIds: []int64{account.ID}}
+
+	done := make(chan error)
+	go func() {
+		done <- server.WatchAccountActivity(req, stream)
+	}()
+
+	res := <-stream.sent
+	require.Equal(t, incoming.ID, res.GetActivity().GetEntryId())
+
+	stop()
+	require.NoError(t, <-done)
+	require.Empty(t, stream.sent)
+}
+
+func TestWatchAccountActivityAllAccounts(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	owned := db.Account{ID: util.RandomInt(1, 1000), Owner: user.Username, Currency: util.USD}
+	held := db.Account{ID: owned.ID + 1, Owner: util.RandomOwner(), Currency: util.EUR}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListAccounts(gomock.Any(), gomock.Eq(db.ListAccountsParams{Owner: user.Username, Limit: activityPageSize})).
+		Times(1).
+		Return([]db.Account{owned, held}, nil)
+	store.EXPECT().GetLastEntryID(gomock.Any(), gomock.Any()).Times(2).Return(int64(0), nil)
+	store.EXPECT().ListRecentEntries(gomock.Any(), gomock.Any()).AnyTimes().Return([]db.ListRecentEntriesRow{}, nil)
+	store.EXPECT().ListEntriesAfter(gomock.Any(), gomock.Any()).AnyTimes().Return([]db.ListEntriesAfterRow{}, nil)
+
+	listener := &fakeActivityListener{wake: make(chan struct{})}
+	server := newTestServer(t, store, nil)
+	server.activityListener = listener
+
+	ctx, stop := context.WithCancel(context.Background())
+	send := func(*pb.AccountActivity) error { return nil }
+	heartbeat := func() error {
+		stop()
+		return nil
+	}
+
+	err := server.watchAccountActivity(ctx, user.Username, &pb.WatchAccountActivityRequest{}, send, heartbeat)
+	require.NoError(t, err)
+
+	// the accounts the user holds are watched with the owned ones
+	require.ElementsMatch(t, []int64{owned.ID, held.ID}, listener.accountIDs)
+}
+
+func TestWatchAccountActivityNotOwner(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+	store.EXPECT().ListEntriesAfter(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.activityListener = &fakeActivityListener{}
+
+	ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+	stream := &fakeActivityStream{ctx: ctx}
+
+	err := server.WatchAccountActivity(&pb.WatchAccountActivityRequest{AccountIds: []int64{account.ID}}, stream)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestWatchAccountActivityInvalidAccountNumber(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	number := util.RandomAccountNumber()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.activityListener = &fakeActivityListener{}
+
+	ctx := setAuthorizationHeader(t, server.tokenMaker, authorizationHeader, authorizationTypeBearer, user.Username, user.Role, time.Minute)
+	stream := &fakeActivityStream{ctx: ctx}
+
+	req := &pb.WatchAccountActivityRequest{AccountNumbers: []string{number[:2] + "00" + number[4:]}}
+	err := server.WatchAccountActivity(req, stream)
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"errors"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// savingsPotError maps the errors of the savings pot transactions to gRPC statuses.
+func savingsPotError(err error, potID int64) error {
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "savings pot [%d] does not exist", potID)
+	case errors.Is(err, db.ErrPotClosed),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.Is(err, db.ErrInsufficientPotBalance):
+		return status.Errorf(codes.FailedPrecondition, "savings pot [%d]: %s", potID, err)
+	}
+
+	return status.Errorf(codes.Internal, "failed to update savings pot: %s", err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/fraud"
 	"github.com/Drolfothesgnir/simplebank/pb"
 	"github.com/Drolfothesgnir/simplebank/token"
 	"github.com/Drolfothesgnir/simplebank/util"
@@ -12,22 +13,32 @@ import (
 
 type Server struct {
 	pb.UnimplementedSimpleBankServer
-	config          util.Config
-	store           db.Store
-	tokenMaker      token.Maker
-	taskDistributor worker.TaskDistributor
+	pb.UnimplementedSimpleBankAdminServer
+	config           util.Config
+	store            db.Store
+	tokenMaker       token.Maker
+	taskDistributor  worker.TaskDistributor
+	activityListener db.ActivityListener
+	fraudEngine      *fraud.Engine
 }
 
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
+func NewServer(
+	config util.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+	activityListener db.ActivityListener,
+) (*Server, error) {
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 	server := Server{
-		config:          config,
-		store:           store,
-		tokenMaker:      tokenMaker,
-		taskDistributor: taskDistributor,
+		config:           config,
+		store:            store,
+		tokenMaker:       tokenMaker,
+		taskDistributor:  taskDistributor,
+		activityListener: activityListener,
+		fraudEngine:      fraud.NewEngine(fraud.DefaultRules(config)...),
 	}
 
 	return &server, nil
@@ -0,0 +1,135 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Drolfothesgnir/simplebank/pb"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AccountActivityEventsPath is where the gateway serves the account activity as Server-Sent Events.
+const AccountActivityEventsPath = "/v1/account_activity/events"
+
+const accountActivityEvent = "activity"
+
+var activityMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// AccountActivityEvents streams the activity of the caller's accounts as Server-Sent Events,
+// for the clients that can't consume the WatchAccountActivity gRPC stream.
+//
+// The access token is read from the authorization header, or from the access_token query parameter
+// since EventSource can't set headers. The accounts to watch are given by repeated account_id or account_number parameters.
+// Every event has the entry id as its id, so a reconnecting EventSource resumes through Last-Event-ID.
+func (server *Server) AccountActivityEvents(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" && r.URL.Query().Has("access_token") {
+		authorization = authorizationTypeBearer + " " + r.URL.Query().Get("access_token")
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs(authorizationHeader, authorization))
+
+	accessibleRoles := []string{util.DepositorRole, util.BankerRole}
+	authPayload, err := server.authorizeUser(ctx, accessibleRoles)
+	if err != nil {
+		writeStatusError(w, unauthenticatedError(err))
+		return
+	}
+
+	req, err := parseAccountActivityEventsRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	started := false
+
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		return rc.Flush()
+	}
+
+	send := func(activity *pb.AccountActivity) error {
+		data, err := activityMarshaler.Marshal(activity)
+		if err != nil {
+			return err
+		}
+
+		if err := start(); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", activity.GetEntryId(), accountActivityEvent, data)
+		if err != nil {
+			return err
+		}
+
+		return rc.Flush()
+	}
+
+	heartbeat := func() error {
+		if err := start(); err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+			return err
+		}
+
+		return rc.Flush()
+	}
+
+	// the response starts with the first heartbeat, errors found before it are reported with a status code
+	err = server.watchAccountActivity(ctx, authPayload.Username, req, send, heartbeat)
+	if err != nil && !started {
+		writeStatusError(w, err)
+	}
+}
+
+func parseAccountActivityEventsRequest(r *http.Request) (*pb.WatchAccountActivityRequest, error) {
+	req := &pb.WatchAccountActivityRequest{}
+
+	for _, value := range r.URL.Query()["account_id"] {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account_id: %s", value)
+		}
+		req.AccountIds = append(req.AccountIds, id)
+	}
+
+	req.AccountNumbers = r.URL.Query()["account_number"]
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	if lastEventID != "" {
+		id, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last event id: %s", lastEventID)
+		}
+		req.LastEntryId = id
+	}
+
+	return req, nil
+}
+
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
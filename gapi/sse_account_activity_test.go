@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// readEvent reads the lines of the next event or comment of the stream.
+func readEvent(t *testing.T, reader *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestAccountActivityEvents(t *testing.T) {
+	user, _ := createRandomUser(t, util.DepositorRole)
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    user.Username,
+		Balance:  util.RandomMoney(),
+		Currency: util.EUR,
+	}
+
+	lastEventID := util.RandomInt(1, 1000)
+	entry := db.ListEntriesAfterRow{ID: lastEventID + 1, AccountID: account.ID, Amount: 15, CreatedAt: time.Now(), Currency: account.Currency, Balance: account.Balance}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).AnyTimes().Return(account, nil)
+	store.EXPECT().ListRecentEntries(gomock.Any(), gomock.Any()).AnyTimes().Return([]db.ListRecentEntriesRow{}, nil)
+	gomock.InOrder(
+		store.EXPECT().
+			ListEntriesAfter(gomock.Any(), gomock.Eq(db.ListEntriesAfterParams{AccountID: account.ID, ID: lastEventID, Limit: activityPageSize})).
+			Return([]db.ListEntriesAfterRow{}, nil),
+		store.EXPECT().
+			ListEntriesAfter(gomock.Any(), gomock.Eq(db.ListEntriesAfterParams{AccountID: account.ID, ID: lastEventID, Limit: activityPageSize})).
+			Return([]db.ListEntriesAfterRow{entry}, nil),
+	)
+
+	listener := &fakeActivityListener{wake: make(chan struct{}, 1)}
+	server := newTestServer(t, store, nil)
+	server.activityListener = listener
+
+	httpServer := httptest.NewServer(http.HandlerFunc(server.AccountActivityEvents))
+	defer httpServer.Close()
+
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	url := fmt.Sprintf("%s?account_id=%d", httpServer.URL, account.ID)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	request.Header.Set("Last-Event-ID", fmt.Sprint(lastEventID))
+
+	response, err := httpServer.Client().Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(response.Body)
+	require.Equal(t, []string{": heartbeat"}, readEvent(t, reader))
+
+	listener.wake <- struct{}{}
+
+	lines := readEvent(t, reader)
+	require.Len(t, lines, 3)
+	require.Equal(t, fmt.Sprintf("id: %d", entry.ID), lines[0])
+	require.Equal(t, "event: "+accountActivityEvent, lines[1])
+	require.Contains(t, lines[2], fmt.Sprintf(`"balance":"%d"`, account.Balance))
+	require.Contains(t, lines[2], `"currency":"EUR"`)
+}
+
+func TestAccountActivityEventsUnauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, AccountActivityEventsPath, nil)
+
+	server.AccountActivityEvents(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/token"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getWebhook returns the webhook if it belongs to the authenticated user. Bankers can access any webhook.
+func (server *Server) getWebhook(ctx context.Context, authPayload *token.Payload, id int64) (db.Webhook, error) {
+	webhook, err := server.store.GetWebhook(ctx, id)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return db.Webhook{}, status.Errorf(codes.NotFound, "webhook [%d] does not exist", id)
+		}
+
+		return db.Webhook{}, status.Errorf(codes.Internal, "failed to get webhook: %s", err)
+	}
+
+	if authPayload.Role != util.BankerRole && webhook.Owner != authPayload.Username {
+		return db.Webhook{}, status.Errorf(codes.PermissionDenied, "webhook doesn't belong to the authenticated user")
+	}
+
+	return webhook, nil
+}
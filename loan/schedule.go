@@ -0,0 +1,100 @@
+// Package loan builds the amortization schedules of loans.
+package loan
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+)
+
+// Installment is a monthly repayment of a loan.
+type Installment struct {
+	Seq       int32
+	DueDate   time.Time
+	Principal int64
+	Interest  int64
+}
+
+// Amount is what the installment collects from the account, the late fee aside.
+func (installment Installment) Amount() int64 {
+	return installment.Principal + installment.Interest
+}
+
+// Schedule splits the principal into monthly installments, the first one is due a month after start.
+// The annual rate is in basis points and the interest is charged monthly on the outstanding principal.
+// Every amount is rounded to the minor unit, the last installment repays whatever principal is left.
+func Schedule(principal int64, annualRateBps int32, termMonths int32, method string, start time.Time) ([]Installment, error) {
+	if principal <= 0 {
+		return nil, fmt.Errorf("principal must be positive")
+	}
+
+	if annualRateBps < 0 {
+		return nil, fmt.Errorf("annual rate must not be negative")
+	}
+
+	if termMonths <= 0 {
+		return nil, fmt.Errorf("term must be positive")
+	}
+
+	rate := float64(annualRateBps) / 10000 / 12
+
+	var payment int64
+	switch method {
+	case util.LoanAnnuity:
+		payment = annuityPayment(principal, rate, termMonths)
+	case util.LoanLinear:
+		payment = principal / int64(termMonths)
+	default:
+		return nil, fmt.Errorf("unsupported repayment method %q", method)
+	}
+
+	installments := make([]Installment, termMonths)
+	outstanding := principal
+
+	for i := range installments {
+		seq := int32(i + 1)
+		interest := int64(math.Round(float64(outstanding) * rate))
+
+		repaid := payment
+		if method == util.LoanAnnuity {
+			repaid = payment - interest
+		}
+
+		if seq == termMonths || repaid > outstanding {
+			repaid = outstanding
+		}
+
+		installments[i] = Installment{
+			Seq:       seq,
+			DueDate:   DueDate(start, seq),
+			Principal: repaid,
+			Interest:  interest,
+		}
+
+		outstanding -= repaid
+	}
+
+	return installments, nil
+}
+
+// annuityPayment is the equal monthly payment that repays the principal with the interest over the term.
+func annuityPayment(principal int64, rate float64, termMonths int32) int64 {
+	if rate == 0 {
+		return int64(math.Ceil(float64(principal) / float64(termMonths)))
+	}
+
+	return int64(math.Round(float64(principal) * rate / (1 - math.Pow(1+rate, -float64(termMonths)))))
+}
+
+// DueDate is the date seq months after start. The day is clamped to the end of shorter months,
+// so a loan started on January 31 is due on the last day of February.
+func DueDate(start time.Time, seq int32) time.Time {
+	year, month, day := start.Date()
+
+	firstOfMonth := time.Date(year, month+time.Month(seq), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
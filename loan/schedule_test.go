@@ -0,0 +1,94 @@
+package loan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleAnnuity(t *testing.T) {
+	start := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	installments, err := Schedule(100000, 1200, 12, util.LoanAnnuity, start)
+	require.NoError(t, err)
+	require.Len(t, installments, 12)
+
+	// 1000.00 at 12% a year over 12 months is 88.85 a month
+	var principal int64
+	for i, installment := range installments {
+		require.Equal(t, int32(i+1), installment.Seq)
+		require.Equal(t, time.Date(2024, time.Month(i+2), 15, 0, 0, 0, 0, time.UTC), installment.DueDate)
+
+		if i < len(installments)-1 {
+			require.Equal(t, int64(8885), installment.Amount())
+		} else {
+			require.InDelta(t, 8885, installment.Amount(), 12)
+		}
+
+		principal += installment.Principal
+	}
+
+	require.Equal(t, int64(100000), principal)
+	require.Equal(t, int64(1000), installments[0].Interest)
+	require.Less(t, installments[11].Interest, installments[0].Interest)
+}
+
+func TestScheduleLinear(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	installments, err := Schedule(1000, 1200, 3, util.LoanLinear, start)
+	require.NoError(t, err)
+	require.Len(t, installments, 3)
+
+	require.Equal(t, Installment{Seq: 1, DueDate: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), Principal: 333, Interest: 10}, installments[0])
+	require.Equal(t, Installment{Seq: 2, DueDate: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), Principal: 333, Interest: 7}, installments[1])
+	require.Equal(t, Installment{Seq: 3, DueDate: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), Principal: 334, Interest: 3}, installments[2])
+}
+
+func TestScheduleZeroRate(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	installments, err := Schedule(1000, 0, 3, util.LoanAnnuity, start)
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{334, 334, 332}, []int64{installments[0].Principal, installments[1].Principal, installments[2].Principal})
+	for _, installment := range installments {
+		require.Zero(t, installment.Interest)
+	}
+}
+
+func TestScheduleInvalid(t *testing.T) {
+	start := time.Now()
+
+	testCases := []struct {
+		name          string
+		principal     int64
+		annualRateBps int32
+		termMonths    int32
+		method        string
+	}{
+		{name: "ZeroPrincipal", principal: 0, annualRateBps: 500, termMonths: 12, method: util.LoanAnnuity},
+		{name: "NegativeRate", principal: 1000, annualRateBps: -1, termMonths: 12, method: util.LoanAnnuity},
+		{name: "ZeroTerm", principal: 1000, annualRateBps: 500, termMonths: 0, method: util.LoanLinear},
+		{name: "UnsupportedMethod", principal: 1000, annualRateBps: 500, termMonths: 12, method: "balloon"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			installments, err := Schedule(tc.principal, tc.annualRateBps, tc.termMonths, tc.method, start)
+			require.Error(t, err)
+			require.Empty(t, installments)
+		})
+	}
+}
+
+func TestDueDate(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC), DueDate(start, 1))
+	require.Equal(t, time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC), DueDate(start, 2))
+	require.Equal(t, time.Date(2023, time.April, 30, 0, 0, 0, 0, time.UTC), DueDate(start, 3))
+	require.Equal(t, time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), DueDate(start, 12))
+}
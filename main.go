@@ -50,7 +50,15 @@ func main() {
 		log.Fatal().Err(err).Msg("cannot connect to the database")
 	}
 
-	store := db.NewStore(conn)
+	var replicaConn *pgxpool.Pool
+	if config.DBReplicaSource != "" {
+		replicaConn, err = pgxpool.New(ctx, config.DBReplicaSource)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot connect to the replica database")
+		}
+	}
+
+	store := db.NewStore(conn, replicaConn)
 
 	runDBMigration(config.MigrationURL, config.DBSource)
 
@@ -62,11 +70,25 @@ func main() {
 
 	waitGroup, ctx := errgroup.WithContext(ctx)
 
-	runTaskProcessor(ctx, waitGroup, redisOpts, store, emailSender)
+	runTaskProcessor(ctx, waitGroup, redisOpts, store, emailSender, taskDistributor)
+
+	runOutboxRelay(ctx, waitGroup, config, store, taskDistributor)
+
+	runEndOfDayScheduler(ctx, waitGroup, config, store, taskDistributor)
+
+	runTransferApprovalExpirer(ctx, waitGroup, config, store)
 
-	servers.RunGatewayServer(ctx, waitGroup, config, store, taskDistributor)
+	runNotificationDigestScheduler(ctx, waitGroup, config, store, taskDistributor)
 
-	servers.RunGrpcServer(ctx, waitGroup, config, store, taskDistributor)
+	runLoanCollector(ctx, waitGroup, config, store)
+
+	activityListener := db.NewPGActivityListener(conn)
+
+	runActivityListener(ctx, waitGroup, activityListener)
+
+	servers.RunGatewayServer(ctx, waitGroup, config, store, taskDistributor, activityListener)
+
+	servers.RunGrpcServer(ctx, waitGroup, config, store, taskDistributor, activityListener)
 
 	err = waitGroup.Wait()
 	if err != nil {
@@ -93,8 +115,9 @@ func runTaskProcessor(
 	redisOpts asynq.RedisClientOpt,
 	store db.Store,
 	emailSender mail.EmailSender,
+	taskDistributor worker.TaskDistributor,
 ) {
-	processor := worker.NewRedisTaskProcessor(redisOpts, store, emailSender)
+	processor := worker.NewRedisTaskProcessor(redisOpts, store, emailSender, taskDistributor)
 
 	err := processor.Start()
 	if err != nil {
@@ -113,3 +136,117 @@ func runTaskProcessor(
 		return nil
 	})
 }
+
+func runOutboxRelay(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+) {
+	relay := worker.NewOutboxRelay(store, taskDistributor, config.OutboxRelayInterval)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("outbox relay started")
+
+		err := relay.Run(ctx)
+
+		log.Info().Msg("outbox relay is stopped")
+
+		return err
+	})
+}
+
+func runEndOfDayScheduler(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+) {
+	scheduler := worker.NewEndOfDayScheduler(store, taskDistributor, config.EndOfDayInterval)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("end of day scheduler started")
+
+		err := scheduler.Run(ctx)
+
+		log.Info().Msg("end of day scheduler is stopped")
+
+		return err
+	})
+}
+
+func runTransferApprovalExpirer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+) {
+	expirer := worker.NewTransferApprovalExpirer(store, config.TransferApprovalExpiryInterval)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("transfer approval expirer started")
+
+		err := expirer.Run(ctx)
+
+		log.Info().Msg("transfer approval expirer is stopped")
+
+		return err
+	})
+}
+
+func runLoanCollector(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+) {
+	collector := worker.NewLoanCollector(store, config.LoanCollectionInterval, config.LoanLateFee)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("loan collector started")
+
+		err := collector.Run(ctx)
+
+		log.Info().Msg("loan collector is stopped")
+
+		return err
+	})
+}
+
+func runNotificationDigestScheduler(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+) {
+	scheduler := worker.NewNotificationDigestScheduler(store, taskDistributor, config.NotificationDigestInterval)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("notification digest scheduler started")
+
+		err := scheduler.Run(ctx)
+
+		log.Info().Msg("notification digest scheduler is stopped")
+
+		return err
+	})
+}
+
+func runActivityListener(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	activityListener *db.PGActivityListener,
+) {
+	waitGroup.Go(func() error {
+		log.Info().Msg("account activity listener started")
+
+		err := activityListener.Run(ctx)
+
+		log.Info().Msg("account activity listener is stopped")
+
+		return err
+	})
+}
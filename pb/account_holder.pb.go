@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: account_holder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountHolder struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username  string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// owner, co_owner or view_only
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountHolder) Reset() {
+	*x = AccountHolder{}
+	mi := &file_account_holder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountHolder) ProtoMessage() {}
+
+func (x *AccountHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_account_holder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountHolder.ProtoReflect.Descriptor instead.
+func (*AccountHolder) Descriptor() ([]byte, []int) {
+	return file_account_holder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountHolder) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountHolder) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AccountHolder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AccountInvitation struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Inviter   string                 `protobuf:"bytes,3,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Invitee   string                 `protobuf:"bytes,4,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	// co_owner or view_only
+	Role string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	// pending, accepted or declined
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountInvitation) Reset() {
+	*x = AccountInvitation{}
+	mi := &file_account_holder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInvitation) ProtoMessage() {}
+
+func (x *AccountInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_account_holder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInvitation.ProtoReflect.Descriptor instead.
+func (*AccountInvitation) Descriptor() ([]byte, []int) {
+	return file_account_holder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountInvitation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountInvitation) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountInvitation) GetInviter() string {
+	if x != nil {
+		return x.Inviter
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetInvitee() string {
+	if x != nil {
+		return x.Invitee
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountInvitation) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+var File_account_holder_proto protoreflect.FileDescriptor
+
+const file_account_holder_proto_rawDesc = "" +
+	"\n" +
+	"\x14account_holder.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x01\n" +
+	"\rAccountHolder\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9c\x02\n" +
+	"\x11AccountInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x18\n" +
+	"\ainviter\x18\x03 \x01(\tR\ainviter\x12\x18\n" +
+	"\ainvitee\x18\x04 \x01(\tR\ainvitee\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fresponded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAtB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_account_holder_proto_rawDescOnce sync.Once
+	file_account_holder_proto_rawDescData []byte
+)
+
+func file_account_holder_proto_rawDescGZIP() []byte {
+	file_account_holder_proto_rawDescOnce.Do(func() {
+		file_account_holder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_holder_proto_rawDesc), len(file_account_holder_proto_rawDesc)))
+	})
+	return file_account_holder_proto_rawDescData
+}
+
+var file_account_holder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_account_holder_proto_goTypes = []any{
+	(*AccountHolder)(nil),         // 0: pb.AccountHolder
+	(*AccountInvitation)(nil),     // 1: pb.AccountInvitation
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_account_holder_proto_depIdxs = []int32{
+	2, // 0: pb.AccountHolder.created_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.AccountInvitation.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.AccountInvitation.responded_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_account_holder_proto_init() }
+func file_account_holder_proto_init() {
+	if File_account_holder_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_holder_proto_rawDesc), len(file_account_holder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_account_holder_proto_goTypes,
+		DependencyIndexes: file_account_holder_proto_depIdxs,
+		MessageInfos:      file_account_holder_proto_msgTypes,
+	}.Build()
+	File_account_holder_proto = out.File
+	file_account_holder_proto_goTypes = nil
+	file_account_holder_proto_depIdxs = nil
+}
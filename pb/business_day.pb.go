@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: business_day.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DailyTotal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalDebits    int64                  `protobuf:"varint,2,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   int64                  `protobuf:"varint,3,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	EntryCount     int64                  `protobuf:"varint,4,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	ClosingBalance int64                  `protobuf:"varint,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DailyTotal) Reset() {
+	*x = DailyTotal{}
+	mi := &file_business_day_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyTotal) ProtoMessage() {}
+
+func (x *DailyTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_business_day_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyTotal.ProtoReflect.Descriptor instead.
+func (*DailyTotal) Descriptor() ([]byte, []int) {
+	return file_business_day_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DailyTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DailyTotal) GetTotalDebits() int64 {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return 0
+}
+
+func (x *DailyTotal) GetTotalCredits() int64 {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return 0
+}
+
+func (x *DailyTotal) GetEntryCount() int64 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *DailyTotal) GetClosingBalance() int64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+type BusinessDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Totals        []*DailyTotal          `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusinessDay) Reset() {
+	*x = BusinessDay{}
+	mi := &file_business_day_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessDay) ProtoMessage() {}
+
+func (x *BusinessDay) ProtoReflect() protoreflect.Message {
+	mi := &file_business_day_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessDay.ProtoReflect.Descriptor instead.
+func (*BusinessDay) Descriptor() ([]byte, []int) {
+	return file_business_day_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BusinessDay) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *BusinessDay) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *BusinessDay) GetTotals() []*DailyTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_business_day_proto protoreflect.FileDescriptor
+
+const file_business_day_proto_rawDesc = "" +
+	"\n" +
+	"\x12business_day.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x01\n" +
+	"\n" +
+	"DailyTotal\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_debits\x18\x02 \x01(\x03R\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\x03 \x01(\x03R\ftotalCredits\x12\x1f\n" +
+	"\ventry_count\x18\x04 \x01(\x03R\n" +
+	"entryCount\x12'\n" +
+	"\x0fclosing_balance\x18\x05 \x01(\x03R\x0eclosingBalance\"\x93\x01\n" +
+	"\vBusinessDay\x12#\n" +
+	"\rbusiness_date\x18\x01 \x01(\tR\fbusinessDate\x127\n" +
+	"\tclosed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12&\n" +
+	"\x06totals\x18\x03 \x03(\v2\x0e.pb.DailyTotalR\x06totalsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_business_day_proto_rawDescOnce sync.Once
+	file_business_day_proto_rawDescData []byte
+)
+
+func file_business_day_proto_rawDescGZIP() []byte {
+	file_business_day_proto_rawDescOnce.Do(func() {
+		file_business_day_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_business_day_proto_rawDesc), len(file_business_day_proto_rawDesc)))
+	})
+	return file_business_day_proto_rawDescData
+}
+
+var file_business_day_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_business_day_proto_goTypes = []any{
+	(*DailyTotal)(nil),            // 0: pb.DailyTotal
+	(*BusinessDay)(nil),           // 1: pb.BusinessDay
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_business_day_proto_depIdxs = []int32{
+	2, // 0: pb.BusinessDay.closed_at:type_name -> google.protobuf.Timestamp
+	0, // 1: pb.BusinessDay.totals:type_name -> pb.DailyTotal
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_business_day_proto_init() }
+func file_business_day_proto_init() {
+	if File_business_day_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_business_day_proto_rawDesc), len(file_business_day_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_business_day_proto_goTypes,
+		DependencyIndexes: file_business_day_proto_depIdxs,
+		MessageInfos:      file_business_day_proto_msgTypes,
+	}.Build()
+	File_business_day_proto = out.File
+	file_business_day_proto_goTypes = nil
+	file_business_day_proto_depIdxs = nil
+}
@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: cash_receipt.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CashReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceiptNumber string                 `protobuf:"bytes,2,opt,name=receipt_number,json=receiptNumber,proto3" json:"receipt_number,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	AccountId     int64                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	BalanceAfter  int64                  `protobuf:"varint,7,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	PerformedBy   string                 `protobuf:"bytes,8,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashReceipt) Reset() {
+	*x = CashReceipt{}
+	mi := &file_cash_receipt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashReceipt) ProtoMessage() {}
+
+func (x *CashReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_receipt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashReceipt.ProtoReflect.Descriptor instead.
+func (*CashReceipt) Descriptor() ([]byte, []int) {
+	return file_cash_receipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CashReceipt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CashReceipt) GetReceiptNumber() string {
+	if x != nil {
+		return x.ReceiptNumber
+	}
+	return ""
+}
+
+func (x *CashReceipt) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CashReceipt) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CashReceipt) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CashReceipt) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CashReceipt) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *CashReceipt) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+func (x *CashReceipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_cash_receipt_proto protoreflect.FileDescriptor
+
+const file_cash_receipt_proto_rawDesc = "" +
+	"\n" +
+	"\x12cash_receipt.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x02\n" +
+	"\vCashReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0ereceipt_number\x18\x02 \x01(\tR\rreceiptNumber\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12#\n" +
+	"\rbalance_after\x18\a \x01(\x03R\fbalanceAfter\x12!\n" +
+	"\fperformed_by\x18\b \x01(\tR\vperformedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_cash_receipt_proto_rawDescOnce sync.Once
+	file_cash_receipt_proto_rawDescData []byte
+)
+
+func file_cash_receipt_proto_rawDescGZIP() []byte {
+	file_cash_receipt_proto_rawDescOnce.Do(func() {
+		file_cash_receipt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cash_receipt_proto_rawDesc), len(file_cash_receipt_proto_rawDesc)))
+	})
+	return file_cash_receipt_proto_rawDescData
+}
+
+var file_cash_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cash_receipt_proto_goTypes = []any{
+	(*CashReceipt)(nil),           // 0: pb.CashReceipt
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_cash_receipt_proto_depIdxs = []int32{
+	1, // 0: pb.CashReceipt.created_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cash_receipt_proto_init() }
+func file_cash_receipt_proto_init() {
+	if File_cash_receipt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cash_receipt_proto_rawDesc), len(file_cash_receipt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cash_receipt_proto_goTypes,
+		DependencyIndexes: file_cash_receipt_proto_depIdxs,
+		MessageInfos:      file_cash_receipt_proto_msgTypes,
+	}.Build()
+	File_cash_receipt_proto = out.File
+	file_cash_receipt_proto_goTypes = nil
+	file_cash_receipt_proto_depIdxs = nil
+}
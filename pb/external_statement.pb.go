@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: external_statement.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExternalStatementLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StatementId    int64                  `protobuf:"varint,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	BookingDate    string                 `protobuf:"bytes,3,opt,name=booking_date,json=bookingDate,proto3" json:"booking_date,omitempty"`
+	ValueDate      string                 `protobuf:"bytes,4,opt,name=value_date,json=valueDate,proto3" json:"value_date,omitempty"`
+	Amount         int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference      string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	EndToEndId     string                 `protobuf:"bytes,7,opt,name=end_to_end_id,json=endToEndId,proto3" json:"end_to_end_id,omitempty"`
+	Counterparty   string                 `protobuf:"bytes,8,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Description    string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	EntryId        int64                  `protobuf:"varint,11,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	ResolvedBy     string                 `protobuf:"bytes,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolutionNote string                 `protobuf:"bytes,13,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	ResolvedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExternalStatementLine) Reset() {
+	*x = ExternalStatementLine{}
+	mi := &file_external_statement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalStatementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalStatementLine) ProtoMessage() {}
+
+func (x *ExternalStatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_external_statement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalStatementLine.ProtoReflect.Descriptor instead.
+func (*ExternalStatementLine) Descriptor() ([]byte, []int) {
+	return file_external_statement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExternalStatementLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExternalStatementLine) GetStatementId() int64 {
+	if x != nil {
+		return x.StatementId
+	}
+	return 0
+}
+
+func (x *ExternalStatementLine) GetBookingDate() string {
+	if x != nil {
+		return x.BookingDate
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetValueDate() string {
+	if x != nil {
+		return x.ValueDate
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExternalStatementLine) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetEndToEndId() string {
+	if x != nil {
+		return x.EndToEndId
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *ExternalStatementLine) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *ExternalStatementLine) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ExternalStatement struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Id                int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format            string                   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Reference         string                   `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	ExternalAccount   string                   `protobuf:"bytes,4,opt,name=external_account,json=externalAccount,proto3" json:"external_account,omitempty"`
+	Currency          string                   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	ClearingAccountId int64                    `protobuf:"varint,6,opt,name=clearing_account_id,json=clearingAccountId,proto3" json:"clearing_account_id,omitempty"`
+	FromDate          string                   `protobuf:"bytes,7,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate            string                   `protobuf:"bytes,8,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	OpeningBalance    int64                    `protobuf:"varint,9,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingBalance    int64                    `protobuf:"varint,10,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	ImportedBy        string                   `protobuf:"bytes,11,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Lines             []*ExternalStatementLine `protobuf:"bytes,13,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExternalStatement) Reset() {
+	*x = ExternalStatement{}
+	mi := &file_external_statement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalStatement) ProtoMessage() {}
+
+func (x *ExternalStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_external_statement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalStatement.ProtoReflect.Descriptor instead.
+func (*ExternalStatement) Descriptor() ([]byte, []int) {
+	return file_external_statement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExternalStatement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExternalStatement) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetExternalAccount() string {
+	if x != nil {
+		return x.ExternalAccount
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetClearingAccountId() int64 {
+	if x != nil {
+		return x.ClearingAccountId
+	}
+	return 0
+}
+
+func (x *ExternalStatement) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *ExternalStatement) GetClosingBalance() int64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+func (x *ExternalStatement) GetImportedBy() string {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return ""
+}
+
+func (x *ExternalStatement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExternalStatement) GetLines() []*ExternalStatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_external_statement_proto protoreflect.FileDescriptor
+
+const file_external_statement_proto_rawDesc = "" +
+	"\n" +
+	"\x18external_statement.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x03\n" +
+	"\x15ExternalStatementLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fstatement_id\x18\x02 \x01(\x03R\vstatementId\x12!\n" +
+	"\fbooking_date\x18\x03 \x01(\tR\vbookingDate\x12\x1d\n" +
+	"\n" +
+	"value_date\x18\x04 \x01(\tR\tvalueDate\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12!\n" +
+	"\rend_to_end_id\x18\a \x01(\tR\n" +
+	"endToEndId\x12\"\n" +
+	"\fcounterparty\x18\b \x01(\tR\fcounterparty\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x19\n" +
+	"\bentry_id\x18\v \x01(\x03R\aentryId\x12\x1f\n" +
+	"\vresolved_by\x18\f \x01(\tR\n" +
+	"resolvedBy\x12'\n" +
+	"\x0fresolution_note\x18\r \x01(\tR\x0eresolutionNote\x12;\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xe5\x03\n" +
+	"\x11ExternalStatement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12)\n" +
+	"\x10external_account\x18\x04 \x01(\tR\x0fexternalAccount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12.\n" +
+	"\x13clearing_account_id\x18\x06 \x01(\x03R\x11clearingAccountId\x12\x1b\n" +
+	"\tfrom_date\x18\a \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\b \x01(\tR\x06toDate\x12'\n" +
+	"\x0fopening_balance\x18\t \x01(\x03R\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\n" +
+	" \x01(\x03R\x0eclosingBalance\x12\x1f\n" +
+	"\vimported_by\x18\v \x01(\tR\n" +
+	"importedBy\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12/\n" +
+	"\x05lines\x18\r \x03(\v2\x19.pb.ExternalStatementLineR\x05linesB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_external_statement_proto_rawDescOnce sync.Once
+	file_external_statement_proto_rawDescData []byte
+)
+
+func file_external_statement_proto_rawDescGZIP() []byte {
+	file_external_statement_proto_rawDescOnce.Do(func() {
+		file_external_statement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_external_statement_proto_rawDesc), len(file_external_statement_proto_rawDesc)))
+	})
+	return file_external_statement_proto_rawDescData
+}
+
+var file_external_statement_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_external_statement_proto_goTypes = []any{
+	(*ExternalStatementLine)(nil), // 0: pb.ExternalStatementLine
+	(*ExternalStatement)(nil),     // 1: pb.ExternalStatement
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_external_statement_proto_depIdxs = []int32{
+	2, // 0: pb.ExternalStatementLine.resolved_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.ExternalStatement.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: pb.ExternalStatement.lines:type_name -> pb.ExternalStatementLine
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_external_statement_proto_init() }
+func file_external_statement_proto_init() {
+	if File_external_statement_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_external_statement_proto_rawDesc), len(file_external_statement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_external_statement_proto_goTypes,
+		DependencyIndexes: file_external_statement_proto_depIdxs,
+		MessageInfos:      file_external_statement_proto_msgTypes,
+	}.Build()
+	File_external_statement_proto = out.File
+	file_external_statement_proto_goTypes = nil
+	file_external_statement_proto_depIdxs = nil
+}
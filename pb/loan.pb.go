@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Loan struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Principal int64                  `protobuf:"varint,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Currency  string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// annual interest rate in basis points
+	AnnualRateBps int32 `protobuf:"varint,5,opt,name=annual_rate_bps,json=annualRateBps,proto3" json:"annual_rate_bps,omitempty"`
+	TermMonths    int32 `protobuf:"varint,6,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	// annuity or linear
+	Method               string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	Status               string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	OutstandingPrincipal int64  `protobuf:"varint,9,opt,name=outstanding_principal,json=outstandingPrincipal,proto3" json:"outstanding_principal,omitempty"`
+	// interest of the installments that are not paid yet
+	OutstandingInterest int64                  `protobuf:"varint,10,opt,name=outstanding_interest,json=outstandingInterest,proto3" json:"outstanding_interest,omitempty"`
+	DisbursedBy         string                 `protobuf:"bytes,11,opt,name=disbursed_by,json=disbursedBy,proto3" json:"disbursed_by,omitempty"`
+	PaidOffAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=paid_off_at,json=paidOffAt,proto3" json:"paid_off_at,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	mi := &file_loan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Loan) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Loan) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Loan) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Loan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Loan) GetAnnualRateBps() int32 {
+	if x != nil {
+		return x.AnnualRateBps
+	}
+	return 0
+}
+
+func (x *Loan) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *Loan) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Loan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Loan) GetOutstandingPrincipal() int64 {
+	if x != nil {
+		return x.OutstandingPrincipal
+	}
+	return 0
+}
+
+func (x *Loan) GetOutstandingInterest() int64 {
+	if x != nil {
+		return x.OutstandingInterest
+	}
+	return 0
+}
+
+func (x *Loan) GetDisbursedBy() string {
+	if x != nil {
+		return x.DisbursedBy
+	}
+	return ""
+}
+
+func (x *Loan) GetPaidOffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidOffAt
+	}
+	return nil
+}
+
+func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LoanInstallment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId int64                  `protobuf:"varint,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Seq    int32                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	// YYYY-MM-DD
+	DueDate       string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Principal     int64                  `protobuf:"varint,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest      int64                  `protobuf:"varint,6,opt,name=interest,proto3" json:"interest,omitempty"`
+	LateFee       int64                  `protobuf:"varint,7,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanInstallment) Reset() {
+	*x = LoanInstallment{}
+	mi := &file_loan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanInstallment) ProtoMessage() {}
+
+func (x *LoanInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanInstallment.ProtoReflect.Descriptor instead.
+func (*LoanInstallment) Descriptor() ([]byte, []int) {
+	return file_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoanInstallment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetInterest() int64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetLateFee() int64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+var File_loan_proto protoreflect.FileDescriptor
+
+const file_loan_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"loan.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x03\n" +
+	"\x04Loan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\x03R\tprincipal\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12&\n" +
+	"\x0fannual_rate_bps\x18\x05 \x01(\x05R\rannualRateBps\x12\x1f\n" +
+	"\vterm_months\x18\x06 \x01(\x05R\n" +
+	"termMonths\x12\x16\n" +
+	"\x06method\x18\a \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x123\n" +
+	"\x15outstanding_principal\x18\t \x01(\x03R\x14outstandingPrincipal\x121\n" +
+	"\x14outstanding_interest\x18\n" +
+	" \x01(\x03R\x13outstandingInterest\x12!\n" +
+	"\fdisbursed_by\x18\v \x01(\tR\vdisbursedBy\x12:\n" +
+	"\vpaid_off_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tpaidOffAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x02\n" +
+	"\x0fLoanInstallment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\x03R\x06loanId\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\x05R\x03seq\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\x03R\tprincipal\x12\x1a\n" +
+	"\binterest\x18\x06 \x01(\x03R\binterest\x12\x19\n" +
+	"\blate_fee\x18\a \x01(\x03R\alateFee\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x123\n" +
+	"\apaid_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAtB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_loan_proto_rawDescOnce sync.Once
+	file_loan_proto_rawDescData []byte
+)
+
+func file_loan_proto_rawDescGZIP() []byte {
+	file_loan_proto_rawDescOnce.Do(func() {
+		file_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_loan_proto_rawDesc), len(file_loan_proto_rawDesc)))
+	})
+	return file_loan_proto_rawDescData
+}
+
+var file_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_loan_proto_goTypes = []any{
+	(*Loan)(nil),                  // 0: pb.Loan
+	(*LoanInstallment)(nil),       // 1: pb.LoanInstallment
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_loan_proto_depIdxs = []int32{
+	2, // 0: pb.Loan.paid_off_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.Loan.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.LoanInstallment.paid_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_loan_proto_init() }
+func file_loan_proto_init() {
+	if File_loan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loan_proto_rawDesc), len(file_loan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_loan_proto_goTypes,
+		DependencyIndexes: file_loan_proto_depIdxs,
+		MessageInfos:      file_loan_proto_msgTypes,
+	}.Build()
+	File_loan_proto = out.File
+	file_loan_proto_goTypes = nil
+	file_loan_proto_depIdxs = nil
+}
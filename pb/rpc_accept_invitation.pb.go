@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_accept_invitation.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_rpc_accept_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptInvitationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AcceptInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *AccountInvitation     `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	Holder        *AccountHolder         `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_rpc_accept_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_invitation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_invitation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptInvitationResponse) GetInvitation() *AccountInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *AcceptInvitationResponse) GetHolder() *AccountHolder {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+var File_rpc_accept_invitation_proto protoreflect.FileDescriptor
+
+const file_rpc_accept_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_accept_invitation.proto\x12\x02pb\x1a\x14account_holder.proto\")\n" +
+	"\x17AcceptInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"|\n" +
+	"\x18AcceptInvitationResponse\x125\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x15.pb.AccountInvitationR\n" +
+	"invitation\x12)\n" +
+	"\x06holder\x18\x02 \x01(\v2\x11.pb.AccountHolderR\x06holderB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_accept_invitation_proto_rawDescOnce sync.Once
+	file_rpc_accept_invitation_proto_rawDescData []byte
+)
+
+func file_rpc_accept_invitation_proto_rawDescGZIP() []byte {
+	file_rpc_accept_invitation_proto_rawDescOnce.Do(func() {
+		file_rpc_accept_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_accept_invitation_proto_rawDesc), len(file_rpc_accept_invitation_proto_rawDesc)))
+	})
+	return file_rpc_accept_invitation_proto_rawDescData
+}
+
+var file_rpc_accept_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_accept_invitation_proto_goTypes = []any{
+	(*AcceptInvitationRequest)(nil),  // 0: pb.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil), // 1: pb.AcceptInvitationResponse
+	(*AccountInvitation)(nil),        // 2: pb.AccountInvitation
+	(*AccountHolder)(nil),            // 3: pb.AccountHolder
+}
+var file_rpc_accept_invitation_proto_depIdxs = []int32{
+	2, // 0: pb.AcceptInvitationResponse.invitation:type_name -> pb.AccountInvitation
+	3, // 1: pb.AcceptInvitationResponse.holder:type_name -> pb.AccountHolder
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_accept_invitation_proto_init() }
+func file_rpc_accept_invitation_proto_init() {
+	if File_rpc_accept_invitation_proto != nil {
+		return
+	}
+	file_account_holder_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_accept_invitation_proto_rawDesc), len(file_rpc_accept_invitation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_accept_invitation_proto_goTypes,
+		DependencyIndexes: file_rpc_accept_invitation_proto_depIdxs,
+		MessageInfos:      file_rpc_accept_invitation_proto_msgTypes,
+	}.Build()
+	File_rpc_accept_invitation_proto = out.File
+	file_rpc_accept_invitation_proto_goTypes = nil
+	file_rpc_accept_invitation_proto_depIdxs = nil
+}
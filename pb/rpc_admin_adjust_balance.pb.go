@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_adjust_balance.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminAdjustBalanceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount    int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAdjustBalanceRequest) Reset() {
+	*x = AdminAdjustBalanceRequest{}
+	mi := &file_rpc_admin_adjust_balance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAdjustBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAdjustBalanceRequest) ProtoMessage() {}
+
+func (x *AdminAdjustBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_adjust_balance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAdjustBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdminAdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_adjust_balance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminAdjustBalanceRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminAdjustBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminAdjustBalanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminAdjustBalanceRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type AdminAdjustBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Adjustment    *BalanceAdjustment     `protobuf:"bytes,2,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAdjustBalanceResponse) Reset() {
+	*x = AdminAdjustBalanceResponse{}
+	mi := &file_rpc_admin_adjust_balance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAdjustBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAdjustBalanceResponse) ProtoMessage() {}
+
+func (x *AdminAdjustBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_adjust_balance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAdjustBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdminAdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_adjust_balance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminAdjustBalanceResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AdminAdjustBalanceResponse) GetAdjustment() *BalanceAdjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+var File_rpc_admin_adjust_balance_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_adjust_balance_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_admin_adjust_balance.proto\x12\x02pb\x1a\raccount.proto\"\x91\x01\n" +
+	"\x19AdminAdjustBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
+	"\x0eaccount_number\x18\x04 \x01(\tR\raccountNumber\"z\n" +
+	"\x1aAdminAdjustBalanceResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\x125\n" +
+	"\n" +
+	"adjustment\x18\x02 \x01(\v2\x15.pb.BalanceAdjustmentR\n" +
+	"adjustmentB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_adjust_balance_proto_rawDescOnce sync.Once
+	file_rpc_admin_adjust_balance_proto_rawDescData []byte
+)
+
+func file_rpc_admin_adjust_balance_proto_rawDescGZIP() []byte {
+	file_rpc_admin_adjust_balance_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_adjust_balance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_adjust_balance_proto_rawDesc), len(file_rpc_admin_adjust_balance_proto_rawDesc)))
+	})
+	return file_rpc_admin_adjust_balance_proto_rawDescData
+}
+
+var file_rpc_admin_adjust_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_adjust_balance_proto_goTypes = []any{
+	(*AdminAdjustBalanceRequest)(nil),  // 0: pb.AdminAdjustBalanceRequest
+	(*AdminAdjustBalanceResponse)(nil), // 1: pb.AdminAdjustBalanceResponse
+	(*Account)(nil),                    // 2: pb.Account
+	(*BalanceAdjustment)(nil),          // 3: pb.BalanceAdjustment
+}
+var file_rpc_admin_adjust_balance_proto_depIdxs = []int32{
+	2, // 0: pb.AdminAdjustBalanceResponse.account:type_name -> pb.Account
+	3, // 1: pb.AdminAdjustBalanceResponse.adjustment:type_name -> pb.BalanceAdjustment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_adjust_balance_proto_init() }
+func file_rpc_admin_adjust_balance_proto_init() {
+	if File_rpc_admin_adjust_balance_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_adjust_balance_proto_rawDesc), len(file_rpc_admin_adjust_balance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_adjust_balance_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_adjust_balance_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_adjust_balance_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_adjust_balance_proto = out.File
+	file_rpc_admin_adjust_balance_proto_goTypes = nil
+	file_rpc_admin_adjust_balance_proto_depIdxs = nil
+}
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_cash_operation.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminCashOperationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount    int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCashOperationRequest) Reset() {
+	*x = AdminCashOperationRequest{}
+	mi := &file_rpc_admin_cash_operation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCashOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCashOperationRequest) ProtoMessage() {}
+
+func (x *AdminCashOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_cash_operation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCashOperationRequest.ProtoReflect.Descriptor instead.
+func (*AdminCashOperationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_cash_operation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminCashOperationRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminCashOperationRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminCashOperationRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type AdminCashOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *CashReceipt           `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCashOperationResponse) Reset() {
+	*x = AdminCashOperationResponse{}
+	mi := &file_rpc_admin_cash_operation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCashOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCashOperationResponse) ProtoMessage() {}
+
+func (x *AdminCashOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_cash_operation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCashOperationResponse.ProtoReflect.Descriptor instead.
+func (*AdminCashOperationResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_cash_operation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminCashOperationResponse) GetReceipt() *CashReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+var File_rpc_admin_cash_operation_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_cash_operation_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_admin_cash_operation.proto\x12\x02pb\x1a\x12cash_receipt.proto\"y\n" +
+	"\x19AdminCashOperationRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\"G\n" +
+	"\x1aAdminCashOperationResponse\x12)\n" +
+	"\areceipt\x18\x01 \x01(\v2\x0f.pb.CashReceiptR\areceiptB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_cash_operation_proto_rawDescOnce sync.Once
+	file_rpc_admin_cash_operation_proto_rawDescData []byte
+)
+
+func file_rpc_admin_cash_operation_proto_rawDescGZIP() []byte {
+	file_rpc_admin_cash_operation_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_cash_operation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_cash_operation_proto_rawDesc), len(file_rpc_admin_cash_operation_proto_rawDesc)))
+	})
+	return file_rpc_admin_cash_operation_proto_rawDescData
+}
+
+var file_rpc_admin_cash_operation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_cash_operation_proto_goTypes = []any{
+	(*AdminCashOperationRequest)(nil),  // 0: pb.AdminCashOperationRequest
+	(*AdminCashOperationResponse)(nil), // 1: pb.AdminCashOperationResponse
+	(*CashReceipt)(nil),                // 2: pb.CashReceipt
+}
+var file_rpc_admin_cash_operation_proto_depIdxs = []int32{
+	2, // 0: pb.AdminCashOperationResponse.receipt:type_name -> pb.CashReceipt
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_cash_operation_proto_init() }
+func file_rpc_admin_cash_operation_proto_init() {
+	if File_rpc_admin_cash_operation_proto != nil {
+		return
+	}
+	file_cash_receipt_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_cash_operation_proto_rawDesc), len(file_rpc_admin_cash_operation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_cash_operation_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_cash_operation_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_cash_operation_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_cash_operation_proto = out.File
+	file_rpc_admin_cash_operation_proto_goTypes = nil
+	file_rpc_admin_cash_operation_proto_depIdxs = nil
+}
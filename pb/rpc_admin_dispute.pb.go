@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_dispute.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminListDisputesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PageId        int32                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListDisputesRequest) Reset() {
+	*x = AdminListDisputesRequest{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListDisputesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListDisputesRequest) ProtoMessage() {}
+
+func (x *AdminListDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListDisputesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminListDisputesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminListDisputesRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *AdminListDisputesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListDisputesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disputes      []*Dispute             `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListDisputesResponse) Reset() {
+	*x = AdminListDisputesResponse{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListDisputesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListDisputesResponse) ProtoMessage() {}
+
+func (x *AdminListDisputesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListDisputesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListDisputesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminListDisputesResponse) GetDisputes() []*Dispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+type AdminStartDisputeInvestigationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminStartDisputeInvestigationRequest) Reset() {
+	*x = AdminStartDisputeInvestigationRequest{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminStartDisputeInvestigationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminStartDisputeInvestigationRequest) ProtoMessage() {}
+
+func (x *AdminStartDisputeInvestigationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminStartDisputeInvestigationRequest.ProtoReflect.Descriptor instead.
+func (*AdminStartDisputeInvestigationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminStartDisputeInvestigationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminStartDisputeInvestigationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminStartDisputeInvestigationResponse) Reset() {
+	*x = AdminStartDisputeInvestigationResponse{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminStartDisputeInvestigationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminStartDisputeInvestigationResponse) ProtoMessage() {}
+
+func (x *AdminStartDisputeInvestigationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminStartDisputeInvestigationResponse.ProtoReflect.Descriptor instead.
+func (*AdminStartDisputeInvestigationResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminStartDisputeInvestigationResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+type AdminResolveDisputeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// customer or merchant
+	Favour        string `protobuf:"bytes,2,opt,name=favour,proto3" json:"favour,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminResolveDisputeRequest) Reset() {
+	*x = AdminResolveDisputeRequest{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResolveDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResolveDisputeRequest) ProtoMessage() {}
+
+func (x *AdminResolveDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResolveDisputeRequest.ProtoReflect.Descriptor instead.
+func (*AdminResolveDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdminResolveDisputeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminResolveDisputeRequest) GetFavour() string {
+	if x != nil {
+		return x.Favour
+	}
+	return ""
+}
+
+func (x *AdminResolveDisputeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminResolveDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminResolveDisputeResponse) Reset() {
+	*x = AdminResolveDisputeResponse{}
+	mi := &file_rpc_admin_dispute_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResolveDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResolveDisputeResponse) ProtoMessage() {}
+
+func (x *AdminResolveDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_dispute_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResolveDisputeResponse.ProtoReflect.Descriptor instead.
+func (*AdminResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_dispute_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AdminResolveDisputeResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+var File_rpc_admin_dispute_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_dispute_proto_rawDesc = "" +
+	"\n" +
+	"\x17rpc_admin_dispute.proto\x12\x02pb\x1a\rdispute.proto\"h\n" +
+	"\x18AdminListDisputesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"D\n" +
+	"\x19AdminListDisputesResponse\x12'\n" +
+	"\bdisputes\x18\x01 \x03(\v2\v.pb.DisputeR\bdisputes\"7\n" +
+	"%AdminStartDisputeInvestigationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"&AdminStartDisputeInvestigationResponse\x12%\n" +
+	"\adispute\x18\x01 \x01(\v2\v.pb.DisputeR\adispute\"X\n" +
+	"\x1aAdminResolveDisputeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06favour\x18\x02 \x01(\tR\x06favour\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"D\n" +
+	"\x1bAdminResolveDisputeResponse\x12%\n" +
+	"\adispute\x18\x01 \x01(\v2\v.pb.DisputeR\adisputeB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_dispute_proto_rawDescOnce sync.Once
+	file_rpc_admin_dispute_proto_rawDescData []byte
+)
+
+func file_rpc_admin_dispute_proto_rawDescGZIP() []byte {
+	file_rpc_admin_dispute_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_dispute_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_dispute_proto_rawDesc), len(file_rpc_admin_dispute_proto_rawDesc)))
+	})
+	return file_rpc_admin_dispute_proto_rawDescData
+}
+
+var file_rpc_admin_dispute_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_rpc_admin_dispute_proto_goTypes = []any{
+	(*AdminListDisputesRequest)(nil),               // 0: pb.AdminListDisputesRequest
+	(*AdminListDisputesResponse)(nil),              // 1: pb.AdminListDisputesResponse
+	(*AdminStartDisputeInvestigationRequest)(nil),  // 2: pb.AdminStartDisputeInvestigationRequest
+	(*AdminStartDisputeInvestigationResponse)(nil), // 3: pb.AdminStartDisputeInvestigationResponse
+	(*AdminResolveDisputeRequest)(nil),             // 4: pb.AdminResolveDisputeRequest
+	(*AdminResolveDisputeResponse)(nil),            // 5: pb.AdminResolveDisputeResponse
+	(*Dispute)(nil),                                // 6: pb.Dispute
+}
+var file_rpc_admin_dispute_proto_depIdxs = []int32{
+	6, // 0: pb.AdminListDisputesResponse.disputes:type_name -> pb.Dispute
+	6, // 1: pb.AdminStartDisputeInvestigationResponse.dispute:type_name -> pb.Dispute
+	6, // 2: pb.AdminResolveDisputeResponse.dispute:type_name -> pb.Dispute
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_dispute_proto_init() }
+func file_rpc_admin_dispute_proto_init() {
+	if File_rpc_admin_dispute_proto != nil {
+		return
+	}
+	file_dispute_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_dispute_proto_rawDesc), len(file_rpc_admin_dispute_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_dispute_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_dispute_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_dispute_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_dispute_proto = out.File
+	file_rpc_admin_dispute_proto_goTypes = nil
+	file_rpc_admin_dispute_proto_depIdxs = nil
+}
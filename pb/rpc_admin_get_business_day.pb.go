@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_get_business_day.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminGetBusinessDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetBusinessDayRequest) Reset() {
+	*x = AdminGetBusinessDayRequest{}
+	mi := &file_rpc_admin_get_business_day_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetBusinessDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetBusinessDayRequest) ProtoMessage() {}
+
+func (x *AdminGetBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_business_day_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetBusinessDayRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetBusinessDayRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_business_day_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminGetBusinessDayRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+type AdminGetBusinessDayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDay   *BusinessDay           `protobuf:"bytes,1,opt,name=business_day,json=businessDay,proto3" json:"business_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetBusinessDayResponse) Reset() {
+	*x = AdminGetBusinessDayResponse{}
+	mi := &file_rpc_admin_get_business_day_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetBusinessDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetBusinessDayResponse) ProtoMessage() {}
+
+func (x *AdminGetBusinessDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_business_day_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetBusinessDayResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetBusinessDayResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_business_day_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminGetBusinessDayResponse) GetBusinessDay() *BusinessDay {
+	if x != nil {
+		return x.BusinessDay
+	}
+	return nil
+}
+
+var File_rpc_admin_get_business_day_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_get_business_day_proto_rawDesc = "" +
+	"\n" +
+	" rpc_admin_get_business_day.proto\x12\x02pb\x1a\x12business_day.proto\"A\n" +
+	"\x1aAdminGetBusinessDayRequest\x12#\n" +
+	"\rbusiness_date\x18\x01 \x01(\tR\fbusinessDate\"Q\n" +
+	"\x1bAdminGetBusinessDayResponse\x122\n" +
+	"\fbusiness_day\x18\x01 \x01(\v2\x0f.pb.BusinessDayR\vbusinessDayB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_get_business_day_proto_rawDescOnce sync.Once
+	file_rpc_admin_get_business_day_proto_rawDescData []byte
+)
+
+func file_rpc_admin_get_business_day_proto_rawDescGZIP() []byte {
+	file_rpc_admin_get_business_day_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_get_business_day_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_get_business_day_proto_rawDesc), len(file_rpc_admin_get_business_day_proto_rawDesc)))
+	})
+	return file_rpc_admin_get_business_day_proto_rawDescData
+}
+
+var file_rpc_admin_get_business_day_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_get_business_day_proto_goTypes = []any{
+	(*AdminGetBusinessDayRequest)(nil),  // 0: pb.AdminGetBusinessDayRequest
+	(*AdminGetBusinessDayResponse)(nil), // 1: pb.AdminGetBusinessDayResponse
+	(*BusinessDay)(nil),                 // 2: pb.BusinessDay
+}
+var file_rpc_admin_get_business_day_proto_depIdxs = []int32{
+	2, // 0: pb.AdminGetBusinessDayResponse.business_day:type_name -> pb.BusinessDay
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_get_business_day_proto_init() }
+func file_rpc_admin_get_business_day_proto_init() {
+	if File_rpc_admin_get_business_day_proto != nil {
+		return
+	}
+	file_business_day_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_get_business_day_proto_rawDesc), len(file_rpc_admin_get_business_day_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_get_business_day_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_get_business_day_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_get_business_day_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_get_business_day_proto = out.File
+	file_rpc_admin_get_business_day_proto_goTypes = nil
+	file_rpc_admin_get_business_day_proto_depIdxs = nil
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_get_cash_receipt.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminGetCashReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetCashReceiptRequest) Reset() {
+	*x = AdminGetCashReceiptRequest{}
+	mi := &file_rpc_admin_get_cash_receipt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetCashReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetCashReceiptRequest) ProtoMessage() {}
+
+func (x *AdminGetCashReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_cash_receipt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetCashReceiptRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetCashReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_cash_receipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminGetCashReceiptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminGetCashReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *CashReceipt           `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetCashReceiptResponse) Reset() {
+	*x = AdminGetCashReceiptResponse{}
+	mi := &file_rpc_admin_get_cash_receipt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetCashReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetCashReceiptResponse) ProtoMessage() {}
+
+func (x *AdminGetCashReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_cash_receipt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetCashReceiptResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetCashReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_cash_receipt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminGetCashReceiptResponse) GetReceipt() *CashReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+var File_rpc_admin_get_cash_receipt_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_get_cash_receipt_proto_rawDesc = "" +
+	"\n" +
+	" rpc_admin_get_cash_receipt.proto\x12\x02pb\x1a\x12cash_receipt.proto\",\n" +
+	"\x1aAdminGetCashReceiptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
+	"\x1bAdminGetCashReceiptResponse\x12)\n" +
+	"\areceipt\x18\x01 \x01(\v2\x0f.pb.CashReceiptR\areceiptB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_get_cash_receipt_proto_rawDescOnce sync.Once
+	file_rpc_admin_get_cash_receipt_proto_rawDescData []byte
+)
+
+func file_rpc_admin_get_cash_receipt_proto_rawDescGZIP() []byte {
+	file_rpc_admin_get_cash_receipt_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_get_cash_receipt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_get_cash_receipt_proto_rawDesc), len(file_rpc_admin_get_cash_receipt_proto_rawDesc)))
+	})
+	return file_rpc_admin_get_cash_receipt_proto_rawDescData
+}
+
+var file_rpc_admin_get_cash_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_get_cash_receipt_proto_goTypes = []any{
+	(*AdminGetCashReceiptRequest)(nil),  // 0: pb.AdminGetCashReceiptRequest
+	(*AdminGetCashReceiptResponse)(nil), // 1: pb.AdminGetCashReceiptResponse
+	(*CashReceipt)(nil),                 // 2: pb.CashReceipt
+}
+var file_rpc_admin_get_cash_receipt_proto_depIdxs = []int32{
+	2, // 0: pb.AdminGetCashReceiptResponse.receipt:type_name -> pb.CashReceipt
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_get_cash_receipt_proto_init() }
+func file_rpc_admin_get_cash_receipt_proto_init() {
+	if File_rpc_admin_get_cash_receipt_proto != nil {
+		return
+	}
+	file_cash_receipt_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_get_cash_receipt_proto_rawDesc), len(file_rpc_admin_get_cash_receipt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_get_cash_receipt_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_get_cash_receipt_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_get_cash_receipt_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_get_cash_receipt_proto = out.File
+	file_rpc_admin_get_cash_receipt_proto_goTypes = nil
+	file_rpc_admin_get_cash_receipt_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_get_trial_balance.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminGetTrialBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTrialBalanceRequest) Reset() {
+	*x = AdminGetTrialBalanceRequest{}
+	mi := &file_rpc_admin_get_trial_balance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTrialBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTrialBalanceRequest) ProtoMessage() {}
+
+func (x *AdminGetTrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_trial_balance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_trial_balance_proto_rawDescGZIP(), []int{0}
+}
+
+type AdminGetTrialBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*TrialBalance        `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetTrialBalanceResponse) Reset() {
+	*x = AdminGetTrialBalanceResponse{}
+	mi := &file_rpc_admin_get_trial_balance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTrialBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTrialBalanceResponse) ProtoMessage() {}
+
+func (x *AdminGetTrialBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_trial_balance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTrialBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetTrialBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_trial_balance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminGetTrialBalanceResponse) GetBalances() []*TrialBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+var File_rpc_admin_get_trial_balance_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_get_trial_balance_proto_rawDesc = "" +
+	"\n" +
+	"!rpc_admin_get_trial_balance.proto\x12\x02pb\x1a\fledger.proto\"\x1d\n" +
+	"\x1bAdminGetTrialBalanceRequest\"L\n" +
+	"\x1cAdminGetTrialBalanceResponse\x12,\n" +
+	"\bbalances\x18\x01 \x03(\v2\x10.pb.TrialBalanceR\bbalancesB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_get_trial_balance_proto_rawDescOnce sync.Once
+	file_rpc_admin_get_trial_balance_proto_rawDescData []byte
+)
+
+func file_rpc_admin_get_trial_balance_proto_rawDescGZIP() []byte {
+	file_rpc_admin_get_trial_balance_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_get_trial_balance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_get_trial_balance_proto_rawDesc), len(file_rpc_admin_get_trial_balance_proto_rawDesc)))
+	})
+	return file_rpc_admin_get_trial_balance_proto_rawDescData
+}
+
+var file_rpc_admin_get_trial_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_get_trial_balance_proto_goTypes = []any{
+	(*AdminGetTrialBalanceRequest)(nil),  // 0: pb.AdminGetTrialBalanceRequest
+	(*AdminGetTrialBalanceResponse)(nil), // 1: pb.AdminGetTrialBalanceResponse
+	(*TrialBalance)(nil),                 // 2: pb.TrialBalance
+}
+var file_rpc_admin_get_trial_balance_proto_depIdxs = []int32{
+	2, // 0: pb.AdminGetTrialBalanceResponse.balances:type_name -> pb.TrialBalance
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_get_trial_balance_proto_init() }
+func file_rpc_admin_get_trial_balance_proto_init() {
+	if File_rpc_admin_get_trial_balance_proto != nil {
+		return
+	}
+	file_ledger_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_get_trial_balance_proto_rawDesc), len(file_rpc_admin_get_trial_balance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_get_trial_balance_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_get_trial_balance_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_get_trial_balance_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_get_trial_balance_proto = out.File
+	file_rpc_admin_get_trial_balance_proto_goTypes = nil
+	file_rpc_admin_get_trial_balance_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_import_statement.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminImportStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminImportStatementRequest) Reset() {
+	*x = AdminImportStatementRequest{}
+	mi := &file_rpc_admin_import_statement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminImportStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImportStatementRequest) ProtoMessage() {}
+
+func (x *AdminImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_import_statement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*AdminImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_import_statement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminImportStatementRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AdminImportStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type AdminImportStatementResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Statements []*ExternalStatement   `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
+	// references of the statements in the file that were already imported
+	Skipped       []string `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminImportStatementResponse) Reset() {
+	*x = AdminImportStatementResponse{}
+	mi := &file_rpc_admin_import_statement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminImportStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImportStatementResponse) ProtoMessage() {}
+
+func (x *AdminImportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_import_statement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImportStatementResponse.ProtoReflect.Descriptor instead.
+func (*AdminImportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_import_statement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminImportStatementResponse) GetStatements() []*ExternalStatement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+func (x *AdminImportStatementResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+var File_rpc_admin_import_statement_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_import_statement_proto_rawDesc = "" +
+	"\n" +
+	" rpc_admin_import_statement.proto\x12\x02pb\x1a\x18external_statement.proto\"O\n" +
+	"\x1bAdminImportStatementRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"o\n" +
+	"\x1cAdminImportStatementResponse\x125\n" +
+	"\n" +
+	"statements\x18\x01 \x03(\v2\x15.pb.ExternalStatementR\n" +
+	"statements\x12\x18\n" +
+	"\askipped\x18\x02 \x03(\tR\askippedB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_import_statement_proto_rawDescOnce sync.Once
+	file_rpc_admin_import_statement_proto_rawDescData []byte
+)
+
+func file_rpc_admin_import_statement_proto_rawDescGZIP() []byte {
+	file_rpc_admin_import_statement_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_import_statement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_import_statement_proto_rawDesc), len(file_rpc_admin_import_statement_proto_rawDesc)))
+	})
+	return file_rpc_admin_import_statement_proto_rawDescData
+}
+
+var file_rpc_admin_import_statement_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_import_statement_proto_goTypes = []any{
+	(*AdminImportStatementRequest)(nil),  // 0: pb.AdminImportStatementRequest
+	(*AdminImportStatementResponse)(nil), // 1: pb.AdminImportStatementResponse
+	(*ExternalStatement)(nil),            // 2: pb.ExternalStatement
+}
+var file_rpc_admin_import_statement_proto_depIdxs = []int32{
+	2, // 0: pb.AdminImportStatementResponse.statements:type_name -> pb.ExternalStatement
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_import_statement_proto_init() }
+func file_rpc_admin_import_statement_proto_init() {
+	if File_rpc_admin_import_statement_proto != nil {
+		return
+	}
+	file_external_statement_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_import_statement_proto_rawDesc), len(file_rpc_admin_import_statement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_import_statement_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_import_statement_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_import_statement_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_import_statement_proto = out.File
+	file_rpc_admin_import_statement_proto_goTypes = nil
+	file_rpc_admin_import_statement_proto_depIdxs = nil
+}
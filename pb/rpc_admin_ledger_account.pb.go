@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_ledger_account.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminCreateLedgerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateLedgerAccountRequest) Reset() {
+	*x = AdminCreateLedgerAccountRequest{}
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateLedgerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateLedgerAccountRequest) ProtoMessage() {}
+
+func (x *AdminCreateLedgerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateLedgerAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateLedgerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_ledger_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminCreateLedgerAccountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AdminCreateLedgerAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminCreateLedgerAccountRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminCreateLedgerAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type AdminCreateLedgerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateLedgerAccountResponse) Reset() {
+	*x = AdminCreateLedgerAccountResponse{}
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateLedgerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateLedgerAccountResponse) ProtoMessage() {}
+
+func (x *AdminCreateLedgerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateLedgerAccountResponse.ProtoReflect.Descriptor instead.
+func (*AdminCreateLedgerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_ledger_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminCreateLedgerAccountResponse) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AdminListLedgerAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListLedgerAccountsRequest) Reset() {
+	*x = AdminListLedgerAccountsRequest{}
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListLedgerAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListLedgerAccountsRequest) ProtoMessage() {}
+
+func (x *AdminListLedgerAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListLedgerAccountsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListLedgerAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_ledger_account_proto_rawDescGZIP(), []int{2}
+}
+
+type AdminListLedgerAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*LedgerAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListLedgerAccountsResponse) Reset() {
+	*x = AdminListLedgerAccountsResponse{}
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListLedgerAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListLedgerAccountsResponse) ProtoMessage() {}
+
+func (x *AdminListLedgerAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_ledger_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListLedgerAccountsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListLedgerAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_ledger_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminListLedgerAccountsResponse) GetAccounts() []*LedgerAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+var File_rpc_admin_ledger_account_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_ledger_account_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_admin_ledger_account.proto\x12\x02pb\x1a\fledger.proto\"y\n" +
+	"\x1fAdminCreateLedgerAccountRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"O\n" +
+	" AdminCreateLedgerAccountResponse\x12+\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.pb.LedgerAccountR\aaccount\" \n" +
+	"\x1eAdminListLedgerAccountsRequest\"P\n" +
+	"\x1fAdminListLedgerAccountsResponse\x12-\n" +
+	"\baccounts\x18\x01 \x03(\v2\x11.pb.LedgerAccountR\baccountsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_ledger_account_proto_rawDescOnce sync.Once
+	file_rpc_admin_ledger_account_proto_rawDescData []byte
+)
+
+func file_rpc_admin_ledger_account_proto_rawDescGZIP() []byte {
+	file_rpc_admin_ledger_account_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_ledger_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_ledger_account_proto_rawDesc), len(file_rpc_admin_ledger_account_proto_rawDesc)))
+	})
+	return file_rpc_admin_ledger_account_proto_rawDescData
+}
+
+var file_rpc_admin_ledger_account_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_admin_ledger_account_proto_goTypes = []any{
+	(*AdminCreateLedgerAccountRequest)(nil),  // 0: pb.AdminCreateLedgerAccountRequest
+	(*AdminCreateLedgerAccountResponse)(nil), // 1: pb.AdminCreateLedgerAccountResponse
+	(*AdminListLedgerAccountsRequest)(nil),   // 2: pb.AdminListLedgerAccountsRequest
+	(*AdminListLedgerAccountsResponse)(nil),  // 3: pb.AdminListLedgerAccountsResponse
+	(*LedgerAccount)(nil),                    // 4: pb.LedgerAccount
+}
+var file_rpc_admin_ledger_account_proto_depIdxs = []int32{
+	4, // 0: pb.AdminCreateLedgerAccountResponse.account:type_name -> pb.LedgerAccount
+	4, // 1: pb.AdminListLedgerAccountsResponse.accounts:type_name -> pb.LedgerAccount
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_ledger_account_proto_init() }
+func file_rpc_admin_ledger_account_proto_init() {
+	if File_rpc_admin_ledger_account_proto != nil {
+		return
+	}
+	file_ledger_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_ledger_account_proto_rawDesc), len(file_rpc_admin_ledger_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_ledger_account_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_ledger_account_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_ledger_account_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_ledger_account_proto = out.File
+	file_rpc_admin_ledger_account_proto_goTypes = nil
+	file_rpc_admin_ledger_account_proto_depIdxs = nil
+}
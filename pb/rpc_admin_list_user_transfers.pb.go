@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_list_user_transfers.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminListUserTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PageId        int32                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserTransfersRequest) Reset() {
+	*x = AdminListUserTransfersRequest{}
+	mi := &file_rpc_admin_list_user_transfers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserTransfersRequest) ProtoMessage() {}
+
+func (x *AdminListUserTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_user_transfers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserTransfersRequest.ProtoReflect.Descriptor instead.
+func (*AdminListUserTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_user_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminListUserTransfersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminListUserTransfersRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *AdminListUserTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListUserTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserTransfersResponse) Reset() {
+	*x = AdminListUserTransfersResponse{}
+	mi := &file_rpc_admin_list_user_transfers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserTransfersResponse) ProtoMessage() {}
+
+func (x *AdminListUserTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_user_transfers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserTransfersResponse.ProtoReflect.Descriptor instead.
+func (*AdminListUserTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_user_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminListUserTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+var File_rpc_admin_list_user_transfers_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_list_user_transfers_proto_rawDesc = "" +
+	"\n" +
+	"#rpc_admin_list_user_transfers.proto\x12\x02pb\x1a\x0etransfer.proto\"q\n" +
+	"\x1dAdminListUserTransfersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"L\n" +
+	"\x1eAdminListUserTransfersResponse\x12*\n" +
+	"\ttransfers\x18\x01 \x03(\v2\f.pb.TransferR\ttransfersB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_list_user_transfers_proto_rawDescOnce sync.Once
+	file_rpc_admin_list_user_transfers_proto_rawDescData []byte
+)
+
+func file_rpc_admin_list_user_transfers_proto_rawDescGZIP() []byte {
+	file_rpc_admin_list_user_transfers_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_list_user_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_list_user_transfers_proto_rawDesc), len(file_rpc_admin_list_user_transfers_proto_rawDesc)))
+	})
+	return file_rpc_admin_list_user_transfers_proto_rawDescData
+}
+
+var file_rpc_admin_list_user_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_list_user_transfers_proto_goTypes = []any{
+	(*AdminListUserTransfersRequest)(nil),  // 0: pb.AdminListUserTransfersRequest
+	(*AdminListUserTransfersResponse)(nil), // 1: pb.AdminListUserTransfersResponse
+	(*Transfer)(nil),                       // 2: pb.Transfer
+}
+var file_rpc_admin_list_user_transfers_proto_depIdxs = []int32{
+	2, // 0: pb.AdminListUserTransfersResponse.transfers:type_name -> pb.Transfer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_list_user_transfers_proto_init() }
+func file_rpc_admin_list_user_transfers_proto_init() {
+	if File_rpc_admin_list_user_transfers_proto != nil {
+		return
+	}
+	file_transfer_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_list_user_transfers_proto_rawDesc), len(file_rpc_admin_list_user_transfers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_list_user_transfers_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_list_user_transfers_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_list_user_transfers_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_list_user_transfers_proto = out.File
+	file_rpc_admin_list_user_transfers_proto_goTypes = nil
+	file_rpc_admin_list_user_transfers_proto_depIdxs = nil
+}
@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminDisburseLoanRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Principal int64                  `protobuf:"varint,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// annual interest rate in basis points
+	AnnualRateBps int32 `protobuf:"varint,3,opt,name=annual_rate_bps,json=annualRateBps,proto3" json:"annual_rate_bps,omitempty"`
+	TermMonths    int32 `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	// annuity or linear
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,6,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDisburseLoanRequest) Reset() {
+	*x = AdminDisburseLoanRequest{}
+	mi := &file_rpc_admin_loan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDisburseLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDisburseLoanRequest) ProtoMessage() {}
+
+func (x *AdminDisburseLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_loan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDisburseLoanRequest.ProtoReflect.Descriptor instead.
+func (*AdminDisburseLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminDisburseLoanRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminDisburseLoanRequest) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *AdminDisburseLoanRequest) GetAnnualRateBps() int32 {
+	if x != nil {
+		return x.AnnualRateBps
+	}
+	return 0
+}
+
+func (x *AdminDisburseLoanRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *AdminDisburseLoanRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AdminDisburseLoanRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type AdminDisburseLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Installments  []*LoanInstallment     `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+	Account       *Account               `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDisburseLoanResponse) Reset() {
+	*x = AdminDisburseLoanResponse{}
+	mi := &file_rpc_admin_loan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDisburseLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDisburseLoanResponse) ProtoMessage() {}
+
+func (x *AdminDisburseLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_loan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDisburseLoanResponse.ProtoReflect.Descriptor instead.
+func (*AdminDisburseLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminDisburseLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *AdminDisburseLoanResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *AdminDisburseLoanResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AdminGetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetLoanRequest) Reset() {
+	*x = AdminGetLoanRequest{}
+	mi := &file_rpc_admin_loan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetLoanRequest) ProtoMessage() {}
+
+func (x *AdminGetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_loan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetLoanRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_loan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminGetLoanRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminGetLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Installments  []*LoanInstallment     `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetLoanResponse) Reset() {
+	*x = AdminGetLoanResponse{}
+	mi := &file_rpc_admin_loan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetLoanResponse) ProtoMessage() {}
+
+func (x *AdminGetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_loan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetLoanResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_loan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminGetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *AdminGetLoanResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+var File_rpc_admin_loan_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_loan_proto_rawDesc = "" +
+	"\n" +
+	"\x14rpc_admin_loan.proto\x12\x02pb\x1a\raccount.proto\x1a\n" +
+	"loan.proto\"\xdf\x01\n" +
+	"\x18AdminDisburseLoanRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\x03R\tprincipal\x12&\n" +
+	"\x0fannual_rate_bps\x18\x03 \x01(\x05R\rannualRateBps\x12\x1f\n" +
+	"\vterm_months\x18\x04 \x01(\x05R\n" +
+	"termMonths\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12%\n" +
+	"\x0eaccount_number\x18\x06 \x01(\tR\raccountNumber\"\x99\x01\n" +
+	"\x19AdminDisburseLoanResponse\x12\x1c\n" +
+	"\x04loan\x18\x01 \x01(\v2\b.pb.LoanR\x04loan\x127\n" +
+	"\finstallments\x18\x02 \x03(\v2\x13.pb.LoanInstallmentR\finstallments\x12%\n" +
+	"\aaccount\x18\x03 \x01(\v2\v.pb.AccountR\aaccount\"%\n" +
+	"\x13AdminGetLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"m\n" +
+	"\x14AdminGetLoanResponse\x12\x1c\n" +
+	"\x04loan\x18\x01 \x01(\v2\b.pb.LoanR\x04loan\x127\n" +
+	"\finstallments\x18\x02 \x03(\v2\x13.pb.LoanInstallmentR\finstallmentsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_loan_proto_rawDescOnce sync.Once
+	file_rpc_admin_loan_proto_rawDescData []byte
+)
+
+func file_rpc_admin_loan_proto_rawDescGZIP() []byte {
+	file_rpc_admin_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_loan_proto_rawDesc), len(file_rpc_admin_loan_proto_rawDesc)))
+	})
+	return file_rpc_admin_loan_proto_rawDescData
+}
+
+var file_rpc_admin_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_admin_loan_proto_goTypes = []any{
+	(*AdminDisburseLoanRequest)(nil),  // 0: pb.AdminDisburseLoanRequest
+	(*AdminDisburseLoanResponse)(nil), // 1: pb.AdminDisburseLoanResponse
+	(*AdminGetLoanRequest)(nil),       // 2: pb.AdminGetLoanRequest
+	(*AdminGetLoanResponse)(nil),      // 3: pb.AdminGetLoanResponse
+	(*Loan)(nil),                      // 4: pb.Loan
+	(*LoanInstallment)(nil),           // 5: pb.LoanInstallment
+	(*Account)(nil),                   // 6: pb.Account
+}
+var file_rpc_admin_loan_proto_depIdxs = []int32{
+	4, // 0: pb.AdminDisburseLoanResponse.loan:type_name -> pb.Loan
+	5, // 1: pb.AdminDisburseLoanResponse.installments:type_name -> pb.LoanInstallment
+	6, // 2: pb.AdminDisburseLoanResponse.account:type_name -> pb.Account
+	4, // 3: pb.AdminGetLoanResponse.loan:type_name -> pb.Loan
+	5, // 4: pb.AdminGetLoanResponse.installments:type_name -> pb.LoanInstallment
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_loan_proto_init() }
+func file_rpc_admin_loan_proto_init() {
+	if File_rpc_admin_loan_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_loan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_loan_proto_rawDesc), len(file_rpc_admin_loan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_loan_proto = out.File
+	file_rpc_admin_loan_proto_goTypes = nil
+	file_rpc_admin_loan_proto_depIdxs = nil
+}
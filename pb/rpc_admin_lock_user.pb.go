@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_lock_user.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminLockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLockUserRequest) Reset() {
+	*x = AdminLockUserRequest{}
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockUserRequest) ProtoMessage() {}
+
+func (x *AdminLockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminLockUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_lock_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminLockUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminLockUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminLockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLockUserResponse) Reset() {
+	*x = AdminLockUserResponse{}
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockUserResponse) ProtoMessage() {}
+
+func (x *AdminLockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminLockUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_lock_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminLockUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AdminUnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUnlockUserRequest) Reset() {
+	*x = AdminUnlockUserRequest{}
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUnlockUserRequest) ProtoMessage() {}
+
+func (x *AdminUnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminUnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_lock_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminUnlockUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AdminUnlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUnlockUserResponse) Reset() {
+	*x = AdminUnlockUserResponse{}
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUnlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUnlockUserResponse) ProtoMessage() {}
+
+func (x *AdminUnlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_lock_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUnlockUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminUnlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_lock_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminUnlockUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_rpc_admin_lock_user_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_lock_user_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_admin_lock_user.proto\x12\x02pb\x1a\x10admin_user.proto\"J\n" +
+	"\x14AdminLockUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\":\n" +
+	"\x15AdminLockUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.pb.AdminUserR\x04user\"4\n" +
+	"\x16AdminUnlockUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"<\n" +
+	"\x17AdminUnlockUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.pb.AdminUserR\x04userB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_lock_user_proto_rawDescOnce sync.Once
+	file_rpc_admin_lock_user_proto_rawDescData []byte
+)
+
+func file_rpc_admin_lock_user_proto_rawDescGZIP() []byte {
+	file_rpc_admin_lock_user_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_lock_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_lock_user_proto_rawDesc), len(file_rpc_admin_lock_user_proto_rawDesc)))
+	})
+	return file_rpc_admin_lock_user_proto_rawDescData
+}
+
+var file_rpc_admin_lock_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_admin_lock_user_proto_goTypes = []any{
+	(*AdminLockUserRequest)(nil),    // 0: pb.AdminLockUserRequest
+	(*AdminLockUserResponse)(nil),   // 1: pb.AdminLockUserResponse
+	(*AdminUnlockUserRequest)(nil),  // 2: pb.AdminUnlockUserRequest
+	(*AdminUnlockUserResponse)(nil), // 3: pb.AdminUnlockUserResponse
+	(*AdminUser)(nil),               // 4: pb.AdminUser
+}
+var file_rpc_admin_lock_user_proto_depIdxs = []int32{
+	4, // 0: pb.AdminLockUserResponse.user:type_name -> pb.AdminUser
+	4, // 1: pb.AdminUnlockUserResponse.user:type_name -> pb.AdminUser
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_lock_user_proto_init() }
+func file_rpc_admin_lock_user_proto_init() {
+	if File_rpc_admin_lock_user_proto != nil {
+		return
+	}
+	file_admin_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_lock_user_proto_rawDesc), len(file_rpc_admin_lock_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_lock_user_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_lock_user_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_lock_user_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_lock_user_proto = out.File
+	file_rpc_admin_lock_user_proto_goTypes = nil
+	file_rpc_admin_lock_user_proto_depIdxs = nil
+}
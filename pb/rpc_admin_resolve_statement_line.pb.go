@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_resolve_statement_line.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminResolveStatementLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        int64                  `protobuf:"varint,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	EntryId       *int64                 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3,oneof" json:"entry_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminResolveStatementLineRequest) Reset() {
+	*x = AdminResolveStatementLineRequest{}
+	mi := &file_rpc_admin_resolve_statement_line_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResolveStatementLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResolveStatementLineRequest) ProtoMessage() {}
+
+func (x *AdminResolveStatementLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_resolve_statement_line_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResolveStatementLineRequest.ProtoReflect.Descriptor instead.
+func (*AdminResolveStatementLineRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_resolve_statement_line_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminResolveStatementLineRequest) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *AdminResolveStatementLineRequest) GetEntryId() int64 {
+	if x != nil && x.EntryId != nil {
+		return *x.EntryId
+	}
+	return 0
+}
+
+func (x *AdminResolveStatementLineRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminResolveStatementLineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          *ExternalStatementLine `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminResolveStatementLineResponse) Reset() {
+	*x = AdminResolveStatementLineResponse{}
+	mi := &file_rpc_admin_resolve_statement_line_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminResolveStatementLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminResolveStatementLineResponse) ProtoMessage() {}
+
+func (x *AdminResolveStatementLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_resolve_statement_line_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminResolveStatementLineResponse.ProtoReflect.Descriptor instead.
+func (*AdminResolveStatementLineResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_resolve_statement_line_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminResolveStatementLineResponse) GetLine() *ExternalStatementLine {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+var File_rpc_admin_resolve_statement_line_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_resolve_statement_line_proto_rawDesc = "" +
+	"\n" +
+	"&rpc_admin_resolve_statement_line.proto\x12\x02pb\x1a\x18external_statement.proto\"|\n" +
+	" AdminResolveStatementLineRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\x03R\x06lineId\x12\x1e\n" +
+	"\bentry_id\x18\x02 \x01(\x03H\x00R\aentryId\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04noteB\v\n" +
+	"\t_entry_id\"R\n" +
+	"!AdminResolveStatementLineResponse\x12-\n" +
+	"\x04line\x18\x01 \x01(\v2\x19.pb.ExternalStatementLineR\x04lineB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_resolve_statement_line_proto_rawDescOnce sync.Once
+	file_rpc_admin_resolve_statement_line_proto_rawDescData []byte
+)
+
+func file_rpc_admin_resolve_statement_line_proto_rawDescGZIP() []byte {
+	file_rpc_admin_resolve_statement_line_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_resolve_statement_line_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_resolve_statement_line_proto_rawDesc), len(file_rpc_admin_resolve_statement_line_proto_rawDesc)))
+	})
+	return file_rpc_admin_resolve_statement_line_proto_rawDescData
+}
+
+var file_rpc_admin_resolve_statement_line_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_resolve_statement_line_proto_goTypes = []any{
+	(*AdminResolveStatementLineRequest)(nil),  // 0: pb.AdminResolveStatementLineRequest
+	(*AdminResolveStatementLineResponse)(nil), // 1: pb.AdminResolveStatementLineResponse
+	(*ExternalStatementLine)(nil),             // 2: pb.ExternalStatementLine
+}
+var file_rpc_admin_resolve_statement_line_proto_depIdxs = []int32{
+	2, // 0: pb.AdminResolveStatementLineResponse.line:type_name -> pb.ExternalStatementLine
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_resolve_statement_line_proto_init() }
+func file_rpc_admin_resolve_statement_line_proto_init() {
+	if File_rpc_admin_resolve_statement_line_proto != nil {
+		return
+	}
+	file_external_statement_proto_init()
+	file_rpc_admin_resolve_statement_line_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_resolve_statement_line_proto_rawDesc), len(file_rpc_admin_resolve_statement_line_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_resolve_statement_line_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_resolve_statement_line_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_resolve_statement_line_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_resolve_statement_line_proto = out.File
+	file_rpc_admin_resolve_statement_line_proto_goTypes = nil
+	file_rpc_admin_resolve_statement_line_proto_depIdxs = nil
+}
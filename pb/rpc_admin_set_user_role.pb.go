@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_set_user_role.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminSetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetUserRoleRequest) Reset() {
+	*x = AdminSetUserRoleRequest{}
+	mi := &file_rpc_admin_set_user_role_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetUserRoleRequest) ProtoMessage() {}
+
+func (x *AdminSetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_set_user_role_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_set_user_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminSetUserRoleRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminSetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AdminSetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetUserRoleResponse) Reset() {
+	*x = AdminSetUserRoleResponse{}
+	mi := &file_rpc_admin_set_user_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetUserRoleResponse) ProtoMessage() {}
+
+func (x *AdminSetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_set_user_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_set_user_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminSetUserRoleResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_rpc_admin_set_user_role_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_set_user_role_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_admin_set_user_role.proto\x12\x02pb\x1a\x10admin_user.proto\"I\n" +
+	"\x17AdminSetUserRoleRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"=\n" +
+	"\x18AdminSetUserRoleResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.pb.AdminUserR\x04userB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_set_user_role_proto_rawDescOnce sync.Once
+	file_rpc_admin_set_user_role_proto_rawDescData []byte
+)
+
+func file_rpc_admin_set_user_role_proto_rawDescGZIP() []byte {
+	file_rpc_admin_set_user_role_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_set_user_role_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_set_user_role_proto_rawDesc), len(file_rpc_admin_set_user_role_proto_rawDesc)))
+	})
+	return file_rpc_admin_set_user_role_proto_rawDescData
+}
+
+var file_rpc_admin_set_user_role_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_set_user_role_proto_goTypes = []any{
+	(*AdminSetUserRoleRequest)(nil),  // 0: pb.AdminSetUserRoleRequest
+	(*AdminSetUserRoleResponse)(nil), // 1: pb.AdminSetUserRoleResponse
+	(*AdminUser)(nil),                // 2: pb.AdminUser
+}
+var file_rpc_admin_set_user_role_proto_depIdxs = []int32{
+	2, // 0: pb.AdminSetUserRoleResponse.user:type_name -> pb.AdminUser
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_set_user_role_proto_init() }
+func file_rpc_admin_set_user_role_proto_init() {
+	if File_rpc_admin_set_user_role_proto != nil {
+		return
+	}
+	file_admin_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_set_user_role_proto_rawDesc), len(file_rpc_admin_set_user_role_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_set_user_role_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_set_user_role_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_set_user_role_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_set_user_role_proto = out.File
+	file_rpc_admin_set_user_role_proto_goTypes = nil
+	file_rpc_admin_set_user_role_proto_depIdxs = nil
+}
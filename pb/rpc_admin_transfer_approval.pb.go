@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_admin_transfer_approval.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminListTransferApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListTransferApprovalsRequest) Reset() {
+	*x = AdminListTransferApprovalsRequest{}
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTransferApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTransferApprovalsRequest) ProtoMessage() {}
+
+func (x *AdminListTransferApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTransferApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListTransferApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_transfer_approval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminListTransferApprovalsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *AdminListTransferApprovalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListTransferApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TransferApproval    `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListTransferApprovalsResponse) Reset() {
+	*x = AdminListTransferApprovalsResponse{}
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTransferApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTransferApprovalsResponse) ProtoMessage() {}
+
+func (x *AdminListTransferApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTransferApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListTransferApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_transfer_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminListTransferApprovalsResponse) GetApprovals() []*TransferApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type AdminDecideTransferApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDecideTransferApprovalRequest) Reset() {
+	*x = AdminDecideTransferApprovalRequest{}
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDecideTransferApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDecideTransferApprovalRequest) ProtoMessage() {}
+
+func (x *AdminDecideTransferApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDecideTransferApprovalRequest.ProtoReflect.Descriptor instead.
+func (*AdminDecideTransferApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_transfer_approval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminDecideTransferApprovalRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDecideTransferApprovalResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Approval *TransferApproval      `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	// transfer is only set when the approval is approved.
+	Transfer      *Transfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDecideTransferApprovalResponse) Reset() {
+	*x = AdminDecideTransferApprovalResponse{}
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDecideTransferApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDecideTransferApprovalResponse) ProtoMessage() {}
+
+func (x *AdminDecideTransferApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_transfer_approval_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDecideTransferApprovalResponse.ProtoReflect.Descriptor instead.
+func (*AdminDecideTransferApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_transfer_approval_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminDecideTransferApprovalResponse) GetApproval() *TransferApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *AdminDecideTransferApprovalResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_rpc_admin_transfer_approval_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_transfer_approval_proto_rawDesc = "" +
+	"\n" +
+	"!rpc_admin_transfer_approval.proto\x12\x02pb\x1a\x0etransfer.proto\x1a\x17transfer_approval.proto\"Y\n" +
+	"!AdminListTransferApprovalsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"X\n" +
+	"\"AdminListTransferApprovalsResponse\x122\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x14.pb.TransferApprovalR\tapprovals\"4\n" +
+	"\"AdminDecideTransferApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x81\x01\n" +
+	"#AdminDecideTransferApprovalResponse\x120\n" +
+	"\bapproval\x18\x01 \x01(\v2\x14.pb.TransferApprovalR\bapproval\x12(\n" +
+	"\btransfer\x18\x02 \x01(\v2\f.pb.TransferR\btransferB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_admin_transfer_approval_proto_rawDescOnce sync.Once
+	file_rpc_admin_transfer_approval_proto_rawDescData []byte
+)
+
+func file_rpc_admin_transfer_approval_proto_rawDescGZIP() []byte {
+	file_rpc_admin_transfer_approval_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_transfer_approval_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_transfer_approval_proto_rawDesc), len(file_rpc_admin_transfer_approval_proto_rawDesc)))
+	})
+	return file_rpc_admin_transfer_approval_proto_rawDescData
+}
+
+var file_rpc_admin_transfer_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_admin_transfer_approval_proto_goTypes = []any{
+	(*AdminListTransferApprovalsRequest)(nil),   // 0: pb.AdminListTransferApprovalsRequest
+	(*AdminListTransferApprovalsResponse)(nil),  // 1: pb.AdminListTransferApprovalsResponse
+	(*AdminDecideTransferApprovalRequest)(nil),  // 2: pb.AdminDecideTransferApprovalRequest
+	(*AdminDecideTransferApprovalResponse)(nil), // 3: pb.AdminDecideTransferApprovalResponse
+	(*TransferApproval)(nil),                    // 4: pb.TransferApproval
+	(*Transfer)(nil),                            // 5: pb.Transfer
+}
+var file_rpc_admin_transfer_approval_proto_depIdxs = []int32{
+	4, // 0: pb.AdminListTransferApprovalsResponse.approvals:type_name -> pb.TransferApproval
+	4, // 1: pb.AdminDecideTransferApprovalResponse.approval:type_name -> pb.TransferApproval
+	5, // 2: pb.AdminDecideTransferApprovalResponse.transfer:type_name -> pb.Transfer
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_transfer_approval_proto_init() }
+func file_rpc_admin_transfer_approval_proto_init() {
+	if File_rpc_admin_transfer_approval_proto != nil {
+		return
+	}
+	file_transfer_proto_init()
+	file_transfer_approval_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_transfer_approval_proto_rawDesc), len(file_rpc_admin_transfer_approval_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_transfer_approval_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_transfer_approval_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_transfer_approval_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_transfer_approval_proto = out.File
+	file_rpc_admin_transfer_approval_proto_goTypes = nil
+	file_rpc_admin_transfer_approval_proto_depIdxs = nil
+}
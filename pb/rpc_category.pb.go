@@ -0,0 +1,558 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_category.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCategoryRuleRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Category              string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	CounterpartyAccountId int64                  `protobuf:"varint,2,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3" json:"counterparty_account_id,omitempty"`
+	MemoContains          string                 `protobuf:"bytes,3,opt,name=memo_contains,json=memoContains,proto3" json:"memo_contains,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRuleRequest) Reset() {
+	*x = CreateCategoryRuleRequest{}
+	mi := &file_rpc_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCategoryRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetCounterpartyAccountId() int64 {
+	if x != nil {
+		return x.CounterpartyAccountId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetMemoContains() string {
+	if x != nil {
+		return x.MemoContains
+	}
+	return ""
+}
+
+type CreateCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CategoryRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRuleResponse) Reset() {
+	*x = CreateCategoryRuleResponse{}
+	mi := &file_rpc_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleResponse) ProtoMessage() {}
+
+func (x *CreateCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryRuleResponse) GetRule() *CategoryRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRulesRequest) Reset() {
+	*x = ListCategoryRulesRequest{}
+	mi := &file_rpc_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRulesRequest) ProtoMessage() {}
+
+func (x *ListCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{2}
+}
+
+type ListCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CategoryRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRulesResponse) Reset() {
+	*x = ListCategoryRulesResponse{}
+	mi := &file_rpc_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRulesResponse) ProtoMessage() {}
+
+func (x *ListCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCategoryRulesResponse) GetRules() []*CategoryRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRuleRequest) Reset() {
+	*x = DeleteCategoryRuleRequest{}
+	mi := &file_rpc_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCategoryRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRuleResponse) Reset() {
+	*x = DeleteCategoryRuleResponse{}
+	mi := &file_rpc_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{5}
+}
+
+type SetEntryCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEntryCategoryRequest) Reset() {
+	*x = SetEntryCategoryRequest{}
+	mi := &file_rpc_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntryCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryCategoryRequest) ProtoMessage() {}
+
+func (x *SetEntryCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SetEntryCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetEntryCategoryRequest) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *SetEntryCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type SetEntryCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEntryCategoryResponse) Reset() {
+	*x = SetEntryCategoryResponse{}
+	mi := &file_rpc_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntryCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryCategoryResponse) ProtoMessage() {}
+
+func (x *SetEntryCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SetEntryCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetEntryCategoryResponse) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *SetEntryCategoryResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ClearEntryCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearEntryCategoryRequest) Reset() {
+	*x = ClearEntryCategoryRequest{}
+	mi := &file_rpc_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearEntryCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearEntryCategoryRequest) ProtoMessage() {}
+
+func (x *ClearEntryCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearEntryCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearEntryCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClearEntryCategoryRequest) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type ClearEntryCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearEntryCategoryResponse) Reset() {
+	*x = ClearEntryCategoryResponse{}
+	mi := &file_rpc_category_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearEntryCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearEntryCategoryResponse) ProtoMessage() {}
+
+func (x *ClearEntryCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_category_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearEntryCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearEntryCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_category_proto_rawDescGZIP(), []int{9}
+}
+
+var File_rpc_category_proto protoreflect.FileDescriptor
+
+const file_rpc_category_proto_rawDesc = "" +
+	"\n" +
+	"\x12rpc_category.proto\x12\x02pb\x1a\x0ecategory.proto\"\x94\x01\n" +
+	"\x19CreateCategoryRuleRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x126\n" +
+	"\x17counterparty_account_id\x18\x02 \x01(\x03R\x15counterpartyAccountId\x12#\n" +
+	"\rmemo_contains\x18\x03 \x01(\tR\fmemoContains\"B\n" +
+	"\x1aCreateCategoryRuleResponse\x12$\n" +
+	"\x04rule\x18\x01 \x01(\v2\x10.pb.CategoryRuleR\x04rule\"\x1a\n" +
+	"\x18ListCategoryRulesRequest\"C\n" +
+	"\x19ListCategoryRulesResponse\x12&\n" +
+	"\x05rules\x18\x01 \x03(\v2\x10.pb.CategoryRuleR\x05rules\"+\n" +
+	"\x19DeleteCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1c\n" +
+	"\x1aDeleteCategoryRuleResponse\"P\n" +
+	"\x17SetEntryCategoryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x03R\aentryId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"Q\n" +
+	"\x18SetEntryCategoryResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x03R\aentryId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"6\n" +
+	"\x19ClearEntryCategoryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x03R\aentryId\"\x1c\n" +
+	"\x1aClearEntryCategoryResponseB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_category_proto_rawDescOnce sync.Once
+	file_rpc_category_proto_rawDescData []byte
+)
+
+func file_rpc_category_proto_rawDescGZIP() []byte {
+	file_rpc_category_proto_rawDescOnce.Do(func() {
+		file_rpc_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_category_proto_rawDesc), len(file_rpc_category_proto_rawDesc)))
+	})
+	return file_rpc_category_proto_rawDescData
+}
+
+var file_rpc_category_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpc_category_proto_goTypes = []any{
+	(*CreateCategoryRuleRequest)(nil),  // 0: pb.CreateCategoryRuleRequest
+	(*CreateCategoryRuleResponse)(nil), // 1: pb.CreateCategoryRuleResponse
+	(*ListCategoryRulesRequest)(nil),   // 2: pb.ListCategoryRulesRequest
+	(*ListCategoryRulesResponse)(nil),  // 3: pb.ListCategoryRulesResponse
+	(*DeleteCategoryRuleRequest)(nil),  // 4: pb.DeleteCategoryRuleRequest
+	(*DeleteCategoryRuleResponse)(nil), // 5: pb.DeleteCategoryRuleResponse
+	(*SetEntryCategoryRequest)(nil),    // 6: pb.SetEntryCategoryRequest
+	(*SetEntryCategoryResponse)(nil),   // 7: pb.SetEntryCategoryResponse
+	(*ClearEntryCategoryRequest)(nil),  // 8: pb.ClearEntryCategoryRequest
+	(*ClearEntryCategoryResponse)(nil), // 9: pb.ClearEntryCategoryResponse
+	(*CategoryRule)(nil),               // 10: pb.CategoryRule
+}
+var file_rpc_category_proto_depIdxs = []int32{
+	10, // 0: pb.CreateCategoryRuleResponse.rule:type_name -> pb.CategoryRule
+	10, // 1: pb.ListCategoryRulesResponse.rules:type_name -> pb.CategoryRule
+	2,  // [2:2] is the sub-list for method output_type
+	2,  // [2:2] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_category_proto_init() }
+func file_rpc_category_proto_init() {
+	if File_rpc_category_proto != nil {
+		return
+	}
+	file_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_category_proto_rawDesc), len(file_rpc_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_category_proto_goTypes,
+		DependencyIndexes: file_rpc_category_proto_depIdxs,
+		MessageInfos:      file_rpc_category_proto_msgTypes,
+	}.Build()
+	File_rpc_category_proto = out.File
+	file_rpc_category_proto_goTypes = nil
+	file_rpc_category_proto_depIdxs = nil
+}
@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_close_savings_pot.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CloseSavingsPotRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	PotId         int64  `protobuf:"varint,3,opt,name=pot_id,json=potId,proto3" json:"pot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseSavingsPotRequest) Reset() {
+	*x = CloseSavingsPotRequest{}
+	mi := &file_rpc_close_savings_pot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSavingsPotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSavingsPotRequest) ProtoMessage() {}
+
+func (x *CloseSavingsPotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_close_savings_pot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSavingsPotRequest.ProtoReflect.Descriptor instead.
+func (*CloseSavingsPotRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_close_savings_pot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CloseSavingsPotRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CloseSavingsPotRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CloseSavingsPotRequest) GetPotId() int64 {
+	if x != nil {
+		return x.PotId
+	}
+	return 0
+}
+
+type CloseSavingsPotResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Pot     *SavingsPot            `protobuf:"bytes,1,opt,name=pot,proto3" json:"pot,omitempty"`
+	Account *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// the sweep of the pot back to the main balance, unset if the pot was empty
+	Entry         *PotEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseSavingsPotResponse) Reset() {
+	*x = CloseSavingsPotResponse{}
+	mi := &file_rpc_close_savings_pot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSavingsPotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSavingsPotResponse) ProtoMessage() {}
+
+func (x *CloseSavingsPotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_close_savings_pot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSavingsPotResponse.ProtoReflect.Descriptor instead.
+func (*CloseSavingsPotResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_close_savings_pot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CloseSavingsPotResponse) GetPot() *SavingsPot {
+	if x != nil {
+		return x.Pot
+	}
+	return nil
+}
+
+func (x *CloseSavingsPotResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CloseSavingsPotResponse) GetEntry() *PotEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+var File_rpc_close_savings_pot_proto protoreflect.FileDescriptor
+
+const file_rpc_close_savings_pot_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_close_savings_pot.proto\x12\x02pb\x1a\raccount.proto\x1a\x11savings_pot.proto\"u\n" +
+	"\x16CloseSavingsPotRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x15\n" +
+	"\x06pot_id\x18\x03 \x01(\x03R\x05potId\"\x86\x01\n" +
+	"\x17CloseSavingsPotResponse\x12 \n" +
+	"\x03pot\x18\x01 \x01(\v2\x0e.pb.SavingsPotR\x03pot\x12%\n" +
+	"\aaccount\x18\x02 \x01(\v2\v.pb.AccountR\aaccount\x12\"\n" +
+	"\x05entry\x18\x03 \x01(\v2\f.pb.PotEntryR\x05entryB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_close_savings_pot_proto_rawDescOnce sync.Once
+	file_rpc_close_savings_pot_proto_rawDescData []byte
+)
+
+func file_rpc_close_savings_pot_proto_rawDescGZIP() []byte {
+	file_rpc_close_savings_pot_proto_rawDescOnce.Do(func() {
+		file_rpc_close_savings_pot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_close_savings_pot_proto_rawDesc), len(file_rpc_close_savings_pot_proto_rawDesc)))
+	})
+	return file_rpc_close_savings_pot_proto_rawDescData
+}
+
+var file_rpc_close_savings_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_close_savings_pot_proto_goTypes = []any{
+	(*CloseSavingsPotRequest)(nil),  // 0: pb.CloseSavingsPotRequest
+	(*CloseSavingsPotResponse)(nil), // 1: pb.CloseSavingsPotResponse
+	(*SavingsPot)(nil),              // 2: pb.SavingsPot
+	(*Account)(nil),                 // 3: pb.Account
+	(*PotEntry)(nil),                // 4: pb.PotEntry
+}
+var file_rpc_close_savings_pot_proto_depIdxs = []int32{
+	2, // 0: pb.CloseSavingsPotResponse.pot:type_name -> pb.SavingsPot
+	3, // 1: pb.CloseSavingsPotResponse.account:type_name -> pb.Account
+	4, // 2: pb.CloseSavingsPotResponse.entry:type_name -> pb.PotEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_close_savings_pot_proto_init() }
+func file_rpc_close_savings_pot_proto_init() {
+	if File_rpc_close_savings_pot_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_savings_pot_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_close_savings_pot_proto_rawDesc), len(file_rpc_close_savings_pot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_close_savings_pot_proto_goTypes,
+		DependencyIndexes: file_rpc_close_savings_pot_proto_depIdxs,
+		MessageInfos:      file_rpc_close_savings_pot_proto_msgTypes,
+	}.Build()
+	File_rpc_close_savings_pot_proto = out.File
+	file_rpc_close_savings_pot_proto_goTypes = nil
+	file_rpc_close_savings_pot_proto_depIdxs = nil
+}
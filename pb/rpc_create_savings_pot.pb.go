@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_create_savings_pot.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateSavingsPotRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  int64  `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// YYYY-MM-DD, not in the past
+	Deadline      string `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavingsPotRequest) Reset() {
+	*x = CreateSavingsPotRequest{}
+	mi := &file_rpc_create_savings_pot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavingsPotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavingsPotRequest) ProtoMessage() {}
+
+func (x *CreateSavingsPotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_savings_pot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavingsPotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavingsPotRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_savings_pot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateSavingsPotRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateSavingsPotRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreateSavingsPotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavingsPotRequest) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *CreateSavingsPotRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+type CreateSavingsPotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pot           *SavingsPot            `protobuf:"bytes,1,opt,name=pot,proto3" json:"pot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavingsPotResponse) Reset() {
+	*x = CreateSavingsPotResponse{}
+	mi := &file_rpc_create_savings_pot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavingsPotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavingsPotResponse) ProtoMessage() {}
+
+func (x *CreateSavingsPotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_savings_pot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavingsPotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavingsPotResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_savings_pot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSavingsPotResponse) GetPot() *SavingsPot {
+	if x != nil {
+		return x.Pot
+	}
+	return nil
+}
+
+var File_rpc_create_savings_pot_proto protoreflect.FileDescriptor
+
+const file_rpc_create_savings_pot_proto_rawDesc = "" +
+	"\n" +
+	"\x1crpc_create_savings_pot.proto\x12\x02pb\x1a\x11savings_pot.proto\"\xb4\x01\n" +
+	"\x17CreateSavingsPotRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x04 \x01(\x03R\ftargetAmount\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\tR\bdeadline\"<\n" +
+	"\x18CreateSavingsPotResponse\x12 \n" +
+	"\x03pot\x18\x01 \x01(\v2\x0e.pb.SavingsPotR\x03potB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_create_savings_pot_proto_rawDescOnce sync.Once
+	file_rpc_create_savings_pot_proto_rawDescData []byte
+)
+
+func file_rpc_create_savings_pot_proto_rawDescGZIP() []byte {
+	file_rpc_create_savings_pot_proto_rawDescOnce.Do(func() {
+		file_rpc_create_savings_pot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_savings_pot_proto_rawDesc), len(file_rpc_create_savings_pot_proto_rawDesc)))
+	})
+	return file_rpc_create_savings_pot_proto_rawDescData
+}
+
+var file_rpc_create_savings_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_savings_pot_proto_goTypes = []any{
+	(*CreateSavingsPotRequest)(nil),  // 0: pb.CreateSavingsPotRequest
+	(*CreateSavingsPotResponse)(nil), // 1: pb.CreateSavingsPotResponse
+	(*SavingsPot)(nil),               // 2: pb.SavingsPot
+}
+var file_rpc_create_savings_pot_proto_depIdxs = []int32{
+	2, // 0: pb.CreateSavingsPotResponse.pot:type_name -> pb.SavingsPot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_savings_pot_proto_init() }
+func file_rpc_create_savings_pot_proto_init() {
+	if File_rpc_create_savings_pot_proto != nil {
+		return
+	}
+	file_savings_pot_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_savings_pot_proto_rawDesc), len(file_rpc_create_savings_pot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_savings_pot_proto_goTypes,
+		DependencyIndexes: file_rpc_create_savings_pot_proto_depIdxs,
+		MessageInfos:      file_rpc_create_savings_pot_proto_msgTypes,
+	}.Build()
+	File_rpc_create_savings_pot_proto = out.File
+	file_rpc_create_savings_pot_proto_goTypes = nil
+	file_rpc_create_savings_pot_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_export_user_data.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_rpc_export_user_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_export_user_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_export_user_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportUserDataRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataExport    *DataExport            `protobuf:"bytes,1,opt,name=data_export,json=dataExport,proto3" json:"data_export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_rpc_export_user_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_export_user_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_export_user_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportUserDataResponse) GetDataExport() *DataExport {
+	if x != nil {
+		return x.DataExport
+	}
+	return nil
+}
+
+var File_rpc_export_user_data_proto protoreflect.FileDescriptor
+
+const file_rpc_export_user_data_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_export_user_data.proto\x12\x02pb\x1a\x11data_export.proto\"3\n" +
+	"\x15ExportUserDataRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"I\n" +
+	"\x16ExportUserDataResponse\x12/\n" +
+	"\vdata_export\x18\x01 \x01(\v2\x0e.pb.DataExportR\n" +
+	"dataExportB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_export_user_data_proto_rawDescOnce sync.Once
+	file_rpc_export_user_data_proto_rawDescData []byte
+)
+
+func file_rpc_export_user_data_proto_rawDescGZIP() []byte {
+	file_rpc_export_user_data_proto_rawDescOnce.Do(func() {
+		file_rpc_export_user_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_export_user_data_proto_rawDesc), len(file_rpc_export_user_data_proto_rawDesc)))
+	})
+	return file_rpc_export_user_data_proto_rawDescData
+}
+
+var file_rpc_export_user_data_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_export_user_data_proto_goTypes = []any{
+	(*ExportUserDataRequest)(nil),  // 0: pb.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil), // 1: pb.ExportUserDataResponse
+	(*DataExport)(nil),             // 2: pb.DataExport
+}
+var file_rpc_export_user_data_proto_depIdxs = []int32{
+	2, // 0: pb.ExportUserDataResponse.data_export:type_name -> pb.DataExport
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_export_user_data_proto_init() }
+func file_rpc_export_user_data_proto_init() {
+	if File_rpc_export_user_data_proto != nil {
+		return
+	}
+	file_data_export_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_export_user_data_proto_rawDesc), len(file_rpc_export_user_data_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_export_user_data_proto_goTypes,
+		DependencyIndexes: file_rpc_export_user_data_proto_depIdxs,
+		MessageInfos:      file_rpc_export_user_data_proto_msgTypes,
+	}.Build()
+	File_rpc_export_user_data_proto = out.File
+	file_rpc_export_user_data_proto_goTypes = nil
+	file_rpc_export_user_data_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_get_account_loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAccountLoanRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	LoanId        int64  `protobuf:"varint,3,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountLoanRequest) Reset() {
+	*x = GetAccountLoanRequest{}
+	mi := &file_rpc_get_account_loan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLoanRequest) ProtoMessage() {}
+
+func (x *GetAccountLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_account_loan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_account_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAccountLoanRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountLoanRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetAccountLoanRequest) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+type GetAccountLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Installments  []*LoanInstallment     `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountLoanResponse) Reset() {
+	*x = GetAccountLoanResponse{}
+	mi := &file_rpc_get_account_loan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLoanResponse) ProtoMessage() {}
+
+func (x *GetAccountLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_account_loan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_account_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccountLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetAccountLoanResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+var File_rpc_get_account_loan_proto protoreflect.FileDescriptor
+
+const file_rpc_get_account_loan_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_get_account_loan.proto\x12\x02pb\x1a\n" +
+	"loan.proto\"v\n" +
+	"\x15GetAccountLoanRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x17\n" +
+	"\aloan_id\x18\x03 \x01(\x03R\x06loanId\"o\n" +
+	"\x16GetAccountLoanResponse\x12\x1c\n" +
+	"\x04loan\x18\x01 \x01(\v2\b.pb.LoanR\x04loan\x127\n" +
+	"\finstallments\x18\x02 \x03(\v2\x13.pb.LoanInstallmentR\finstallmentsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_get_account_loan_proto_rawDescOnce sync.Once
+	file_rpc_get_account_loan_proto_rawDescData []byte
+)
+
+func file_rpc_get_account_loan_proto_rawDescGZIP() []byte {
+	file_rpc_get_account_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_get_account_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_account_loan_proto_rawDesc), len(file_rpc_get_account_loan_proto_rawDesc)))
+	})
+	return file_rpc_get_account_loan_proto_rawDescData
+}
+
+var file_rpc_get_account_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_account_loan_proto_goTypes = []any{
+	(*GetAccountLoanRequest)(nil),  // 0: pb.GetAccountLoanRequest
+	(*GetAccountLoanResponse)(nil), // 1: pb.GetAccountLoanResponse
+	(*Loan)(nil),                   // 2: pb.Loan
+	(*LoanInstallment)(nil),        // 3: pb.LoanInstallment
+}
+var file_rpc_get_account_loan_proto_depIdxs = []int32{
+	2, // 0: pb.GetAccountLoanResponse.loan:type_name -> pb.Loan
+	3, // 1: pb.GetAccountLoanResponse.installments:type_name -> pb.LoanInstallment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_account_loan_proto_init() }
+func file_rpc_get_account_loan_proto_init() {
+	if File_rpc_get_account_loan_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_account_loan_proto_rawDesc), len(file_rpc_get_account_loan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_account_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_get_account_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_get_account_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_get_account_loan_proto = out.File
+	file_rpc_get_account_loan_proto_goTypes = nil
+	file_rpc_get_account_loan_proto_depIdxs = nil
+}
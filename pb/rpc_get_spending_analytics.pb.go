@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_get_spending_analytics.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSpendingAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// week or month
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// first day of the range in the YYYY-MM-DD format
+	FromDate string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	// last day of the range in the YYYY-MM-DD format, included
+	ToDate        string `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendingAnalyticsRequest) Reset() {
+	*x = GetSpendingAnalyticsRequest{}
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSpendingAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spending_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSpendingAnalyticsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetSpendingAnalyticsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetSpendingAnalyticsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetSpendingAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        []*SpendingTotal       `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendingAnalyticsResponse) Reset() {
+	*x = GetSpendingAnalyticsResponse{}
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSpendingAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spending_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSpendingAnalyticsResponse) GetTotals() []*SpendingTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_rpc_get_spending_analytics_proto protoreflect.FileDescriptor
+
+const file_rpc_get_spending_analytics_proto_rawDesc = "" +
+	"\n" +
+	" rpc_get_spending_analytics.proto\x12\x02pb\x1a\x0ecategory.proto\"k\n" +
+	"\x1bGetSpendingAnalyticsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"I\n" +
+	"\x1cGetSpendingAnalyticsResponse\x12)\n" +
+	"\x06totals\x18\x01 \x03(\v2\x11.pb.SpendingTotalR\x06totalsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_get_spending_analytics_proto_rawDescOnce sync.Once
+	file_rpc_get_spending_analytics_proto_rawDescData []byte
+)
+
+func file_rpc_get_spending_analytics_proto_rawDescGZIP() []byte {
+	file_rpc_get_spending_analytics_proto_rawDescOnce.Do(func() {
+		file_rpc_get_spending_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_spending_analytics_proto_rawDesc), len(file_rpc_get_spending_analytics_proto_rawDesc)))
+	})
+	return file_rpc_get_spending_analytics_proto_rawDescData
+}
+
+var file_rpc_get_spending_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_spending_analytics_proto_goTypes = []any{
+	(*GetSpendingAnalyticsRequest)(nil),  // 0: pb.GetSpendingAnalyticsRequest
+	(*GetSpendingAnalyticsResponse)(nil), // 1: pb.GetSpendingAnalyticsResponse
+	(*SpendingTotal)(nil),                // 2: pb.SpendingTotal
+}
+var file_rpc_get_spending_analytics_proto_depIdxs = []int32{
+	2, // 0: pb.GetSpendingAnalyticsResponse.totals:type_name -> pb.SpendingTotal
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_spending_analytics_proto_init() }
+func file_rpc_get_spending_analytics_proto_init() {
+	if File_rpc_get_spending_analytics_proto != nil {
+		return
+	}
+	file_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_spending_analytics_proto_rawDesc), len(file_rpc_get_spending_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_spending_analytics_proto_goTypes,
+		DependencyIndexes: file_rpc_get_spending_analytics_proto_depIdxs,
+		MessageInfos:      file_rpc_get_spending_analytics_proto_msgTypes,
+	}.Build()
+	File_rpc_get_spending_analytics_proto = out.File
+	file_rpc_get_spending_analytics_proto_goTypes = nil
+	file_rpc_get_spending_analytics_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_account_disputes.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAccountDisputesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	PageId        int32  `protobuf:"varint,3,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountDisputesRequest) Reset() {
+	*x = ListAccountDisputesRequest{}
+	mi := &file_rpc_list_account_disputes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountDisputesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountDisputesRequest) ProtoMessage() {}
+
+func (x *ListAccountDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_disputes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountDisputesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_disputes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAccountDisputesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListAccountDisputesRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ListAccountDisputesRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListAccountDisputesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAccountDisputesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disputes      []*Dispute             `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountDisputesResponse) Reset() {
+	*x = ListAccountDisputesResponse{}
+	mi := &file_rpc_list_account_disputes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountDisputesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountDisputesResponse) ProtoMessage() {}
+
+func (x *ListAccountDisputesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_disputes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountDisputesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountDisputesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_disputes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAccountDisputesResponse) GetDisputes() []*Dispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+var File_rpc_list_account_disputes_proto protoreflect.FileDescriptor
+
+const file_rpc_list_account_disputes_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_list_account_disputes.proto\x12\x02pb\x1a\rdispute.proto\"\x98\x01\n" +
+	"\x1aListAccountDisputesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x17\n" +
+	"\apage_id\x18\x03 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"F\n" +
+	"\x1bListAccountDisputesResponse\x12'\n" +
+	"\bdisputes\x18\x01 \x03(\v2\v.pb.DisputeR\bdisputesB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_account_disputes_proto_rawDescOnce sync.Once
+	file_rpc_list_account_disputes_proto_rawDescData []byte
+)
+
+func file_rpc_list_account_disputes_proto_rawDescGZIP() []byte {
+	file_rpc_list_account_disputes_proto_rawDescOnce.Do(func() {
+		file_rpc_list_account_disputes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_account_disputes_proto_rawDesc), len(file_rpc_list_account_disputes_proto_rawDesc)))
+	})
+	return file_rpc_list_account_disputes_proto_rawDescData
+}
+
+var file_rpc_list_account_disputes_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_account_disputes_proto_goTypes = []any{
+	(*ListAccountDisputesRequest)(nil),  // 0: pb.ListAccountDisputesRequest
+	(*ListAccountDisputesResponse)(nil), // 1: pb.ListAccountDisputesResponse
+	(*Dispute)(nil),                     // 2: pb.Dispute
+}
+var file_rpc_list_account_disputes_proto_depIdxs = []int32{
+	2, // 0: pb.ListAccountDisputesResponse.disputes:type_name -> pb.Dispute
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_account_disputes_proto_init() }
+func file_rpc_list_account_disputes_proto_init() {
+	if File_rpc_list_account_disputes_proto != nil {
+		return
+	}
+	file_dispute_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_account_disputes_proto_rawDesc), len(file_rpc_list_account_disputes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_account_disputes_proto_goTypes,
+		DependencyIndexes: file_rpc_list_account_disputes_proto_depIdxs,
+		MessageInfos:      file_rpc_list_account_disputes_proto_msgTypes,
+	}.Build()
+	File_rpc_list_account_disputes_proto = out.File
+	file_rpc_list_account_disputes_proto_goTypes = nil
+	file_rpc_list_account_disputes_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_alert_rules.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAlertRulesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_rpc_list_alert_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_alert_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_alert_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAlertRulesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListAlertRulesRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_rpc_list_alert_rules_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_alert_rules_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_alert_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+var File_rpc_list_alert_rules_proto protoreflect.FileDescriptor
+
+const file_rpc_list_alert_rules_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_list_alert_rules.proto\x12\x02pb\x1a\x10alert_rule.proto\"]\n" +
+	"\x15ListAlertRulesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\"=\n" +
+	"\x16ListAlertRulesResponse\x12#\n" +
+	"\x05rules\x18\x01 \x03(\v2\r.pb.AlertRuleR\x05rulesB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_alert_rules_proto_rawDescOnce sync.Once
+	file_rpc_list_alert_rules_proto_rawDescData []byte
+)
+
+func file_rpc_list_alert_rules_proto_rawDescGZIP() []byte {
+	file_rpc_list_alert_rules_proto_rawDescOnce.Do(func() {
+		file_rpc_list_alert_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_alert_rules_proto_rawDesc), len(file_rpc_list_alert_rules_proto_rawDesc)))
+	})
+	return file_rpc_list_alert_rules_proto_rawDescData
+}
+
+var file_rpc_list_alert_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_alert_rules_proto_goTypes = []any{
+	(*ListAlertRulesRequest)(nil),  // 0: pb.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil), // 1: pb.ListAlertRulesResponse
+	(*AlertRule)(nil),              // 2: pb.AlertRule
+}
+var file_rpc_list_alert_rules_proto_depIdxs = []int32{
+	2, // 0: pb.ListAlertRulesResponse.rules:type_name -> pb.AlertRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_alert_rules_proto_init() }
+func file_rpc_list_alert_rules_proto_init() {
+	if File_rpc_list_alert_rules_proto != nil {
+		return
+	}
+	file_alert_rule_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_alert_rules_proto_rawDesc), len(file_rpc_list_alert_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_alert_rules_proto_goTypes,
+		DependencyIndexes: file_rpc_list_alert_rules_proto_depIdxs,
+		MessageInfos:      file_rpc_list_alert_rules_proto_msgTypes,
+	}.Build()
+	File_rpc_list_alert_rules_proto = out.File
+	file_rpc_list_alert_rules_proto_goTypes = nil
+	file_rpc_list_alert_rules_proto_depIdxs = nil
+}
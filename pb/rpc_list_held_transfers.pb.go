@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_held_transfers.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListHeldTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeldTransfersRequest) Reset() {
+	*x = ListHeldTransfersRequest{}
+	mi := &file_rpc_list_held_transfers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeldTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldTransfersRequest) ProtoMessage() {}
+
+func (x *ListHeldTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_held_transfers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListHeldTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_held_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListHeldTransfersRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListHeldTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHeldTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*HeldTransfer        `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeldTransfersResponse) Reset() {
+	*x = ListHeldTransfersResponse{}
+	mi := &file_rpc_list_held_transfers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeldTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldTransfersResponse) ProtoMessage() {}
+
+func (x *ListHeldTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_held_transfers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListHeldTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_held_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListHeldTransfersResponse) GetTransfers() []*HeldTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+var File_rpc_list_held_transfers_proto protoreflect.FileDescriptor
+
+const file_rpc_list_held_transfers_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_list_held_transfers.proto\x12\x02pb\x1a\x0etransfer.proto\"P\n" +
+	"\x18ListHeldTransfersRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"K\n" +
+	"\x19ListHeldTransfersResponse\x12.\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x10.pb.HeldTransferR\ttransfersB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_held_transfers_proto_rawDescOnce sync.Once
+	file_rpc_list_held_transfers_proto_rawDescData []byte
+)
+
+func file_rpc_list_held_transfers_proto_rawDescGZIP() []byte {
+	file_rpc_list_held_transfers_proto_rawDescOnce.Do(func() {
+		file_rpc_list_held_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_held_transfers_proto_rawDesc), len(file_rpc_list_held_transfers_proto_rawDesc)))
+	})
+	return file_rpc_list_held_transfers_proto_rawDescData
+}
+
+var file_rpc_list_held_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_held_transfers_proto_goTypes = []any{
+	(*ListHeldTransfersRequest)(nil),  // 0: pb.ListHeldTransfersRequest
+	(*ListHeldTransfersResponse)(nil), // 1: pb.ListHeldTransfersResponse
+	(*HeldTransfer)(nil),              // 2: pb.HeldTransfer
+}
+var file_rpc_list_held_transfers_proto_depIdxs = []int32{
+	2, // 0: pb.ListHeldTransfersResponse.transfers:type_name -> pb.HeldTransfer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_held_transfers_proto_init() }
+func file_rpc_list_held_transfers_proto_init() {
+	if File_rpc_list_held_transfers_proto != nil {
+		return
+	}
+	file_transfer_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_held_transfers_proto_rawDesc), len(file_rpc_list_held_transfers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_held_transfers_proto_goTypes,
+		DependencyIndexes: file_rpc_list_held_transfers_proto_depIdxs,
+		MessageInfos:      file_rpc_list_held_transfers_proto_msgTypes,
+	}.Build()
+	File_rpc_list_held_transfers_proto = out.File
+	file_rpc_list_held_transfers_proto_goTypes = nil
+	file_rpc_list_held_transfers_proto_depIdxs = nil
+}
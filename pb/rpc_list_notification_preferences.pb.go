@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_notification_preferences.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationPreferencesRequest) Reset() {
+	*x = ListNotificationPreferencesRequest{}
+	mi := &file_rpc_list_notification_preferences_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *ListNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_notification_preferences_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_notification_preferences_proto_rawDescGZIP(), []int{0}
+}
+
+type ListNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationPreferencesResponse) Reset() {
+	*x = ListNotificationPreferencesResponse{}
+	mi := &file_rpc_list_notification_preferences_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *ListNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_notification_preferences_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_notification_preferences_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_rpc_list_notification_preferences_proto protoreflect.FileDescriptor
+
+const file_rpc_list_notification_preferences_proto_rawDesc = "" +
+	"\n" +
+	"'rpc_list_notification_preferences.proto\x12\x02pb\x1a\x12notification.proto\"$\n" +
+	"\"ListNotificationPreferencesRequest\"c\n" +
+	"#ListNotificationPreferencesResponse\x12<\n" +
+	"\vpreferences\x18\x01 \x03(\v2\x1a.pb.NotificationPreferenceR\vpreferencesB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_notification_preferences_proto_rawDescOnce sync.Once
+	file_rpc_list_notification_preferences_proto_rawDescData []byte
+)
+
+func file_rpc_list_notification_preferences_proto_rawDescGZIP() []byte {
+	file_rpc_list_notification_preferences_proto_rawDescOnce.Do(func() {
+		file_rpc_list_notification_preferences_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_notification_preferences_proto_rawDesc), len(file_rpc_list_notification_preferences_proto_rawDesc)))
+	})
+	return file_rpc_list_notification_preferences_proto_rawDescData
+}
+
+var file_rpc_list_notification_preferences_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_notification_preferences_proto_goTypes = []any{
+	(*ListNotificationPreferencesRequest)(nil),  // 0: pb.ListNotificationPreferencesRequest
+	(*ListNotificationPreferencesResponse)(nil), // 1: pb.ListNotificationPreferencesResponse
+	(*NotificationPreference)(nil),              // 2: pb.NotificationPreference
+}
+var file_rpc_list_notification_preferences_proto_depIdxs = []int32{
+	2, // 0: pb.ListNotificationPreferencesResponse.preferences:type_name -> pb.NotificationPreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_notification_preferences_proto_init() }
+func file_rpc_list_notification_preferences_proto_init() {
+	if File_rpc_list_notification_preferences_proto != nil {
+		return
+	}
+	file_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_notification_preferences_proto_rawDesc), len(file_rpc_list_notification_preferences_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_notification_preferences_proto_goTypes,
+		DependencyIndexes: file_rpc_list_notification_preferences_proto_depIdxs,
+		MessageInfos:      file_rpc_list_notification_preferences_proto_msgTypes,
+	}.Build()
+	File_rpc_list_notification_preferences_proto = out.File
+	file_rpc_list_notification_preferences_proto_goTypes = nil
+	file_rpc_list_notification_preferences_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_savings_pots.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListSavingsPotsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavingsPotsRequest) Reset() {
+	*x = ListSavingsPotsRequest{}
+	mi := &file_rpc_list_savings_pots_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavingsPotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavingsPotsRequest) ProtoMessage() {}
+
+func (x *ListSavingsPotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_savings_pots_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavingsPotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavingsPotsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_savings_pots_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListSavingsPotsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListSavingsPotsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type ListSavingsPotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pots          []*SavingsPot          `protobuf:"bytes,1,rep,name=pots,proto3" json:"pots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavingsPotsResponse) Reset() {
+	*x = ListSavingsPotsResponse{}
+	mi := &file_rpc_list_savings_pots_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavingsPotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavingsPotsResponse) ProtoMessage() {}
+
+func (x *ListSavingsPotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_savings_pots_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavingsPotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavingsPotsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_savings_pots_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSavingsPotsResponse) GetPots() []*SavingsPot {
+	if x != nil {
+		return x.Pots
+	}
+	return nil
+}
+
+var File_rpc_list_savings_pots_proto protoreflect.FileDescriptor
+
+const file_rpc_list_savings_pots_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_list_savings_pots.proto\x12\x02pb\x1a\x11savings_pot.proto\"^\n" +
+	"\x16ListSavingsPotsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\"=\n" +
+	"\x17ListSavingsPotsResponse\x12\"\n" +
+	"\x04pots\x18\x01 \x03(\v2\x0e.pb.SavingsPotR\x04potsB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_savings_pots_proto_rawDescOnce sync.Once
+	file_rpc_list_savings_pots_proto_rawDescData []byte
+)
+
+func file_rpc_list_savings_pots_proto_rawDescGZIP() []byte {
+	file_rpc_list_savings_pots_proto_rawDescOnce.Do(func() {
+		file_rpc_list_savings_pots_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_savings_pots_proto_rawDesc), len(file_rpc_list_savings_pots_proto_rawDesc)))
+	})
+	return file_rpc_list_savings_pots_proto_rawDescData
+}
+
+var file_rpc_list_savings_pots_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_savings_pots_proto_goTypes = []any{
+	(*ListSavingsPotsRequest)(nil),  // 0: pb.ListSavingsPotsRequest
+	(*ListSavingsPotsResponse)(nil), // 1: pb.ListSavingsPotsResponse
+	(*SavingsPot)(nil),              // 2: pb.SavingsPot
+}
+var file_rpc_list_savings_pots_proto_depIdxs = []int32{
+	2, // 0: pb.ListSavingsPotsResponse.pots:type_name -> pb.SavingsPot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_savings_pots_proto_init() }
+func file_rpc_list_savings_pots_proto_init() {
+	if File_rpc_list_savings_pots_proto != nil {
+		return
+	}
+	file_savings_pot_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_savings_pots_proto_rawDesc), len(file_rpc_list_savings_pots_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_savings_pots_proto_goTypes,
+		DependencyIndexes: file_rpc_list_savings_pots_proto_depIdxs,
+		MessageInfos:      file_rpc_list_savings_pots_proto_msgTypes,
+	}.Build()
+	File_rpc_list_savings_pots_proto = out.File
+	file_rpc_list_savings_pots_proto_goTypes = nil
+	file_rpc_list_savings_pots_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_list_webhooks.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_rpc_list_webhooks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_webhooks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_webhooks_proto_rawDescGZIP(), []int{0}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_rpc_list_webhooks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_webhooks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_webhooks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+var File_rpc_list_webhooks_proto protoreflect.FileDescriptor
+
+const file_rpc_list_webhooks_proto_rawDesc = "" +
+	"\n" +
+	"\x17rpc_list_webhooks.proto\x12\x02pb\x1a\rwebhook.proto\"\x15\n" +
+	"\x13ListWebhooksRequest\"?\n" +
+	"\x14ListWebhooksResponse\x12'\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\v.pb.WebhookR\bwebhooksB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_webhooks_proto_rawDescOnce sync.Once
+	file_rpc_list_webhooks_proto_rawDescData []byte
+)
+
+func file_rpc_list_webhooks_proto_rawDescGZIP() []byte {
+	file_rpc_list_webhooks_proto_rawDescOnce.Do(func() {
+		file_rpc_list_webhooks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_webhooks_proto_rawDesc), len(file_rpc_list_webhooks_proto_rawDesc)))
+	})
+	return file_rpc_list_webhooks_proto_rawDescData
+}
+
+var file_rpc_list_webhooks_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_webhooks_proto_goTypes = []any{
+	(*ListWebhooksRequest)(nil),  // 0: pb.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil), // 1: pb.ListWebhooksResponse
+	(*Webhook)(nil),              // 2: pb.Webhook
+}
+var file_rpc_list_webhooks_proto_depIdxs = []int32{
+	2, // 0: pb.ListWebhooksResponse.webhooks:type_name -> pb.Webhook
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_webhooks_proto_init() }
+func file_rpc_list_webhooks_proto_init() {
+	if File_rpc_list_webhooks_proto != nil {
+		return
+	}
+	file_webhook_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_webhooks_proto_rawDesc), len(file_rpc_list_webhooks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_webhooks_proto_goTypes,
+		DependencyIndexes: file_rpc_list_webhooks_proto_depIdxs,
+		MessageInfos:      file_rpc_list_webhooks_proto_msgTypes,
+	}.Build()
+	File_rpc_list_webhooks_proto = out.File
+	file_rpc_list_webhooks_proto_goTypes = nil
+	file_rpc_list_webhooks_proto_depIdxs = nil
+}
@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_remove_account_holder.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RemoveAccountHolderRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// takes the account by its account number instead of account_id
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Username      string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccountHolderRequest) Reset() {
+	*x = RemoveAccountHolderRequest{}
+	mi := &file_rpc_remove_account_holder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountHolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountHolderRequest) ProtoMessage() {}
+
+func (x *RemoveAccountHolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_remove_account_holder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountHolderRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAccountHolderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_remove_account_holder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoveAccountHolderRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *RemoveAccountHolderRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *RemoveAccountHolderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RemoveAccountHolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccountHolderResponse) Reset() {
+	*x = RemoveAccountHolderResponse{}
+	mi := &file_rpc_remove_account_holder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountHolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountHolderResponse) ProtoMessage() {}
+
+func (x *RemoveAccountHolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_remove_account_holder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountHolderResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAccountHolderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_remove_account_holder_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_remove_account_holder_proto protoreflect.FileDescriptor
+
+const file_rpc_remove_account_holder_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_remove_account_holder.proto\x12\x02pb\"~\n" +
+	"\x1aRemoveAccountHolderRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"\x1d\n" +
+	"\x1bRemoveAccountHolderResponseB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_remove_account_holder_proto_rawDescOnce sync.Once
+	file_rpc_remove_account_holder_proto_rawDescData []byte
+)
+
+func file_rpc_remove_account_holder_proto_rawDescGZIP() []byte {
+	file_rpc_remove_account_holder_proto_rawDescOnce.Do(func() {
+		file_rpc_remove_account_holder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_remove_account_holder_proto_rawDesc), len(file_rpc_remove_account_holder_proto_rawDesc)))
+	})
+	return file_rpc_remove_account_holder_proto_rawDescData
+}
+
+var file_rpc_remove_account_holder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_remove_account_holder_proto_goTypes = []any{
+	(*RemoveAccountHolderRequest)(nil),  // 0: pb.RemoveAccountHolderRequest
+	(*RemoveAccountHolderResponse)(nil), // 1: pb.RemoveAccountHolderResponse
+}
+var file_rpc_remove_account_holder_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_remove_account_holder_proto_init() }
+func file_rpc_remove_account_holder_proto_init() {
+	if File_rpc_remove_account_holder_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_remove_account_holder_proto_rawDesc), len(file_rpc_remove_account_holder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_remove_account_holder_proto_goTypes,
+		DependencyIndexes: file_rpc_remove_account_holder_proto_depIdxs,
+		MessageInfos:      file_rpc_remove_account_holder_proto_msgTypes,
+	}.Build()
+	File_rpc_remove_account_holder_proto = out.File
+	file_rpc_remove_account_holder_proto_goTypes = nil
+	file_rpc_remove_account_holder_proto_depIdxs = nil
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_review_transfer.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTransferRequest) Reset() {
+	*x = ReviewTransferRequest{}
+	mi := &file_rpc_review_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTransferRequest) ProtoMessage() {}
+
+func (x *ReviewTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReviewTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_review_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewTransferRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReviewTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewTransferResponse) Reset() {
+	*x = ReviewTransferResponse{}
+	mi := &file_rpc_review_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTransferResponse) ProtoMessage() {}
+
+func (x *ReviewTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTransferResponse.ProtoReflect.Descriptor instead.
+func (*ReviewTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_review_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_rpc_review_transfer_proto protoreflect.FileDescriptor
+
+const file_rpc_review_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_review_transfer.proto\x12\x02pb\x1a\x0etransfer.proto\"'\n" +
+	"\x15ReviewTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"B\n" +
+	"\x16ReviewTransferResponse\x12(\n" +
+	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransferB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_review_transfer_proto_rawDescOnce sync.Once
+	file_rpc_review_transfer_proto_rawDescData []byte
+)
+
+func file_rpc_review_transfer_proto_rawDescGZIP() []byte {
+	file_rpc_review_transfer_proto_rawDescOnce.Do(func() {
+		file_rpc_review_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_review_transfer_proto_rawDesc), len(file_rpc_review_transfer_proto_rawDesc)))
+	})
+	return file_rpc_review_transfer_proto_rawDescData
+}
+
+var file_rpc_review_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_review_transfer_proto_goTypes = []any{
+	(*ReviewTransferRequest)(nil),  // 0: pb.ReviewTransferRequest
+	(*ReviewTransferResponse)(nil), // 1: pb.ReviewTransferResponse
+	(*Transfer)(nil),               // 2: pb.Transfer
+}
+var file_rpc_review_transfer_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewTransferResponse.transfer:type_name -> pb.Transfer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_review_transfer_proto_init() }
+func file_rpc_review_transfer_proto_init() {
+	if File_rpc_review_transfer_proto != nil {
+		return
+	}
+	file_transfer_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_review_transfer_proto_rawDesc), len(file_rpc_review_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_review_transfer_proto_goTypes,
+		DependencyIndexes: file_rpc_review_transfer_proto_depIdxs,
+		MessageInfos:      file_rpc_review_transfer_proto_msgTypes,
+	}.Build()
+	File_rpc_review_transfer_proto = out.File
+	file_rpc_review_transfer_proto_goTypes = nil
+	file_rpc_review_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_set_notification_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetNotificationPreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	MinAmount     int64                  `protobuf:"varint,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferenceRequest) Reset() {
+	*x = SetNotificationPreferenceRequest{}
+	mi := &file_rpc_set_notification_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceRequest) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_notification_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_notification_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetNotificationPreferenceRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferenceRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *SetNotificationPreferenceRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SetNotificationPreferenceResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferenceResponse) Reset() {
+	*x = SetNotificationPreferenceResponse{}
+	mi := &file_rpc_set_notification_preference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceResponse) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_notification_preference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_notification_preference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetNotificationPreferenceResponse) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+var File_rpc_set_notification_preference_proto protoreflect.FileDescriptor
+
+const file_rpc_set_notification_preference_proto_rawDesc = "" +
+	"\n" +
+	"%rpc_set_notification_preference.proto\x12\x02pb\x1a\x12notification.proto\"z\n" +
+	" SetNotificationPreferenceRequest\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x02 \x01(\x03R\tminAmount\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\"_\n" +
+	"!SetNotificationPreferenceResponse\x12:\n" +
+	"\n" +
+	"preference\x18\x01 \x01(\v2\x1a.pb.NotificationPreferenceR\n" +
+	"preferenceB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_notification_preference_proto_rawDescOnce sync.Once
+	file_rpc_set_notification_preference_proto_rawDescData []byte
+)
+
+func file_rpc_set_notification_preference_proto_rawDescGZIP() []byte {
+	file_rpc_set_notification_preference_proto_rawDescOnce.Do(func() {
+		file_rpc_set_notification_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_notification_preference_proto_rawDesc), len(file_rpc_set_notification_preference_proto_rawDesc)))
+	})
+	return file_rpc_set_notification_preference_proto_rawDescData
+}
+
+var file_rpc_set_notification_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_notification_preference_proto_goTypes = []any{
+	(*SetNotificationPreferenceRequest)(nil),  // 0: pb.SetNotificationPreferenceRequest
+	(*SetNotificationPreferenceResponse)(nil), // 1: pb.SetNotificationPreferenceResponse
+	(*NotificationPreference)(nil),            // 2: pb.NotificationPreference
+}
+var file_rpc_set_notification_preference_proto_depIdxs = []int32{
+	2, // 0: pb.SetNotificationPreferenceResponse.preference:type_name -> pb.NotificationPreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_notification_preference_proto_init() }
+func file_rpc_set_notification_preference_proto_init() {
+	if File_rpc_set_notification_preference_proto != nil {
+		return
+	}
+	file_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_notification_preference_proto_rawDesc), len(file_rpc_set_notification_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_notification_preference_proto_goTypes,
+		DependencyIndexes: file_rpc_set_notification_preference_proto_depIdxs,
+		MessageInfos:      file_rpc_set_notification_preference_proto_msgTypes,
+	}.Build()
+	File_rpc_set_notification_preference_proto = out.File
+	file_rpc_set_notification_preference_proto_goTypes = nil
+	file_rpc_set_notification_preference_proto_depIdxs = nil
+}
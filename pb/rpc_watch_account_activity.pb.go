@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: rpc_watch_account_activity.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchAccountActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accounts to watch, all accounts the user owns or holds if empty
+	AccountIds []int64 `protobuf:"varint,1,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	// id of the last entry the client has seen, entries after it are sent first,
+	// recent entries can be sent again after a resume, so clients should skip the entry ids they have seen
+	LastEntryId int64 `protobuf:"varint,2,opt,name=last_entry_id,json=lastEntryId,proto3" json:"last_entry_id,omitempty"`
+	// accounts to watch by their account numbers, in addition to account_ids
+	AccountNumbers []string `protobuf:"bytes,3,rep,name=account_numbers,json=accountNumbers,proto3" json:"account_numbers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchAccountActivityRequest) Reset() {
+	*x = WatchAccountActivityRequest{}
+	mi := &file_rpc_watch_account_activity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAccountActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAccountActivityRequest) ProtoMessage() {}
+
+func (x *WatchAccountActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_account_activity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAccountActivityRequest.ProtoReflect.Descriptor instead.
+func (*WatchAccountActivityRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_account_activity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchAccountActivityRequest) GetAccountIds() []int64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *WatchAccountActivityRequest) GetLastEntryId() int64 {
+	if x != nil {
+		return x.LastEntryId
+	}
+	return 0
+}
+
+func (x *WatchAccountActivityRequest) GetAccountNumbers() []string {
+	if x != nil {
+		return x.AccountNumbers
+	}
+	return nil
+}
+
+type WatchAccountActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      *AccountActivity       `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAccountActivityResponse) Reset() {
+	*x = WatchAccountActivityResponse{}
+	mi := &file_rpc_watch_account_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAccountActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAccountActivityResponse) ProtoMessage() {}
+
+func (x *WatchAccountActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_account_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAccountActivityResponse.ProtoReflect.Descriptor instead.
+func (*WatchAccountActivityResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_account_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchAccountActivityResponse) GetActivity() *AccountActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+var File_rpc_watch_account_activity_proto protoreflect.FileDescriptor
+
+const file_rpc_watch_account_activity_proto_rawDesc = "" +
+	"\n" +
+	" rpc_watch_account_activity.proto\x12\x02pb\x1a\x16account_activity.proto\"\x8b\x01\n" +
+	"\x1bWatchAccountActivityRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\x03R\n" +
+	"accountIds\x12\"\n" +
+	"\rlast_entry_id\x18\x02 \x01(\x03R\vlastEntryId\x12'\n" +
+	"\x0faccount_numbers\x18\x03 \x03(\tR\x0eaccountNumbers\"O\n" +
+	"\x1cWatchAccountActivityResponse\x12/\n" +
+	"\bactivity\x18\x01 \x01(\v2\x13.pb.AccountActivityR\bactivityB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_watch_account_activity_proto_rawDescOnce sync.Once
+	file_rpc_watch_account_activity_proto_rawDescData []byte
+)
+
+func file_rpc_watch_account_activity_proto_rawDescGZIP() []byte {
+	file_rpc_watch_account_activity_proto_rawDescOnce.Do(func() {
+		file_rpc_watch_account_activity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_watch_account_activity_proto_rawDesc), len(file_rpc_watch_account_activity_proto_rawDesc)))
+	})
+	return file_rpc_watch_account_activity_proto_rawDescData
+}
+
+var file_rpc_watch_account_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_watch_account_activity_proto_goTypes = []any{
+	(*WatchAccountActivityRequest)(nil),  // 0: pb.WatchAccountActivityRequest
+	(*WatchAccountActivityResponse)(nil), // 1: pb.WatchAccountActivityResponse
+	(*AccountActivity)(nil),              // 2: pb.AccountActivity
+}
+var file_rpc_watch_account_activity_proto_depIdxs = []int32{
+	2, // 0: pb.WatchAccountActivityResponse.activity:type_name -> pb.AccountActivity
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_watch_account_activity_proto_init() }
+func file_rpc_watch_account_activity_proto_init() {
+	if File_rpc_watch_account_activity_proto != nil {
+		return
+	}
+	file_account_activity_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_watch_account_activity_proto_rawDesc), len(file_rpc_watch_account_activity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_watch_account_activity_proto_goTypes,
+		DependencyIndexes: file_rpc_watch_account_activity_proto_depIdxs,
+		MessageInfos:      file_rpc_watch_account_activity_proto_msgTypes,
+	}.Build()
+	File_rpc_watch_account_activity_proto = out.File
+	file_rpc_watch_account_activity_proto_goTypes = nil
+	file_rpc_watch_account_activity_proto_depIdxs = nil
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: savings_pot.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SavingsPot struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId    int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount int64                  `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// YYYY-MM-DD
+	Deadline string `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Balance  int64  `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	// open or closed
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavingsPot) Reset() {
+	*x = SavingsPot{}
+	mi := &file_savings_pot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavingsPot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavingsPot) ProtoMessage() {}
+
+func (x *SavingsPot) ProtoReflect() protoreflect.Message {
+	mi := &file_savings_pot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavingsPot.ProtoReflect.Descriptor instead.
+func (*SavingsPot) Descriptor() ([]byte, []int) {
+	return file_savings_pot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavingsPot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavingsPot) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SavingsPot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavingsPot) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *SavingsPot) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *SavingsPot) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *SavingsPot) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SavingsPot) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *SavingsPot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PotEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PotId     int64                  `protobuf:"varint,2,opt,name=pot_id,json=potId,proto3" json:"pot_id,omitempty"`
+	AccountId int64                  `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// positive when moved from the main balance into the pot, negative when moved back
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PotEntry) Reset() {
+	*x = PotEntry{}
+	mi := &file_savings_pot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PotEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PotEntry) ProtoMessage() {}
+
+func (x *PotEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_savings_pot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PotEntry.ProtoReflect.Descriptor instead.
+func (*PotEntry) Descriptor() ([]byte, []int) {
+	return file_savings_pot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PotEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PotEntry) GetPotId() int64 {
+	if x != nil {
+		return x.PotId
+	}
+	return 0
+}
+
+func (x *PotEntry) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PotEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PotEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_savings_pot_proto protoreflect.FileDescriptor
+
+const file_savings_pot_proto_rawDesc = "" +
+	"\n" +
+	"\x11savings_pot.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x02\n" +
+	"\n" +
+	"SavingsPot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x04 \x01(\x03R\ftargetAmount\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\tR\bdeadline\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\x03R\abalance\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x127\n" +
+	"\tclosed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa3\x01\n" +
+	"\bPotEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06pot_id\x18\x02 \x01(\x03R\x05potId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var (
+	file_savings_pot_proto_rawDescOnce sync.Once
+	file_savings_pot_proto_rawDescData []byte
+)
+
+func file_savings_pot_proto_rawDescGZIP() []byte {
+	file_savings_pot_proto_rawDescOnce.Do(func() {
+		file_savings_pot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_savings_pot_proto_rawDesc), len(file_savings_pot_proto_rawDesc)))
+	})
+	return file_savings_pot_proto_rawDescData
+}
+
+var file_savings_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_savings_pot_proto_goTypes = []any{
+	(*SavingsPot)(nil),            // 0: pb.SavingsPot
+	(*PotEntry)(nil),              // 1: pb.PotEntry
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_savings_pot_proto_depIdxs = []int32{
+	2, // 0: pb.SavingsPot.closed_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.SavingsPot.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.PotEntry.created_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_savings_pot_proto_init() }
+func file_savings_pot_proto_init() {
+	if File_savings_pot_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_savings_pot_proto_rawDesc), len(file_savings_pot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_savings_pot_proto_goTypes,
+		DependencyIndexes: file_savings_pot_proto_depIdxs,
+		MessageInfos:      file_savings_pot_proto_msgTypes,
+	}.Build()
+	File_savings_pot_proto = out.File
+	file_savings_pot_proto_goTypes = nil
+	file_savings_pot_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package pb
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,7 +27,7 @@ var File_service_simple_bank_proto protoreflect.FileDescriptor
 
 const file_service_simple_bank_proto_rawDesc = "" +
 	"\n" +
-	"\x19service_simple_bank.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x81\x05\n" +
+	"\x19service_simple_bank.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto\x1a\x18rpc_create_webhook.proto\x1a\x17rpc_list_webhooks.proto\x1a\x18rpc_delete_webhook.proto\x1a!rpc_list_webhook_deliveries.proto\x1a\x1brpc_redeliver_webhook.proto\x1a rpc_watch_account_activity.proto\x1a\x1brpc_list_audit_events.proto\x1a\x1arpc_export_user_data.proto\x1a\x19rpc_get_data_export.proto\x1a\x14rpc_erase_user.proto\x1a\x1drpc_list_held_transfers.proto\x1a\x19rpc_review_transfer.proto\x1a'rpc_list_notification_preferences.proto\x1a%rpc_set_notification_preference.proto\x1a(rpc_delete_notification_preference.proto\x1a\x12rpc_category.proto\x1a rpc_get_spending_analytics.proto\x1a\x18rpc_create_account.proto\x1a\x15rpc_get_account.proto\x1a\x17rpc_list_accounts.proto\x1a\x17rpc_close_account.proto\x1a\x19rpc_create_transfer.proto\x1a\x16rpc_get_transfer.proto\x1a\x18rpc_list_transfers.proto\x1a\x1crpc_create_savings_pot.proto\x1a\x1brpc_list_savings_pots.proto\x1a rpc_move_savings_pot_money.proto\x1a\x1brpc_close_savings_pot.proto\x1a\x1brpc_create_alert_rule.proto\x1a\x1arpc_list_alert_rules.proto\x1a\x1brpc_delete_alert_rule.proto\x1a\x1arpc_export_statement.proto\x1a\x1erpc_list_account_holders.proto\x1a\x1frpc_remove_account_holder.proto\x1a\x1brpc_create_invitation.proto\x1a\x1arpc_list_invitations.proto\x1a\x1brpc_accept_invitation.proto\x1a\x1crpc_decline_invitation.proto\x1a\x16rpc_open_dispute.proto\x1a\x15rpc_get_dispute.proto\x1a\x1frpc_list_account_disputes.proto\x1a\x14rpc_list_loans.proto\x1a\x1arpc_get_account_loan.proto\x1a\x19google/api/httpbody.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xa0c\n" +
 	"\n" +
 	"SimpleBank\x12\x85\x01\n" +
 	"\n" +
@@ -35,34 +36,276 @@ const file_service_simple_bank_proto_rawDesc = "" +
 	"Login user\x1aAUse this API to login user and get access token and refresh token\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/user/login\x12\x9b\x01\n" +
 	"\n" +
 	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"^\x92AH\x12\vUpdate user\x1a9Use this API to update users full name, password or email\x82\xd3\xe4\x93\x02\r:\x01*2\b/v1/user\x12\xa4\x01\n" +
-	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"d\x92AI\x12\fVerify email\x1a9Use this API to verify newly created user's email address\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_emailB\x9a\x01\x92An\x12l\n" +
+	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"d\x92AI\x12\fVerify email\x1a9Use this API to verify newly created user's email address\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12\xc1\x01\n" +
+	"\rCreateWebhook\x12\x18.pb.CreateWebhookRequest\x1a\x19.pb.CreateWebhookResponse\"{\x92Aa\x12\x0eCreate webhook\x1aOUse this API to subscribe a URL to account events, signed with the given secret\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12\x94\x01\n" +
+	"\fListWebhooks\x12\x17.pb.ListWebhooksRequest\x1a\x18.pb.ListWebhooksResponse\"Q\x92A:\x12\rList webhooks\x1a)Use this API to list webhooks of the user\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12\xa9\x01\n" +
+	"\rDeleteWebhook\x12\x18.pb.DeleteWebhookRequest\x1a\x19.pb.DeleteWebhookResponse\"c\x92AG\x12\x0eDelete webhook\x1a5Use this API to delete a webhook and its delivery log\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12\xea\x01\n" +
+	"\x15ListWebhookDeliveries\x12 .pb.ListWebhookDeliveriesRequest\x1a!.pb.ListWebhookDeliveriesResponse\"\x8b\x01\x92A\\\x12\x17List webhook deliveries\x1aAUse this API to list delivery attempts of a webhook, newest first\x82\xd3\xe4\x93\x02&\x12$/v1/webhooks/{webhook_id}/deliveries\x12\xca\x01\n" +
+	"\x10RedeliverWebhook\x12\x1b.pb.RedeliverWebhookRequest\x1a\x1c.pb.RedeliverWebhookResponse\"{\x92AB\x12\x11Redeliver webhook\x1a-Use this API to send a webhook delivery again\x82\xd3\xe4\x93\x020\"./v1/webhook_deliveries/{delivery_id}/redeliver\x12\xd0\x01\n" +
+	"\x14WatchAccountActivity\x12\x1f.pb.WatchAccountActivityRequest\x1a .pb.WatchAccountActivityResponse\"s\x92Ap\x12\x16Watch account activity\x1aVUse this API to receive new entries and balances of the user's accounts as they happen0\x01\x12\xe1\x01\n" +
+	"\x0fListAuditEvents\x12\x1a.pb.ListAuditEventsRequest\x1a\x1b.pb.ListAuditEventsResponse\"\x94\x01\x92Ay\x12\x11List audit events\x1adUse this API to search the audit log by actor, target and time range, newest first. Only for bankers\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit_events\x12\x84\x02\n" +
+	"\x0eExportUserData\x12\x19.pb.ExportUserDataRequest\x1a\x1a.pb.ExportUserDataResponse\"\xba\x01\x92A\x96\x01\x12\x10Export user data\x1a\x81\x01Use this API to request a ZIP archive of all data stored about the user. A time-limited download link is emailed when it is ready\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/user/data_exports\x12\xaf\x01\n" +
+	"\rGetDataExport\x12\x18.pb.GetDataExportRequest\x1a\x19.pb.GetDataExportResponse\"i\x92AI\x12\x0fGet data export\x1a6Use this API to check the status of a user data export\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/data_exports/{id}\x12\xd8\x02\n" +
+	"\tEraseUser\x12\x14.pb.EraseUserRequest\x1a\x15.pb.EraseUserResponse\"\x9d\x02\x92A\x80\x02\x12\n" +
+	"Erase user\x1a\xf1\x01Use this API to pseudonymize the personal data of the user. Accounts, entries and transfers are kept, the user's webhooks, holdings of other accounts and pending invitations are dropped. The user must not own accounts with a non-zero balance\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/user/erase\x12\xef\x01\n" +
+	"\x11ListHeldTransfers\x12\x1c.pb.ListHeldTransfersRequest\x1a\x1d.pb.ListHeldTransfersResponse\"\x9c\x01\x92A\x7f\x12\x13List held transfers\x1ahUse this API to list the transfers held by the fraud checks, with the rules that fired. Only for bankers\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/held_transfers\x12\xdb\x01\n" +
+	"\x0fReleaseTransfer\x12\x19.pb.ReviewTransferRequest\x1a\x1a.pb.ReviewTransferResponse\"\x90\x01\x92Af\x12\x15Release held transfer\x1aMUse this API to complete a held transfer and move its money. Only for bankers\x82\xd3\xe4\x93\x02!\"\x1f/v1/held_transfers/{id}/release\x12\xd3\x01\n" +
+	"\x0eRejectTransfer\x12\x19.pb.ReviewTransferRequest\x1a\x1a.pb.ReviewTransferResponse\"\x89\x01\x92A`\x12\x14Reject held transfer\x1aHUse this API to reject a held transfer. No money moves. Only for bankers\x82\xd3\xe4\x93\x02 \"\x1e/v1/held_transfers/{id}/reject\x12\xf9\x01\n" +
+	"\x1bListNotificationPreferences\x12&.pb.ListNotificationPreferencesRequest\x1a'.pb.ListNotificationPreferencesResponse\"\x88\x01\x92Aa\x12\x1dList notification preferences\x1a@Use this API to list the transfer events the user is notified of\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification_preferences\x12\xd9\x02\n" +
+	"\x19SetNotificationPreference\x12$.pb.SetNotificationPreferenceRequest\x1a%.pb.SetNotificationPreferenceResponse\"\xee\x01\x92A\xb6\x01\x12\x1bSet notification preference\x1a\x96\x01Use this API to be notified when money arrives on or leaves the user's accounts. Transfers below the minimum amount are batched into a periodic digest\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/notification_preferences/{event_type}\x12\x81\x02\n" +
+	"\x1cDeleteNotificationPreference\x12'.pb.DeleteNotificationPreferenceRequest\x1a(.pb.DeleteNotificationPreferenceResponse\"\x8d\x01\x92AY\x12\x1eDelete notification preference\x1a7Use this API to stop the notifications of an event type\x82\xd3\xe4\x93\x02+*)/v1/notification_preferences/{event_type}\x12\xfc\x01\n" +
+	"\x12CreateCategoryRule\x12\x1d.pb.CreateCategoryRuleRequest\x1a\x1e.pb.CreateCategoryRuleResponse\"\xa6\x01\x92A\x85\x01\x12\x14Create category rule\x1amUse this API to categorize the entries of the user's accounts by the counterparty or the memo of the transfer\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/category_rules\x12\xd9\x01\n" +
+	"\x11ListCategoryRules\x12\x1c.pb.ListCategoryRulesRequest\x1a\x1d.pb.ListCategoryRulesResponse\"\x86\x01\x92Ai\x12\x13List category rules\x1aRUse this API to list the category rules of the user, the oldest matching rule wins\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/category_rules\x12\xb5\x01\n" +
+	"\x12DeleteCategoryRule\x12\x1d.pb.DeleteCategoryRuleRequest\x1a\x1e.pb.DeleteCategoryRuleResponse\"`\x92A>\x12\x14Delete category rule\x1a&Use this API to delete a category rule\x82\xd3\xe4\x93\x02\x19*\x17/v1/category_rules/{id}\x12\xda\x01\n" +
+	"\x10SetEntryCategory\x12\x1b.pb.SetEntryCategoryRequest\x1a\x1c.pb.SetEntryCategoryResponse\"\x8a\x01\x92A]\x12\x12Set entry category\x1aGUse this API to override the category of an entry of the user's account\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/entries/{entry_id}/category\x12\xec\x01\n" +
+	"\x12ClearEntryCategory\x12\x1d.pb.ClearEntryCategoryRequest\x1a\x1e.pb.ClearEntryCategoryResponse\"\x96\x01\x92Al\x12\x14Clear entry category\x1aTUse this API to drop the category set by the user, so the category rules apply again\x82\xd3\xe4\x93\x02!*\x1f/v1/entries/{entry_id}/category\x12\x8a\x02\n" +
+	"\x14GetSpendingAnalytics\x12\x1f.pb.GetSpendingAnalyticsRequest\x1a .pb.GetSpendingAnalyticsResponse\"\xae\x01\x92A\x8c\x01\x12\x16Get spending analytics\x1arUse this API to get the debits and credits of the accounts the user owns or holds by category and by week or month\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/analytics/spending\x12\xd7\x01\n" +
+	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"\x90\x01\x92Av\x12\x0eCreate account\x1adUse this API to open an account in a currency for the user. A user can have one account per currency\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12\xc1\x01\n" +
+	"\n" +
+	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"\x83\x01\x92AD\x12\vGet account\x1a5Use this API to get an account the user owns or holds\x82\xd3\xe4\x93\x026Z!\x12\x1f/v1/accounts/by_number/{number}\x12\x11/v1/accounts/{id}\x12\xa3\x01\n" +
+	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"`\x92AI\x12\rList accounts\x1a8Use this API to list the accounts the user owns or holds\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12\xdf\x01\n" +
+	"\fCloseAccount\x12\x17.pb.CloseAccountRequest\x1a\x18.pb.CloseAccountResponse\"\x9b\x01\x92A\\\x12\rClose account\x1aKUse this API to close an empty account. Only the owner can close an account\x82\xd3\xe4\x93\x026Z!*\x1f/v1/accounts/by_number/{number}*\x11/v1/accounts/{id}\x12\xce\x02\n" +
+	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"\x84\x02\x92A\xe8\x01\x12\x0fCreate transfer\x1a\xd4\x01Use this API to move money from an account the user owns or co-owns to another account in the same currency. Transfers flagged by the fraud checks are held for review, large transfers wait for a banker's approval\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfers\x12\xb8\x01\n" +
+	"\vGetTransfer\x12\x16.pb.GetTransferRequest\x1a\x17.pb.GetTransferResponse\"x\x92A[\x12\fGet transfer\x1aKUse this API to get a transfer from or to an account the user owns or holds\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/transfers/{id}\x12\x8c\x02\n" +
+	"\rListTransfers\x12\x18.pb.ListTransfersRequest\x1a\x19.pb.ListTransfersResponse\"\xc5\x01\x92Ab\x12\x0eList transfers\x1aPUse this API to list the transfers from and to an account the user owns or holds\x82\xd3\xe4\x93\x02ZZ3\x121/v1/accounts/by_number/{account_number}/transfers\x12#/v1/accounts/{account_id}/transfers\x12\xb3\x02\n" +
+	"\x10CreateSavingsPot\x12\x1b.pb.CreateSavingsPotRequest\x1a\x1c.pb.CreateSavingsPotResponse\"\xe3\x01\x92A\x83\x01\x12\x12Create savings pot\x1amUse this API to open a savings pot with a target amount and a deadline on an account the user owns or co-owns\x82\xd3\xe4\x93\x02V:\x01*Z1:\x01*\",/v1/accounts/by_number/{account_number}/pots\"\x1e/v1/accounts/{account_id}/pots\x12\x85\x02\n" +
+	"\x0fListSavingsPots\x12\x1a.pb.ListSavingsPotsRequest\x1a\x1b.pb.ListSavingsPotsResponse\"\xb8\x01\x92A_\x12\x11List savings pots\x1aJUse this API to list the savings pots of an account the user owns or holds\x82\xd3\xe4\x93\x02PZ.\x12,/v1/accounts/by_number/{account_number}/pots\x12\x1e/v1/accounts/{account_id}/pots\x12\x81\x03\n" +
+	"\x13MoveSavingsPotMoney\x12\x1e.pb.MoveSavingsPotMoneyRequest\x1a\x1f.pb.MoveSavingsPotMoneyResponse\"\xa8\x02\x92A\xaa\x01\x12\x16Move savings pot money\x1a\x8f\x01Use this API to move money from the available balance of an account into a savings pot or back. Money in pots can't be transferred or withdrawn\x82\xd3\xe4\x93\x02t:\x01*Z@:\x01*\";/v1/accounts/by_number/{account_number}/pots/{pot_id}/moves\"-/v1/accounts/{account_id}/pots/{pot_id}/moves\x12\xbe\x02\n" +
+	"\x0fCloseSavingsPot\x12\x1a.pb.CloseSavingsPotRequest\x1a\x1b.pb.CloseSavingsPotResponse\"\xf1\x01\x92At\x12\x11Close savings pot\x1a_Use this API to close a savings pot, its money is swept back to the main balance of the account\x82\xd3\xe4\x93\x02t:\x01*Z@:\x01*\";/v1/accounts/by_number/{account_number}/pots/{pot_id}/close\"-/v1/accounts/{account_id}/pots/{pot_id}/close\x12\xe7\x02\n" +
+	"\x0fCreateAlertRule\x12\x1a.pb.CreateAlertRuleRequest\x1a\x1b.pb.CreateAlertRuleResponse\"\x9a\x02\x92A\xac\x01\x12\x11Create alert rule\x1a\x96\x01Use this API to be alerted when the balance of an account the user holds runs low, a large debit is made or the spending of the day passes a threshold\x82\xd3\xe4\x93\x02d:\x01*Z8:\x01*\"3/v1/accounts/by_number/{account_number}/alert_rules\"%/v1/accounts/{account_id}/alert_rules\x12\x82\x02\n" +
+	"\x0eListAlertRules\x12\x19.pb.ListAlertRulesRequest\x1a\x1a.pb.ListAlertRulesResponse\"\xb8\x01\x92AQ\x12\x10List alert rules\x1a=Use this API to list the user's own alert rules on an account\x82\xd3\xe4\x93\x02^Z5\x123/v1/accounts/by_number/{account_number}/alert_rules\x12%/v1/accounts/{account_id}/alert_rules\x12\x8b\x02\n" +
+	"\x0fDeleteAlertRule\x12\x1a.pb.DeleteAlertRuleRequest\x1a\x1b.pb.DeleteAlertRuleResponse\"\xbe\x01\x92AM\x12\x11Delete alert rule\x1a8Use this API to delete one of the user's own alert rules\x82\xd3\xe4\x93\x02hZ:*8/v1/accounts/by_number/{account_number}/alert_rules/{id}**/v1/accounts/{account_id}/alert_rules/{id}\x12\xc5\x02\n" +
+	"\x0fExportStatement\x12\x1a.pb.ExportStatementRequest\x1a\x14.google.api.HttpBody\"\xff\x01\x92A\x9b\x01\x12\x10Export statement\x1a\x86\x01Use this API to download the entries booked on an account the user holds between two business dates as an ISO 20022 camt.053 statement\x82\xd3\xe4\x93\x02ZZ3\x121/v1/accounts/by_number/{account_number}/statement\x12#/v1/accounts/{account_id}/statement\x12\x8a\x02\n" +
+	"\x12ListAccountHolders\x12\x1d.pb.ListAccountHoldersRequest\x1a\x1e.pb.ListAccountHoldersResponse\"\xb4\x01\x92AU\x12\x14List account holders\x1a=Use this API to list the holders of an account the user holds\x82\xd3\xe4\x93\x02VZ1\x12//v1/accounts/by_number/{account_number}/holders\x12!/v1/accounts/{account_id}/holders\x12\xcb\x02\n" +
+	"\x13RemoveAccountHolder\x12\x1e.pb.RemoveAccountHolderRequest\x1a\x1f.pb.RemoveAccountHolderResponse\"\xf2\x01\x92A}\x12\x15Remove account holder\x1adUse this API to remove a holder from an account the user owns, or to leave an account the user holds\x82\xd3\xe4\x93\x02lZ<*:/v1/accounts/by_number/{account_number}/holders/{username}*,/v1/accounts/{account_id}/holders/{username}\x12\xb0\x02\n" +
+	"\x10CreateInvitation\x12\x1b.pb.CreateInvitationRequest\x1a\x1c.pb.CreateInvitationResponse\"\xe0\x01\x92As\x12\x11Create invitation\x1a^Use this API to invite another user to hold an account the user owns as a co-owner or a viewer\x82\xd3\xe4\x93\x02d:\x01*Z8:\x01*\"3/v1/accounts/by_number/{account_number}/invitations\"%/v1/accounts/{account_id}/invitations\x12\xb4\x01\n" +
+	"\x0fListInvitations\x12\x1a.pb.ListInvitationsRequest\x1a\x1b.pb.ListInvitationsResponse\"h\x92AN\x12\x10List invitations\x1a:Use this API to list the invitations addressed to the user\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/invitations\x12\xdc\x01\n" +
+	"\x10AcceptInvitation\x12\x1b.pb.AcceptInvitationRequest\x1a\x1c.pb.AcceptInvitationResponse\"\x8c\x01\x92Ac\x12\x11Accept invitation\x1aNUse this API to accept a pending invitation and become a holder of the account\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/invitations/{id}/accept\x12\xbe\x01\n" +
+	"\x11DeclineInvitation\x12\x1c.pb.DeclineInvitationRequest\x1a\x1d.pb.DeclineInvitationResponse\"l\x92AB\x12\x12Decline invitation\x1a,Use this API to decline a pending invitation\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/invitations/{id}/decline\x12\xfa\x01\n" +
+	"\vOpenDispute\x12\x16.pb.OpenDisputeRequest\x1a\x17.pb.OpenDisputeResponse\"\xb9\x01\x92A\x9e\x01\x12\fOpen dispute\x1a\x8d\x01Use this API to dispute a completed transfer paid from an account the user can spend from. No money moves until a banker resolves the dispute\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/disputes\x12\xc0\x01\n" +
+	"\n" +
+	"GetDispute\x12\x15.pb.GetDisputeRequest\x1a\x16.pb.GetDisputeResponse\"\x82\x01\x92Af\x12\vGet dispute\x1aWUse this API to get a dispute of a transfer paid from an account the user owns or holds\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/disputes/{id}\x12\xa7\x02\n" +
+	"\x13ListAccountDisputes\x12\x1e.pb.ListAccountDisputesRequest\x1a\x1f.pb.ListAccountDisputesResponse\"\xce\x01\x92Am\x12\x15List account disputes\x1aTUse this API to list the disputes of an account the user owns or holds, newest first\x82\xd3\xe4\x93\x02XZ2\x120/v1/accounts/by_number/{account_number}/disputes\x12\"/v1/accounts/{account_id}/disputes\x12\x95\x02\n" +
+	"\tListLoans\x12\x14.pb.ListLoansRequest\x1a\x15.pb.ListLoansResponse\"\xda\x01\x92A\x7f\x12\n" +
+	"List loans\x1aqUse this API to list the loans of an account the user owns or holds with their outstanding principal and interest\x82\xd3\xe4\x93\x02RZ/\x12-/v1/accounts/by_number/{account_number}/loans\x12\x1f/v1/accounts/{account_id}/loans\x12\xb2\x02\n" +
+	"\x0eGetAccountLoan\x12\x19.pb.GetAccountLoanRequest\x1a\x1a.pb.GetAccountLoanResponse\"\xe8\x01\x92Ay\x12\x10Get account loan\x1aeUse this API to get a loan of an account the user owns or holds with the schedule of its installments\x82\xd3\xe4\x93\x02fZ9\x127/v1/accounts/by_number/{account_number}/loans/{loan_id}\x12)/v1/accounts/{account_id}/loans/{loan_id}B\x9a\x01\x92An\x12l\n" +
 	"\vSimple Bank\"X\n" +
 	"\x0eDrolfothesgnir\x12,https://github.com/Drolfothesgnir/simplebank\x1a\x18kyryl.yeletsky@gmail.com2\x031.1Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
 
 var file_service_simple_bank_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),   // 0: pb.CreateUserRequest
-	(*LoginUserRequest)(nil),    // 1: pb.LoginUserRequest
-	(*UpdateUserRequest)(nil),   // 2: pb.UpdateUserRequest
-	(*VerifyEmailRequest)(nil),  // 3: pb.VerifyEmailRequest
-	(*CreateUserResponse)(nil),  // 4: pb.CreateUserResponse
-	(*LoginUserResponse)(nil),   // 5: pb.LoginUserResponse
-	(*UpdateUserResponse)(nil),  // 6: pb.UpdateUserResponse
-	(*VerifyEmailResponse)(nil), // 7: pb.VerifyEmailResponse
+	(*CreateUserRequest)(nil),                    // 0: pb.CreateUserRequest
+	(*LoginUserRequest)(nil),                     // 1: pb.LoginUserRequest
+	(*UpdateUserRequest)(nil),                    // 2: pb.UpdateUserRequest
+	(*VerifyEmailRequest)(nil),                   // 3: pb.VerifyEmailRequest
+	(*CreateWebhookRequest)(nil),                 // 4: pb.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),                  // 5: pb.ListWebhooksRequest
+	(*DeleteWebhookRequest)(nil),                 // 6: pb.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),         // 7: pb.ListWebhookDeliveriesRequest
+	(*RedeliverWebhookRequest)(nil),              // 8: pb.RedeliverWebhookRequest
+	(*WatchAccountActivityRequest)(nil),          // 9: pb.WatchAccountActivityRequest
+	(*ListAuditEventsRequest)(nil),               // 10: pb.ListAuditEventsRequest
+	(*ExportUserDataRequest)(nil),                // 11: pb.ExportUserDataRequest
+	(*GetDataExportRequest)(nil),                 // 12: pb.GetDataExportRequest
+	(*EraseUserRequest)(nil),                     // 13: pb.EraseUserRequest
+	(*ListHeldTransfersRequest)(nil),             // 14: pb.ListHeldTransfersRequest
+	(*ReviewTransferRequest)(nil),                // 15: pb.ReviewTransferRequest
+	(*ListNotificationPreferencesRequest)(nil),   // 16: pb.ListNotificationPreferencesRequest
+	(*SetNotificationPreferenceRequest)(nil),     // 17: pb.SetNotificationPreferenceRequest
+	(*DeleteNotificationPreferenceRequest)(nil),  // 18: pb.DeleteNotificationPreferenceRequest
+	(*CreateCategoryRuleRequest)(nil),            // 19: pb.CreateCategoryRuleRequest
+	(*ListCategoryRulesRequest)(nil),             // 20: pb.ListCategoryRulesRequest
+	(*DeleteCategoryRuleRequest)(nil),            // 21: pb.DeleteCategoryRuleRequest
+	(*SetEntryCategoryRequest)(nil),              // 22: pb.SetEntryCategoryRequest
+	(*ClearEntryCategoryRequest)(nil),            // 23: pb.ClearEntryCategoryRequest
+	(*GetSpendingAnalyticsRequest)(nil),          // 24: pb.GetSpendingAnalyticsRequest
+	(*CreateAccountRequest)(nil),                 // 25: pb.CreateAccountRequest
+	(*GetAccountRequest)(nil),                    // 26: pb.GetAccountRequest
+	(*ListAccountsRequest)(nil),                  // 27: pb.ListAccountsRequest
+	(*CloseAccountRequest)(nil),                  // 28: pb.CloseAccountRequest
+	(*CreateTransferRequest)(nil),                // 29: pb.CreateTransferRequest
+	(*GetTransferRequest)(nil),                   // 30: pb.GetTransferRequest
+	(*ListTransfersRequest)(nil),                 // 31: pb.ListTransfersRequest
+	(*CreateSavingsPotRequest)(nil),              // 32: pb.CreateSavingsPotRequest
+	(*ListSavingsPotsRequest)(nil),               // 33: pb.ListSavingsPotsRequest
+	(*MoveSavingsPotMoneyRequest)(nil),           // 34: pb.MoveSavingsPotMoneyRequest
+	(*CloseSavingsPotRequest)(nil),               // 35: pb.CloseSavingsPotRequest
+	(*CreateAlertRuleRequest)(nil),               // 36: pb.CreateAlertRuleRequest
+	(*ListAlertRulesRequest)(nil),                // 37: pb.ListAlertRulesRequest
+	(*DeleteAlertRuleRequest)(nil),               // 38: pb.DeleteAlertRuleRequest
+	(*ExportStatementRequest)(nil),               // 39: pb.ExportStatementRequest
+	(*ListAccountHoldersRequest)(nil),            // 40: pb.ListAccountHoldersRequest
+	(*RemoveAccountHolderRequest)(nil),           // 41: pb.RemoveAccountHolderRequest
+	(*CreateInvitationRequest)(nil),              // 42: pb.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),               // 43: pb.ListInvitationsRequest
+	(*AcceptInvitationRequest)(nil),              // 44: pb.AcceptInvitationRequest
+	(*DeclineInvitationRequest)(nil),             // 45: pb.DeclineInvitationRequest
+	(*OpenDisputeRequest)(nil),                   // 46: pb.OpenDisputeRequest
+	(*GetDisputeRequest)(nil),                    // 47: pb.GetDisputeRequest
+	(*ListAccountDisputesRequest)(nil),           // 48: pb.ListAccountDisputesRequest
+	(*ListLoansRequest)(nil),                     // 49: pb.ListLoansRequest
+	(*GetAccountLoanRequest)(nil),                // 50: pb.GetAccountLoanRequest
+	(*CreateUserResponse)(nil),                   // 51: pb.CreateUserResponse
+	(*LoginUserResponse)(nil),                    // 52: pb.LoginUserResponse
+	(*UpdateUserResponse)(nil),                   // 53: pb.UpdateUserResponse
+	(*VerifyEmailResponse)(nil),                  // 54: pb.VerifyEmailResponse
+	(*CreateWebhookResponse)(nil),                // 55: pb.CreateWebhookResponse
+	(*ListWebhooksResponse)(nil),                 // 56: pb.ListWebhooksResponse
+	(*DeleteWebhookResponse)(nil),                // 57: pb.DeleteWebhookResponse
+	(*ListWebhookDeliveriesResponse)(nil),        // 58: pb.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookResponse)(nil),             // 59: pb.RedeliverWebhookResponse
+	(*WatchAccountActivityResponse)(nil),         // 60: pb.WatchAccountActivityResponse
+	(*ListAuditEventsResponse)(nil),              // 61: pb.ListAuditEventsResponse
+	(*ExportUserDataResponse)(nil),               // 62: pb.ExportUserDataResponse
+	(*GetDataExportResponse)(nil),                // 63: pb.GetDataExportResponse
+	(*EraseUserResponse)(nil),                    // 64: pb.EraseUserResponse
+	(*ListHeldTransfersResponse)(nil),            // 65: pb.ListHeldTransfersResponse
+	(*ReviewTransferResponse)(nil),               // 66: pb.ReviewTransferResponse
+	(*ListNotificationPreferencesResponse)(nil),  // 67: pb.ListNotificationPreferencesResponse
+	(*SetNotificationPreferenceResponse)(nil),    // 68: pb.SetNotificationPreferenceResponse
+	(*DeleteNotificationPreferenceResponse)(nil), // 69: pb.DeleteNotificationPreferenceResponse
+	(*CreateCategoryRuleResponse)(nil),           // 70: pb.CreateCategoryRuleResponse
+	(*ListCategoryRulesResponse)(nil),            // 71: pb.ListCategoryRulesResponse
+	(*DeleteCategoryRuleResponse)(nil),           // 72: pb.DeleteCategoryRuleResponse
+	(*SetEntryCategoryResponse)(nil),             // 73: pb.SetEntryCategoryResponse
+	(*ClearEntryCategoryResponse)(nil),           // 74: pb.ClearEntryCategoryResponse
+	(*GetSpendingAnalyticsResponse)(nil),         // 75: pb.GetSpendingAnalyticsResponse
+	(*CreateAccountResponse)(nil),                // 76: pb.CreateAccountResponse
+	(*GetAccountResponse)(nil),                   // 77: pb.GetAccountResponse
+	(*ListAccountsResponse)(nil),                 // 78: pb.ListAccountsResponse
+	(*CloseAccountResponse)(nil),                 // 79: pb.CloseAccountResponse
+	(*CreateTransferResponse)(nil),               // 80: pb.CreateTransferResponse
+	(*GetTransferResponse)(nil),                  // 81: pb.GetTransferResponse
+	(*ListTransfersResponse)(nil),                // 82: pb.ListTransfersResponse
+	(*CreateSavingsPotResponse)(nil),             // 83: pb.CreateSavingsPotResponse
+	(*ListSavingsPotsResponse)(nil),              // 84: pb.ListSavingsPotsResponse
+	(*MoveSavingsPotMoneyResponse)(nil),          // 85: pb.MoveSavingsPotMoneyResponse
+	(*CloseSavingsPotResponse)(nil),              // 86: pb.CloseSavingsPotResponse
+	(*CreateAlertRuleResponse)(nil),              // 87: pb.CreateAlertRuleResponse
+	(*ListAlertRulesResponse)(nil),               // 88: pb.ListAlertRulesResponse
+	(*DeleteAlertRuleResponse)(nil),              // 89: pb.DeleteAlertRuleResponse
+	(*httpbody.HttpBody)(nil),                    // 90: google.api.HttpBody
+	(*ListAccountHoldersResponse)(nil),           // 91: pb.ListAccountHoldersResponse
+	(*RemoveAccountHolderResponse)(nil),          // 92: pb.RemoveAccountHolderResponse
+	(*CreateInvitationResponse)(nil),             // 93: pb.CreateInvitationResponse
+	(*ListInvitationsResponse)(nil),              // 94: pb.ListInvitationsResponse
+	(*AcceptInvitationResponse)(nil),             // 95: pb.AcceptInvitationResponse
+	(*DeclineInvitationResponse)(nil),            // 96: pb.DeclineInvitationResponse
+	(*OpenDisputeResponse)(nil),                  // 97: pb.OpenDisputeResponse
+	(*GetDisputeResponse)(nil),                   // 98: pb.GetDisputeResponse
+	(*ListAccountDisputesResponse)(nil),          // 99: pb.ListAccountDisputesResponse
+	(*ListLoansResponse)(nil),                    // 100: pb.ListLoansResponse
+	(*GetAccountLoanResponse)(nil),               // 101: pb.GetAccountLoanResponse
 }
 var file_service_simple_bank_proto_depIdxs = []int32{
-	0, // 0: pb.SimpleBank.CreateUser:input_type -> pb.CreateUserRequest
-	1, // 1: pb.SimpleBank.LoginUser:input_type -> pb.LoginUserRequest
-	2, // 2: pb.SimpleBank.UpdateUser:input_type -> pb.UpdateUserRequest
-	3, // 3: pb.SimpleBank.VerifyEmail:input_type -> pb.VerifyEmailRequest
-	4, // 4: pb.SimpleBank.CreateUser:output_type -> pb.CreateUserResponse
-	5, // 5: pb.SimpleBank.LoginUser:output_type -> pb.LoginUserResponse
-	6, // 6: pb.SimpleBank.UpdateUser:output_type -> pb.UpdateUserResponse
-	7, // 7: pb.SimpleBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: pb.SimpleBank.CreateUser:input_type -> pb.CreateUserRequest
+	1,   // 1: pb.SimpleBank.LoginUser:input_type -> pb.LoginUserRequest
+	2,   // 2: pb.SimpleBank.UpdateUser:input_type -> pb.UpdateUserRequest
+	3,   // 3: pb.SimpleBank.VerifyEmail:input_type -> pb.VerifyEmailRequest
+	4,   // 4: pb.SimpleBank.CreateWebhook:input_type -> pb.CreateWebhookRequest
+	5,   // 5: pb.SimpleBank.ListWebhooks:input_type -> pb.ListWebhooksRequest
+	6,   // 6: pb.SimpleBank.DeleteWebhook:input_type -> pb.DeleteWebhookRequest
+	7,   // 7: pb.SimpleBank.ListWebhookDeliveries:input_type -> pb.ListWebhookDeliveriesRequest
+	8,   // 8: pb.SimpleBank.RedeliverWebhook:input_type -> pb.RedeliverWebhookRequest
+	9,   // 9: pb.SimpleBank.WatchAccountActivity:input_type -> pb.WatchAccountActivityRequest
+	10,  // 10: pb.SimpleBank.ListAuditEvents:input_type -> pb.ListAuditEventsRequest
+	11,  // 11: pb.SimpleBank.ExportUserData:input_type -> pb.ExportUserDataRequest
+	12,  // 12: pb.SimpleBank.GetDataExport:input_type -> pb.GetDataExportRequest
+	13,  // 13: pb.SimpleBank.EraseUser:input_type -> pb.EraseUserRequest
+	14,  // 14: pb.SimpleBank.ListHeldTransfers:input_type -> pb.ListHeldTransfersRequest
+	15,  // 15: pb.SimpleBank.ReleaseTransfer:input_type -> pb.ReviewTransferRequest
+	15,  // 16: pb.SimpleBank.RejectTransfer:input_type -> pb.ReviewTransferRequest
+	16,  // 17: pb.SimpleBank.ListNotificationPreferences:input_type -> pb.ListNotificationPreferencesRequest
+	17,  // 18: pb.SimpleBank.SetNotificationPreference:input_type -> pb.SetNotificationPreferenceRequest
+	18,  // 19: pb.SimpleBank.DeleteNotificationPreference:input_type -> pb.DeleteNotificationPreferenceRequest
+	19,  // 20: pb.SimpleBank.CreateCategoryRule:input_type -> pb.CreateCategoryRuleRequest
+	20,  // 21: pb.SimpleBank.ListCategoryRules:input_type -> pb.ListCategoryRulesRequest
+	21,  // 22: pb.SimpleBank.DeleteCategoryRule:input_type -> pb.DeleteCategoryRuleRequest
+	22,  // 23: pb.SimpleBank.SetEntryCategory:input_type -> pb.SetEntryCategoryRequest
+	23,  // 24: pb.SimpleBank.ClearEntryCategory:input_type -> pb.ClearEntryCategoryRequest
+	24,  // 25: pb.SimpleBank.GetSpendingAnalytics:input_type -> pb.GetSpendingAnalyticsRequest
+	25,  // 26: pb.SimpleBank.CreateAccount:input_type -> pb.CreateAccountRequest
+	26,  // 27: pb.SimpleBank.GetAccount:input_type -> pb.GetAccountRequest
+	27,  // 28: pb.SimpleBank.ListAccounts:input_type -> pb.ListAccountsRequest
+	28,  // 29: pb.SimpleBank.CloseAccount:input_type -> pb.CloseAccountRequest
+	29,  // 30: pb.SimpleBank.CreateTransfer:input_type -> pb.CreateTransferRequest
+	30,  // 31: pb.SimpleBank.GetTransfer:input_type -> pb.GetTransferRequest
+	31,  // 32: pb.SimpleBank.ListTransfers:input_type -> pb.ListTransfersRequest
+	32,  // 33: pb.SimpleBank.CreateSavingsPot:input_type -> pb.CreateSavingsPotRequest
+	33,  // 34: pb.SimpleBank.ListSavingsPots:input_type -> pb.ListSavingsPotsRequest
+	34,  // 35: pb.SimpleBank.MoveSavingsPotMoney:input_type -> pb.MoveSavingsPotMoneyRequest
+	35,  // 36: pb.SimpleBank.CloseSavingsPot:input_type -> pb.CloseSavingsPotRequest
+	36,  // 37: pb.SimpleBank.CreateAlertRule:input_type -> pb.CreateAlertRuleRequest
+	37,  // 38: pb.SimpleBank.ListAlertRules:input_type -> pb.ListAlertRulesRequest
+	38,  // 39: pb.SimpleBank.DeleteAlertRule:input_type -> pb.DeleteAlertRuleRequest
+	39,  // 40: pb.SimpleBank.ExportStatement:input_type -> pb.ExportStatementRequest
+	40,  // 41: pb.SimpleBank.ListAccountHolders:input_type -> pb.ListAccountHoldersRequest
+	41,  // 42: pb.SimpleBank.RemoveAccountHolder:input_type -> pb.RemoveAccountHolderRequest
+	42,  // 43: pb.SimpleBank.CreateInvitation:input_type -> pb.CreateInvitationRequest
+	43,  // 44: pb.SimpleBank.ListInvitations:input_type -> pb.ListInvitationsRequest
+	44,  // 45: pb.SimpleBank.AcceptInvitation:input_type -> pb.AcceptInvitationRequest
+	45,  // 46: pb.SimpleBank.DeclineInvitation:input_type -> pb.DeclineInvitationRequest
+	46,  // 47: pb.SimpleBank.OpenDispute:input_type -> pb.OpenDisputeRequest
+	47,  // 48: pb.SimpleBank.GetDispute:input_type -> pb.GetDisputeRequest
+	48,  // 49: pb.SimpleBank.ListAccountDisputes:input_type -> pb.ListAccountDisputesRequest
+	49,  // 50: pb.SimpleBank.ListLoans:input_type -> pb.ListLoansRequest
+	50,  // 51: pb.SimpleBank.GetAccountLoan:input_type -> pb.GetAccountLoanRequest
+	51,  // 52: pb.SimpleBank.CreateUser:output_type -> pb.CreateUserResponse
+	52,  // 53: pb.SimpleBank.LoginUser:output_type -> pb.LoginUserResponse
+	53,  // 54: pb.SimpleBank.UpdateUser:output_type -> pb.UpdateUserResponse
+	54,  // 55: pb.SimpleBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	55,  // 56: pb.SimpleBank.CreateWebhook:output_type -> pb.CreateWebhookResponse
+	56,  // 57: pb.SimpleBank.ListWebhooks:output_type -> pb.ListWebhooksResponse
+	57,  // 58: pb.SimpleBank.DeleteWebhook:output_type -> pb.DeleteWebhookResponse
+	58,  // 59: pb.SimpleBank.ListWebhookDeliveries:output_type -> pb.ListWebhookDeliveriesResponse
+	59,  // 60: pb.SimpleBank.RedeliverWebhook:output_type -> pb.RedeliverWebhookResponse
+	60,  // 61: pb.SimpleBank.WatchAccountActivity:output_type -> pb.WatchAccountActivityResponse
+	61,  // 62: pb.SimpleBank.ListAuditEvents:output_type -> pb.ListAuditEventsResponse
+	62,  // 63: pb.SimpleBank.ExportUserData:output_type -> pb.ExportUserDataResponse
+	63,  // 64: pb.SimpleBank.GetDataExport:output_type -> pb.GetDataExportResponse
+	64,  // 65: pb.SimpleBank.EraseUser:output_type -> pb.EraseUserResponse
+	65,  // 66: pb.SimpleBank.ListHeldTransfers:output_type -> pb.ListHeldTransfersResponse
+	66,  // 67: pb.SimpleBank.ReleaseTransfer:output_type -> pb.ReviewTransferResponse
+	66,  // 68: pb.SimpleBank.RejectTransfer:output_type -> pb.ReviewTransferResponse
+	67,  // 69: pb.SimpleBank.ListNotificationPreferences:output_type -> pb.ListNotificationPreferencesResponse
+	68,  // 70: pb.SimpleBank.SetNotificationPreference:output_type -> pb.SetNotificationPreferenceResponse
+	69,  // 71: pb.SimpleBank.DeleteNotificationPreference:output_type -> pb.DeleteNotificationPreferenceResponse
+	70,  // 72: pb.SimpleBank.CreateCategoryRule:output_type -> pb.CreateCategoryRuleResponse
+	71,  // 73: pb.SimpleBank.ListCategoryRules:output_type -> pb.ListCategoryRulesResponse
+	72,  // 74: pb.SimpleBank.DeleteCategoryRule:output_type -> pb.DeleteCategoryRuleResponse
+	73,  // 75: pb.SimpleBank.SetEntryCategory:output_type -> pb.SetEntryCategoryResponse
+	74,  // 76: pb.SimpleBank.ClearEntryCategory:output_type -> pb.ClearEntryCategoryResponse
+	75,  // 77: pb.SimpleBank.GetSpendingAnalytics:output_type -> pb.GetSpendingAnalyticsResponse
+	76,  // 78: pb.SimpleBank.CreateAccount:output_type -> pb.CreateAccountResponse
+	77,  // 79: pb.SimpleBank.GetAccount:output_type -> pb.GetAccountResponse
+	78,  // 80: pb.SimpleBank.ListAccounts:output_type -> pb.ListAccountsResponse
+	79,  // 81: pb.SimpleBank.CloseAccount:output_type -> pb.CloseAccountResponse
+	80,  // 82: pb.SimpleBank.CreateTransfer:output_type -> pb.CreateTransferResponse
+	81,  // 83: pb.SimpleBank.GetTransfer:output_type -> pb.GetTransferResponse
+	82,  // 84: pb.SimpleBank.ListTransfers:output_type -> pb.ListTransfersResponse
+	83,  // 85: pb.SimpleBank.CreateSavingsPot:output_type -> pb.CreateSavingsPotResponse
+	84,  // 86: pb.SimpleBank.ListSavingsPots:output_type -> pb.ListSavingsPotsResponse
+	85,  // 87: pb.SimpleBank.MoveSavingsPotMoney:output_type -> pb.MoveSavingsPotMoneyResponse
+	86,  // 88: pb.SimpleBank.CloseSavingsPot:output_type -> pb.CloseSavingsPotResponse
+	87,  // 89: pb.SimpleBank.CreateAlertRule:output_type -> pb.CreateAlertRuleResponse
+	88,  // 90: pb.SimpleBank.ListAlertRules:output_type -> pb.ListAlertRulesResponse
+	89,  // 91: pb.SimpleBank.DeleteAlertRule:output_type -> pb.DeleteAlertRuleResponse
+	90,  // 92: pb.SimpleBank.ExportStatement:output_type -> google.api.HttpBody
+	91,  // 93: pb.SimpleBank.ListAccountHolders:output_type -> pb.ListAccountHoldersResponse
+	92,  // 94: pb.SimpleBank.RemoveAccountHolder:output_type -> pb.RemoveAccountHolderResponse
+	93,  // 95: pb.SimpleBank.CreateInvitation:output_type -> pb.CreateInvitationResponse
+	94,  // 96: pb.SimpleBank.ListInvitations:output_type -> pb.ListInvitationsResponse
+	95,  // 97: pb.SimpleBank.AcceptInvitation:output_type -> pb.AcceptInvitationResponse
+	96,  // 98: pb.SimpleBank.DeclineInvitation:output_type -> pb.DeclineInvitationResponse
+	97,  // 99: pb.SimpleBank.OpenDispute:output_type -> pb.OpenDisputeResponse
+	98,  // 100: pb.SimpleBank.GetDispute:output_type -> pb.GetDisputeResponse
+	99,  // 101: pb.SimpleBank.ListAccountDisputes:output_type -> pb.ListAccountDisputesResponse
+	100, // 102: pb.SimpleBank.ListLoans:output_type -> pb.ListLoansResponse
+	101, // 103: pb.SimpleBank.GetAccountLoan:output_type -> pb.GetAccountLoanResponse
+	52,  // [52:104] is the sub-list for method output_type
+	0,   // [0:52] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_simple_bank_proto_init() }
@@ -74,6 +317,49 @@ func file_service_simple_bank_proto_init() {
 	file_rpc_login_user_proto_init()
 	file_rpc_update_user_proto_init()
 	file_rpc_verify_email_proto_init()
+	file_rpc_create_webhook_proto_init()
+	file_rpc_list_webhooks_proto_init()
+	file_rpc_delete_webhook_proto_init()
+	file_rpc_list_webhook_deliveries_proto_init()
+	file_rpc_redeliver_webhook_proto_init()
+	file_rpc_watch_account_activity_proto_init()
+	file_rpc_list_audit_events_proto_init()
+	file_rpc_export_user_data_proto_init()
+	file_rpc_get_data_export_proto_init()
+	file_rpc_erase_user_proto_init()
+	file_rpc_list_held_transfers_proto_init()
+	file_rpc_review_transfer_proto_init()
+	file_rpc_list_notification_preferences_proto_init()
+	file_rpc_set_notification_preference_proto_init()
+	file_rpc_delete_notification_preference_proto_init()
+	file_rpc_category_proto_init()
+	file_rpc_get_spending_analytics_proto_init()
+	file_rpc_create_account_proto_init()
+	file_rpc_get_account_proto_init()
+	file_rpc_list_accounts_proto_init()
+	file_rpc_close_account_proto_init()
+	file_rpc_create_transfer_proto_init()
+	file_rpc_get_transfer_proto_init()
+	file_rpc_list_transfers_proto_init()
+	file_rpc_create_savings_pot_proto_init()
+	file_rpc_list_savings_pots_proto_init()
+	file_rpc_move_savings_pot_money_proto_init()
+	file_rpc_close_savings_pot_proto_init()
+	file_rpc_create_alert_rule_proto_init()
+	file_rpc_list_alert_rules_proto_init()
+	file_rpc_delete_alert_rule_proto_init()
+	file_rpc_export_statement_proto_init()
+	file_rpc_list_account_holders_proto_init()
+	file_rpc_remove_account_holder_proto_init()
+	file_rpc_create_invitation_proto_init()
+	file_rpc_list_invitations_proto_init()
+	file_rpc_accept_invitation_proto_init()
+	file_rpc_decline_invitation_proto_init()
+	file_rpc_open_dispute_proto_init()
+	file_rpc_get_dispute_proto_init()
+	file_rpc_list_account_disputes_proto_init()
+	file_rpc_list_loans_proto_init()
+	file_rpc_get_account_loan_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: service_simple_bank_admin.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_service_simple_bank_admin_proto protoreflect.FileDescriptor
+
+const file_service_simple_bank_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x1fservice_simple_bank_admin.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x1arpc_admin_list_users.proto\x1a\"rpc_admin_list_user_accounts.proto\x1a#rpc_admin_list_user_transfers.proto\x1a\x19rpc_admin_lock_user.proto\x1a\x1drpc_admin_set_user_role.proto\x1a\x1erpc_admin_adjust_balance.proto\x1a\x1erpc_admin_cash_operation.proto\x1a rpc_admin_get_cash_receipt.proto\x1a\x1erpc_admin_ledger_account.proto\x1a!rpc_admin_get_trial_balance.proto\x1a rpc_admin_get_business_day.proto\x1a rpc_admin_import_statement.proto\x1a.rpc_admin_list_unmatched_statement_lines.proto\x1a&rpc_admin_resolve_statement_line.proto\x1a!rpc_admin_transfer_approval.proto\x1a\x17rpc_admin_dispute.proto\x1a\x14rpc_admin_loan.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x9c8\n" +
+	"\x0fSimpleBankAdmin\x12\xe6\x01\n" +
+	"\tListUsers\x12\x19.pb.AdminListUsersRequest\x1a\x1a.pb.AdminListUsersResponse\"\xa1\x01\x92A\x86\x01\x12\n" +
+	"List users\x1axUse this API to list users, optionally searching by username, email or full name and filtering by role. Only for bankers\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/users\x12\xe8\x01\n" +
+	"\x10ListUserAccounts\x12 .pb.AdminListUserAccountsRequest\x1a!.pb.AdminListUserAccountsResponse\"\x8e\x01\x92A`\x12\x12List user accounts\x1aJUse this API to list the accounts any user owns or holds. Only for bankers\x82\xd3\xe4\x93\x02%\x12#/v1/admin/users/{username}/accounts\x12\xfb\x01\n" +
+	"\x11ListUserTransfers\x12!.pb.AdminListUserTransfersRequest\x1a\".pb.AdminListUserTransfersResponse\"\x9e\x01\x92Ao\x12\x13List user transfers\x1aXUse this API to list the transfers from or to the accounts of any user. Only for bankers\x82\xd3\xe4\x93\x02&\x12$/v1/admin/users/{username}/transfers\x12\xf0\x01\n" +
+	"\bLockUser\x12\x18.pb.AdminLockUserRequest\x1a\x19.pb.AdminLockUserResponse\"\xae\x01\x92A\x80\x01\x12\tLock user\x1asUse this API to lock a user out. The user can't log in and all of the user's sessions are blocked. Only for bankers\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/admin/users/{username}/lock\x12\xc2\x01\n" +
+	"\n" +
+	"UnlockUser\x12\x1a.pb.AdminUnlockUserRequest\x1a\x1b.pb.AdminUnlockUserResponse\"{\x92AO\x12\vUnlock user\x1a@Use this API to let a locked user log in again. Only for bankers\x82\xd3\xe4\x93\x02#\"!/v1/admin/users/{username}/unlock\x12\xef\x01\n" +
+	"\vSetUserRole\x12\x1b.pb.AdminSetUserRoleRequest\x1a\x1c.pb.AdminSetUserRoleResponse\"\xa4\x01\x92Aw\x12\rSet user role\x1afUse this API to grant or revoke the banker role. Bankers can't change their own role. Only for bankers\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/admin/users/{username}/role\x12\xc0\x02\n" +
+	"\rAdjustBalance\x12\x1d.pb.AdminAdjustBalanceRequest\x1a\x1e.pb.AdminAdjustBalanceResponse\"\xef\x01\x92Av\x12\x16Adjust account balance\x1a\\Use this API to credit or debit an account manually. A reason is mandatory. Only for bankers\x82\xd3\xe4\x93\x02p:\x01*Z>:\x01*\"9/v1/admin/accounts/by_number/{account_number}/adjustments\"+/v1/admin/accounts/{account_id}/adjustments\x12\xd5\x02\n" +
+	"\vDepositCash\x12\x1d.pb.AdminCashOperationRequest\x1a\x1e.pb.AdminCashOperationResponse\"\x86\x02\x92A\x92\x01\x12\fDeposit cash\x1a\x81\x01Use this API to credit cash paid in at the counter. The bank's clearing account in the same currency is debited. Only for bankers\x82\xd3\xe4\x93\x02j:\x01*Z;:\x01*\"6/v1/admin/accounts/by_number/{account_number}/deposits\"(/v1/admin/accounts/{account_id}/deposits\x12\xde\x02\n" +
+	"\fWithdrawCash\x12\x1d.pb.AdminCashOperationRequest\x1a\x1e.pb.AdminCashOperationResponse\"\x8e\x02\x92A\x94\x01\x12\rWithdraw cash\x1a\x82\x01Use this API to debit cash paid out at the counter. The bank's clearing account in the same currency is credited. Only for bankers\x82\xd3\xe4\x93\x02p:\x01*Z>:\x01*\"9/v1/admin/accounts/by_number/{account_number}/withdrawals\"+/v1/admin/accounts/{account_id}/withdrawals\x12\xf2\x01\n" +
+	"\x0eGetCashReceipt\x12\x1e.pb.AdminGetCashReceiptRequest\x1a\x1f.pb.AdminGetCashReceiptResponse\"\x9e\x01\x92Am\x12\x10Get cash receipt\x1aYUse this API to print the receipt of a cash deposit or withdrawal again. Only for bankers\x82\xd3\xe4\x93\x02(\x12&/v1/admin/cash_operations/{id}/receipt\x12\xfc\x01\n" +
+	"\x13CreateLedgerAccount\x12#.pb.AdminCreateLedgerAccountRequest\x1a$.pb.AdminCreateLedgerAccountResponse\"\x99\x01\x92Ar\x12\x15Create ledger account\x1aYUse this API to add an internal account to the bank's chart of accounts. Only for bankers\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/admin/ledger_accounts\x12\xed\x01\n" +
+	"\x12ListLedgerAccounts\x12\".pb.AdminListLedgerAccountsRequest\x1a#.pb.AdminListLedgerAccountsResponse\"\x8d\x01\x92Ai\x12\x14List ledger accounts\x1aQUse this API to list the bank's chart of accounts with balances. Only for bankers\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/admin/ledger_accounts\x12\x9b\x02\n" +
+	"\x0fGetTrialBalance\x12\x1f.pb.AdminGetTrialBalanceRequest\x1a .pb.AdminGetTrialBalanceResponse\"\xc4\x01\x92A\xa1\x01\x12\x11Get trial balance\x1a\x8b\x01Use this API to check that debits equal credits in every currency, both in the account balances and in the posted entries. Only for bankers\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/admin/trial_balance\x12\x89\x02\n" +
+	"\x0eGetBusinessDay\x12\x1e.pb.AdminGetBusinessDayRequest\x1a\x1f.pb.AdminGetBusinessDayResponse\"\xb5\x01\x92A\x82\x01\x12\x10Get business day\x1anUse this API to get the totals per currency frozen by the end of day close of a business day. Only for bankers\x82\xd3\xe4\x93\x02)\x12'/v1/admin/business_days/{business_date}\x12\xbd\x02\n" +
+	"\x0fImportStatement\x12\x1f.pb.AdminImportStatementRequest\x1a .pb.AdminImportStatementResponse\"\xe6\x01\x92A\xb4\x01\x12\x19Import external statement\x1a\x96\x01Use this API to import a camt.053 or MT940 statement of an external account and match its lines against the clearing account entries. Only for bankers\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/reconciliation/statements\x12\xbd\x02\n" +
+	"\x1bListUnmatchedStatementLines\x12+.pb.AdminListUnmatchedStatementLinesRequest\x1a,.pb.AdminListUnmatchedStatementLinesResponse\"\xc2\x01\x92A\x8e\x01\x12\x1eList unmatched statement lines\x1alUse this API to list the external statement lines no clearing account entry was matched to. Only for bankers\x82\xd3\xe4\x93\x02*\x12(/v1/admin/reconciliation/unmatched_lines\x12\xdb\x02\n" +
+	"\x14ResolveStatementLine\x12$.pb.AdminResolveStatementLineRequest\x1a%.pb.AdminResolveStatementLineResponse\"\xf5\x01\x92A\xb6\x01\x12\x16Resolve statement line\x1a\x9b\x01Use this API to match an unmatched statement line to a clearing account entry by hand, or to resolve it with a note when no entry matches. Only for bankers\x82\xd3\xe4\x93\x025:\x01*\"0/v1/admin/reconciliation/lines/{line_id}/resolve\x12\xac\x02\n" +
+	"\x15ListTransferApprovals\x12%.pb.AdminListTransferApprovalsRequest\x1a&.pb.AdminListTransferApprovalsResponse\"\xc3\x01\x92A\x9b\x01\x12\x1fList pending transfer approvals\x1axUse this API to list the transfers above the approval threshold that wait for a decision, oldest first. Only for bankers\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/admin/transfer_approvals\x12\x8b\x03\n" +
+	"\x17ApproveTransferApproval\x12&.pb.AdminDecideTransferApprovalRequest\x1a'.pb.AdminDecideTransferApprovalResponse\"\x9e\x02\x92A\xe6\x01\x12\x10Approve transfer\x1a\xd1\x01Use this API to approve and execute a pending transfer. The transfer is screened by the fraud checks again and held for review if they flag it. The initiator of the transfer cannot approve it. Only for bankers\x82\xd3\xe4\x93\x02.:\x01*\")/v1/admin/transfer_approvals/{id}/approve\x12\xa0\x02\n" +
+	"\x16RejectTransferApproval\x12&.pb.AdminDecideTransferApprovalRequest\x1a'.pb.AdminDecideTransferApprovalResponse\"\xb4\x01\x92A~\x12\x0fReject transfer\x1akUse this API to reject a pending transfer. The initiator of the transfer cannot reject it. Only for bankers\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/admin/transfer_approvals/{id}/reject\x12\xc8\x01\n" +
+	"\fListDisputes\x12\x1c.pb.AdminListDisputesRequest\x1a\x1d.pb.AdminListDisputesResponse\"{\x92A^\x12\rList disputes\x1aMUse this API to list the disputes in a status, oldest first. Only for bankers\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/admin/disputes\x12\xba\x02\n" +
+	"\x19StartDisputeInvestigation\x12).pb.AdminStartDisputeInvestigationRequest\x1a*.pb.AdminStartDisputeInvestigationResponse\"\xc5\x01\x92A\x93\x01\x12\x1bStart dispute investigation\x1atUse this API to take an open dispute and start investigating it. The customer is notified by email. Only for bankers\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/disputes/{id}/investigate\x12\xe9\x02\n" +
+	"\x0eResolveDispute\x12\x1e.pb.AdminResolveDisputeRequest\x1a\x1f.pb.AdminResolveDisputeResponse\"\x95\x02\x92A\xe7\x01\x12\x0fResolve dispute\x1a\xd3\x01Use this API to resolve a dispute under investigation in favour of the customer or the merchant. A resolution in favour of the customer pays the transfer back. The customer is notified by email. Only for bankers\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/admin/disputes/{id}/resolve\x12\xe5\x02\n" +
+	"\fDisburseLoan\x12\x1c.pb.AdminDisburseLoanRequest\x1a\x1d.pb.AdminDisburseLoanResponse\"\x97\x02\x92A\xa9\x01\x12\rDisburse loan\x1a\x97\x01Use this API to credit a loan to an account. The installments are scheduled monthly and collected from the account on their due dates. Only for bankers\x82\xd3\xe4\x93\x02d:\x01*Z8:\x01*\"3/v1/admin/accounts/by_number/{account_number}/loans\"%/v1/admin/accounts/{account_id}/loans\x12\xe8\x01\n" +
+	"\aGetLoan\x12\x17.pb.AdminGetLoanRequest\x1a\x18.pb.AdminGetLoanResponse\"\xa9\x01\x92A\x89\x01\x12\bGet loan\x1a}Use this API to get a loan with its outstanding principal and interest and the schedule of its installments. Only for bankers\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/admin/loans/{id}B)Z'github.com/Drolfothesgnir/simplebank/pbb\x06proto3"
+
+var file_service_simple_bank_admin_proto_goTypes = []any{
+	(*AdminListUsersRequest)(nil),                    // 0: pb.AdminListUsersRequest
+	(*AdminListUserAccountsRequest)(nil),             // 1: pb.AdminListUserAccountsRequest
+	(*AdminListUserTransfersRequest)(nil),            // 2: pb.AdminListUserTransfersRequest
+	(*AdminLockUserRequest)(nil),                     // 3: pb.AdminLockUserRequest
+	(*AdminUnlockUserRequest)(nil),                   // 4: pb.AdminUnlockUserRequest
+	(*AdminSetUserRoleRequest)(nil),                  // 5: pb.AdminSetUserRoleRequest
+	(*AdminAdjustBalanceRequest)(nil),                // 6: pb.AdminAdjustBalanceRequest
+	(*AdminCashOperationRequest)(nil),                // 7: pb.AdminCashOperationRequest
+	(*AdminGetCashReceiptRequest)(nil),               // 8: pb.AdminGetCashReceiptRequest
+	(*AdminCreateLedgerAccountRequest)(nil),          // 9: pb.AdminCreateLedgerAccountRequest
+	(*AdminListLedgerAccountsRequest)(nil),           // 10: pb.AdminListLedgerAccountsRequest
+	(*AdminGetTrialBalanceRequest)(nil),              // 11: pb.AdminGetTrialBalanceRequest
+	(*AdminGetBusinessDayRequest)(nil),               // 12: pb.AdminGetBusinessDayRequest
+	(*AdminImportStatementRequest)(nil),              // 13: pb.AdminImportStatementRequest
+	(*AdminListUnmatchedStatementLinesRequest)(nil),  // 14: pb.AdminListUnmatchedStatementLinesRequest
+	(*AdminResolveStatementLineRequest)(nil),         // 15: pb.AdminResolveStatementLineRequest
+	(*AdminListTransferApprovalsRequest)(nil),        // 16: pb.AdminListTransferApprovalsRequest
+	(*AdminDecideTransferApprovalRequest)(nil),       // 17: pb.AdminDecideTransferApprovalRequest
+	(*AdminListDisputesRequest)(nil),                 // 18: pb.AdminListDisputesRequest
+	(*AdminStartDisputeInvestigationRequest)(nil),    // 19: pb.AdminStartDisputeInvestigationRequest
+	(*AdminResolveDisputeRequest)(nil),               // 20: pb.AdminResolveDisputeRequest
+	(*AdminDisburseLoanRequest)(nil),                 // 21: pb.AdminDisburseLoanRequest
+	(*AdminGetLoanRequest)(nil),                      // 22: pb.AdminGetLoanRequest
+	(*AdminListUsersResponse)(nil),                   // 23: pb.AdminListUsersResponse
+	(*AdminListUserAccountsResponse)(nil),            // 24: pb.AdminListUserAccountsResponse
+	(*AdminListUserTransfersResponse)(nil),           // 25: pb.AdminListUserTransfersResponse
+	(*AdminLockUserResponse)(nil),                    // 26: pb.AdminLockUserResponse
+	(*AdminUnlockUserResponse)(nil),                  // 27: pb.AdminUnlockUserResponse
+	(*AdminSetUserRoleResponse)(nil),                 // 28: pb.AdminSetUserRoleResponse
+	(*AdminAdjustBalanceResponse)(nil),               // 29: pb.AdminAdjustBalanceResponse
+	(*AdminCashOperationResponse)(nil),               // 30: pb.AdminCashOperationResponse
+	(*AdminGetCashReceiptResponse)(nil),              // 31: pb.AdminGetCashReceiptResponse
+	(*AdminCreateLedgerAccountResponse)(nil),         // 32: pb.AdminCreateLedgerAccountResponse
+	(*AdminListLedgerAccountsResponse)(nil),          // 33: pb.AdminListLedgerAccountsResponse
+	(*AdminGetTrialBalanceResponse)(nil),             // 34: pb.AdminGetTrialBalanceResponse
+	(*AdminGetBusinessDayResponse)(nil),              // 35: pb.AdminGetBusinessDayResponse
+	(*AdminImportStatementResponse)(nil),             // 36: pb.AdminImportStatementResponse
+	(*AdminListUnmatchedStatementLinesResponse)(nil), // 37: pb.AdminListUnmatchedStatementLinesResponse
+	(*AdminResolveStatementLineResponse)(nil),        // 38: pb.AdminResolveStatementLineResponse
+	(*AdminListTransferApprovalsResponse)(nil),       // 39: pb.AdminListTransferApprovalsResponse
+	(*AdminDecideTransferApprovalResponse)(nil),      // 40: pb.AdminDecideTransferApprovalResponse
+	(*AdminListDisputesResponse)(nil),                // 41: pb.AdminListDisputesResponse
+	(*AdminStartDisputeInvestigationResponse)(nil),   // 42: pb.AdminStartDisputeInvestigationResponse
+	(*AdminResolveDisputeResponse)(nil),              // 43: pb.AdminResolveDisputeResponse
+	(*AdminDisburseLoanResponse)(nil),                // 44: pb.AdminDisburseLoanResponse
+	(*AdminGetLoanResponse)(nil),                     // 45: pb.AdminGetLoanResponse
+}
+var file_service_simple_bank_admin_proto_depIdxs = []int32{
+	0,  // 0: pb.SimpleBankAdmin.ListUsers:input_type -> pb.AdminListUsersRequest
+	1,  // 1: pb.SimpleBankAdmin.ListUserAccounts:input_type -> pb.AdminListUserAccountsRequest
+	2,  // 2: pb.SimpleBankAdmin.ListUserTransfers:input_type -> pb.AdminListUserTransfersRequest
+	3,  // 3: pb.SimpleBankAdmin.LockUser:input_type -> pb.AdminLockUserRequest
+	4,  // 4: pb.SimpleBankAdmin.UnlockUser:input_type -> pb.AdminUnlockUserRequest
+	5,  // 5: pb.SimpleBankAdmin.SetUserRole:input_type -> pb.AdminSetUserRoleRequest
+	6,  // 6: pb.SimpleBankAdmin.AdjustBalance:input_type -> pb.AdminAdjustBalanceRequest
+	7,  // 7: pb.SimpleBankAdmin.DepositCash:input_type -> pb.AdminCashOperationRequest
+	7,  // 8: pb.SimpleBankAdmin.WithdrawCash:input_type -> pb.AdminCashOperationRequest
+	8,  // 9: pb.SimpleBankAdmin.GetCashReceipt:input_type -> pb.AdminGetCashReceiptRequest
+	9,  // 10: pb.SimpleBankAdmin.CreateLedgerAccount:input_type -> pb.AdminCreateLedgerAccountRequest
+	10, // 11: pb.SimpleBankAdmin.ListLedgerAccounts:input_type -> pb.AdminListLedgerAccountsRequest
+	11, // 12: pb.SimpleBankAdmin.GetTrialBalance:input_type -> pb.AdminGetTrialBalanceRequest
+	12, // 13: pb.SimpleBankAdmin.GetBusinessDay:input_type -> pb.AdminGetBusinessDayRequest
+	13, // 14: pb.SimpleBankAdmin.ImportStatement:input_type -> pb.AdminImportStatementRequest
+	14, // 15: pb.SimpleBankAdmin.ListUnmatchedStatementLines:input_type -> pb.AdminListUnmatchedStatementLinesRequest
+	15, // 16: pb.SimpleBankAdmin.ResolveStatementLine:input_type -> pb.AdminResolveStatementLineRequest
+	16, // 17: pb.SimpleBankAdmin.ListTransferApprovals:input_type -> pb.AdminListTransferApprovalsRequest
+	17, // 18: pb.SimpleBankAdmin.ApproveTransferApproval:input_type -> pb.AdminDecideTransferApprovalRequest
+	17, // 19: pb.SimpleBankAdmin.RejectTransferApproval:input_type -> pb.AdminDecideTransferApprovalRequest
+	18, // 20: pb.SimpleBankAdmin.ListDisputes:input_type -> pb.AdminListDisputesRequest
+	19, // 21: pb.SimpleBankAdmin.StartDisputeInvestigation:input_type -> pb.AdminStartDisputeInvestigationRequest
+	20, // 22: pb.SimpleBankAdmin.ResolveDispute:input_type -> pb.AdminResolveDisputeRequest
+	21, // 23: pb.SimpleBankAdmin.DisburseLoan:input_type -> pb.AdminDisburseLoanRequest
+	22, // 24: pb.SimpleBankAdmin.GetLoan:input_type -> pb.AdminGetLoanRequest
+	23, // 25: pb.SimpleBankAdmin.ListUsers:output_type -> pb.AdminListUsersResponse
+	24, // 26: pb.SimpleBankAdmin.ListUserAccounts:output_type -> pb.AdminListUserAccountsResponse
+	25, // 27: pb.SimpleBankAdmin.ListUserTransfers:output_type -> pb.AdminListUserTransfersResponse
+	26, // 28: pb.SimpleBankAdmin.LockUser:output_type -> pb.AdminLockUserResponse
+	27, // 29: pb.SimpleBankAdmin.UnlockUser:output_type -> pb.AdminUnlockUserResponse
+	28, // 30: pb.SimpleBankAdmin.SetUserRole:output_type -> pb.AdminSetUserRoleResponse
+	29, // 31: pb.SimpleBankAdmin.AdjustBalance:output_type -> pb.AdminAdjustBalanceResponse
+	30, // 32: pb.SimpleBankAdmin.DepositCash:output_type -> pb.AdminCashOperationResponse
+	30, // 33: pb.SimpleBankAdmin.WithdrawCash:output_type -> pb.AdminCashOperationResponse
+	31, // 34: pb.SimpleBankAdmin.GetCashReceipt:output_type -> pb.AdminGetCashReceiptResponse
+	32, // 35: pb.SimpleBankAdmin.CreateLedgerAccount:output_type -> pb.AdminCreateLedgerAccountResponse
+	33, // 36: pb.SimpleBankAdmin.ListLedgerAccounts:output_type -> pb.AdminListLedgerAccountsResponse
+	34, // 37: pb.SimpleBankAdmin.GetTrialBalance:output_type -> pb.AdminGetTrialBalanceResponse
+	35, // 38: pb.SimpleBankAdmin.GetBusinessDay:output_type -> pb.AdminGetBusinessDayResponse
+	36, // 39: pb.SimpleBankAdmin.ImportStatement:output_type -> pb.AdminImportStatementResponse
+	37, // 40: pb.SimpleBankAdmin.ListUnmatchedStatementLines:output_type -> pb.AdminListUnmatchedStatementLinesResponse
+	38, // 41: pb.SimpleBankAdmin.ResolveStatementLine:output_type -> pb.AdminResolveStatementLineResponse
+	39, // 42: pb.SimpleBankAdmin.ListTransferApprovals:output_type -> pb.AdminListTransferApprovalsResponse
+	40, // 43: pb.SimpleBankAdmin.ApproveTransferApproval:output_type -> pb.AdminDecideTransferApprovalResponse
+	40, // 44: pb.SimpleBankAdmin.RejectTransferApproval:output_type -> pb.AdminDecideTransferApprovalResponse
+	41, // 45: pb.SimpleBankAdmin.ListDisputes:output_type -> pb.AdminListDisputesResponse
+	42, // 46: pb.SimpleBankAdmin.StartDisputeInvestigation:output_type -> pb.AdminStartDisputeInvestigationResponse
+	43, // 47: pb.SimpleBankAdmin.ResolveDispute:output_type -> pb.AdminResolveDisputeResponse
+	44, // 48: pb.SimpleBankAdmin.DisburseLoan:output_type -> pb.AdminDisburseLoanResponse
+	45, // 49: pb.SimpleBankAdmin.GetLoan:output_type -> pb.AdminGetLoanResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_service_simple_bank_admin_proto_init() }
+func file_service_simple_bank_admin_proto_init() {
+	if File_service_simple_bank_admin_proto != nil {
+		return
+	}
+	file_rpc_admin_list_users_proto_init()
+	file_rpc_admin_list_user_accounts_proto_init()
+	file_rpc_admin_list_user_transfers_proto_init()
+	file_rpc_admin_lock_user_proto_init()
+	file_rpc_admin_set_user_role_proto_init()
+	file_rpc_admin_adjust_balance_proto_init()
+	file_rpc_admin_cash_operation_proto_init()
+	file_rpc_admin_get_cash_receipt_proto_init()
+	file_rpc_admin_ledger_account_proto_init()
+	file_rpc_admin_get_trial_balance_proto_init()
+	file_rpc_admin_get_business_day_proto_init()
+	file_rpc_admin_import_statement_proto_init()
+	file_rpc_admin_list_unmatched_statement_lines_proto_init()
+	file_rpc_admin_resolve_statement_line_proto_init()
+	file_rpc_admin_transfer_approval_proto_init()
+	file_rpc_admin_dispute_proto_init()
+	file_rpc_admin_loan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_simple_bank_admin_proto_rawDesc), len(file_service_simple_bank_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_simple_bank_admin_proto_goTypes,
+		DependencyIndexes: file_service_simple_bank_admin_proto_depIdxs,
+	}.Build()
+	File_service_simple_bank_admin_proto = out.File
+	file_service_simple_bank_admin_proto_goTypes = nil
+	file_service_simple_bank_admin_proto_depIdxs = nil
+}
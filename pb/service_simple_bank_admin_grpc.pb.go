@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: service_simple_bank_admin.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SimpleBankAdmin_ListUsers_FullMethodName                   = "/pb.SimpleBankAdmin/ListUsers"
+	SimpleBankAdmin_ListUserAccounts_FullMethodName            = "/pb.SimpleBankAdmin/ListUserAccounts"
+	SimpleBankAdmin_ListUserTransfers_FullMethodName           = "/pb.SimpleBankAdmin/ListUserTransfers"
+	SimpleBankAdmin_LockUser_FullMethodName                    = "/pb.SimpleBankAdmin/LockUser"
+	SimpleBankAdmin_UnlockUser_FullMethodName                  = "/pb.SimpleBankAdmin/UnlockUser"
+	SimpleBankAdmin_SetUserRole_FullMethodName                 = "/pb.SimpleBankAdmin/SetUserRole"
+	SimpleBankAdmin_AdjustBalance_FullMethodName               = "/pb.SimpleBankAdmin/AdjustBalance"
+	SimpleBankAdmin_DepositCash_FullMethodName                 = "/pb.SimpleBankAdmin/DepositCash"
+	SimpleBankAdmin_WithdrawCash_FullMethodName                = "/pb.SimpleBankAdmin/WithdrawCash"
+	SimpleBankAdmin_GetCashReceipt_FullMethodName              = "/pb.SimpleBankAdmin/GetCashReceipt"
+	SimpleBankAdmin_CreateLedgerAccount_FullMethodName         = "/pb.SimpleBankAdmin/CreateLedgerAccount"
+	SimpleBankAdmin_ListLedgerAccounts_FullMethodName          = "/pb.SimpleBankAdmin/ListLedgerAccounts"
+	SimpleBankAdmin_GetTrialBalance_FullMethodName             = "/pb.SimpleBankAdmin/GetTrialBalance"
+	SimpleBankAdmin_GetBusinessDay_FullMethodName              = "/pb.SimpleBankAdmin/GetBusinessDay"
+	SimpleBankAdmin_ImportStatement_FullMethodName             = "/pb.SimpleBankAdmin/ImportStatement"
+	SimpleBankAdmin_ListUnmatchedStatementLines_FullMethodName = "/pb.SimpleBankAdmin/ListUnmatchedStatementLines"
+	SimpleBankAdmin_ResolveStatementLine_FullMethodName        = "/pb.SimpleBankAdmin/ResolveStatementLine"
+	SimpleBankAdmin_ListTransferApprovals_FullMethodName       = "/pb.SimpleBankAdmin/ListTransferApprovals"
+	SimpleBankAdmin_ApproveTransferApproval_FullMethodName     = "/pb.SimpleBankAdmin/ApproveTransferApproval"
+	SimpleBankAdmin_RejectTransferApproval_FullMethodName      = "/pb.SimpleBankAdmin/RejectTransferApproval"
+	SimpleBankAdmin_ListDisputes_FullMethodName                = "/pb.SimpleBankAdmin/ListDisputes"
+	SimpleBankAdmin_StartDisputeInvestigation_FullMethodName   = "/pb.SimpleBankAdmin/StartDisputeInvestigation"
+	SimpleBankAdmin_ResolveDispute_FullMethodName              = "/pb.SimpleBankAdmin/ResolveDispute"
+	SimpleBankAdmin_DisburseLoan_FullMethodName                = "/pb.SimpleBankAdmin/DisburseLoan"
+	SimpleBankAdmin_GetLoan_FullMethodName                     = "/pb.SimpleBankAdmin/GetLoan"
+)
+
+// SimpleBankAdminClient is the client API for SimpleBankAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SimpleBankAdmin is the back office API. Every call is restricted to bankers and recorded in the audit log.
+type SimpleBankAdminClient interface {
+	ListUsers(ctx context.Context, in *AdminListUsersRequest, opts ...grpc.CallOption) (*AdminListUsersResponse, error)
+	ListUserAccounts(ctx context.Context, in *AdminListUserAccountsRequest, opts ...grpc.CallOption) (*AdminListUserAccountsResponse, error)
+	ListUserTransfers(ctx context.Context, in *AdminListUserTransfersRequest, opts ...grpc.CallOption) (*AdminListUserTransfersResponse, error)
+	LockUser(ctx context.Context, in *AdminLockUserRequest, opts ...grpc.CallOption) (*AdminLockUserResponse, error)
+	UnlockUser(ctx context.Context, in *AdminUnlockUserRequest, opts ...grpc.CallOption) (*AdminUnlockUserResponse, error)
+	SetUserRole(ctx context.Context, in *AdminSetUserRoleRequest, opts ...grpc.CallOption) (*AdminSetUserRoleResponse, error)
+	AdjustBalance(ctx context.Context, in *AdminAdjustBalanceRequest, opts ...grpc.CallOption) (*AdminAdjustBalanceResponse, error)
+	DepositCash(ctx context.Context, in *AdminCashOperationRequest, opts ...grpc.CallOption) (*AdminCashOperationResponse, error)
+	WithdrawCash(ctx context.Context, in *AdminCashOperationRequest, opts ...grpc.CallOption) (*AdminCashOperationResponse, error)
+	GetCashReceipt(ctx context.Context, in *AdminGetCashReceiptRequest, opts ...grpc.CallOption) (*AdminGetCashReceiptResponse, error)
+	CreateLedgerAccount(ctx context.Context, in *AdminCreateLedgerAccountRequest, opts ...grpc.CallOption) (*AdminCreateLedgerAccountResponse, error)
+	ListLedgerAccounts(ctx context.Context, in *AdminListLedgerAccountsRequest, opts ...grpc.CallOption) (*AdminListLedgerAccountsResponse, error)
+	GetTrialBalance(ctx context.Context, in *AdminGetTrialBalanceRequest, opts ...grpc.CallOption) (*AdminGetTrialBalanceResponse, error)
+	GetBusinessDay(ctx context.Context, in *AdminGetBusinessDayRequest, opts ...grpc.CallOption) (*AdminGetBusinessDayResponse, error)
+	ImportStatement(ctx context.Context, in *AdminImportStatementRequest, opts ...grpc.CallOption) (*AdminImportStatementResponse, error)
+	ListUnmatchedStatementLines(ctx context.Context, in *AdminListUnmatchedStatementLinesRequest, opts ...grpc.CallOption) (*AdminListUnmatchedStatementLinesResponse, error)
+	ResolveStatementLine(ctx context.Context, in *AdminResolveStatementLineRequest, opts ...grpc.CallOption) (*AdminResolveStatementLineResponse, error)
+	ListTransferApprovals(ctx context.Context, in *AdminListTransferApprovalsRequest, opts ...grpc.CallOption) (*AdminListTransferApprovalsResponse, error)
+	ApproveTransferApproval(ctx context.Context, in *AdminDecideTransferApprovalRequest, opts ...grpc.CallOption) (*AdminDecideTransferApprovalResponse, error)
+	RejectTransferApproval(ctx context.Context, in *AdminDecideTransferApprovalRequest, opts ...grpc.CallOption) (*AdminDecideTransferApprovalResponse, error)
+	ListDisputes(ctx context.Context, in *AdminListDisputesRequest, opts ...grpc.CallOption) (*AdminListDisputesResponse, error)
+	StartDisputeInvestigation(ctx context.Context, in *AdminStartDisputeInvestigationRequest, opts ...grpc.CallOption) (*AdminStartDisputeInvestigationResponse, error)
+	ResolveDispute(ctx context.Context, in *AdminResolveDisputeRequest, opts ...grpc.CallOption) (*AdminResolveDisputeResponse, error)
+	DisburseLoan(ctx context.Context, in *AdminDisburseLoanRequest, opts ...grpc.CallOption) (*AdminDisburseLoanResponse, error)
+	GetLoan(ctx context.Context, in *AdminGetLoanRequest, opts ...grpc.CallOption) (*AdminGetLoanResponse, error)
+}
+
+type simpleBankAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSimpleBankAdminClient(cc grpc.ClientConnInterface) SimpleBankAdminClient {
+	return &simpleBankAdminClient{cc}
+}
+
+func (c *simpleBankAdminClient) ListUsers(ctx context.Context, in *AdminListUsersRequest, opts ...grpc.CallOption) (*AdminListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUsersResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListUserAccounts(ctx context.Context, in *AdminListUserAccountsRequest, opts ...grpc.CallOption) (*AdminListUserAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUserAccountsResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListUserAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListUserTransfers(ctx context.Context, in *AdminListUserTransfersRequest, opts ...grpc.CallOption) (*AdminListUserTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUserTransfersResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListUserTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) LockUser(ctx context.Context, in *AdminLockUserRequest, opts ...grpc.CallOption) (*AdminLockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminLockUserResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_LockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) UnlockUser(ctx context.Context, in *AdminUnlockUserRequest, opts ...grpc.CallOption) (*AdminUnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUnlockUserResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) SetUserRole(ctx context.Context, in *AdminSetUserRoleRequest, opts ...grpc.CallOption) (*AdminSetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSetUserRoleResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_SetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) AdjustBalance(ctx context.Context, in *AdminAdjustBalanceRequest, opts ...grpc.CallOption) (*AdminAdjustBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminAdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_AdjustBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) DepositCash(ctx context.Context, in *AdminCashOperationRequest, opts ...grpc.CallOption) (*AdminCashOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCashOperationResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_DepositCash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) WithdrawCash(ctx context.Context, in *AdminCashOperationRequest, opts ...grpc.CallOption) (*AdminCashOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCashOperationResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_WithdrawCash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) GetCashReceipt(ctx context.Context, in *AdminGetCashReceiptRequest, opts ...grpc.CallOption) (*AdminGetCashReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetCashReceiptResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_GetCashReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) CreateLedgerAccount(ctx context.Context, in *AdminCreateLedgerAccountRequest, opts ...grpc.CallOption) (*AdminCreateLedgerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateLedgerAccountResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_CreateLedgerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListLedgerAccounts(ctx context.Context, in *AdminListLedgerAccountsRequest, opts ...grpc.CallOption) (*AdminListLedgerAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListLedgerAccountsResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListLedgerAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) GetTrialBalance(ctx context.Context, in *AdminGetTrialBalanceRequest, opts ...grpc.CallOption) (*AdminGetTrialBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) GetBusinessDay(ctx context.Context, in *AdminGetBusinessDayRequest, opts ...grpc.CallOption) (*AdminGetBusinessDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetBusinessDayResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_GetBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ImportStatement(ctx context.Context, in *AdminImportStatementRequest, opts ...grpc.CallOption) (*AdminImportStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminImportStatementResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListUnmatchedStatementLines(ctx context.Context, in *AdminListUnmatchedStatementLinesRequest, opts ...grpc.CallOption) (*AdminListUnmatchedStatementLinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUnmatchedStatementLinesResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListUnmatchedStatementLines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ResolveStatementLine(ctx context.Context, in *AdminResolveStatementLineRequest, opts ...grpc.CallOption) (*AdminResolveStatementLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminResolveStatementLineResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ResolveStatementLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListTransferApprovals(ctx context.Context, in *AdminListTransferApprovalsRequest, opts ...grpc.CallOption) (*AdminListTransferApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListTransferApprovalsResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListTransferApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ApproveTransferApproval(ctx context.Context, in *AdminDecideTransferApprovalRequest, opts ...grpc.CallOption) (*AdminDecideTransferApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDecideTransferApprovalResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ApproveTransferApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) RejectTransferApproval(ctx context.Context, in *AdminDecideTransferApprovalRequest, opts ...grpc.CallOption) (*AdminDecideTransferApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDecideTransferApprovalResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_RejectTransferApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ListDisputes(ctx context.Context, in *AdminListDisputesRequest, opts ...grpc.CallOption) (*AdminListDisputesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListDisputesResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ListDisputes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) StartDisputeInvestigation(ctx context.Context, in *AdminStartDisputeInvestigationRequest, opts ...grpc.CallOption) (*AdminStartDisputeInvestigationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminStartDisputeInvestigationResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_StartDisputeInvestigation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) ResolveDispute(ctx context.Context, in *AdminResolveDisputeRequest, opts ...grpc.CallOption) (*AdminResolveDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_ResolveDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) DisburseLoan(ctx context.Context, in *AdminDisburseLoanRequest, opts ...grpc.CallOption) (*AdminDisburseLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDisburseLoanResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_DisburseLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleBankAdminClient) GetLoan(ctx context.Context, in *AdminGetLoanRequest, opts ...grpc.CallOption) (*AdminGetLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetLoanResponse)
+	err := c.cc.Invoke(ctx, SimpleBankAdmin_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SimpleBankAdminServer is the server API for SimpleBankAdmin service.
+// All implementations must embed UnimplementedSimpleBankAdminServer
+// for forward compatibility.
+//
+// SimpleBankAdmin is the back office API. Every call is restricted to bankers and recorded in the audit log.
+type SimpleBankAdminServer interface {
+	ListUsers(context.Context, *AdminListUsersRequest) (*AdminListUsersResponse, error)
+	ListUserAccounts(context.Context, *AdminListUserAccountsRequest) (*AdminListUserAccountsResponse, error)
+	ListUserTransfers(context.Context, *AdminListUserTransfersRequest) (*AdminListUserTransfersResponse, error)
+	LockUser(context.Context, *AdminLockUserRequest) (*AdminLockUserResponse, error)
+	UnlockUser(context.Context, *AdminUnlockUserRequest) (*AdminUnlockUserResponse, error)
+	SetUserRole(context.Context, *AdminSetUserRoleRequest) (*AdminSetUserRoleResponse, error)
+	AdjustBalance(context.Context, *AdminAdjustBalanceRequest) (*AdminAdjustBalanceResponse, error)
+	DepositCash(context.Context, *AdminCashOperationRequest) (*AdminCashOperationResponse, error)
+	WithdrawCash(context.Context, *AdminCashOperationRequest) (*AdminCashOperationResponse, error)
+	GetCashReceipt(context.Context, *AdminGetCashReceiptRequest) (*AdminGetCashReceiptResponse, error)
+	CreateLedgerAccount(context.Context, *AdminCreateLedgerAccountRequest) (*AdminCreateLedgerAccountResponse, error)
+	ListLedgerAccounts(context.Context, *AdminListLedgerAccountsRequest) (*AdminListLedgerAccountsResponse, error)
+	GetTrialBalance(context.Context, *AdminGetTrialBalanceRequest) (*AdminGetTrialBalanceResponse, error)
+	GetBusinessDay(context.Context, *AdminGetBusinessDayRequest) (*AdminGetBusinessDayResponse, error)
+	ImportStatement(context.Context, *AdminImportStatementRequest) (*AdminImportStatementResponse, error)
+	ListUnmatchedStatementLines(context.Context, *AdminListUnmatchedStatementLinesRequest) (*AdminListUnmatchedStatementLinesResponse, error)
+	ResolveStatementLine(context.Context, *AdminResolveStatementLineRequest) (*AdminResolveStatementLineResponse, error)
+	ListTransferApprovals(context.Context, *AdminListTransferApprovalsRequest) (*AdminListTransferApprovalsResponse, error)
+	ApproveTransferApproval(context.Context, *AdminDecideTransferApprovalRequest) (*AdminDecideTransferApprovalResponse, error)
+	RejectTransferApproval(context.Context, *AdminDecideTransferApprovalRequest) (*AdminDecideTransferApprovalResponse, error)
+	ListDisputes(context.Context, *AdminListDisputesRequest) (*AdminListDisputesResponse, error)
+	StartDisputeInvestigation(context.Context, *AdminStartDisputeInvestigationRequest) (*AdminStartDisputeInvestigationResponse, error)
+	ResolveDispute(context.Context, *AdminResolveDisputeRequest) (*AdminResolveDisputeResponse, error)
+	DisburseLoan(context.Context, *AdminDisburseLoanRequest) (*AdminDisburseLoanResponse, error)
+	GetLoan(context.Context, *AdminGetLoanRequest) (*AdminGetLoanResponse, error)
+	mustEmbedUnimplementedSimpleBankAdminServer()
+}
+
+// UnimplementedSimpleBankAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSimpleBankAdminServer struct{}
+
+func (UnimplementedSimpleBankAdminServer) ListUsers(context.Context, *AdminListUsersRequest) (*AdminListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListUserAccounts(context.Context, *AdminListUserAccountsRequest) (*AdminListUserAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserAccounts not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListUserTransfers(context.Context, *AdminListUserTransfersRequest) (*AdminListUserTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTransfers not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) LockUser(context.Context, *AdminLockUserRequest) (*AdminLockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUser not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) UnlockUser(context.Context, *AdminUnlockUserRequest) (*AdminUnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) SetUserRole(context.Context, *AdminSetUserRoleRequest) (*AdminSetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) AdjustBalance(context.Context, *AdminAdjustBalanceRequest) (*AdminAdjustBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBalance not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) DepositCash(context.Context, *AdminCashOperationRequest) (*AdminCashOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCash not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) WithdrawCash(context.Context, *AdminCashOperationRequest) (*AdminCashOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCash not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) GetCashReceipt(context.Context, *AdminGetCashReceiptRequest) (*AdminGetCashReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashReceipt not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) CreateLedgerAccount(context.Context, *AdminCreateLedgerAccountRequest) (*AdminCreateLedgerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLedgerAccount not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListLedgerAccounts(context.Context, *AdminListLedgerAccountsRequest) (*AdminListLedgerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerAccounts not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) GetTrialBalance(context.Context, *AdminGetTrialBalanceRequest) (*AdminGetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) GetBusinessDay(context.Context, *AdminGetBusinessDayRequest) (*AdminGetBusinessDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessDay not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ImportStatement(context.Context, *AdminImportStatementRequest) (*AdminImportStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListUnmatchedStatementLines(context.Context, *AdminListUnmatchedStatementLinesRequest) (*AdminListUnmatchedStatementLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnmatchedStatementLines not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ResolveStatementLine(context.Context, *AdminResolveStatementLineRequest) (*AdminResolveStatementLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStatementLine not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListTransferApprovals(context.Context, *AdminListTransferApprovalsRequest) (*AdminListTransferApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransferApprovals not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ApproveTransferApproval(context.Context, *AdminDecideTransferApprovalRequest) (*AdminDecideTransferApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTransferApproval not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) RejectTransferApproval(context.Context, *AdminDecideTransferApprovalRequest) (*AdminDecideTransferApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTransferApproval not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ListDisputes(context.Context, *AdminListDisputesRequest) (*AdminListDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) StartDisputeInvestigation(context.Context, *AdminStartDisputeInvestigationRequest) (*AdminStartDisputeInvestigationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDisputeInvestigation not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) ResolveDispute(context.Context, *AdminResolveDisputeRequest) (*AdminResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) DisburseLoan(context.Context, *AdminDisburseLoanRequest) (*AdminDisburseLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) GetLoan(context.Context, *AdminGetLoanRequest) (*AdminGetLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedSimpleBankAdminServer) mustEmbedUnimplementedSimpleBankAdminServer() {}
+func (UnimplementedSimpleBankAdminServer) testEmbeddedByValue()                         {}
+
+// UnsafeSimpleBankAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SimpleBankAdminServer will
+// result in compilation errors.
+type UnsafeSimpleBankAdminServer interface {
+	mustEmbedUnimplementedSimpleBankAdminServer()
+}
+
+func RegisterSimpleBankAdminServer(s grpc.ServiceRegistrar, srv SimpleBankAdminServer) {
+	// If the following call pancis, it indicates UnimplementedSimpleBankAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SimpleBankAdmin_ServiceDesc, srv)
+}
+
+func _SimpleBankAdmin_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListUsers(ctx, req.(*AdminListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListUserAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUserAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListUserAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListUserAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListUserAccounts(ctx, req.(*AdminListUserAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListUserTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUserTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListUserTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListUserTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListUserTransfers(ctx, req.(*AdminListUserTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_LockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).LockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_LockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).LockUser(ctx, req.(*AdminLockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).UnlockUser(ctx, req.(*AdminUnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).SetUserRole(ctx, req.(*AdminSetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_AdjustBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).AdjustBalance(ctx, req.(*AdminAdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_DepositCash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCashOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).DepositCash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_DepositCash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).DepositCash(ctx, req.(*AdminCashOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_WithdrawCash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCashOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).WithdrawCash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_WithdrawCash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).WithdrawCash(ctx, req.(*AdminCashOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_GetCashReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetCashReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).GetCashReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_GetCashReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).GetCashReceipt(ctx, req.(*AdminGetCashReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_CreateLedgerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateLedgerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).CreateLedgerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_CreateLedgerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).CreateLedgerAccount(ctx, req.(*AdminCreateLedgerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListLedgerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListLedgerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListLedgerAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListLedgerAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListLedgerAccounts(ctx, req.(*AdminListLedgerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).GetTrialBalance(ctx, req.(*AdminGetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_GetBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).GetBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_GetBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).GetBusinessDay(ctx, req.(*AdminGetBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ImportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ImportStatement(ctx, req.(*AdminImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListUnmatchedStatementLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUnmatchedStatementLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListUnmatchedStatementLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListUnmatchedStatementLines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListUnmatchedStatementLines(ctx, req.(*AdminListUnmatchedStatementLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ResolveStatementLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResolveStatementLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ResolveStatementLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ResolveStatementLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ResolveStatementLine(ctx, req.(*AdminResolveStatementLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListTransferApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTransferApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListTransferApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListTransferApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListTransferApprovals(ctx, req.(*AdminListTransferApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ApproveTransferApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDecideTransferApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ApproveTransferApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ApproveTransferApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ApproveTransferApproval(ctx, req.(*AdminDecideTransferApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_RejectTransferApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDecideTransferApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).RejectTransferApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_RejectTransferApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).RejectTransferApproval(ctx, req.(*AdminDecideTransferApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ListDisputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ListDisputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ListDisputes(ctx, req.(*AdminListDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_StartDisputeInvestigation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStartDisputeInvestigationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).StartDisputeInvestigation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_StartDisputeInvestigation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).StartDisputeInvestigation(ctx, req.(*AdminStartDisputeInvestigationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResolveDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).ResolveDispute(ctx, req.(*AdminResolveDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDisburseLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).DisburseLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_DisburseLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).DisburseLoan(ctx, req.(*AdminDisburseLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimpleBankAdmin_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleBankAdminServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimpleBankAdmin_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleBankAdminServer).GetLoan(ctx, req.(*AdminGetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SimpleBankAdmin_ServiceDesc is the grpc.ServiceDesc for SimpleBankAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SimpleBankAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.SimpleBankAdmin",
+	HandlerType: (*SimpleBankAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _SimpleBankAdmin_ListUsers_Handler,
+		},
+		{
+			MethodName: "ListUserAccounts",
+			Handler:    _SimpleBankAdmin_ListUserAccounts_Handler,
+		},
+		{
+			MethodName: "ListUserTransfers",
+			Handler:    _SimpleBankAdmin_ListUserTransfers_Handler,
+		},
+		{
+			MethodName: "LockUser",
+			Handler:    _SimpleBankAdmin_LockUser_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _SimpleBankAdmin_UnlockUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _SimpleBankAdmin_SetUserRole_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _SimpleBankAdmin_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "DepositCash",
+			Handler:    _SimpleBankAdmin_DepositCash_Handler,
+		},
+		{
+			MethodName: "WithdrawCash",
+			Handler:    _SimpleBankAdmin_WithdrawCash_Handler,
+		},
+		{
+			MethodName: "GetCashReceipt",
+			Handler:    _SimpleBankAdmin_GetCashReceipt_Handler,
+		},
+		{
+			MethodName: "CreateLedgerAccount",
+			Handler:    _SimpleBankAdmin_CreateLedgerAccount_Handler,
+		},
+		{
+			MethodName: "ListLedgerAccounts",
+			Handler:    _SimpleBankAdmin_ListLedgerAccounts_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _SimpleBankAdmin_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "GetBusinessDay",
+			Handler:    _SimpleBankAdmin_GetBusinessDay_Handler,
+		},
+		{
+			MethodName: "ImportStatement",
+			Handler:    _SimpleBankAdmin_ImportStatement_Handler,
+		},
+		{
+			MethodName: "ListUnmatchedStatementLines",
+			Handler:    _SimpleBankAdmin_ListUnmatchedStatementLines_Handler,
+		},
+		{
+			MethodName: "ResolveStatementLine",
+			Handler:    _SimpleBankAdmin_ResolveStatementLine_Handler,
+		},
+		{
+			MethodName: "ListTransferApprovals",
+			Handler:    _SimpleBankAdmin_ListTransferApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveTransferApproval",
+			Handler:    _SimpleBankAdmin_ApproveTransferApproval_Handler,
+		},
+		{
+			MethodName: "RejectTransferApproval",
+			Handler:    _SimpleBankAdmin_RejectTransferApproval_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _SimpleBankAdmin_ListDisputes_Handler,
+		},
+		{
+			MethodName: "StartDisputeInvestigation",
+			Handler:    _SimpleBankAdmin_StartDisputeInvestigation_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _SimpleBankAdmin_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _SimpleBankAdmin_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _SimpleBankAdmin_GetLoan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_simple_bank_admin.proto",
+}
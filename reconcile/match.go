@@ -0,0 +1,112 @@
+// Package reconcile matches the lines of external bank statements to the entries of the bank's own books.
+package reconcile
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// DateTolerance is how many days the booking dates of a line and its entry can be apart.
+// The other bank can book a payment a few days before or after the bank itself.
+const DateTolerance = 3
+
+// Line is a line of an external statement. Amount is positive for credits on the external account.
+type Line struct {
+	ID          int64
+	Amount      int64
+	BookingDate time.Time
+	// References are the texts of the line that can carry the reference of the entry.
+	References []string
+}
+
+// Entry is an entry of the clearing account the external account mirrors.
+type Entry struct {
+	ID           int64
+	Amount       int64
+	BusinessDate time.Time
+	// References identify the entry, e.g. its entry reference or the cash receipt number.
+	References []string
+}
+
+// Match pairs the lines with entries and returns the matched entry id by line id.
+// The clearing account mirrors the external account from the other side, so a line matches an entry
+// of the opposite amount booked within DateTolerance days. A line whose texts carry a reference
+// of a candidate entry is matched to it first; a line without a reference of any entry is matched
+// only when a single entry is a candidate. Every entry is matched at most once and the rest are left unmatched.
+func Match(lines []Line, entries []Entry) map[int64]int64 {
+	matches := make(map[int64]int64)
+	used := make(map[int64]bool)
+
+	candidates := func(line Line) []Entry {
+		var res []Entry
+		for _, entry := range entries {
+			if !used[entry.ID] && entry.Amount == -line.Amount && withinTolerance(line.BookingDate, entry.BusinessDate) {
+				res = append(res, entry)
+			}
+		}
+
+		return res
+	}
+
+	for _, line := range lines {
+		for _, entry := range candidates(line) {
+			if referencesEntry(line, entry) {
+				matches[line.ID] = entry.ID
+				used[entry.ID] = true
+				break
+			}
+		}
+	}
+
+	for _, line := range lines {
+		if _, ok := matches[line.ID]; ok {
+			continue
+		}
+
+		// several candidates are ambiguous without a reference, so a banker has to pick one.
+		// A line naming an entry that is already matched is most likely a duplicate.
+		if referencesAny(line, entries) {
+			continue
+		}
+
+		if entries := candidates(line); len(entries) == 1 {
+			matches[line.ID] = entries[0].ID
+			used[entries[0].ID] = true
+		}
+	}
+
+	return matches
+}
+
+func withinTolerance(a, b time.Time) bool {
+	days := a.Sub(b).Hours() / 24
+	return days <= DateTolerance && days >= -DateTolerance
+}
+
+func referencesAny(line Line, entries []Entry) bool {
+	for _, entry := range entries {
+		if referencesEntry(line, entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// referencesEntry reports whether a text of the line contains a reference of the entry as a whole word.
+func referencesEntry(line Line, entry Entry) bool {
+	for _, text := range line.References {
+		words := strings.FieldsFunc(strings.ToUpper(text), func(r rune) bool {
+			return r == ' ' || r == '/' || r == ',' || r == ';' || r == ':' || r == '?' || r == '+'
+		})
+
+		for _, reference := range entry.References {
+			if reference != "" && slices.Contains(words, strings.ToUpper(reference)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
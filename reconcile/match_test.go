@@ -0,0 +1,99 @@
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	day := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	entries := []Entry{
+		{ID: 1, Amount: -500, BusinessDate: day, References: []string{"E1", "DEP-20240304-000001"}},
+		{ID: 2, Amount: -500, BusinessDate: day, References: []string{"E2", "DEP-20240304-000002"}},
+		{ID: 3, Amount: 250, BusinessDate: day.AddDate(0, 0, 1), References: []string{"E3"}},
+		{ID: 4, Amount: -900, BusinessDate: day.AddDate(0, 0, -10), References: []string{"E4"}},
+		{ID: 5, Amount: -700, BusinessDate: day, References: []string{"E5"}},
+		{ID: 6, Amount: -700, BusinessDate: day, References: []string{"E6"}},
+	}
+
+	testCases := []struct {
+		name     string
+		lines    []Line
+		expected map[int64]int64
+	}{
+		{
+			name: "ByReference",
+			lines: []Line{
+				{ID: 10, Amount: 500, BookingDate: day, References: []string{"Cash deposit DEP-20240304-000002"}},
+			},
+			expected: map[int64]int64{10: 2},
+		},
+		{
+			name: "ReferenceIsCaseInsensitive",
+			lines: []Line{
+				{ID: 10, Amount: 500, BookingDate: day.AddDate(0, 0, 2), References: []string{"", "ref/e1"}},
+			},
+			expected: map[int64]int64{10: 1},
+		},
+		{
+			name: "ReferenceMustBeAWholeWord",
+			lines: []Line{
+				{ID: 10, Amount: 500, BookingDate: day, References: []string{"E12"}},
+			},
+			expected: map[int64]int64{},
+		},
+		{
+			name: "SingleCandidateWithoutReference",
+			lines: []Line{
+				{ID: 10, Amount: -250, BookingDate: day, References: []string{"payout"}},
+			},
+			expected: map[int64]int64{10: 3},
+		},
+		{
+			name: "AmbiguousWithoutReference",
+			lines: []Line{
+				{ID: 10, Amount: 700, BookingDate: day},
+			},
+			expected: map[int64]int64{},
+		},
+		{
+			name: "ReferencedEntriesAreTakenFirst",
+			lines: []Line{
+				{ID: 10, Amount: 700, BookingDate: day},
+				{ID: 11, Amount: 700, BookingDate: day, References: []string{"E5"}},
+			},
+			expected: map[int64]int64{10: 6, 11: 5},
+		},
+		{
+			name: "EntryMatchedOnce",
+			lines: []Line{
+				{ID: 10, Amount: 500, BookingDate: day, References: []string{"E1"}},
+				{ID: 11, Amount: 500, BookingDate: day, References: []string{"E1"}},
+			},
+			expected: map[int64]int64{10: 1},
+		},
+		{
+			name: "OutsideDateTolerance",
+			lines: []Line{
+				{ID: 10, Amount: 900, BookingDate: day, References: []string{"E4"}},
+			},
+			expected: map[int64]int64{},
+		},
+		{
+			name: "AmountMustBeOpposite",
+			lines: []Line{
+				{ID: 10, Amount: -500, BookingDate: day, References: []string{"E1"}},
+			},
+			expected: map[int64]int64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Match(tc.lines, entries))
+		})
+	}
+}
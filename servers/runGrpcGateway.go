@@ -24,8 +24,9 @@ func RunGatewayServer(
 	config util.Config,
 	store db.Store,
 	taskDistributor worker.TaskDistributor,
+	activityListener db.ActivityListener,
 ) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+	server, err := gapi.NewServer(config, store, taskDistributor, activityListener)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create the gRPC gateway server")
 	}
@@ -48,8 +49,15 @@ func RunGatewayServer(
 		log.Fatal().Err(err).Msg("failed to register handler server")
 	}
 
+	err = pb.RegisterSimpleBankAdminHandlerServer(ctx, grpcMux, server)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to register admin handler server")
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.HandleFunc("GET "+gapi.AccountActivityEventsPath, server.AccountActivityEvents)
+	mux.HandleFunc("GET "+gapi.DataExportArchivePath, server.DataExportArchive)
 
 	statikFS, err := fs.New()
 	if err != nil {
@@ -60,7 +68,7 @@ func RunGatewayServer(
 	mux.Handle("/swagger/", swaggerHandler)
 
 	httpServer := &http.Server{
-		Handler: gapi.HttpLogger(mux),
+		Handler: gapi.HttpLogger(gapi.HttpReadYourWrites(mux)),
 		Addr:    config.HTTPServerAddress,
 	}
 
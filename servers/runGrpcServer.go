@@ -23,16 +23,18 @@ func RunGrpcServer(
 	config util.Config,
 	store db.Store,
 	taskDistributor worker.TaskDistributor,
+	activityListener db.ActivityListener,
 ) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+	server, err := gapi.NewServer(config, store, taskDistributor, activityListener)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create the gRPC server")
 	}
 
-	grpcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
+	interceptors := grpc.ChainUnaryInterceptor(gapi.GrpcLogger, gapi.GrpcReadYourWrites)
 
-	grpcServer := grpc.NewServer(grpcLogger)
+	grpcServer := grpc.NewServer(interceptors)
 	pb.RegisterSimpleBankServer(grpcServer, server)
+	pb.RegisterSimpleBankAdminServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
@@ -0,0 +1,607 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Camt053Namespace is the XML namespace of the camt.053 version the bank writes.
+// Statements of any camt.053 version can be read.
+const Camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+
+const camt053NamespacePrefix = "urn:iso:std:iso:20022:tech:xsd:camt.053."
+
+var isIBAN = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[a-zA-Z0-9]{1,30}$`).MatchString
+
+// BankTransactionCodeIssuer issues the proprietary bank transaction codes of the entries.
+const BankTransactionCodeIssuer = "SIMPLEBANK"
+
+const (
+	isoDate     = "2006-01-02"
+	isoDateTime = "2006-01-02T15:04:05Z"
+
+	credit = "CRDT"
+	debit  = "DBIT"
+	booked = "BOOK"
+)
+
+// The types below mirror the camt.053.001.02 message. Only the elements the bank fills in are declared.
+
+type camt053Document struct {
+	XMLName xml.Name                  `xml:"urn:iso:std:iso:20022:tech:xsd:camt.053.001.02 Document"`
+	Message camtBankToCustomerMessage `xml:"BkToCstmrStmt"`
+}
+
+type camtBankToCustomerMessage struct {
+	GroupHeader camtGroupHeader `xml:"GrpHdr"`
+	Statements  []camtStatement `xml:"Stmt"`
+}
+
+type camtGroupHeader struct {
+	MessageID string `xml:"MsgId"`
+	CreatedAt string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID        string        `xml:"Id"`
+	CreatedAt string        `xml:"CreDtTm"`
+	Period    *camtPeriod   `xml:"FrToDt,omitempty"`
+	Account   camtAccount   `xml:"Acct"`
+	Balances  []camtBalance `xml:"Bal"`
+	Summary   *camtSummary  `xml:"TxsSummry,omitempty"`
+	Entries   []camtEntry   `xml:"Ntry"`
+}
+
+type camtPeriod struct {
+	From string `xml:"FrDtTm"`
+	To   string `xml:"ToDtTm"`
+}
+
+type camtAccount struct {
+	ID       camtAccountID `xml:"Id"`
+	Currency string        `xml:"Ccy,omitempty"`
+	Owner    *camtParty    `xml:"Ownr,omitempty"`
+}
+
+type camtAccountID struct {
+	IBAN  string         `xml:"IBAN,omitempty"`
+	Other *camtGenericID `xml:"Othr,omitempty"`
+}
+
+type camtGenericID struct {
+	ID string `xml:"Id"`
+}
+
+type camtParty struct {
+	Name string `xml:"Nm,omitempty"`
+	// Party wraps the name of related parties since camt.053.001.08, it is only read.
+	Party *camtParty `xml:"Pty,omitempty"`
+}
+
+func (party *camtParty) name() string {
+	switch {
+	case party == nil:
+		return ""
+	case party.Party != nil:
+		return party.Party.Name
+	}
+
+	return party.Name
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt,omitempty"`
+	DateTime string `xml:"DtTm,omitempty"`
+}
+
+type camtBalance struct {
+	Type        camtBalanceType `xml:"Tp"`
+	Amount      camtAmount      `xml:"Amt"`
+	CreditDebit string          `xml:"CdtDbtInd"`
+	Date        camtDate        `xml:"Dt"`
+}
+
+type camtBalanceType struct {
+	CodeOrProprietary camtCodeOrProprietary `xml:"CdOrPrtry"`
+}
+
+type camtCodeOrProprietary struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+type camtSummary struct {
+	Total   camtTotal    `xml:"TtlNtries"`
+	Credits camtSubtotal `xml:"TtlCdtNtries"`
+	Debits  camtSubtotal `xml:"TtlDbtNtries"`
+}
+
+type camtTotal struct {
+	Count       int    `xml:"NbOfNtries"`
+	Sum         string `xml:"Sum"`
+	Net         string `xml:"TtlNetNtryAmt"`
+	CreditDebit string `xml:"CdtDbtInd"`
+}
+
+type camtSubtotal struct {
+	Count int    `xml:"NbOfNtries"`
+	Sum   string `xml:"Sum"`
+}
+
+type camtEntry struct {
+	Reference       string              `xml:"NtryRef,omitempty"`
+	Amount          camtAmount          `xml:"Amt"`
+	CreditDebit     string              `xml:"CdtDbtInd"`
+	Status          camtStatus          `xml:"Sts"`
+	BookingDate     *camtDate           `xml:"BookgDt,omitempty"`
+	ValueDate       *camtDate           `xml:"ValDt,omitempty"`
+	ServicerRef     string              `xml:"AcctSvcrRef,omitempty"`
+	TransactionCode camtTransactionCode `xml:"BkTxCd"`
+	Details         []camtEntryDetails  `xml:"NtryDtls"`
+	AdditionalInfo  string              `xml:"AddtlNtryInf,omitempty"`
+}
+
+// camtStatus is a code since camt.053.001.08 and plain text before.
+type camtStatus struct {
+	Text string `xml:",chardata"`
+	Code string `xml:"Cd,omitempty"`
+}
+
+func (status camtStatus) value() string {
+	if status.Code != "" {
+		return status.Code
+	}
+
+	return strings.TrimSpace(status.Text)
+}
+
+type camtTransactionCode struct {
+	Proprietary *camtProprietaryCode `xml:"Prtry,omitempty"`
+}
+
+type camtProprietaryCode struct {
+	Code   string `xml:"Cd"`
+	Issuer string `xml:"Issr,omitempty"`
+}
+
+type camtEntryDetails struct {
+	Transactions []camtTransaction `xml:"TxDtls"`
+}
+
+type camtTransaction struct {
+	References     *camtReferences     `xml:"Refs,omitempty"`
+	RelatedParties *camtRelatedParties `xml:"RltdPties,omitempty"`
+	RemittanceInfo *camtRemittanceInfo `xml:"RmtInf,omitempty"`
+	AdditionalInfo string              `xml:"AddtlTxInf,omitempty"`
+}
+
+// camtRemittanceInfo is only read: other banks put the payment reference given by the payer there.
+type camtRemittanceInfo struct {
+	Unstructured []string `xml:"Ustrd"`
+}
+
+type camtReferences struct {
+	ServicerRef string `xml:"AcctSvcrRef,omitempty"`
+	EndToEndID  string `xml:"EndToEndId,omitempty"`
+	TxID        string `xml:"TxId,omitempty"`
+}
+
+type camtRelatedParties struct {
+	Debtor          *camtParty       `xml:"Dbtr,omitempty"`
+	DebtorAccount   *camtCashAccount `xml:"DbtrAcct,omitempty"`
+	Creditor        *camtParty       `xml:"Cdtr,omitempty"`
+	CreditorAccount *camtCashAccount `xml:"CdtrAcct,omitempty"`
+}
+
+type camtCashAccount struct {
+	ID camtAccountID `xml:"Id"`
+}
+
+// WriteCamt053 writes the statements as a single camt.053 message.
+func WriteCamt053(w io.Writer, messageID string, createdAt time.Time, statements ...Statement) error {
+	doc := camt053Document{
+		Message: camtBankToCustomerMessage{
+			GroupHeader: camtGroupHeader{
+				MessageID: messageID,
+				CreatedAt: createdAt.UTC().Format(isoDateTime),
+			},
+			Statements: make([]camtStatement, len(statements)),
+		},
+	}
+
+	for i, statement := range statements {
+		doc.Message.Statements[i] = newCamtStatement(statement)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode camt.053 message: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+func newCamtStatement(statement Statement) camtStatement {
+	res := camtStatement{
+		ID:        statement.ID,
+		CreatedAt: statement.CreatedAt.UTC().Format(isoDateTime),
+		Period: &camtPeriod{
+			From: startOfDay(statement.From).Format(isoDateTime),
+			To:   startOfDay(statement.To).Add(24*time.Hour - time.Second).Format(isoDateTime),
+		},
+		Account: camtAccount{
+			ID:       camtAccountIdentification(statement.Account.Account),
+			Currency: statement.Currency,
+		},
+		Balances: []camtBalance{
+			newCamtBalance("OPBD", statement.OpeningBalance, statement.Currency, statement.From),
+			newCamtBalance("CLBD", statement.ClosingBalance, statement.Currency, statement.To),
+		},
+	}
+
+	if statement.Account.Name != "" {
+		res.Account.Owner = &camtParty{Name: statement.Account.Name}
+	}
+
+	var credits, debits camtSubtotal
+	var creditSum, debitSum int64
+	for _, entry := range statement.Entries {
+		res.Entries = append(res.Entries, newCamtEntry(entry, statement))
+
+		if entry.Amount < 0 {
+			debits.Count++
+			debitSum -= entry.Amount
+		} else {
+			credits.Count++
+			creditSum += entry.Amount
+		}
+	}
+
+	credits.Sum = formatAmount(creditSum)
+	debits.Sum = formatAmount(debitSum)
+
+	res.Summary = &camtSummary{
+		Total: camtTotal{
+			Count:       credits.Count + debits.Count,
+			Sum:         formatAmount(creditSum + debitSum),
+			Net:         formatAmount(creditSum - debitSum),
+			CreditDebit: creditDebit(creditSum - debitSum),
+		},
+		Credits: credits,
+		Debits:  debits,
+	}
+
+	return res
+}
+
+func newCamtBalance(code string, amount int64, currency string, date time.Time) camtBalance {
+	return camtBalance{
+		Type:        camtBalanceType{CodeOrProprietary: camtCodeOrProprietary{Code: code}},
+		Amount:      camtAmount{Currency: currency, Value: formatAmount(amount)},
+		CreditDebit: creditDebit(amount),
+		Date:        camtDate{Date: date.Format(isoDate)},
+	}
+}
+
+func newCamtEntry(entry Entry, statement Statement) camtEntry {
+	res := camtEntry{
+		Reference:   entry.Reference,
+		Amount:      camtAmount{Currency: statement.Currency, Value: formatAmount(entry.Amount)},
+		CreditDebit: creditDebit(entry.Amount),
+		Status:      camtStatus{Text: booked},
+		BookingDate: &camtDate{Date: entry.BookingDate.Format(isoDate)},
+		ValueDate:   &camtDate{Date: entry.ValueDate.Format(isoDate)},
+		ServicerRef: entry.Reference,
+		TransactionCode: camtTransactionCode{
+			Proprietary: &camtProprietaryCode{Code: entry.Code, Issuer: BankTransactionCodeIssuer},
+		},
+		AdditionalInfo: entry.Description,
+	}
+
+	transaction := camtTransaction{
+		References: &camtReferences{
+			ServicerRef: entry.Reference,
+			EndToEndID:  entry.EndToEndID,
+		},
+		AdditionalInfo: entry.Description,
+	}
+
+	// the money goes from the debtor to the creditor
+	if entry.Counterparty != (Party{}) {
+		owner, counterparty := statement.Account, entry.Counterparty
+		debtor, creditor := counterparty, owner
+		if entry.Amount < 0 {
+			debtor, creditor = owner, counterparty
+		}
+
+		transaction.RelatedParties = &camtRelatedParties{
+			Debtor:          &camtParty{Name: debtor.Name},
+			DebtorAccount:   &camtCashAccount{ID: camtAccountIdentification(debtor.Account)},
+			Creditor:        &camtParty{Name: creditor.Name},
+			CreditorAccount: &camtCashAccount{ID: camtAccountIdentification(creditor.Account)},
+		}
+	}
+
+	res.Details = []camtEntryDetails{{Transactions: []camtTransaction{transaction}}}
+
+	return res
+}
+
+// camtAccountIdentification identifies IBAN-like account numbers by IBAN and any other account by its plain id.
+func camtAccountIdentification(account string) camtAccountID {
+	if isIBAN(account) {
+		return camtAccountID{IBAN: account}
+	}
+
+	return camtAccountID{Other: &camtGenericID{ID: account}}
+}
+
+func creditDebit(amount int64) string {
+	if amount < 0 {
+		return debit
+	}
+
+	return credit
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// EntryReference is the reference of an entry that is unique at the bank.
+func EntryReference(entryID int64) string {
+	return "E" + strconv.FormatInt(entryID, 10)
+}
+
+// TransferReference is the end-to-end reference of the entries of a transfer.
+func TransferReference(transferID int64) string {
+	return "T" + strconv.FormatInt(transferID, 10)
+}
+
+// ParseCamt053 reads the statements of a camt.053 message. Only booked entries are returned.
+func ParseCamt053(r io.Reader) ([]Statement, error) {
+	var doc struct {
+		XMLName xml.Name
+		Message camtBankToCustomerMessage `xml:"BkToCstmrStmt"`
+	}
+
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid camt.053 message: %w", err)
+	}
+
+	if doc.XMLName.Local != "Document" || !strings.HasPrefix(doc.XMLName.Space, camt053NamespacePrefix) {
+		return nil, fmt.Errorf("not a camt.053 message: %s", doc.XMLName.Space)
+	}
+
+	if len(doc.Message.Statements) == 0 {
+		return nil, fmt.Errorf("camt.053 message has no statements")
+	}
+
+	statements := make([]Statement, len(doc.Message.Statements))
+	for i, stmt := range doc.Message.Statements {
+		statement, err := parseCamtStatement(stmt)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i+1, err)
+		}
+
+		statements[i] = statement
+	}
+
+	return statements, nil
+}
+
+func parseCamtStatement(stmt camtStatement) (Statement, error) {
+	res := Statement{
+		ID:       stmt.ID,
+		Account:  Party{Name: stmt.Account.Owner.name(), Account: stmt.Account.ID.value()},
+		Currency: stmt.Account.Currency,
+	}
+
+	if res.ID == "" {
+		return res, fmt.Errorf("missing statement id")
+	}
+
+	if res.Account.Account == "" {
+		return res, fmt.Errorf("missing account")
+	}
+
+	var err error
+	if stmt.CreatedAt != "" {
+		res.CreatedAt, err = parseDateTime(stmt.CreatedAt)
+		if err != nil {
+			return res, fmt.Errorf("invalid creation time: %w", err)
+		}
+	}
+
+	var hasOpening, hasClosing bool
+	for _, balance := range stmt.Balances {
+		amount, err := parseCamtAmount(balance.Amount, balance.CreditDebit)
+		if err != nil {
+			return res, fmt.Errorf("invalid balance: %w", err)
+		}
+
+		date, err := balance.Date.value()
+		if err != nil {
+			return res, fmt.Errorf("invalid balance date: %w", err)
+		}
+
+		if res.Currency == "" {
+			res.Currency = balance.Amount.Currency
+		}
+
+		switch balance.Type.CodeOrProprietary.Code {
+		case "OPBD", "PRCD":
+			res.OpeningBalance, res.From, hasOpening = amount, date, true
+		case "CLBD":
+			res.ClosingBalance, res.To, hasClosing = amount, date, true
+		}
+	}
+
+	if !hasOpening || !hasClosing {
+		return res, fmt.Errorf("missing opening or closing booked balance")
+	}
+
+	if stmt.Period != nil {
+		from, err := parseDateTime(stmt.Period.From)
+		if err != nil {
+			return res, fmt.Errorf("invalid period: %w", err)
+		}
+
+		to, err := parseDateTime(stmt.Period.To)
+		if err != nil {
+			return res, fmt.Errorf("invalid period: %w", err)
+		}
+
+		res.From, res.To = startOfDay(from), startOfDay(to)
+	}
+
+	for i, ntry := range stmt.Entries {
+		if ntry.Status.value() != booked {
+			continue
+		}
+
+		entry, err := parseCamtEntry(ntry)
+		if err != nil {
+			return res, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+
+		res.Entries = append(res.Entries, entry)
+	}
+
+	return res, nil
+}
+
+func parseCamtEntry(ntry camtEntry) (Entry, error) {
+	var res Entry
+
+	var err error
+	res.Amount, err = parseCamtAmount(ntry.Amount, ntry.CreditDebit)
+	if err != nil {
+		return res, err
+	}
+
+	if ntry.BookingDate == nil {
+		return res, fmt.Errorf("missing booking date")
+	}
+
+	res.BookingDate, err = ntry.BookingDate.value()
+	if err != nil {
+		return res, fmt.Errorf("invalid booking date: %w", err)
+	}
+
+	res.ValueDate = res.BookingDate
+	if ntry.ValueDate != nil {
+		res.ValueDate, err = ntry.ValueDate.value()
+		if err != nil {
+			return res, fmt.Errorf("invalid value date: %w", err)
+		}
+	}
+
+	res.Reference = ntry.ServicerRef
+	if res.Reference == "" {
+		res.Reference = ntry.Reference
+	}
+
+	if ntry.TransactionCode.Proprietary != nil {
+		res.Code = ntry.TransactionCode.Proprietary.Code
+	}
+
+	res.Description = ntry.AdditionalInfo
+
+	if len(ntry.Details) == 0 || len(ntry.Details[0].Transactions) == 0 {
+		return res, nil
+	}
+
+	transaction := ntry.Details[0].Transactions[0]
+	if transaction.References != nil && transaction.References.EndToEndID != "NOTPROVIDED" {
+		res.EndToEndID = transaction.References.EndToEndID
+	}
+
+	if parties := transaction.RelatedParties; parties != nil {
+		// the counterparty of a credit is the debtor and of a debit the creditor
+		party, account := parties.Debtor, parties.DebtorAccount
+		if res.Amount < 0 {
+			party, account = parties.Creditor, parties.CreditorAccount
+		}
+
+		res.Counterparty.Name = party.name()
+		if account != nil {
+			res.Counterparty.Account = account.ID.value()
+		}
+	}
+
+	if transaction.RemittanceInfo != nil && len(transaction.RemittanceInfo.Unstructured) > 0 {
+		res.Description = strings.Join(transaction.RemittanceInfo.Unstructured, " ")
+	} else if transaction.AdditionalInfo != "" && res.Description == "" {
+		res.Description = transaction.AdditionalInfo
+	}
+
+	return res, nil
+}
+
+func (id camtAccountID) value() string {
+	if id.IBAN != "" {
+		return id.IBAN
+	}
+
+	if id.Other != nil {
+		return id.Other.ID
+	}
+
+	return ""
+}
+
+func (date camtDate) value() (time.Time, error) {
+	if date.Date != "" {
+		return time.Parse(isoDate, date.Date)
+	}
+
+	t, err := parseDateTime(date.DateTime)
+	if err != nil {
+		return t, err
+	}
+
+	return startOfDay(t), nil
+}
+
+// parseDateTime parses an ISO date time. The time zone is optional, UTC is assumed without it.
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date time %q", value)
+}
+
+func parseCamtAmount(amount camtAmount, creditDebit string) (int64, error) {
+	value, err := parseAmount(strings.TrimSpace(amount.Value))
+	if err != nil {
+		return 0, err
+	}
+
+	switch creditDebit {
+	case credit:
+		return value, nil
+	case debit:
+		return -value, nil
+	}
+
+	return 0, fmt.Errorf("invalid credit debit indicator %q", creditDebit)
+}
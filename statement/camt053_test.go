@@ -0,0 +1,295 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStatement() Statement {
+	day := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	return Statement{
+		ID:             "STMT-42-20240304-20240305",
+		CreatedAt:      day.Add(72 * time.Hour),
+		Account:        Party{Name: "Jane Doe", Account: "42"},
+		Currency:       "EUR",
+		From:           day,
+		To:             day.AddDate(0, 0, 1),
+		OpeningBalance: 10_000,
+		ClosingBalance: 12_550,
+		Entries: []Entry{
+			{
+				Reference:    EntryReference(101),
+				EndToEndID:   "T7",
+				Code:         "TRANSFER",
+				Amount:       5_000,
+				BookingDate:  day,
+				ValueDate:    day,
+				Counterparty: Party{Name: "John Roe", Account: "7"},
+				Description:  "Transfer 7",
+			},
+			{
+				Reference:    EntryReference(102),
+				EndToEndID:   "T8",
+				Code:         "TRANSFER",
+				Amount:       -2_450,
+				BookingDate:  day.AddDate(0, 0, 1),
+				ValueDate:    day.AddDate(0, 0, 1),
+				Counterparty: Party{Name: "John Roe", Account: "7"},
+				Description:  "Transfer 8",
+			},
+		},
+	}
+}
+
+func writeTestStatement(t *testing.T, statements ...Statement) []byte {
+	var buf bytes.Buffer
+	err := WriteCamt053(&buf, "MSG-1", time.Date(2024, time.March, 7, 8, 0, 0, 0, time.UTC), statements...)
+	require.NoError(t, err)
+
+	return buf.Bytes()
+}
+
+// validateCamt053 checks the message against the camt.053 schema with xmllint.
+func validateCamt053(t *testing.T, message []byte) {
+	xmllint, err := exec.LookPath("xmllint")
+	if err != nil {
+		// CI installs libxml2-utils, so a missing xmllint there is a broken
+		// pipeline rather than an optional check.
+		if os.Getenv("CI") != "" {
+			t.Fatal("xmllint is not installed")
+		}
+		t.Skip("xmllint is not installed")
+	}
+
+	path := filepath.Join(t.TempDir(), "camt053.xml")
+	require.NoError(t, os.WriteFile(path, message, 0o600))
+
+	out, err := exec.Command(xmllint, "--noout", "--schema", "testdata/camt.053.001.02.xsd", path).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestWriteCamt053(t *testing.T) {
+	message := writeTestStatement(t, newTestStatement())
+	validateCamt053(t, message)
+
+	var doc camt053Document
+	require.NoError(t, xml.Unmarshal(message, &doc))
+	require.Equal(t, Camt053Namespace, doc.XMLName.Space)
+	require.Equal(t, "MSG-1", doc.Message.GroupHeader.MessageID)
+	require.Len(t, doc.Message.Statements, 1)
+
+	stmt := doc.Message.Statements[0]
+	require.Equal(t, "42", stmt.Account.ID.Other.ID)
+	require.Equal(t, "2024-03-04T00:00:00Z", stmt.Period.From)
+	require.Equal(t, "2024-03-05T23:59:59Z", stmt.Period.To)
+
+	require.Len(t, stmt.Balances, 2)
+	require.Equal(t, "OPBD", stmt.Balances[0].Type.CodeOrProprietary.Code)
+	require.Equal(t, "100.00", stmt.Balances[0].Amount.Value)
+	require.Equal(t, "CLBD", stmt.Balances[1].Type.CodeOrProprietary.Code)
+	require.Equal(t, "125.50", stmt.Balances[1].Amount.Value)
+	require.Equal(t, "2024-03-05", stmt.Balances[1].Date.Date)
+
+	require.Equal(t, 2, stmt.Summary.Total.Count)
+	require.Equal(t, "74.50", stmt.Summary.Total.Sum)
+	require.Equal(t, "25.50", stmt.Summary.Total.Net)
+	require.Equal(t, credit, stmt.Summary.Total.CreditDebit)
+
+	require.Len(t, stmt.Entries, 2)
+
+	// the counterparty paid the account owner
+	incoming := stmt.Entries[0]
+	require.Equal(t, "50.00", incoming.Amount.Value)
+	require.Equal(t, credit, incoming.CreditDebit)
+	parties := incoming.Details[0].Transactions[0].RelatedParties
+	require.Equal(t, "John Roe", parties.Debtor.Name)
+	require.Equal(t, "42", parties.CreditorAccount.ID.Other.ID)
+
+	// the account owner paid the counterparty
+	outgoing := stmt.Entries[1]
+	require.Equal(t, "24.50", outgoing.Amount.Value)
+	require.Equal(t, debit, outgoing.CreditDebit)
+	require.Equal(t, "T8", outgoing.Details[0].Transactions[0].References.EndToEndID)
+	parties = outgoing.Details[0].Transactions[0].RelatedParties
+	require.Equal(t, "Jane Doe", parties.Debtor.Name)
+	require.Equal(t, "7", parties.CreditorAccount.ID.Other.ID)
+}
+
+func TestWriteCamt053NegativeBalanceWithoutEntries(t *testing.T) {
+	statement := newTestStatement()
+	statement.Account.Name = ""
+	statement.OpeningBalance = -1
+	statement.ClosingBalance = -1
+	statement.Entries = []Entry{{
+		Reference:   EntryReference(103),
+		Code:        "CASH_DEPOSIT",
+		Amount:      0,
+		BookingDate: statement.From,
+		ValueDate:   statement.From,
+	}}
+
+	message := writeTestStatement(t, statement, newTestStatement())
+	validateCamt053(t, message)
+
+	var doc camt053Document
+	require.NoError(t, xml.Unmarshal(message, &doc))
+	require.Len(t, doc.Message.Statements, 2)
+
+	stmt := doc.Message.Statements[0]
+	require.Nil(t, stmt.Account.Owner)
+	require.Equal(t, "0.01", stmt.Balances[0].Amount.Value)
+	require.Equal(t, debit, stmt.Balances[0].CreditDebit)
+	require.Nil(t, stmt.Entries[0].Details[0].Transactions[0].RelatedParties)
+}
+
+func TestParseCamt053(t *testing.T) {
+	written := newTestStatement()
+
+	statements, err := ParseCamt053(bytes.NewReader(writeTestStatement(t, written)))
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+
+	stmt := statements[0]
+	require.Equal(t, written.ID, stmt.ID)
+	require.Equal(t, written.Account, stmt.Account)
+	require.Equal(t, written.Currency, stmt.Currency)
+	require.Equal(t, written.From, stmt.From)
+	require.Equal(t, written.To, stmt.To)
+	require.Equal(t, written.OpeningBalance, stmt.OpeningBalance)
+	require.Equal(t, written.ClosingBalance, stmt.ClosingBalance)
+	require.Equal(t, written.Entries, stmt.Entries)
+}
+
+func TestParseCamt053LaterVersion(t *testing.T) {
+	message := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>M1</MsgId><CreDtTm>2024-03-06T07:00:00+01:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>S1</Id>
+      <CreDtTm>2024-03-06T07:00:00+01:00</CreDtTm>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>EUR</Ccy></Acct>
+      <Bal><Tp><CdOrPrtry><Cd>PRCD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">10</Amt><CdtDbtInd>DBIT</CdtDbtInd><Dt><Dt>2024-03-04</Dt></Dt></Bal>
+      <Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">5.5</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-03-05</Dt></Dt></Bal>
+      <Ntry>
+        <Amt Ccy="EUR">15.50</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><DtTm>2024-03-05T10:00:00</DtTm></BookgDt>
+        <AcctSvcrRef>BANK-1</AcctSvcrRef>
+        <BkTxCd/>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs>
+          <RltdPties><Dbtr><Pty><Nm>ACME Corp</Nm></Pty></Dbtr></RltdPties>
+          <RmtInf><Ustrd>Invoice</Ustrd><Ustrd>E42</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts>
+        <BkTxCd/>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+	statements, err := ParseCamt053(strings.NewReader(message))
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+
+	stmt := statements[0]
+	require.Equal(t, "DE89370400440532013000", stmt.Account.Account)
+	require.Equal(t, int64(-1_000), stmt.OpeningBalance)
+	require.Equal(t, int64(550), stmt.ClosingBalance)
+	require.Equal(t, time.Date(2024, time.March, 6, 6, 0, 0, 0, time.UTC), stmt.CreatedAt)
+
+	// the pending entry is left out
+	require.Len(t, stmt.Entries, 1)
+	entry := stmt.Entries[0]
+	require.Equal(t, int64(1_550), entry.Amount)
+	require.Equal(t, "BANK-1", entry.Reference)
+	require.Empty(t, entry.EndToEndID)
+	require.Equal(t, "ACME Corp", entry.Counterparty.Name)
+	require.Equal(t, "Invoice E42", entry.Description)
+	require.Equal(t, time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), entry.BookingDate)
+}
+
+func TestParseCamt053Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		message string
+	}{
+		{
+			name:    "NotXML",
+			message: "not xml",
+		},
+		{
+			name:    "OtherMessage",
+			message: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"><BkToCstmrStmt/></Document>`,
+		},
+		{
+			name:    "NoStatements",
+			message: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"><BkToCstmrStmt/></Document>`,
+		},
+		{
+			name: "MissingBalances",
+			message: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"><BkToCstmrStmt><Stmt>
+				<Id>S1</Id><Acct><Id><Othr><Id>1</Id></Othr></Id></Acct>
+			</Stmt></BkToCstmrStmt></Document>`,
+		},
+		{
+			name: "InvalidAmount",
+			message: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"><BkToCstmrStmt><Stmt>
+				<Id>S1</Id><Acct><Id><Othr><Id>1</Id></Othr></Id></Acct>
+				<Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="EUR">1.234</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-03-04</Dt></Dt></Bal>
+			</Stmt></BkToCstmrStmt></Document>`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseCamt053(strings.NewReader(tc.message))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected int64
+		valid    bool
+	}{
+		{"100", 10_000, true},
+		{"100.5", 10_050, true},
+		{"100,50", 10_050, true},
+		{"0.01", 1, true},
+		{"1.000", 100, true},
+		{"1.", 100, true},
+		{"1.001", 0, false},
+		{"-1", 0, false},
+		{"+1", 0, false},
+		{".5", 0, false},
+		{"1 000", 0, false},
+		{"99999999999999999999", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			amount, err := parseAmount(tc.value)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, amount)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+)
+
+// MaxDays is the longest period a single statement can cover.
+const MaxDays = 366
+
+// Proprietary bank transaction codes of the statement entries.
+const (
+	CodeTransfer   = "TRANSFER"
+	CodeAdjustment = "ADJUSTMENT"
+	CodeOther      = "OTHER"
+)
+
+// OpeningBalance returns the balance of the account at the start of the business date.
+// It is read from the snapshot of the previous day when that day is closed.
+func OpeningBalance(ctx context.Context, store db.Store, accountID int64, date time.Time) (int64, error) {
+	snapshot, err := store.GetAccountBalanceSnapshot(ctx, db.GetAccountBalanceSnapshotParams{
+		AccountID:    accountID,
+		BusinessDate: db.BusinessDate(date.AddDate(0, 0, -1)),
+	})
+	if err == nil {
+		return snapshot.ClosingBalance, nil
+	}
+
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return 0, err
+	}
+
+	return store.GetOpeningBalance(ctx, db.GetOpeningBalanceParams{
+		BusinessDate: db.BusinessDate(date),
+		AccountID:    accountID,
+	})
+}
+
+// NewEntry converts an entry booked on the statement account to a statement entry.
+func NewEntry(entry db.ListStatementEntriesRow) Entry {
+	res := Entry{
+		Reference:   EntryReference(entry.ID),
+		Code:        CodeOther,
+		Amount:      entry.Amount,
+		BookingDate: entry.BusinessDate.Time,
+		ValueDate:   entry.BusinessDate.Time,
+	}
+
+	switch {
+	case entry.TransferID.Valid:
+		res.Code = CodeTransfer
+		res.EndToEndID = TransferReference(entry.TransferID.Int64)
+		res.Description = fmt.Sprintf("Transfer %d", entry.TransferID.Int64)
+		res.Counterparty = Party{Account: entry.CounterpartyAccountNumber}
+	case entry.CashOperationKind != "":
+		res.Code = "CASH_" + strings.ToUpper(entry.CashOperationKind)
+		res.Description = "Cash " + entry.CashOperationKind
+	case entry.IsAdjustment:
+		res.Code = CodeAdjustment
+		res.Description = "Balance adjustment"
+	}
+
+	return res
+}
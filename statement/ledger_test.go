@@ -0,0 +1,36 @@
+package statement
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntry(t *testing.T) {
+	day := db.BusinessDate(time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC))
+
+	transfer := NewEntry(db.ListStatementEntriesRow{
+		ID:                        101,
+		Amount:                    2_500,
+		BusinessDate:              day,
+		TransferID:                pgtype.Int8{Int64: 7, Valid: true},
+		CounterpartyAccountNumber: "XS71SIMP577474894439",
+	})
+	require.Equal(t, CodeTransfer, transfer.Code)
+	require.Equal(t, "T7", transfer.EndToEndID)
+	require.Equal(t, Party{Account: "XS71SIMP577474894439"}, transfer.Counterparty)
+
+	// the reason of an adjustment is internal to the bank and stays out of the statement
+	adjustment := NewEntry(db.ListStatementEntriesRow{
+		ID:           102,
+		Amount:       -300,
+		BusinessDate: day,
+		IsAdjustment: true,
+	})
+	require.Equal(t, CodeAdjustment, adjustment.Code)
+	require.Equal(t, "Balance adjustment", adjustment.Description)
+	require.Equal(t, Party{}, adjustment.Counterparty)
+}
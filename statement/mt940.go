@@ -0,0 +1,230 @@
+package statement
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// mt940Field is a tagged field of an MT940 message, with its continuation lines.
+type mt940Field struct {
+	tag   string
+	value string
+	line  int
+}
+
+var (
+	mt940Tag = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+	// :60F:C240304EUR1000,00
+	mt940Balance = regexp.MustCompile(`^([CD])([0-9]{6})([A-Z]{3})([0-9]+,[0-9]*)$`)
+	// :61:2403040304C500,00NTRFREF123//BANKREF
+	mt940Entry = regexp.MustCompile(`^([0-9]{6})([0-9]{4})?(RC|RD|C|D)[A-Z]?([0-9]+,[0-9]*)([SNF][A-Z0-9]{3})([^/]*)(?://(.*))?$`)
+	// the reference ends at the next keyword or subfield
+	mt940EndToEndRef = regexp.MustCompile(`EREF\+(.*?)(?:(?:KREF|MREF|CRED|DEBT|SVWZ|ABWA|ABWE)\+|\?|$)`)
+)
+
+// ParseMT940 reads the statements of an MT940 file. A file holds one or more messages,
+// optionally wrapped in SWIFT blocks. The period of a statement spans the dates of its balances.
+func ParseMT940(r io.Reader) ([]Statement, error) {
+	fields, err := readMT940Fields(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []Statement
+	var current *Statement
+	var entry *Entry
+
+	for _, field := range fields {
+		if field.tag == "20" {
+			statements = append(statements, Statement{ID: field.value})
+			current, entry = &statements[len(statements)-1], nil
+			continue
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("line %d: field :%s: before the start of a statement", field.line, field.tag)
+		}
+
+		switch field.tag {
+		case "25":
+			current.Account.Account = field.value
+		case "28C":
+			current.ID += "/" + field.value
+		case "60F", "60M":
+			current.OpeningBalance, current.From, current.Currency, err = parseMT940Balance(field.value)
+		case "62F", "62M":
+			var currency string
+			current.ClosingBalance, current.To, currency, err = parseMT940Balance(field.value)
+			if err == nil && currency != current.Currency {
+				err = fmt.Errorf("closing balance in %s, opening balance in %s", currency, current.Currency)
+			}
+		case "61":
+			var parsed Entry
+			parsed, err = parseMT940Entry(field.value)
+			current.Entries = append(current.Entries, parsed)
+			entry = &current.Entries[len(current.Entries)-1]
+		case "86":
+			// information to account owner, belongs to the entry above it
+			if entry != nil {
+				entry.Description = strings.Join(strings.Fields(field.value), " ")
+				if reference := mt940EndToEndID(field.value); reference != "" {
+					entry.EndToEndID = reference
+				}
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: field :%s: %w", field.line, field.tag, err)
+		}
+	}
+
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("MT940 file has no statements")
+	}
+
+	for _, statement := range statements {
+		if statement.Account.Account == "" || statement.Currency == "" || statement.To.IsZero() {
+			return nil, fmt.Errorf("statement %s: missing account, opening or closing balance", statement.ID)
+		}
+	}
+
+	return statements, nil
+}
+
+func readMT940Fields(r io.Reader) ([]mt940Field, error) {
+	var fields []mt940Field
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r ")
+
+		// SWIFT block headers and trailers around the text block
+		if strings.HasPrefix(line, "{") {
+			if _, text, ok := strings.Cut(line, "{4:"); ok {
+				line = text
+			} else {
+				continue
+			}
+		}
+
+		if line == "" || line == "-" || strings.HasPrefix(line, "-}") {
+			continue
+		}
+
+		if match := mt940Tag.FindStringSubmatch(line); match != nil {
+			fields = append(fields, mt940Field{tag: match[1], value: match[2], line: n})
+			continue
+		}
+
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("line %d: expected a field tag", n)
+		}
+
+		fields[len(fields)-1].value += "\n" + line
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read MT940 file: %w", err)
+	}
+
+	return fields, nil
+}
+
+func parseMT940Balance(value string) (amount int64, date time.Time, currency string, err error) {
+	match := mt940Balance.FindStringSubmatch(value)
+	if match == nil {
+		return 0, date, "", fmt.Errorf("invalid balance %q", value)
+	}
+
+	date, err = time.Parse("060102", match[2])
+	if err != nil {
+		return 0, date, "", fmt.Errorf("invalid balance date %q", match[2])
+	}
+
+	amount, err = parseAmount(match[4])
+	if err != nil {
+		return 0, date, "", err
+	}
+
+	if match[1] == "D" {
+		amount = -amount
+	}
+
+	return amount, date, match[3], nil
+}
+
+func parseMT940Entry(value string) (Entry, error) {
+	var res Entry
+
+	// the first line is the entry, the optional second one supplementary details
+	line, details, _ := strings.Cut(value, "\n")
+
+	match := mt940Entry.FindStringSubmatch(line)
+	if match == nil {
+		return res, fmt.Errorf("invalid statement line %q", line)
+	}
+
+	var err error
+	res.ValueDate, err = time.Parse("060102", match[1])
+	if err != nil {
+		return res, fmt.Errorf("invalid value date %q", match[1])
+	}
+
+	res.BookingDate = res.ValueDate
+	if match[2] != "" {
+		// the entry date has no year, it is the closest date to the value date
+		res.BookingDate, err = time.Parse("20060102", res.ValueDate.Format("2006")+match[2])
+		if err != nil {
+			return res, fmt.Errorf("invalid entry date %q", match[2])
+		}
+
+		switch {
+		case res.BookingDate.Sub(res.ValueDate) > 180*24*time.Hour:
+			res.BookingDate = res.BookingDate.AddDate(-1, 0, 0)
+		case res.ValueDate.Sub(res.BookingDate) > 180*24*time.Hour:
+			res.BookingDate = res.BookingDate.AddDate(1, 0, 0)
+		}
+	}
+
+	res.Amount, err = parseAmount(match[4])
+	if err != nil {
+		return res, err
+	}
+
+	// a reversal of a credit is a debit and a reversal of a debit a credit
+	if match[3] == "D" || match[3] == "RC" {
+		res.Amount = -res.Amount
+	}
+
+	res.Code = match[5]
+
+	if reference := match[6]; reference != "NONREF" {
+		res.EndToEndID = reference
+	}
+
+	res.Reference = match[7]
+	if res.Reference == "" {
+		res.Reference = res.EndToEndID
+	}
+
+	if details != "" {
+		res.Description = strings.TrimSpace(details)
+	}
+
+	return res, nil
+}
+
+// mt940EndToEndID returns the end-to-end reference of the structured information
+// German banks put in field 86, e.g. EREF+E2E-123SVWZ+Invoice 42.
+func mt940EndToEndID(value string) string {
+	match := mt940EndToEndRef.FindStringSubmatch(strings.ReplaceAll(value, "\n", ""))
+	if match == nil || match[1] == "NOTPROVIDED" {
+		return ""
+	}
+
+	return strings.TrimSpace(match[1])
+}
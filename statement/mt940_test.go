@@ -0,0 +1,92 @@
+package statement
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMT940(t *testing.T) {
+	file, err := os.Open("testdata/statement.mt940")
+	require.NoError(t, err)
+	defer file.Close()
+
+	statements, err := ParseMT940(file)
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+
+	stmt := statements[0]
+	require.Equal(t, "STMT240305/00042/001", stmt.ID)
+	require.Equal(t, "DE89370400440532013000", stmt.Account.Account)
+	require.Equal(t, "EUR", stmt.Currency)
+	require.Equal(t, int64(100_000), stmt.OpeningBalance)
+	require.Equal(t, int64(124_950), stmt.ClosingBalance)
+	require.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), stmt.From)
+	require.Equal(t, time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), stmt.To)
+
+	require.Len(t, stmt.Entries, 2)
+
+	deposit := stmt.Entries[0]
+	require.Equal(t, int64(50_000), deposit.Amount)
+	require.Equal(t, "BANK-1", deposit.Reference)
+	require.Equal(t, "DEP-20240304-000042", deposit.EndToEndID)
+	require.Equal(t, "NTRF", deposit.Code)
+
+	payment := stmt.Entries[1]
+	require.Equal(t, int64(-25_050), payment.Amount)
+	require.Equal(t, "E17", payment.EndToEndID)
+	require.Equal(t, "BANK-2", payment.Reference)
+	require.Equal(t, "Outgoing payment E17", payment.Description)
+	require.Equal(t, time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), payment.BookingDate)
+}
+
+func TestParseMT940EntryDateAcrossYearEnd(t *testing.T) {
+	entry, err := parseMT940Entry("2312310102RD10,NMSCREF")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), entry.ValueDate)
+	require.Equal(t, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), entry.BookingDate)
+	require.Equal(t, int64(1_000), entry.Amount)
+	require.Equal(t, "REF", entry.Reference)
+}
+
+func TestParseMT940Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "Empty",
+			content: "",
+		},
+		{
+			name:    "FieldBeforeStatement",
+			content: ":25:ACCOUNT\n:20:STMT\n",
+		},
+		{
+			name:    "InvalidBalance",
+			content: ":20:STMT\n:25:ACCOUNT\n:60F:X240304EUR1,00\n",
+		},
+		{
+			name:    "InvalidEntry",
+			content: ":20:STMT\n:25:ACCOUNT\n:60F:C240304EUR1,00\n:61:24030C1,00\n:62F:C240304EUR1,00\n",
+		},
+		{
+			name:    "CurrencyMismatch",
+			content: ":20:STMT\n:25:ACCOUNT\n:60F:C240304EUR1,00\n:62F:C240304USD1,00\n",
+		},
+		{
+			name:    "MissingClosingBalance",
+			content: ":20:STMT\n:25:ACCOUNT\n:60F:C240304EUR1,00\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseMT940(strings.NewReader(tc.content))
+			require.Error(t, err)
+		})
+	}
+}
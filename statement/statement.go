@@ -0,0 +1,94 @@
+// Package statement builds bank statements from the ledger, writes them in the ISO 20022 camt.053 format
+// and reads statements of other banks in the camt.053 and SWIFT MT940 formats.
+package statement
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Party is a side of a payment: the holder and the account identifier.
+type Party struct {
+	Name    string
+	Account string
+}
+
+// Entry is a booked movement of money on the statement account.
+type Entry struct {
+	// Reference identifies the entry at the bank that books it.
+	Reference string
+	// EndToEndID is the reference of the payment known to both sides, e.g. the id of a transfer.
+	EndToEndID string
+	// Code is the proprietary bank transaction code, e.g. TRANSFER.
+	Code string
+	// Amount is positive for credits and negative for debits.
+	Amount      int64
+	BookingDate time.Time
+	ValueDate   time.Time
+	// Counterparty is the other side of the payment, if there is one.
+	Counterparty Party
+	Description  string
+}
+
+// Statement lists the entries booked on an account between two business dates, both inclusive.
+type Statement struct {
+	ID        string
+	CreatedAt time.Time
+	Account   Party
+	Currency  string
+	From      time.Time
+	To        time.Time
+	// OpeningBalance is the balance at the start of From and ClosingBalance at the end of To.
+	OpeningBalance int64
+	ClosingBalance int64
+	Entries        []Entry
+}
+
+// Amounts are kept in the minor units of the currency, and all supported currencies have two decimals.
+const minorUnitsPerUnit = 100
+
+// formatAmount returns the absolute value of the amount as a decimal number of currency units.
+func formatAmount(amount int64) string {
+	if amount < 0 {
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%d.%02d", amount/minorUnitsPerUnit, amount%minorUnitsPerUnit)
+}
+
+// parseAmount parses a non-negative decimal number of currency units into minor units.
+// Both a decimal point and a decimal comma are accepted.
+func parseAmount(value string) (int64, error) {
+	units, fraction, _ := strings.Cut(strings.Replace(value, ",", ".", 1), ".")
+	fraction = strings.TrimRight(fraction, "0")
+
+	if !isDigits(units) || (fraction != "" && !isDigits(fraction)) || len(fraction) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	whole, err := strconv.ParseInt(units, 10, 64)
+	if err != nil || whole > math.MaxInt64/minorUnitsPerUnit-1 {
+		return 0, fmt.Errorf("amount %q is too large", value)
+	}
+
+	cents, _ := strconv.ParseInt(fraction+strings.Repeat("0", 2-len(fraction)), 10, 64)
+
+	return whole*minorUnitsPerUnit + cents, nil
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package util
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Account numbers are built like IBANs: a country code, two check digits and the basic account number,
+// which is the bank code followed by random digits, e.g. XS51 SIMP 0123 4567 8901.
+// XS is a user-assigned ISO 3166 code, so an account number can't be mistaken for a real IBAN.
+const (
+	AccountNumberCountry  = "XS"
+	AccountNumberBankCode = "SIMP"
+	AccountNumberDigits   = 12
+	AccountNumberLength   = len(AccountNumberCountry) + 2 + len(AccountNumberBankCode) + AccountNumberDigits
+)
+
+var accountNumberSpace = new(big.Int).Exp(big.NewInt(10), big.NewInt(AccountNumberDigits), nil)
+
+// NewAccountNumber returns a random account number with valid check digits.
+// The digits are random rather than derived from the account id, so they don't reveal how many accounts there are.
+func NewAccountNumber() (string, error) {
+	n, err := rand.Int(rand.Reader, accountNumberSpace)
+	if err != nil {
+		return "", fmt.Errorf("cannot generate account number: %w", err)
+	}
+
+	return accountNumber(n.Int64()), nil
+}
+
+// accountNumber returns the account number with the given digits and their check digits.
+func accountNumber(digits int64) string {
+	bban := fmt.Sprintf("%s%0*d", AccountNumberBankCode, AccountNumberDigits, digits)
+	checksum := AccountNumberChecksum(AccountNumberCountry + "00" + bban)
+
+	return fmt.Sprintf("%s%02d%s", AccountNumberCountry, 98-checksum, bban)
+}
+
+// AccountNumberChecksum computes the ISO 7064 mod 97-10 checksum of an IBAN-like number:
+// the first four characters are moved to the end and letters are replaced by 10 to 35.
+// The checksum of a number with valid check digits is 1. It returns -1 for characters other than
+// ASCII digits and uppercase letters.
+func AccountNumberChecksum(number string) int {
+	if len(number) < 4 {
+		return -1
+	}
+
+	checksum := 0
+	for _, c := range number[4:] + number[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			checksum = (checksum*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			checksum = (checksum*100 + int(c-'A') + 10) % 97
+		default:
+			return -1
+		}
+	}
+
+	return checksum
+}
+
+// NormalizeAccountNumber removes the spaces of the printed form and uppercases the account number.
+func NormalizeAccountNumber(number string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(number), ""))
+}
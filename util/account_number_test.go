@@ -0,0 +1,43 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountNumberChecksum(t *testing.T) {
+	require.Equal(t, 1, AccountNumberChecksum("GB82WEST12345698765432"))
+	require.Equal(t, 1, AccountNumberChecksum("DE89370400440532013000"))
+	require.NotEqual(t, 1, AccountNumberChecksum("DE89370400440532013001"))
+	require.Equal(t, -1, AccountNumberChecksum("de89370400440532013000"))
+	require.Equal(t, -1, AccountNumberChecksum("DE8"))
+}
+
+func TestNewAccountNumber(t *testing.T) {
+	number, err := NewAccountNumber()
+	require.NoError(t, err)
+	require.Len(t, number, AccountNumberLength)
+	require.Equal(t, AccountNumberCountry, number[:2])
+	require.Equal(t, AccountNumberBankCode, number[4:8])
+	require.Equal(t, 1, AccountNumberChecksum(number))
+
+	// any single mistyped digit is detected
+	for i := 2; i < len(number); i++ {
+		if number[i] < '0' || number[i] > '9' {
+			continue
+		}
+
+		typo := []byte(number)
+		typo[i] = '0' + (typo[i]-'0'+1)%10
+		require.NotEqual(t, 1, AccountNumberChecksum(string(typo)))
+	}
+
+	other, err := NewAccountNumber()
+	require.NoError(t, err)
+	require.NotEqual(t, number, other)
+}
+
+func TestNormalizeAccountNumber(t *testing.T) {
+	require.Equal(t, "XS51SIMP012345678901", NormalizeAccountNumber(" xs51 simp 0123 4567\t8901 "))
+}
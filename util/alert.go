@@ -0,0 +1,21 @@
+package util
+
+// Kinds of alert rules.
+const (
+	// AlertLowBalance fires when the available balance, the balance less the savings pots,
+	// drops below the threshold and stays silent until it recovers.
+	AlertLowBalance = "low_balance"
+	// AlertLargeDebit fires for every single debit above the threshold.
+	AlertLargeDebit = "large_debit"
+	// AlertDailySpend fires once a day when the debits of the day pass the threshold.
+	AlertDailySpend = "daily_spend"
+)
+
+func IsSupportedAlertKind(kind string) bool {
+	switch kind {
+	case AlertLowBalance, AlertLargeDebit, AlertDailySpend:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Types of the targets of audit events.
+const (
+	AuditTargetUser          = "user"
+	AuditTargetAccount       = "account"
+	AuditTargetTransfer      = "transfer"
+	AuditTargetInvitation    = "invitation"
+	AuditTargetWebhook       = "webhook"
+	AuditTargetLedger        = "ledger"
+	AuditTargetLedgerAccount = "ledger_account"
+	AuditTargetStatement     = "external_statement"
+	AuditTargetStatementLine = "statement_line"
+	AuditTargetApproval      = "transfer_approval"
+	AuditTargetDispute       = "dispute"
+	AuditTargetAlertRule     = "alert_rule"
+	AuditTargetSavingsPot    = "savings_pot"
+	AuditTargetLoan          = "loan"
+	AuditTargetSession       = "session"
+)
+
+var auditTargets = map[string]bool{
+	AuditTargetUser:          true,
+	AuditTargetAccount:       true,
+	AuditTargetTransfer:      true,
+	AuditTargetInvitation:    true,
+	AuditTargetWebhook:       true,
+	AuditTargetLedger:        true,
+	AuditTargetLedgerAccount: true,
+	AuditTargetStatement:     true,
+	AuditTargetStatementLine: true,
+	AuditTargetApproval:      true,
+	AuditTargetDispute:       true,
+	AuditTargetAlertRule:     true,
+	AuditTargetSavingsPot:    true,
+	AuditTargetLoan:          true,
+	AuditTargetSession:       true,
+}
+
+func IsSupportedAuditTarget(targetType string) bool {
+	return auditTargets[targetType]
+}
+
+// auditRedactedFields are never written to the audit log in clear.
+var auditRedactedFields = map[string]bool{
+	"hashed_password": true,
+	"secret":          true,
+	"secret_code":     true,
+}
+
+const auditRedactedValue = "[redacted]"
+
+// AuditErasedFields hold the personal data of a user, their values are replaced with AuditErasedValue
+// in the audit events of the user when the user is erased.
+var AuditErasedFields = []string{"email", "full_name"}
+
+const AuditErasedValue = "[erased]"
+
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditDiff returns the JSON encoded fields that differ between the JSON representations of before and after.
+// A nil before or after stands for a created or deleted target. Sensitive fields are redacted.
+func AuditDiff(before any, after any) ([]byte, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(map[string]AuditChange)
+
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			diff[key] = AuditChange{Before: value, After: afterFields[key]}
+		}
+	}
+
+	for key, value := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			diff[key] = AuditChange{After: value}
+		}
+	}
+
+	for key, change := range diff {
+		if auditRedactedFields[key] {
+			if change.Before != nil {
+				change.Before = auditRedactedValue
+			}
+			if change.After != nil {
+				change.After = auditRedactedValue
+			}
+			diff[key] = change
+		}
+	}
+
+	return json.Marshal(diff)
+}
+
+func auditFields(value any) (map[string]any, error) {
+	fields := make(map[string]any)
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
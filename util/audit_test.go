@@ -0,0 +1,50 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type auditTarget struct {
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	HashedPassword string `json:"hashed_password"`
+}
+
+func TestAuditDiff(t *testing.T) {
+	before := auditTarget{Username: "alice", FullName: "Alice", HashedPassword: "old"}
+	after := auditTarget{Username: "alice", FullName: "Alice Smith", HashedPassword: "new"}
+
+	data, err := AuditDiff(before, after)
+	require.NoError(t, err)
+
+	var diff map[string]AuditChange
+	require.NoError(t, json.Unmarshal(data, &diff))
+
+	require.Len(t, diff, 2)
+	require.Equal(t, AuditChange{Before: "Alice", After: "Alice Smith"}, diff["full_name"])
+	require.Equal(t, AuditChange{Before: auditRedactedValue, After: auditRedactedValue}, diff["hashed_password"])
+}
+
+func TestAuditDiffCreated(t *testing.T) {
+	data, err := AuditDiff(nil, auditTarget{Username: "bob"})
+	require.NoError(t, err)
+
+	var diff map[string]AuditChange
+	require.NoError(t, json.Unmarshal(data, &diff))
+
+	require.Equal(t, AuditChange{After: "bob"}, diff["username"])
+	require.Equal(t, AuditChange{After: ""}, diff["full_name"])
+}
+
+func TestAuditDiffDeleted(t *testing.T) {
+	data, err := AuditDiff(auditTarget{Username: "bob"}, nil)
+	require.NoError(t, err)
+
+	var diff map[string]AuditChange
+	require.NoError(t, json.Unmarshal(data, &diff))
+
+	require.Equal(t, AuditChange{Before: "bob"}, diff["username"])
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kinds of cash operations.
+const (
+	CashDeposit    = "deposit"
+	CashWithdrawal = "withdrawal"
+)
+
+// CashReceiptNumber returns the human-readable number printed on the receipt of a cash operation,
+// e.g. DEP-20240131-000042.
+func CashReceiptNumber(kind string, id int64, createdAt time.Time) string {
+	prefix := "DEP"
+	if kind == CashWithdrawal {
+		prefix = "WDR"
+	}
+
+	return fmt.Sprintf("%s-%s-%06d", prefix, createdAt.UTC().Format("20060102"), id)
+}
@@ -9,6 +9,7 @@ import (
 type Config struct {
 	Environment          string        `mapstructure:"ENVIRONMENT"`
 	DBSource             string        `mapstructure:"DB_SOURCE"`
+	DBReplicaSource      string        `mapstructure:"DB_REPLICA_SOURCE"`
 	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
 	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
 	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
@@ -19,6 +20,27 @@ type Config struct {
 	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	OutboxRelayInterval  time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	EndOfDayInterval     time.Duration `mapstructure:"END_OF_DAY_INTERVAL"`
+
+	// NotificationDigestInterval is how often the batched small transfer notifications are sent.
+	NotificationDigestInterval time.Duration `mapstructure:"NOTIFICATION_DIGEST_INTERVAL"`
+
+	// TransferApprovalThreshold is the amount above which a transfer waits for a banker's approval, zero disables approvals.
+	TransferApprovalThreshold      int64         `mapstructure:"TRANSFER_APPROVAL_THRESHOLD"`
+	TransferApprovalTTL            time.Duration `mapstructure:"TRANSFER_APPROVAL_TTL"`
+	TransferApprovalExpiryInterval time.Duration `mapstructure:"TRANSFER_APPROVAL_EXPIRY_INTERVAL"`
+
+	// LoanLateFee is charged once on a loan installment that can't be collected on its due date.
+	LoanLateFee            int64         `mapstructure:"LOAN_LATE_FEE"`
+	LoanCollectionInterval time.Duration `mapstructure:"LOAN_COLLECTION_INTERVAL"`
+
+	FraudVelocityWindow        time.Duration `mapstructure:"FRAUD_VELOCITY_WINDOW"`
+	FraudVelocityMaxTransfers  int64         `mapstructure:"FRAUD_VELOCITY_MAX_TRANSFERS"`
+	FraudNewRecipientThreshold int64         `mapstructure:"FRAUD_NEW_RECIPIENT_THRESHOLD"`
+	FraudHistoryFactor         int64         `mapstructure:"FRAUD_HISTORY_FACTOR"`
+	FraudNewIPWindow           time.Duration `mapstructure:"FRAUD_NEW_IP_WINDOW"`
+	FraudNewIPThreshold        int64         `mapstructure:"FRAUD_NEW_IP_THRESHOLD"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
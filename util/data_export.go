@@ -0,0 +1,8 @@
+package util
+
+// Statuses of an export of the user data.
+const (
+	DataExportPending = "pending"
+	DataExportReady   = "ready"
+	DataExportFailed  = "failed"
+)
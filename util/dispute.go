@@ -0,0 +1,38 @@
+package util
+
+// Statuses of a dispute. A dispute is opened by the customer, investigated by a banker
+// and resolved in favour of either the customer or the merchant.
+const (
+	DisputeOpen             = "open"
+	DisputeInvestigating    = "investigating"
+	DisputeResolvedCustomer = "resolved_customer"
+	DisputeResolvedMerchant = "resolved_merchant"
+)
+
+// Parties a dispute can be resolved in favour of.
+const (
+	DisputeFavourCustomer = "customer"
+	DisputeFavourMerchant = "merchant"
+)
+
+// DisputeResolution maps the party a dispute is resolved in favour of to the status of the resolved dispute.
+// It returns false for an unknown party.
+func DisputeResolution(favour string) (string, bool) {
+	switch favour {
+	case DisputeFavourCustomer:
+		return DisputeResolvedCustomer, true
+	case DisputeFavourMerchant:
+		return DisputeResolvedMerchant, true
+	}
+
+	return "", false
+}
+
+func IsSupportedDisputeStatus(status string) bool {
+	switch status {
+	case DisputeOpen, DisputeInvestigating, DisputeResolvedCustomer, DisputeResolvedMerchant:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,43 @@
+package util
+
+// Roles a user can hold on an account. The account owner implicitly holds OwnerHolderRole.
+const (
+	OwnerHolderRole   = "owner"
+	CoOwnerHolderRole = "co_owner"
+	ViewerHolderRole  = "view_only"
+)
+
+// Statuses of an invitation to become an account holder.
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+)
+
+var holderRoleRank = map[string]int{
+	ViewerHolderRole:  1,
+	CoOwnerHolderRole: 2,
+	OwnerHolderRole:   3,
+}
+
+func IsSupportedHolderRole(role string) bool {
+	_, ok := holderRoleRank[role]
+	return ok
+}
+
+// IsInvitableHolderRole reports whether an invitation may grant the role.
+// Every account has exactly one owner, so ownership can't be handed out by an invitation.
+func IsInvitableHolderRole(role string) bool {
+	return role == CoOwnerHolderRole || role == ViewerHolderRole
+}
+
+// HolderRoleAllows reports whether role grants at least the permissions of required.
+// View-only holders may read the account, co-owners may also debit it and owners may manage its holders.
+func HolderRoleAllows(role string, required string) bool {
+	rank, ok := holderRoleRank[role]
+	if !ok {
+		return false
+	}
+
+	return rank >= holderRoleRank[required]
+}
@@ -0,0 +1,38 @@
+package util
+
+// Types of the bank's general-ledger accounts.
+const (
+	LedgerAsset     = "asset"
+	LedgerLiability = "liability"
+	LedgerEquity    = "equity"
+	LedgerRevenue   = "revenue"
+	LedgerExpense   = "expense"
+)
+
+// Codes of the ledger accounts seeded for every currency.
+const (
+	LedgerCodeCashClearing      = "1000"
+	LedgerCodeDisputeSuspense   = "1100"
+	LedgerCodeLoansReceivable   = "1200"
+	LedgerCodeEquity            = "3000"
+	LedgerCodeFeeIncome         = "4000"
+	LedgerCodeInterestIncome    = "4100"
+	LedgerCodeInterestExpense   = "5000"
+	LedgerCodeManualAdjustments = "5100"
+)
+
+// Customer accounts are not ledger accounts, but their balances show up
+// in the trial balance under this code.
+const LedgerCodeCustomerDeposits = "2000"
+
+func IsSupportedLedgerAccountType(accountType string) bool {
+	switch accountType {
+	case LedgerAsset, LedgerLiability, LedgerEquity, LedgerRevenue, LedgerExpense:
+		return true
+	}
+
+	return false
+}
+
+// BusinessDateLayout is the format of business dates in APIs and task payloads.
+const BusinessDateLayout = "2006-01-02"
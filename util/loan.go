@@ -0,0 +1,34 @@
+package util
+
+// Repayment methods of loans.
+const (
+	// LoanAnnuity repays the loan in equal installments.
+	LoanAnnuity = "annuity"
+	// LoanLinear repays an equal part of the principal in every installment plus the interest on the rest.
+	LoanLinear = "linear"
+)
+
+// LoanServicingOwner owns the accounts, one per currency, that loan installments are collected into.
+const LoanServicingOwner = "simplebank"
+
+// Statuses of loans.
+const (
+	LoanActive  = "active"
+	LoanPaidOff = "paid_off"
+)
+
+// Statuses of loan installments.
+const (
+	InstallmentScheduled = "scheduled"
+	InstallmentOverdue   = "overdue"
+	InstallmentPaid      = "paid"
+)
+
+func IsSupportedLoanMethod(method string) bool {
+	switch method {
+	case LoanAnnuity, LoanLinear:
+		return true
+	}
+
+	return false
+}
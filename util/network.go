@@ -0,0 +1,18 @@
+package util
+
+import "net/netip"
+
+// carrier-grade NAT range, not covered by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicIP reports whether the address is routable on the public internet. Loopback, private,
+// link-local (which includes the cloud metadata address 169.254.169.254), multicast and unspecified
+// addresses are not.
+func IsPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!sharedAddressSpace.Contains(addr)
+}
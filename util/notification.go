@@ -0,0 +1,25 @@
+package util
+
+// Event types users can be notified of.
+const (
+	NotificationMoneyIn  = "money_in"
+	NotificationMoneyOut = "money_out"
+)
+
+// Channels notifications are sent through.
+const (
+	NotificationChannelEmail = "email"
+)
+
+func IsSupportedNotificationEventType(eventType string) bool {
+	switch eventType {
+	case NotificationMoneyIn, NotificationMoneyOut:
+		return true
+	}
+
+	return false
+}
+
+func IsSupportedNotificationChannel(channel string) bool {
+	return channel == NotificationChannelEmail
+}
@@ -31,6 +31,10 @@ func RandomMoney() int64 {
 	return RandomInt(0, 1000)
 }
 
+func RandomAccountNumber() string {
+	return accountNumber(rand.Int63n(1_000_000_000_000))
+}
+
 func RandomCurrency() string {
 	currencies := []string{USD, EUR, CAD}
 	n := len(currencies)
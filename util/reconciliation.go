@@ -0,0 +1,26 @@
+package util
+
+// Formats of the external statements the bank imports.
+const (
+	StatementFormatCamt053 = "camt053"
+	StatementFormatMT940   = "mt940"
+)
+
+// Reconciliation statuses of external statement lines.
+const (
+	// StatementLineUnmatched lines wait in the queue for a banker.
+	StatementLineUnmatched = "unmatched"
+	// StatementLineMatched lines are paired with a clearing account entry.
+	StatementLineMatched = "matched"
+	// StatementLineResolved lines were explained by a banker without an entry.
+	StatementLineResolved = "resolved"
+)
+
+func IsSupportedStatementFormat(format string) bool {
+	switch format {
+	case StatementFormatCamt053, StatementFormatMT940:
+		return true
+	}
+
+	return false
+}
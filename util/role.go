@@ -4,3 +4,12 @@ const (
 	DepositorRole = "depositor"
 	BankerRole    = "banker"
 )
+
+func IsSupportedRole(role string) bool {
+	switch role {
+	case DepositorRole, BankerRole:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,13 @@
+package util
+
+// Statuses of savings pots.
+const (
+	PotOpen   = "open"
+	PotClosed = "closed"
+)
+
+// Directions of the moves between the main balance and a savings pot.
+const (
+	PotMoveIn  = "in"
+	PotMoveOut = "out"
+)
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSecret(t *testing.T) {
+	secret1, err := NewSecret(32)
+	require.NoError(t, err)
+	require.Len(t, secret1, 43)
+
+	secret2, err := NewSecret(32)
+	require.NoError(t, err)
+	require.NotEqual(t, secret1, secret2)
+}
+
+func TestHashSecret(t *testing.T) {
+	// the digest matches the one computed by postgres in migration 000028
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", HashSecret("hello"))
+	require.NotEqual(t, HashSecret("hello"), HashSecret("hello "))
+}
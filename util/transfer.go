@@ -0,0 +1,52 @@
+package util
+
+// Statuses of a transfer. Money moves only for completed transfers.
+const (
+	TransferCompleted = "completed"
+	TransferHeld      = "held"
+	TransferDenied    = "denied"
+	TransferRejected  = "rejected"
+)
+
+// Statuses of a transfer approval. Transfers above the approval threshold wait for a banker
+// other than the initiator and are only executed once approved.
+const (
+	TransferApprovalPending  = "pending"
+	TransferApprovalApproved = "approved"
+	TransferApprovalRejected = "rejected"
+	TransferApprovalExpired  = "expired"
+)
+
+// Decisions of a fraud rule on a transfer, from the least to the most severe.
+const (
+	FraudAllow  = "allow"
+	FraudReview = "review"
+	FraudDeny   = "deny"
+)
+
+var fraudDecisionRank = map[string]int{
+	FraudAllow:  0,
+	FraudReview: 1,
+	FraudDeny:   2,
+}
+
+// MoreSevereFraudDecision returns the more severe of the two decisions.
+func MoreSevereFraudDecision(a string, b string) string {
+	if fraudDecisionRank[b] > fraudDecisionRank[a] {
+		return b
+	}
+
+	return a
+}
+
+// TransferStatusForDecision maps the fraud decision on a new transfer to its status.
+func TransferStatusForDecision(decision string) string {
+	switch decision {
+	case FraudReview:
+		return TransferHeld
+	case FraudDeny:
+		return TransferDenied
+	default:
+		return TransferCompleted
+	}
+}
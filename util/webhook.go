@@ -0,0 +1,8 @@
+package util
+
+// Statuses of a webhook delivery. The status reflects the latest delivery attempt.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
@@ -3,7 +3,14 @@ package val
 import (
 	"fmt"
 	"net/mail"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Drolfothesgnir/simplebank/util"
 )
 
 const (
@@ -15,11 +22,33 @@ const (
 	EMAIL_MAX_LENGTH     = 100
 	FULL_NAME_MIN_LENGTH = 3
 	FULL_NAME_MAX_LENGTH = 100
+	PAGE_SIZE_MIN        = 5
+	PAGE_SIZE_MAX        = 20
+
+	WEBHOOK_URL_MIN_LENGTH    = 10
+	WEBHOOK_URL_MAX_LENGTH    = 2048
+	WEBHOOK_SECRET_MIN_LENGTH = 16
+	WEBHOOK_SECRET_MAX_LENGTH = 128
+
+	SEARCH_QUERY_MAX_LENGTH = 100
+	REASON_MIN_LENGTH       = 3
+	REASON_MAX_LENGTH       = 500
+
+	STATEMENT_MAX_SIZE = 4 << 20
+
+	CATEGORY_MIN_LENGTH = 2
+	CATEGORY_MAX_LENGTH = 32
+	MEMO_MAX_LENGTH     = 140
+
+	DISPUTE_REASON_MAX_LENGTH   = 200
+	DISPUTE_EVIDENCE_MAX_LENGTH = 5000
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername   = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName   = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidLedgerCode = regexp.MustCompile(`^[0-9]{4}$`).MatchString
+	isValidCategory   = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 )
 
 func ValidateStringLength(value string, minLength int, maxLength int) error {
@@ -82,3 +111,156 @@ func ValidateEmailID(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateStringLength(value, 32, 128)
 }
+
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+// ValidateAccountNumber checks the length, the bank prefix and the check digits of an account number,
+// so that a mistyped number is rejected before it is looked up. Spaces and lowercase letters are allowed.
+func ValidateAccountNumber(value string) error {
+	number := util.NormalizeAccountNumber(value)
+	if len(number) != util.AccountNumberLength {
+		return fmt.Errorf("account number must contain %d characters", util.AccountNumberLength)
+	}
+
+	if number[:2] != util.AccountNumberCountry || number[4:8] != util.AccountNumberBankCode {
+		return fmt.Errorf("account number must start with %s and have bank code %s", util.AccountNumberCountry, util.AccountNumberBankCode)
+	}
+
+	if util.AccountNumberChecksum(number) != 1 {
+		return fmt.Errorf("account number has invalid check digits")
+	}
+
+	return nil
+}
+
+// ValidateAccountRef checks a reference to an account, which is either its id or its account number.
+func ValidateAccountRef(value string) error {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ValidateID(id)
+	}
+
+	return ValidateAccountNumber(value)
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < PAGE_SIZE_MIN || value > PAGE_SIZE_MAX {
+		return fmt.Errorf("must be between %d-%d", PAGE_SIZE_MIN, PAGE_SIZE_MAX)
+	}
+
+	return nil
+}
+
+func ValidateWebhookURL(value string) error {
+	if err := ValidateStringLength(value, WEBHOOK_URL_MIN_LENGTH, WEBHOOK_URL_MAX_LENGTH); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("must be an absolute https URL")
+	}
+
+	// host names are checked again when the worker connects, after they are resolved
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("must not point to the local host")
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !util.IsPublicIP(addr) {
+		return fmt.Errorf("must not point to a loopback, private, link-local or metadata address")
+	}
+
+	return nil
+}
+
+func ValidateWebhookSecret(value string) error {
+	return ValidateStringLength(value, WEBHOOK_SECRET_MIN_LENGTH, WEBHOOK_SECRET_MAX_LENGTH)
+}
+
+func ValidateSearchQuery(value string) error {
+	return ValidateStringLength(value, 1, SEARCH_QUERY_MAX_LENGTH)
+}
+
+// ValidateReason checks the free-text justification bankers must give for administrative actions.
+func ValidateReason(value string) error {
+	return ValidateStringLength(strings.TrimSpace(value), REASON_MIN_LENGTH, REASON_MAX_LENGTH)
+}
+
+func ValidateLedgerCode(value string) error {
+	if !isValidLedgerCode(value) {
+		return fmt.Errorf("must be a 4-digit code")
+	}
+
+	return nil
+}
+
+func ValidateLedgerAccountName(value string) error {
+	return ValidateStringLength(value, 3, 100)
+}
+
+// ValidateBusinessDate checks that the value is a calendar date in the YYYY-MM-DD format.
+func ValidateBusinessDate(value string) error {
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return fmt.Errorf("must be a date in the YYYY-MM-DD format")
+	}
+
+	return nil
+}
+
+func ValidateStatementContent(value []byte) error {
+	if len(value) == 0 {
+		return fmt.Errorf("must not be empty")
+	}
+
+	if len(value) > STATEMENT_MAX_SIZE {
+		return fmt.Errorf("must be at most %d bytes", STATEMENT_MAX_SIZE)
+	}
+
+	return nil
+}
+
+func ValidateCategory(value string) error {
+	if err := ValidateStringLength(value, CATEGORY_MIN_LENGTH, CATEGORY_MAX_LENGTH); err != nil {
+		return err
+	}
+
+	if !isValidCategory(value) {
+		return fmt.Errorf("category must contain only lowercase letters, digits, or underscores")
+	}
+
+	return nil
+}
+
+func ValidateMemo(value string) error {
+	return ValidateStringLength(value, 0, MEMO_MAX_LENGTH)
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("must be one of %s, %s, %s", util.USD, util.EUR, util.CAD)
+	}
+
+	return nil
+}
+
+func ValidateDisputeReason(value string) error {
+	return ValidateStringLength(value, 1, DISPUTE_REASON_MAX_LENGTH)
+}
+
+func ValidateDisputeEvidence(value string) error {
+	return ValidateStringLength(value, 1, DISPUTE_EVIDENCE_MAX_LENGTH)
+}
@@ -12,6 +12,51 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskDomainEvent(
+		ctx context.Context,
+		payload *PayloadDomainEvent,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDeliverWebhook(
+		ctx context.Context,
+		payload *PayloadDeliverWebhook,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskExportUserData(
+		ctx context.Context,
+		payload *PayloadExportUserData,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskCloseBusinessDay(
+		ctx context.Context,
+		payload *PayloadCloseBusinessDay,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTransferApprovalExpiredEmail(
+		ctx context.Context,
+		payload *PayloadSendTransferApprovalExpiredEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendDisputeEmail(
+		ctx context.Context,
+		payload *PayloadSendDisputeEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTransferNotification(
+		ctx context.Context,
+		payload *PayloadSendTransferNotification,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendNotificationDigest(
+		ctx context.Context,
+		payload *PayloadSendNotificationDigest,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskEvaluateAlerts(
+		ctx context.Context,
+		payload *PayloadEvaluateAlerts,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultEndOfDayInterval = time.Minute
+
+// EndOfDayScheduler enqueues the close of the business days that are over.
+// Days are closed one at a time and in order: the next day is only scheduled
+// once the previous one is closed, so a backlog after downtime is caught up day by day.
+// When no day has been closed yet, the first day to close is yesterday.
+type EndOfDayScheduler struct {
+	store           db.Store
+	taskDistributor TaskDistributor
+	interval        time.Duration
+	now             func() time.Time
+}
+
+func NewEndOfDayScheduler(store db.Store, taskDistributor TaskDistributor, interval time.Duration) *EndOfDayScheduler {
+	if interval <= 0 {
+		interval = defaultEndOfDayInterval
+	}
+
+	return &EndOfDayScheduler{
+		store:           store,
+		taskDistributor: taskDistributor,
+		interval:        interval,
+		now:             time.Now,
+	}
+}
+
+// Run checks for a business day to close every interval until ctx is done.
+func (scheduler *EndOfDayScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := scheduler.ScheduleNext(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to schedule end of day close")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScheduleNext enqueues the close of the next business day if it is over.
+func (scheduler *EndOfDayScheduler) ScheduleNext(ctx context.Context) error {
+	today := db.BusinessDate(scheduler.now()).Time
+
+	next := today.AddDate(0, 0, -1)
+
+	last, err := scheduler.store.GetLastClosedBusinessDay(ctx)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get last closed business day: %w", err)
+	}
+
+	if err == nil {
+		next = last.BusinessDate.Time.AddDate(0, 0, 1)
+	}
+
+	if !next.Before(today) {
+		return nil
+	}
+
+	businessDate := next.Format(util.BusinessDateLayout)
+
+	err = scheduler.taskDistributor.DistributeTaskCloseBusinessDay(
+		ctx,
+		&PayloadCloseBusinessDay{BusinessDate: businessDate},
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueCritical),
+		asynq.TaskID("close_business_day:"+businessDate),
+	)
+
+	// the close is already queued or running
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
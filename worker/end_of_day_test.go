@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func (distributor *fakeDistributor) DistributeTaskCloseBusinessDay(ctx context.Context, payload *PayloadCloseBusinessDay, opts ...asynq.Option) error {
+	return distributor.record(TypeCloseBusinessDay + ":" + payload.BusinessDate)
+}
+
+func TestEndOfDayScheduler(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 0, 30, 0, 0, time.UTC)
+
+	closedDay := func(year int, month time.Month, day int) db.BusinessDay {
+		return db.BusinessDay{BusinessDate: db.BusinessDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))}
+	}
+
+	testCases := []struct {
+		name              string
+		lastClosed        db.BusinessDay
+		lastClosedErr     error
+		fail              map[string]error
+		expectedPublished []string
+	}{
+		{
+			name:              "FirstClose",
+			lastClosedErr:     db.ErrRecordNotFound,
+			expectedPublished: []string{TypeCloseBusinessDay + ":2024-03-09"},
+		},
+		{
+			name:              "CatchUpInOrder",
+			lastClosed:        closedDay(2024, time.March, 6),
+			expectedPublished: []string{TypeCloseBusinessDay + ":2024-03-07"},
+		},
+		{
+			name:       "UpToDate",
+			lastClosed: closedDay(2024, time.March, 9),
+		},
+		{
+			name:       "AlreadyScheduled",
+			lastClosed: closedDay(2024, time.March, 8),
+			fail:       map[string]error{TypeCloseBusinessDay + ":2024-03-09": asynq.ErrTaskIDConflict},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetLastClosedBusinessDay(gomock.Any()).
+				Times(1).
+				Return(tc.lastClosed, tc.lastClosedErr)
+
+			distributor := &fakeDistributor{fail: tc.fail}
+			scheduler := NewEndOfDayScheduler(store, distributor, 0)
+			scheduler.now = func() time.Time { return now }
+
+			err := scheduler.ScheduleNext(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPublished, distributor.published)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	loanCollectionBatchSize       = 100
+	defaultLoanCollectionInterval = time.Hour
+)
+
+// LoanCollector collects the loan installments that are due from the accounts of the borrowers.
+// An installment that can't be collected is charged the late fee once and retried on every run until it is paid.
+type LoanCollector struct {
+	store    db.Store
+	interval time.Duration
+	lateFee  int64
+	now      func() time.Time
+}
+
+func NewLoanCollector(store db.Store, interval time.Duration, lateFee int64) *LoanCollector {
+	if interval <= 0 {
+		interval = defaultLoanCollectionInterval
+	}
+
+	return &LoanCollector{
+		store:    store,
+		interval: interval,
+		lateFee:  lateFee,
+		now:      time.Now,
+	}
+}
+
+// Run collects the due installments every interval until ctx is done.
+func (collector *LoanCollector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(collector.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := collector.CollectDue(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to collect loan installments")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// CollectDue goes through the unpaid installments due by today batch by batch and returns the number of the collected ones.
+// A failure to collect one installment is logged and doesn't stop the others.
+func (collector *LoanCollector) CollectDue(ctx context.Context) (int, error) {
+	today := db.BusinessDate(collector.now())
+	collected := 0
+	var afterID int64
+
+	for {
+		installments, err := collector.store.ListDueLoanInstallments(ctx, db.ListDueLoanInstallmentsParams{
+			DueDate: today,
+			AfterID: afterID,
+			Limit:   loanCollectionBatchSize,
+		})
+		if err != nil {
+			return collected, err
+		}
+
+		for _, installment := range installments {
+			result, err := collector.store.CollectLoanInstallmentTx(ctx, db.CollectLoanInstallmentTxParams{
+				InstallmentID: installment.ID,
+				LateFee:       collector.lateFee,
+			})
+			if err != nil {
+				log.Error().Err(err).Int64("installment_id", installment.ID).Msg("failed to collect loan installment")
+				continue
+			}
+
+			if result.Collected {
+				collected++
+			}
+		}
+
+		if len(installments) < loanCollectionBatchSize {
+			return collected, nil
+		}
+
+		afterID = installments[len(installments)-1].ID
+	}
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoanCollector(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	firstBatch := make([]db.LoanInstallment, loanCollectionBatchSize)
+	for i := range firstBatch {
+		firstBatch[i] = db.LoanInstallment{ID: int64(i + 1)}
+	}
+	lastID := firstBatch[len(firstBatch)-1].ID
+
+	gomock.InOrder(
+		store.EXPECT().
+			ListDueLoanInstallments(gomock.Any(), db.ListDueLoanInstallmentsParams{
+				DueDate: db.BusinessDate(now),
+				AfterID: 0,
+				Limit:   loanCollectionBatchSize,
+			}).
+			Times(1).
+			Return(firstBatch, nil),
+		store.EXPECT().
+			ListDueLoanInstallments(gomock.Any(), db.ListDueLoanInstallmentsParams{
+				DueDate: db.BusinessDate(now),
+				AfterID: lastID,
+				Limit:   loanCollectionBatchSize,
+			}).
+			Times(1).
+			Return([]db.LoanInstallment{{ID: lastID + 1}}, nil),
+	)
+
+	store.EXPECT().
+		CollectLoanInstallmentTx(gomock.Any(), gomock.Any()).
+		Times(loanCollectionBatchSize + 1).
+		DoAndReturn(func(ctx context.Context, arg db.CollectLoanInstallmentTxParams) (db.CollectLoanInstallmentTxResult, error) {
+			require.Equal(t, int64(25), arg.LateFee)
+
+			switch {
+			case arg.InstallmentID == 1:
+				return db.CollectLoanInstallmentTxResult{}, errors.New("boom")
+			case arg.InstallmentID%2 == 0:
+				return db.CollectLoanInstallmentTxResult{Collected: true}, nil
+			}
+
+			// not enough money, the installment stays overdue
+			return db.CollectLoanInstallmentTxResult{}, nil
+		})
+
+	collector := NewLoanCollector(store, 0, 25)
+	collector.now = func() time.Time { return now }
+
+	collected, err := collector.CollectDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, loanCollectionBatchSize/2, collected)
+}
@@ -42,6 +42,177 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTaskCloseBusinessDay mocks base method.
+func (m *MockTaskDistributor) DistributeTaskCloseBusinessDay(ctx context.Context, payload *worker.PayloadCloseBusinessDay, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskCloseBusinessDay", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskCloseBusinessDay indicates an expected call of DistributeTaskCloseBusinessDay.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskCloseBusinessDay(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskCloseBusinessDay", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskCloseBusinessDay), varargs...)
+}
+
+// DistributeTaskDeliverWebhook mocks base method.
+func (m *MockTaskDistributor) DistributeTaskDeliverWebhook(ctx context.Context, payload *worker.PayloadDeliverWebhook, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskDeliverWebhook", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskDeliverWebhook indicates an expected call of DistributeTaskDeliverWebhook.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskDeliverWebhook(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskDeliverWebhook", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskDeliverWebhook), varargs...)
+}
+
+// DistributeTaskDomainEvent mocks base method.
+func (m *MockTaskDistributor) DistributeTaskDomainEvent(ctx context.Context, payload *worker.PayloadDomainEvent, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskDomainEvent", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskDomainEvent indicates an expected call of DistributeTaskDomainEvent.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskDomainEvent(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskDomainEvent", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskDomainEvent), varargs...)
+}
+
+// DistributeTaskEvaluateAlerts mocks base method.
+func (m *MockTaskDistributor) DistributeTaskEvaluateAlerts(ctx context.Context, payload *worker.PayloadEvaluateAlerts, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskEvaluateAlerts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskEvaluateAlerts indicates an expected call of DistributeTaskEvaluateAlerts.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskEvaluateAlerts(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskEvaluateAlerts", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskEvaluateAlerts), varargs...)
+}
+
+// DistributeTaskExportUserData mocks base method.
+func (m *MockTaskDistributor) DistributeTaskExportUserData(ctx context.Context, payload *worker.PayloadExportUserData, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskExportUserData", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskExportUserData indicates an expected call of DistributeTaskExportUserData.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskExportUserData(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskExportUserData", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskExportUserData), varargs...)
+}
+
+// DistributeTaskSendDisputeEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendDisputeEmail(ctx context.Context, payload *worker.PayloadSendDisputeEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendDisputeEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendDisputeEmail indicates an expected call of DistributeTaskSendDisputeEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendDisputeEmail(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendDisputeEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendDisputeEmail), varargs...)
+}
+
+// DistributeTaskSendNotificationDigest mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendNotificationDigest(ctx context.Context, payload *worker.PayloadSendNotificationDigest, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendNotificationDigest", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendNotificationDigest indicates an expected call of DistributeTaskSendNotificationDigest.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendNotificationDigest(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendNotificationDigest", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendNotificationDigest), varargs...)
+}
+
+// DistributeTaskSendTransferApprovalExpiredEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTransferApprovalExpiredEmail(ctx context.Context, payload *worker.PayloadSendTransferApprovalExpiredEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTransferApprovalExpiredEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTransferApprovalExpiredEmail indicates an expected call of DistributeTaskSendTransferApprovalExpiredEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTransferApprovalExpiredEmail(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTransferApprovalExpiredEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTransferApprovalExpiredEmail), varargs...)
+}
+
+// DistributeTaskSendTransferNotification mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTransferNotification(ctx context.Context, payload *worker.PayloadSendTransferNotification, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTransferNotification", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTransferNotification indicates an expected call of DistributeTaskSendTransferNotification.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTransferNotification(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTransferNotification", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTransferNotification), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(ctx context.Context, payload *worker.PayloadSendVerifyEmail, opts ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	notificationDigestBatchSize       = 100
+	defaultNotificationDigestInterval = time.Hour
+)
+
+// NotificationDigestScheduler enqueues a digest for every user with batched notifications every interval.
+// The username is used as the task id, so a user never has two digests queued at once.
+type NotificationDigestScheduler struct {
+	store           db.Store
+	taskDistributor TaskDistributor
+	interval        time.Duration
+}
+
+func NewNotificationDigestScheduler(store db.Store, taskDistributor TaskDistributor, interval time.Duration) *NotificationDigestScheduler {
+	if interval <= 0 {
+		interval = defaultNotificationDigestInterval
+	}
+
+	return &NotificationDigestScheduler{
+		store:           store,
+		taskDistributor: taskDistributor,
+		interval:        interval,
+	}
+}
+
+// Run schedules the digests every interval until ctx is done.
+func (scheduler *NotificationDigestScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := scheduler.ScheduleDigests(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to schedule notification digests")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScheduleDigests enqueues the digests of all the users with batched notifications and returns their number.
+func (scheduler *NotificationDigestScheduler) ScheduleDigests(ctx context.Context) (int, error) {
+	scheduled := 0
+
+	for offset := int32(0); ; offset += notificationDigestBatchSize {
+		recipients, err := scheduler.store.ListDigestRecipients(ctx, db.ListDigestRecipientsParams{
+			Limit:  notificationDigestBatchSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return scheduled, err
+		}
+
+		for _, username := range recipients {
+			err = scheduler.taskDistributor.DistributeTaskSendNotificationDigest(
+				ctx,
+				&PayloadSendNotificationDigest{Username: username},
+				asynq.MaxRetry(5),
+				asynq.Queue(QueueDefault),
+				asynq.TaskID("notification_digest:"+username),
+			)
+			if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+				return scheduled, err
+			}
+
+			scheduled++
+		}
+
+		if len(recipients) < notificationDigestBatchSize {
+			return scheduled, nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	outboxRelayBatchSize       = 100
+	defaultOutboxRelayInterval = time.Second
+)
+
+// OutboxRelay publishes the events stored in the outbox table to the task queue.
+// Every event is published at least once: if the relay fails after the task is enqueued
+// but before the event is marked as sent, the event is published again on the next run.
+// The event id is used as the task id, so such duplicates are dropped by asynq while the first task is retained.
+type OutboxRelay struct {
+	store           db.Store
+	taskDistributor TaskDistributor
+	interval        time.Duration
+}
+
+func NewOutboxRelay(store db.Store, taskDistributor TaskDistributor, interval time.Duration) *OutboxRelay {
+	if interval <= 0 {
+		interval = defaultOutboxRelayInterval
+	}
+
+	return &OutboxRelay{
+		store:           store,
+		taskDistributor: taskDistributor,
+		interval:        interval,
+	}
+}
+
+// Run relays the pending events every interval until ctx is done.
+func (relay *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := relay.RelayPending(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to relay outbox events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes one batch of the pending events and returns the number of the sent ones.
+func (relay *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	arg := db.RelayOutboxTxParams{
+		Limit: outboxRelayBatchSize,
+		Publish: func(event db.Outbox) error {
+			return relay.publish(ctx, event)
+		},
+	}
+
+	result, err := relay.store.RelayOutboxTx(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range result.Failed {
+		log.Error().
+			Int64("event_id", event.ID).
+			Str("event_type", event.EventType).
+			Str("aggregate", event.AggregateType+":"+event.AggregateID).
+			Msg("failed to publish outbox event")
+	}
+
+	return len(result.Sent), nil
+}
+
+func (relay *OutboxRelay) publish(ctx context.Context, event db.Outbox) error {
+	var err error
+
+	switch event.EventType {
+	case db.EventUserCreated:
+		var user db.UserCreatedEvent
+		if err := json.Unmarshal(event.Payload, &user); err != nil {
+			return fmt.Errorf("failed to deserialize event payload: %w", err)
+		}
+
+		payload := &PayloadSendVerifyEmail{Username: user.Username}
+
+		err = relay.taskDistributor.DistributeTaskSendVerifyEmail(ctx, payload, outboxTaskOptions(event, QueueCritical)...)
+	default:
+		payload := &PayloadDomainEvent{
+			ID:            event.ID,
+			AggregateType: event.AggregateType,
+			AggregateID:   event.AggregateID,
+			EventType:     event.EventType,
+			Payload:       event.Payload,
+		}
+
+		err = relay.taskDistributor.DistributeTaskDomainEvent(ctx, payload, outboxTaskOptions(event, QueueDefault)...)
+	}
+
+	// the event has already been published by a previous run
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+func outboxTaskOptions(event db.Outbox, queue string) []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(queue),
+		asynq.TaskID(fmt.Sprintf("outbox:%d", event.ID)),
+	}
+}
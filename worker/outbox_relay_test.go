@@ -0,0 +1,142 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeDistributor records the published tasks instead of enqueuing them.
+// Tasks the relay never publishes are left to the embedded nil interface.
+type fakeDistributor struct {
+	TaskDistributor
+	published []string
+	fail      map[string]error
+}
+
+func (distributor *fakeDistributor) DistributeTaskSendVerifyEmail(ctx context.Context, payload *PayloadSendVerifyEmail, opts ...asynq.Option) error {
+	return distributor.record(TypeVerifyEmail + ":" + payload.Username)
+}
+
+func (distributor *fakeDistributor) DistributeTaskDomainEvent(ctx context.Context, payload *PayloadDomainEvent, opts ...asynq.Option) error {
+	return distributor.record(payload.EventType + ":" + payload.AggregateID)
+}
+
+func (distributor *fakeDistributor) record(key string) error {
+	if err := distributor.fail[key]; err != nil {
+		return err
+	}
+
+	distributor.published = append(distributor.published, key)
+	return nil
+}
+
+func newOutboxEvent(t *testing.T, id int64, aggregateType string, aggregateID string, eventType string, payload any) db.Outbox {
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	return db.Outbox{
+		ID:            id,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+	}
+}
+
+func TestOutboxRelay(t *testing.T) {
+	username := util.RandomOwner()
+
+	events := []db.Outbox{
+		newOutboxEvent(t, 1, db.AggregateUser, username, db.EventUserCreated, db.UserCreatedEvent{Username: username}),
+		newOutboxEvent(t, 2, db.AggregateTransfer, "7", db.EventTransferCompleted, db.Transfer{ID: 7}),
+		newOutboxEvent(t, 3, db.AggregateTransfer, "8", db.EventTransferCompleted, db.Transfer{ID: 8}),
+		newOutboxEvent(t, 4, db.AggregateUser, username, db.EventEmailVerified, db.EmailVerifiedEvent{Username: username}),
+	}
+
+	testCases := []struct {
+		name              string
+		fail              map[string]error
+		expectedPublished []string
+		expectedSent      int
+	}{
+		{
+			name: "OK",
+			expectedPublished: []string{
+				TypeVerifyEmail + ":" + username,
+				db.EventTransferCompleted + ":7",
+				db.EventTransferCompleted + ":8",
+				db.EventEmailVerified + ":" + username,
+			},
+			expectedSent: 4,
+		},
+		{
+			name: "FailureKeepsAggregateOrder",
+			fail: map[string]error{TypeVerifyEmail + ":" + username: errors.New("redis is down")},
+			expectedPublished: []string{
+				db.EventTransferCompleted + ":7",
+				db.EventTransferCompleted + ":8",
+			},
+			expectedSent: 2,
+		},
+		{
+			name: "AlreadyPublished",
+			fail: map[string]error{db.EventTransferCompleted + ":7": asynq.ErrTaskIDConflict},
+			expectedPublished: []string{
+				TypeVerifyEmail + ":" + username,
+				db.EventTransferCompleted + ":8",
+				db.EventEmailVerified + ":" + username,
+			},
+			expectedSent: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				RelayOutboxTx(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, arg db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
+					// mimics the per aggregate blocking of the store
+					var result db.RelayOutboxTxResult
+					blocked := make(map[string]bool)
+					for _, event := range events {
+						aggregate := event.AggregateType + ":" + event.AggregateID
+						if blocked[aggregate] {
+							continue
+						}
+
+						if err := arg.Publish(event); err != nil {
+							blocked[aggregate] = true
+							result.Failed = append(result.Failed, event)
+							continue
+						}
+
+						result.Sent = append(result.Sent, event)
+					}
+
+					return result, nil
+				})
+
+			distributor := &fakeDistributor{fail: tc.fail}
+			relay := NewOutboxRelay(store, distributor, 0)
+
+			sent, err := relay.RelayPending(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSent, sent)
+			require.Equal(t, tc.expectedPublished, distributor.published)
+		})
+	}
+}
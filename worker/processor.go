@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
 	"github.com/Drolfothesgnir/simplebank/mail"
@@ -19,12 +20,23 @@ type TaskProcessor interface {
 	Start() error
 	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDomainEvent(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCloseBusinessDay(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTransferApprovalExpiredEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendDisputeEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTransferNotification(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendNotificationDigest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEvaluateAlerts(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server      *asynq.Server
-	store       db.Store
-	emailSender mail.EmailSender
+	server          *asynq.Server
+	store           db.Store
+	emailSender     mail.EmailSender
+	taskDistributor TaskDistributor
+	httpClient      *http.Client
 }
 
 func reportError(ctx context.Context, task *asynq.Task, err error) {
@@ -39,25 +51,42 @@ func reportError(ctx context.Context, task *asynq.Task, err error) {
 		Msg("task failed")
 }
 
-func NewRedisTaskProcessor(clientOpt asynq.RedisClientOpt, store db.Store, emailSender mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(
+	clientOpt asynq.RedisClientOpt,
+	store db.Store,
+	emailSender mail.EmailSender,
+	taskDistributor TaskDistributor,
+) TaskProcessor {
 	server := asynq.NewServer(clientOpt, asynq.Config{
 		Queues: map[string]int{
 			QueueCritical: 10,
 			QueueDefault:  5,
 		},
-		ErrorHandler: asynq.ErrorHandlerFunc(reportError),
-		Logger:       NewLogger(),
+		ErrorHandler:   asynq.ErrorHandlerFunc(reportError),
+		RetryDelayFunc: retryDelay,
+		Logger:         NewLogger(),
 	})
 	return &RedisTaskProcessor{
-		server:      server,
-		store:       store,
-		emailSender: emailSender,
+		server:          server,
+		store:           store,
+		emailSender:     emailSender,
+		taskDistributor: taskDistributor,
+		httpClient:      newWebhookClient(),
 	}
 }
 
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TypeVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TypeDomainEvent, processor.ProcessTaskDomainEvent)
+	mux.HandleFunc(TypeDeliverWebhook, processor.ProcessTaskDeliverWebhook)
+	mux.HandleFunc(TypeExportUserData, processor.ProcessTaskExportUserData)
+	mux.HandleFunc(TypeCloseBusinessDay, processor.ProcessTaskCloseBusinessDay)
+	mux.HandleFunc(TypeSendTransferApprovalExpiredEmail, processor.ProcessTaskSendTransferApprovalExpiredEmail)
+	mux.HandleFunc(TypeSendDisputeEmail, processor.ProcessTaskSendDisputeEmail)
+	mux.HandleFunc(TypeSendTransferNotification, processor.ProcessTaskSendTransferNotification)
+	mux.HandleFunc(TypeSendNotificationDigest, processor.ProcessTaskSendNotificationDigest)
+	mux.HandleFunc(TypeEvaluateAlerts, processor.ProcessTaskEvaluateAlerts)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeCloseBusinessDay = "ledger:close_business_day"
+
+type PayloadCloseBusinessDay struct {
+	BusinessDate string `json:"business_date"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskCloseBusinessDay(
+	ctx context.Context,
+	payload *PayloadCloseBusinessDay,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize close business day payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeCloseBusinessDay, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue close business day task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskCloseBusinessDay(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadCloseBusinessDay
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	businessDate, err := time.Parse(util.BusinessDateLayout, payload.BusinessDate)
+	if err != nil {
+		return fmt.Errorf("invalid business date %q: %v: %w", payload.BusinessDate, err, asynq.SkipRetry)
+	}
+
+	result, err := processor.store.CloseBusinessDayTx(ctx, db.CloseBusinessDayTxParams{BusinessDate: businessDate})
+	if err != nil {
+		// closed by a previous run of the task
+		if errors.Is(err, db.ErrBusinessDayAlreadyClosed) {
+			return nil
+		}
+
+		if errors.Is(err, db.ErrBusinessDayNotOver) {
+			return fmt.Errorf("cannot close business day %s: %v: %w", payload.BusinessDate, err, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to close business day %s: %w", payload.BusinessDate, err)
+	}
+
+	for _, total := range result.Totals {
+		log.Info().
+			Str("business_date", payload.BusinessDate).
+			Str("currency", total.Currency).
+			Int64("debits", total.TotalDebits).
+			Int64("credits", total.TotalCredits).
+			Int64("entries", total.EntryCount).
+			Int64("closing_balance", total.ClosingBalance).
+			Msg("daily totals")
+	}
+
+	log.Info().
+		Str("business_date", payload.BusinessDate).
+		Int64("snapshots", result.Snapshots).
+		Msg("closed business day")
+
+	return nil
+}
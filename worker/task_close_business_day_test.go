@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProcessTaskCloseBusinessDay(t *testing.T) {
+	businessDate := time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		businessDate  string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name:         "OK",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CloseBusinessDayTx(gomock.Any(), gomock.Eq(db.CloseBusinessDayTxParams{BusinessDate: businessDate})).
+					Times(1).
+					Return(db.CloseBusinessDayTxResult{Snapshots: 3}, nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "AlreadyClosed",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CloseBusinessDayTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloseBusinessDayTxResult{}, db.ErrBusinessDayAlreadyClosed)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "NotOver",
+			businessDate: "2024-03-09",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CloseBusinessDayTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloseBusinessDayTxResult{}, db.ErrBusinessDayNotOver)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+		{
+			name:         "InvalidDate",
+			businessDate: "09.03.2024",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CloseBusinessDayTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			payload, err := json.Marshal(PayloadCloseBusinessDay{BusinessDate: tc.businessDate})
+			require.NoError(t, err)
+
+			processor := &RedisTaskProcessor{store: store}
+			err = processor.ProcessTaskCloseBusinessDay(context.Background(), asynq.NewTask(TypeCloseBusinessDay, payload))
+			tc.checkResponse(t, err)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TypeDeliverWebhook = "webhook:deliver"
+)
+
+// Headers sent with every webhook request.
+const (
+	WebhookEventHeader     = "X-Simplebank-Event"
+	WebhookDeliveryHeader  = "X-Simplebank-Delivery"
+	WebhookSignatureHeader = "X-Simplebank-Signature"
+	WebhookTimestampHeader = "X-Simplebank-Timestamp"
+)
+
+const (
+	webhookMaxRetry       = 8
+	webhookBaseRetryDelay = 10 * time.Second
+	webhookMaxRetryDelay  = time.Hour
+	webhookMaxErrorLength = 255
+	webhookTimeout        = 10 * time.Second
+)
+
+// errWebhookAddress is returned when a webhook host resolves to an address that isn't public.
+var errWebhookAddress = errors.New("webhook host resolves to a loopback, private, link-local or metadata address")
+
+// WebhookEventTypes are the events a webhook can subscribe to.
+var WebhookEventTypes = []string{
+	db.EventTransferCompleted,
+	db.EventEmailVerified,
+}
+
+func IsWebhookEventType(eventType string) bool {
+	return slices.Contains(WebhookEventTypes, eventType)
+}
+
+type PayloadDeliverWebhook struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+// WebhookRequestBody is the JSON body posted to the webhook URL.
+type WebhookRequestBody struct {
+	DeliveryID int64           `json:"delivery_id"`
+	EventID    int64           `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// SignWebhookPayload returns the value of the signature header for the body sent at the timestamp,
+// a hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret.
+// The timestamp is signed so receivers can reject replayed deliveries.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookClient returns the client that delivers webhooks. The URL is validated when the webhook
+// is created, but its host may resolve to a different address later, so the address is checked again
+// on every connection. Redirects are not followed.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !util.IsPublicIP(addrPort.Addr()) {
+				return errWebhookAddress
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookRetryDelay doubles the delay after every failed attempt of a webhook delivery.
+func webhookRetryDelay(n int) time.Duration {
+	delay := webhookBaseRetryDelay * time.Duration(math.Pow(2, float64(n)))
+	if delay <= 0 || delay > webhookMaxRetryDelay {
+		return webhookMaxRetryDelay
+	}
+
+	return delay
+}
+
+func retryDelay(n int, err error, task *asynq.Task) time.Duration {
+	if task.Type() == TypeDeliverWebhook {
+		return webhookRetryDelay(n)
+	}
+
+	return asynq.DefaultRetryDelayFunc(n, err, task)
+}
+
+func webhookTaskOptions() []asynq.Option {
+	return []asynq.Option{
+		asynq.MaxRetry(webhookMaxRetry),
+		asynq.Queue(QueueDefault),
+	}
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskDeliverWebhook(
+	ctx context.Context,
+	payload *PayloadDeliverWebhook,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize webhook delivery payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeDeliverWebhook, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// scheduleWebhookDeliveries records a delivery for every webhook of the owners subscribed to the event
+// and enqueues it. Scheduling the same event twice reuses the recorded deliveries.
+func (processor *RedisTaskProcessor) scheduleWebhookDeliveries(ctx context.Context, event *PayloadDomainEvent, owners []string) error {
+	for _, owner := range owners {
+		webhooks, err := processor.store.ListWebhooksForEvent(ctx, db.ListWebhooksForEventParams{
+			Owner:     owner,
+			EventType: event.EventType,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list webhooks: %w", err)
+		}
+
+		for _, webhook := range webhooks {
+			delivery, err := processor.store.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+				WebhookID: webhook.ID,
+				EventID:   event.ID,
+				EventType: event.EventType,
+				Payload:   event.Payload,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create webhook delivery: %w", err)
+			}
+
+			opts := append(webhookTaskOptions(), asynq.TaskID(fmt.Sprintf("webhook-delivery:%d", delivery.ID)))
+
+			err = processor.taskDistributor.DistributeTaskDeliverWebhook(ctx, &PayloadDeliverWebhook{DeliveryID: delivery.ID}, opts...)
+			if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+				return fmt.Errorf("failed to distribute webhook delivery: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskDeliverWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDeliverWebhook
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	delivery, err := processor.store.GetWebhookDelivery(ctx, payload.DeliveryID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("webhook delivery doesn't exist: %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	webhook, err := processor.store.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("webhook doesn't exist: %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	responseStatus, deliveryErr := deliverWebhook(ctx, processor.httpClient, webhook, delivery)
+
+	arg := db.UpdateWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         util.WebhookDeliverySucceeded,
+		ResponseStatus: pgtype.Int4{Int32: int32(responseStatus), Valid: responseStatus != 0},
+	}
+
+	if deliveryErr != nil {
+		errMessage := deliveryErr.Error()
+		if len(errMessage) > webhookMaxErrorLength {
+			errMessage = errMessage[:webhookMaxErrorLength]
+		}
+
+		arg.Status = util.WebhookDeliveryFailed
+		arg.LastError = pgtype.Text{String: errMessage, Valid: true}
+	}
+
+	_, err = processor.store.UpdateWebhookDeliveryAttempt(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+
+	if deliveryErr != nil {
+		return fmt.Errorf("failed to deliver webhook: %w", deliveryErr)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int64("delivery_id", delivery.ID).
+		Str("url", webhook.Url).
+		Msg("processed task")
+
+	return nil
+}
+
+// deliverWebhook posts the signed delivery to the webhook URL.
+// It returns the response status code, or 0 if no response was received.
+func deliverWebhook(ctx context.Context, client *http.Client, webhook db.Webhook, delivery db.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(WebhookRequestBody{
+		DeliveryID: delivery.ID,
+		EventID:    delivery.EventID,
+		EventType:  delivery.EventType,
+		CreatedAt:  delivery.CreatedAt,
+		Data:       delivery.Payload,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	timestamp := time.Now().Unix()
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProcessTaskDeliverWebhook(t *testing.T) {
+	secret := util.RandomString(32)
+
+	delivery := db.WebhookDelivery{
+		ID:        util.RandomInt(1, 1000),
+		WebhookID: util.RandomInt(1, 1000),
+		EventID:   util.RandomInt(1, 1000),
+		EventType: db.EventTransferCompleted,
+		Payload:   []byte(`{"id":1,"amount":10}`),
+		Status:    util.WebhookDeliveryPending,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	testCases := []struct {
+		name           string
+		responseStatus int
+		expectedStatus string
+		expectError    bool
+	}{
+		{
+			name:           "OK",
+			responseStatus: http.StatusNoContent,
+			expectedStatus: util.WebhookDeliverySucceeded,
+		},
+		{
+			name:           "ReceiverError",
+			responseStatus: http.StatusInternalServerError,
+			expectedStatus: util.WebhookDeliveryFailed,
+			expectError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				require.Equal(t, http.MethodPost, r.Method)
+				timestamp, err := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+				require.NoError(t, err)
+				require.WithinDuration(t, time.Now(), time.Unix(timestamp, 0), time.Minute)
+				require.Equal(t, SignWebhookPayload(secret, timestamp, body), r.Header.Get(WebhookSignatureHeader))
+				require.Equal(t, delivery.EventType, r.Header.Get(WebhookEventHeader))
+				require.Equal(t, strconv.FormatInt(delivery.ID, 10), r.Header.Get(WebhookDeliveryHeader))
+
+				var got WebhookRequestBody
+				require.NoError(t, json.Unmarshal(body, &got))
+				require.Equal(t, delivery.ID, got.DeliveryID)
+				require.Equal(t, delivery.EventID, got.EventID)
+				require.JSONEq(t, string(delivery.Payload), string(got.Data))
+
+				w.WriteHeader(tc.responseStatus)
+			}))
+			defer receiver.Close()
+
+			webhook := db.Webhook{
+				ID:         delivery.WebhookID,
+				Owner:      util.RandomOwner(),
+				Url:        receiver.URL,
+				EventTypes: []string{delivery.EventType},
+				Secret:     secret,
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetWebhookDelivery(gomock.Any(), gomock.Eq(delivery.ID)).Times(1).Return(delivery, nil)
+			store.EXPECT().GetWebhook(gomock.Any(), gomock.Eq(webhook.ID)).Times(1).Return(webhook, nil)
+			store.EXPECT().
+				UpdateWebhookDeliveryAttempt(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, arg db.UpdateWebhookDeliveryAttemptParams) (db.WebhookDelivery, error) {
+					require.Equal(t, delivery.ID, arg.ID)
+					require.Equal(t, tc.expectedStatus, arg.Status)
+					require.Equal(t, int32(tc.responseStatus), arg.ResponseStatus.Int32)
+					require.Equal(t, tc.expectError, arg.LastError.Valid)
+					return delivery, nil
+				})
+
+			processor := &RedisTaskProcessor{store: store, httpClient: receiver.Client()}
+
+			payload, err := json.Marshal(PayloadDeliverWebhook{DeliveryID: delivery.ID})
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskDeliverWebhook(context.Background(), asynq.NewTask(TypeDeliverWebhook, payload))
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSignWebhookPayloadCoversTimestamp(t *testing.T) {
+	secret := util.RandomString(32)
+	body := []byte(`{"id":1}`)
+
+	signature := SignWebhookPayload(secret, 1700000000, body)
+	require.Equal(t, signature, SignWebhookPayload(secret, 1700000000, body))
+	require.NotEqual(t, signature, SignWebhookPayload(secret, 1700000001, body))
+}
+
+func TestWebhookClientRefusesLocalAddresses(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the webhook client must not reach a loopback address")
+	}))
+	defer receiver.Close()
+
+	res, err := newWebhookClient().Post(receiver.URL, "application/json", nil)
+	if res != nil {
+		res.Body.Close()
+	}
+	require.ErrorIs(t, err, errWebhookAddress)
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	require.Equal(t, webhookBaseRetryDelay, webhookRetryDelay(0))
+	require.Equal(t, 2*webhookBaseRetryDelay, webhookRetryDelay(1))
+	require.Equal(t, 8*webhookBaseRetryDelay, webhookRetryDelay(3))
+	require.Equal(t, webhookMaxRetryDelay, webhookRetryDelay(100))
+}
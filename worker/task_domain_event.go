@@ -0,0 +1,229 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TypeDomainEvent = "domain:event"
+)
+
+// PayloadDomainEvent carries an outbox event to the task processor.
+type PayloadDomainEvent struct {
+	ID            int64           `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskDomainEvent(
+	ctx context.Context,
+	payload *PayloadDomainEvent,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize domain event payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeDomainEvent, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue domain event task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskDomainEvent(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDomainEvent
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	if IsWebhookEventType(payload.EventType) {
+		owners, err := processor.eventOwners(ctx, &payload)
+		if err != nil {
+			return err
+		}
+
+		if err := processor.scheduleWebhookDeliveries(ctx, &payload, owners); err != nil {
+			return err
+		}
+	}
+
+	switch payload.EventType {
+	case db.EventTransferCompleted:
+		if err := processor.scheduleTransferNotification(ctx, &payload); err != nil {
+			return err
+		}
+	case db.EventTransferApprovalExpired:
+		if err := processor.scheduleTransferApprovalExpiredEmail(ctx, &payload); err != nil {
+			return err
+		}
+	case db.EventDisputeStatusChanged:
+		if err := processor.scheduleDisputeEmail(ctx, &payload); err != nil {
+			return err
+		}
+	case db.EventAccountPosted:
+		if err := processor.scheduleAlertEvaluation(ctx, &payload); err != nil {
+			return err
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int64("event_id", payload.ID).
+		Str("event_type", payload.EventType).
+		Str("aggregate", payload.AggregateType+":"+payload.AggregateID).
+		Msg("processed task")
+
+	return nil
+}
+
+// scheduleTransferApprovalExpiredEmail enqueues the email to the initiator of the expired approval.
+// The approval id is used as the task id, so a redelivered event doesn't send the email twice.
+func (processor *RedisTaskProcessor) scheduleTransferApprovalExpiredEmail(ctx context.Context, event *PayloadDomainEvent) error {
+	var approval db.TransferApproval
+	if err := json.Unmarshal(event.Payload, &approval); err != nil {
+		return fmt.Errorf("failed to deserialize event payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err := processor.taskDistributor.DistributeTaskSendTransferApprovalExpiredEmail(
+		ctx,
+		&PayloadSendTransferApprovalExpiredEmail{ApprovalID: approval.ID},
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueDefault),
+		asynq.TaskID(fmt.Sprintf("transfer_approval_expired:%d", approval.ID)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// scheduleTransferNotification enqueues the notifications of a completed transfer once the transfer is committed.
+func (processor *RedisTaskProcessor) scheduleTransferNotification(ctx context.Context, event *PayloadDomainEvent) error {
+	var transfer db.Transfer
+	if err := json.Unmarshal(event.Payload, &transfer); err != nil {
+		return fmt.Errorf("failed to deserialize event payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err := processor.taskDistributor.DistributeTaskSendTransferNotification(
+		ctx,
+		&PayloadSendTransferNotification{TransferID: transfer.ID},
+		asynq.MaxRetry(5),
+		asynq.Queue(QueueDefault),
+		asynq.TaskID(fmt.Sprintf("transfer_notification:%d", transfer.ID)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// scheduleDisputeEmail enqueues the email about the new status of the dispute.
+// The dispute id and status are used as the task id, so a redelivered event doesn't send the email twice.
+func (processor *RedisTaskProcessor) scheduleDisputeEmail(ctx context.Context, event *PayloadDomainEvent) error {
+	var dispute db.Dispute
+	if err := json.Unmarshal(event.Payload, &dispute); err != nil {
+		return fmt.Errorf("failed to deserialize event payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err := processor.taskDistributor.DistributeTaskSendDisputeEmail(
+		ctx,
+		&PayloadSendDisputeEmail{DisputeID: dispute.ID, Status: dispute.Status},
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueDefault),
+		asynq.TaskID(fmt.Sprintf("dispute_email:%d:%s", dispute.ID, dispute.Status)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// scheduleAlertEvaluation enqueues the evaluation of the alert rules of the posted account.
+// The event id is used as the task id, so a redelivered event doesn't evaluate the posting twice.
+func (processor *RedisTaskProcessor) scheduleAlertEvaluation(ctx context.Context, event *PayloadDomainEvent) error {
+	var posted db.AccountPostedEvent
+	if err := json.Unmarshal(event.Payload, &posted); err != nil {
+		return fmt.Errorf("failed to deserialize event payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err := processor.taskDistributor.DistributeTaskEvaluateAlerts(
+		ctx,
+		&PayloadEvaluateAlerts{AccountID: posted.AccountID, Amount: posted.Amount},
+		asynq.MaxRetry(5),
+		asynq.Queue(QueueDefault),
+		asynq.TaskID(fmt.Sprintf("alert_evaluation:%d", event.ID)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// eventOwners returns the users the event is about. Events of a transfer go to the owners
+// and the joint holders of both accounts.
+func (processor *RedisTaskProcessor) eventOwners(ctx context.Context, event *PayloadDomainEvent) ([]string, error) {
+	switch event.AggregateType {
+	case db.AggregateUser:
+		return []string{event.AggregateID}, nil
+	case db.AggregateTransfer:
+		var transfer db.Transfer
+		if err := json.Unmarshal(event.Payload, &transfer); err != nil {
+			return nil, fmt.Errorf("failed to deserialize event payload: %v: %w", err, asynq.SkipRetry)
+		}
+
+		var owners []string
+		for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+			account, err := processor.store.GetAccount(ctx, accountID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get account: %w", err)
+			}
+
+			if !slices.Contains(owners, account.Owner) {
+				owners = append(owners, account.Owner)
+			}
+
+			holders, err := processor.store.ListAccountHolders(ctx, accountID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list account holders: %w", err)
+			}
+
+			for _, holder := range holders {
+				if !slices.Contains(owners, holder.Username) {
+					owners = append(owners, holder.Username)
+				}
+			}
+		}
+
+		return owners, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEventOwnersIncludesHolders(t *testing.T) {
+	from := db.Account{ID: 1, Owner: util.RandomOwner()}
+	to := db.Account{ID: 2, Owner: util.RandomOwner()}
+	coOwner := util.RandomOwner()
+	viewer := util.RandomOwner()
+
+	transfer := db.Transfer{ID: 7, FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10}
+	payload, err := json.Marshal(transfer)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+	store.EXPECT().
+		ListAccountHolders(gomock.Any(), gomock.Eq(from.ID)).
+		Times(1).
+		Return([]db.AccountHolder{{AccountID: from.ID, Username: coOwner, Role: util.CoOwnerHolderRole}}, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+	store.EXPECT().
+		ListAccountHolders(gomock.Any(), gomock.Eq(to.ID)).
+		Times(1).
+		Return([]db.AccountHolder{
+			{AccountID: to.ID, Username: viewer, Role: util.ViewerHolderRole},
+			{AccountID: to.ID, Username: coOwner, Role: util.CoOwnerHolderRole},
+		}, nil)
+
+	processor := &RedisTaskProcessor{store: store}
+
+	owners, err := processor.eventOwners(context.Background(), &PayloadDomainEvent{
+		AggregateType: db.AggregateTransfer,
+		EventType:     db.EventTransferCompleted,
+		Payload:       payload,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{from.Owner, coOwner, to.Owner, viewer}, owners)
+}
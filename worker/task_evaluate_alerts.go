@@ -0,0 +1,249 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeEvaluateAlerts = "alert:evaluate"
+
+type PayloadEvaluateAlerts struct {
+	AccountID int64 `json:"account_id"`
+	// Amount is the posting that changed the balance, negative for debits.
+	Amount int64 `json:"amount"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskEvaluateAlerts(
+	ctx context.Context,
+	payload *PayloadEvaluateAlerts,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize evaluate alerts payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeEvaluateAlerts, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue evaluate alerts task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskEvaluateAlerts evaluates the alert rules of the account after a posting and emails
+// the holders whose rules fired. The balance is read at processing time, so postings processed
+// out of order don't raise alerts for a balance the account no longer has.
+func (processor *RedisTaskProcessor) ProcessTaskEvaluateAlerts(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadEvaluateAlerts
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	rules, err := processor.store.ListAlertRulesByAccount(ctx, payload.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to list alert rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// the rules are evaluated right after the balance changed, the replica may not have caught up yet
+	account, err := processor.store.GetAccount(db.WithReadYourWrites(ctx), payload.AccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("account [%d] does not exist: %w", payload.AccountID, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	fired := 0
+	for _, rule := range rules {
+		ok, err := processor.evaluateAlertRule(ctx, rule, account, payload.Amount)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			fired++
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int64("account", account.ID).Int("fired", fired).Msg("processed alert rules")
+
+	return nil
+}
+
+// evaluateAlertRule sends the alert if the rule fires and was not triggered already.
+// It reports whether the alert was sent.
+func (processor *RedisTaskProcessor) evaluateAlertRule(ctx context.Context, rule db.AlertRule, account db.Account, amount int64) (bool, error) {
+	var value int64
+	var rearmBefore pgtype.Timestamptz
+
+	switch rule.Kind {
+	case util.AlertLowBalance:
+		// the money set aside in savings pots can't be spent, so it doesn't count
+		available := account.Balance - account.PotBalance
+		if available >= rule.Threshold {
+			if rule.TriggeredAt.Valid {
+				if err := processor.store.RearmAlertRule(ctx, rule.ID); err != nil {
+					return false, fmt.Errorf("failed to rearm alert rule: %w", err)
+				}
+			}
+
+			return false, nil
+		}
+
+		value = available
+	case util.AlertLargeDebit:
+		if -amount <= rule.Threshold {
+			return false, nil
+		}
+
+		value = -amount
+		// every large debit is alerted on its own
+		rearmBefore = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	case util.AlertDailySpend:
+		if amount >= 0 {
+			return false, nil
+		}
+
+		now := time.Now().UTC()
+		startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		total, err := processor.store.GetDebitTotalSince(ctx, db.GetDebitTotalSinceParams{
+			AccountID: account.ID,
+			CreatedAt: startOfDay,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get the debits of the day: %w", err)
+		}
+
+		if total <= rule.Threshold {
+			return false, nil
+		}
+
+		value = total
+		rearmBefore = pgtype.Timestamptz{Time: startOfDay, Valid: true}
+	default:
+		return false, nil
+	}
+
+	holds, err := processor.holdsAccount(ctx, account, rule.Username)
+	if err != nil {
+		return false, err
+	}
+
+	// the user was removed from the account after creating the rule
+	if !holds {
+		return false, nil
+	}
+
+	triggered, err := processor.store.TriggerAlertRule(ctx, db.TriggerAlertRuleParams{
+		ID:          rule.ID,
+		RearmBefore: rearmBefore,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to trigger alert rule: %w", err)
+	}
+
+	if triggered == 0 {
+		return false, nil
+	}
+
+	if err := processor.sendAlert(ctx, rule, account, value); err != nil {
+		// rearm the rule, so the retry sends the alert
+		if rearmErr := processor.store.RearmAlertRule(ctx, rule.ID); rearmErr != nil {
+			log.Error().Err(rearmErr).Int64("rule", rule.ID).Msg("failed to rearm alert rule")
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// holdsAccount reports whether the user still owns or holds the account.
+func (processor *RedisTaskProcessor) holdsAccount(ctx context.Context, account db.Account, username string) (bool, error) {
+	if account.Owner == username {
+		return true, nil
+	}
+
+	_, err := processor.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: account.ID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get account holder: %w", err)
+	}
+
+	return true, nil
+}
+
+func (processor *RedisTaskProcessor) sendAlert(ctx context.Context, rule db.AlertRule, account db.Account, value int64) error {
+	user, err := processor.store.GetUser(ctx, rule.Username)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user information: %w", err)
+	}
+
+	subject, content := renderAlert(user, rule, account, value)
+
+	err = processor.emailSender.SendEmail(subject, content, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send alert: %w", err)
+	}
+
+	return nil
+}
+
+func renderAlert(user db.User, rule db.AlertRule, account db.Account, value int64) (subject string, content string) {
+	switch rule.Kind {
+	case util.AlertLowBalance:
+		subject = fmt.Sprintf("Low balance on account %s", account.Number)
+		content = fmt.Sprintf(`
+			Hello %s, <br/>
+			The available balance of your account %s dropped to %d %s, below your alert threshold of %d %s.<br/>
+			We won't alert you again until the balance is back above the threshold.<br/>
+		`, user.FullName, account.Number, value, account.Currency, rule.Threshold, account.Currency)
+	case util.AlertLargeDebit:
+		subject = fmt.Sprintf("Large debit of %d %s", value, account.Currency)
+		content = fmt.Sprintf(`
+			Hello %s, <br/>
+			%d %s was debited from your account %s, above your alert threshold of %d %s.<br/>
+		`, user.FullName, value, account.Currency, account.Number, rule.Threshold, account.Currency)
+	case util.AlertDailySpend:
+		subject = fmt.Sprintf("Daily spend limit passed on account %s", account.Number)
+		content = fmt.Sprintf(`
+			Hello %s, <br/>
+			Your account %s spent %d %s today, above your daily limit of %d %s.<br/>
+		`, user.FullName, account.Number, value, account.Currency, rule.Threshold, account.Currency)
+	}
+
+	return
+}
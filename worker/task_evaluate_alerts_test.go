@@ -0,0 +1,193 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func (distributor *fakeDistributor) DistributeTaskEvaluateAlerts(ctx context.Context, payload *PayloadEvaluateAlerts, opts ...asynq.Option) error {
+	return distributor.record(fmt.Sprintf("%s:%d:%d", TypeEvaluateAlerts, payload.AccountID, payload.Amount))
+}
+
+func TestProcessTaskDomainEventSchedulesAlertEvaluation(t *testing.T) {
+	posted := db.AccountPostedEvent{AccountID: util.RandomInt(1, 1000), Amount: -50, Balance: 20}
+	event := newOutboxEvent(t, 1, db.AggregateAccount, strconv.FormatInt(posted.AccountID, 10), db.EventAccountPosted, posted)
+
+	distributor := &fakeDistributor{}
+	processor := &RedisTaskProcessor{taskDistributor: distributor}
+
+	payload, err := json.Marshal(PayloadDomainEvent{
+		ID:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+	})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskDomainEvent(context.Background(), asynq.NewTask(TypeDomainEvent, payload))
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("%s:%d:%d", TypeEvaluateAlerts, posted.AccountID, posted.Amount)}, distributor.published)
+}
+
+func TestProcessTaskEvaluateAlerts(t *testing.T) {
+	owner := db.User{Username: util.RandomOwner(), FullName: util.RandomOwner(), Email: util.RandomEmail()}
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: owner.Username, Balance: 40, Currency: util.USD, Number: util.RandomAccountNumber()}
+
+	newRule := func(kind string, threshold int64) db.AlertRule {
+		return db.AlertRule{ID: util.RandomInt(1, 1000), AccountID: account.ID, Username: owner.Username, Kind: kind, Threshold: threshold}
+	}
+
+	testCases := []struct {
+		name       string
+		amount     int64
+		potBalance int64
+		rules      []db.AlertRule
+		buildStubs func(store *mockdb.MockStore, rules []db.AlertRule)
+		expectedTo []string
+	}{
+		{
+			name:   "LowBalance",
+			amount: -60,
+			rules:  []db.AlertRule{newRule(util.AlertLowBalance, 100)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				arg := db.TriggerAlertRuleParams{ID: rules[0].ID}
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+			},
+			expectedTo: []string{owner.Email},
+		},
+		{
+			name:       "LowBalanceOfPotMove",
+			potBalance: 30,
+			rules:      []db.AlertRule{newRule(util.AlertLowBalance, 20)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				arg := db.TriggerAlertRuleParams{ID: rules[0].ID}
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+			},
+			expectedTo: []string{owner.Email},
+		},
+		{
+			name:   "LowBalanceAlreadyTriggered",
+			amount: -10,
+			rules:  []db.AlertRule{newRule(util.AlertLowBalance, 100)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:   "LowBalanceRecovered",
+			amount: 30,
+			rules: func() []db.AlertRule {
+				rule := newRule(util.AlertLowBalance, 20)
+				rule.TriggeredAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+				return []db.AlertRule{rule}
+			}(),
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().RearmAlertRule(gomock.Any(), gomock.Eq(rules[0].ID)).Times(1).Return(nil)
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:   "LargeDebit",
+			amount: -500,
+			rules:  []db.AlertRule{newRule(util.AlertLargeDebit, 100), newRule(util.AlertLargeDebit, 1000)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().
+					TriggerAlertRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.TriggerAlertRuleParams) (int64, error) {
+						require.Equal(t, rules[0].ID, arg.ID)
+						require.True(t, arg.RearmBefore.Valid)
+						return 1, nil
+					})
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+			},
+			expectedTo: []string{owner.Email},
+		},
+		{
+			name:   "DailySpend",
+			amount: -50,
+			rules:  []db.AlertRule{newRule(util.AlertDailySpend, 200)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().GetDebitTotalSince(gomock.Any(), gomock.Any()).Times(1).Return(int64(250), nil)
+				store.EXPECT().
+					TriggerAlertRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.TriggerAlertRuleParams) (int64, error) {
+						now := time.Now().UTC()
+						require.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), arg.RearmBefore.Time)
+						return 1, nil
+					})
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(owner.Username)).Times(1).Return(owner, nil)
+			},
+			expectedTo: []string{owner.Email},
+		},
+		{
+			name:   "DailySpendIgnoresCredits",
+			amount: 50,
+			rules:  []db.AlertRule{newRule(util.AlertDailySpend, 200)},
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().GetDebitTotalSince(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:   "HolderRemoved",
+			amount: -60,
+			rules: func() []db.AlertRule {
+				rule := newRule(util.AlertLowBalance, 100)
+				rule.Username = util.RandomOwner()
+				return []db.AlertRule{rule}
+			}(),
+			buildStubs: func(store *mockdb.MockStore, rules []db.AlertRule) {
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountHolder{}, db.ErrRecordNotFound)
+				store.EXPECT().TriggerAlertRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().ListAlertRulesByAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(tc.rules, nil)
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, id int64) (db.Account, error) {
+					require.True(t, db.IsReadYourWrites(ctx))
+					account := account
+					account.PotBalance = tc.potBalance
+					return account, nil
+				})
+			tc.buildStubs(store, tc.rules)
+
+			emailSender := &fakeEmailSender{}
+			processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+			payload, err := json.Marshal(PayloadEvaluateAlerts{AccountID: account.ID, Amount: tc.amount})
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskEvaluateAlerts(context.Background(), asynq.NewTask(TypeEvaluateAlerts, payload))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTo, emailSender.to)
+		})
+	}
+}
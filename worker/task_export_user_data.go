@@ -0,0 +1,267 @@
+package worker
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TypeExportUserData = "user:export_data"
+
+	// DataExportTTL is how long a finished archive can be downloaded.
+	DataExportTTL = 24 * time.Hour
+)
+
+type PayloadExportUserData struct {
+	ExportID int64 `json:"export_id"`
+}
+
+// The exported records leave out credentials: the password hash, refresh tokens and verification codes.
+type exportedUser struct {
+	Username          string    `json:"username"`
+	Role              string    `json:"role"`
+	FullName          string    `json:"full_name"`
+	Email             string    `json:"email"`
+	IsEmailVerified   bool      `json:"is_email_verified"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type exportedSession struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIp  string    `json:"client_ip"`
+	IsBlocked bool      `json:"is_blocked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportedVerificationEmail struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	IsUsed    bool      `json:"is_used"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// dataExportFile is a JSON file of the export archive.
+type dataExportFile struct {
+	Name    string
+	Content any
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskExportUserData(
+	ctx context.Context,
+	payload *PayloadExportUserData,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize user data export payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeExportUserData, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue user data export task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadExportUserData
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// the export has just been requested, so the replica may not have it yet
+	export, err := processor.store.GetDataExport(db.WithReadYourWrites(ctx), payload.ExportID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("data export [%d] does not exist: %w", payload.ExportID, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	if export.Status != util.DataExportPending {
+		return nil
+	}
+
+	user, archive, err := processor.collectUserData(ctx, export.Username)
+	if err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			if failErr := processor.store.FailDataExport(ctx, export.ID); failErr != nil {
+				log.Error().Err(failErr).Int64("export", export.ID).Msg("failed to mark data export as failed")
+			}
+		}
+
+		return fmt.Errorf("failed to collect user data: %w", err)
+	}
+
+	// only the hash of the token is stored, the token itself is known to the user's inbox alone
+	downloadToken, err := util.NewSecret(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate download token: %w", err)
+	}
+
+	export, err = processor.store.CompleteDataExport(ctx, db.CompleteDataExportParams{
+		ID:                export.ID,
+		Archive:           archive,
+		ExpiresAt:         pgtype.Timestamptz{Time: time.Now().Add(DataExportTTL), Valid: true},
+		DownloadTokenHash: pgtype.Text{String: util.HashSecret(downloadToken), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete data export: %w", err)
+	}
+
+	downloadUrl := fmt.Sprintf(
+		"http://localhost:8080/v1/data_exports/%d/archive?token=%s",
+		export.ID,
+		downloadToken,
+	)
+
+	err = processor.emailSender.SendEmail(
+		"Your Simple Bank data export is ready",
+		fmt.Sprintf(`
+			Hello %s, <br/>
+			The copy of your personal data you requested is ready.<br/>
+			Please <a href="%s">click here</a> to download it. The link expires on %s.<br/>
+		`, user.FullName, downloadUrl, export.ExpiresAt.Time.Format(time.RFC1123)),
+		[]string{user.Email},
+		nil, nil, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send data export email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("user", user.Username).Int64("export", export.ID).Msg("exported user data")
+
+	return nil
+}
+
+// collectUserData gathers everything stored about the user into a ZIP archive.
+func (processor *RedisTaskProcessor) collectUserData(ctx context.Context, username string) (db.User, []byte, error) {
+	user, err := processor.store.GetUser(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	sessions, err := processor.store.ListSessionsByUsername(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	accounts, err := processor.store.ListAccountsByOwner(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	entries, err := processor.store.ListEntriesByOwner(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	transfers, err := processor.store.ListTransfersByOwner(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to list transfers: %w", err)
+	}
+
+	verificationEmails, err := processor.store.ListVerificationEmailsByUsername(ctx, username)
+	if err != nil {
+		return db.User{}, nil, fmt.Errorf("failed to list verification emails: %w", err)
+	}
+
+	exportedSessions := make([]exportedSession, len(sessions))
+	for i, session := range sessions {
+		exportedSessions[i] = exportedSession{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			ClientIp:  session.ClientIp,
+			IsBlocked: session.IsBlocked,
+			ExpiresAt: session.ExpiresAt,
+			CreatedAt: session.CreatedAt,
+		}
+	}
+
+	exportedEmails := make([]exportedVerificationEmail, len(verificationEmails))
+	for i, email := range verificationEmails {
+		exportedEmails[i] = exportedVerificationEmail{
+			ID:        email.ID,
+			Email:     email.Email,
+			IsUsed:    email.IsUsed,
+			CreatedAt: email.CreatedAt,
+			ExpiredAt: email.ExpiredAt,
+		}
+	}
+
+	archive, err := buildDataExportArchive([]dataExportFile{
+		{Name: "profile.json", Content: exportedUser{
+			Username:          user.Username,
+			Role:              user.Role,
+			FullName:          user.FullName,
+			Email:             user.Email,
+			IsEmailVerified:   user.IsEmailVerified,
+			PasswordChangedAt: user.PasswordChangedAt,
+			CreatedAt:         user.CreatedAt,
+		}},
+		{Name: "sessions.json", Content: exportedSessions},
+		{Name: "accounts.json", Content: accounts},
+		{Name: "entries.json", Content: entries},
+		{Name: "transfers.json", Content: transfers},
+		{Name: "verification_emails.json", Content: exportedEmails},
+	})
+	if err != nil {
+		return db.User{}, nil, err
+	}
+
+	return user, archive, nil
+}
+
+func buildDataExportArchive(files []dataExportFile) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, file := range files {
+		w, err := zw.Create(file.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to the archive: %w", file.Name, err)
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.Content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close the archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
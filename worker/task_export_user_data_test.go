@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeEmailSender records the sent emails instead of sending them.
+type fakeEmailSender struct {
+	to      []string
+	content string
+}
+
+func (sender *fakeEmailSender) SendEmail(subject string, content string, to []string, cc []string, bcc []string, attachFiles []string) error {
+	sender.to = to
+	sender.content = content
+	return nil
+}
+
+func readArchive(t *testing.T, archive []byte) map[string][]byte {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[file.Name] = content
+	}
+
+	return files
+}
+
+func TestProcessTaskExportUserData(t *testing.T) {
+	user := db.User{
+		Username:       util.RandomOwner(),
+		HashedPassword: "hashed-" + util.RandomString(16),
+		FullName:       util.RandomOwner(),
+		Email:          util.RandomEmail(),
+		Role:           util.DepositorRole,
+	}
+
+	account := db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    user.Username,
+		Balance:  util.RandomMoney(),
+		Currency: util.RandomCurrency(),
+	}
+
+	session := db.Session{
+		Username:     user.Username,
+		RefreshToken: "refresh-" + util.RandomString(16),
+		UserAgent:    "test",
+		ClientIp:     "127.0.0.1",
+	}
+
+	export := db.DataExport{
+		ID:       util.RandomInt(1, 1000),
+		Username: user.Username,
+		Status:   util.DataExportPending,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var archive []byte
+	var tokenHash string
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetDataExport(gomock.Any(), gomock.Eq(export.ID)).Times(1).Return(export, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().ListSessionsByUsername(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.Session{session}, nil)
+	store.EXPECT().ListAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.Account{account}, nil)
+	store.EXPECT().ListEntriesByOwner(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.Entry{}, nil)
+	store.EXPECT().ListTransfersByOwner(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.Transfer{}, nil)
+	store.EXPECT().ListVerificationEmailsByUsername(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.VerificationEmail{}, nil)
+	store.EXPECT().
+		CompleteDataExport(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.CompleteDataExportParams) (db.DataExport, error) {
+			require.Equal(t, export.ID, arg.ID)
+			require.WithinDuration(t, time.Now().Add(DataExportTTL), arg.ExpiresAt.Time, time.Minute)
+
+			require.True(t, arg.DownloadTokenHash.Valid)
+
+			archive = arg.Archive
+			tokenHash = arg.DownloadTokenHash.String
+
+			completed := export
+			completed.Status = util.DataExportReady
+			completed.Archive = arg.Archive
+			completed.ExpiresAt = arg.ExpiresAt
+			completed.DownloadTokenHash = arg.DownloadTokenHash
+			return completed, nil
+		})
+
+	emailSender := &fakeEmailSender{}
+	processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+	payload, err := json.Marshal(PayloadExportUserData{ExportID: export.ID})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskExportUserData(context.Background(), asynq.NewTask(TypeExportUserData, payload))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{user.Email}, emailSender.to)
+	// the email carries the token, the store only its hash
+	match := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(emailSender.content)
+	require.Len(t, match, 2)
+	require.Equal(t, tokenHash, util.HashSecret(match[1]))
+	require.NotContains(t, emailSender.content, tokenHash)
+
+	files := readArchive(t, archive)
+	require.Len(t, files, 6)
+
+	var profile map[string]any
+	require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	require.Equal(t, user.Email, profile["email"])
+
+	var accounts []db.Account
+	require.NoError(t, json.Unmarshal(files["accounts.json"], &accounts))
+	require.Equal(t, []db.Account{account}, accounts)
+
+	// credentials never leave the bank
+	for name, content := range files {
+		require.False(t, strings.Contains(string(content), user.HashedPassword), name)
+		require.False(t, strings.Contains(string(content), session.RefreshToken), name)
+	}
+}
+
+func TestProcessTaskExportUserDataAlreadyDone(t *testing.T) {
+	export := db.DataExport{
+		ID:       util.RandomInt(1, 1000),
+		Username: util.RandomOwner(),
+		Status:   util.DataExportReady,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetDataExport(gomock.Any(), gomock.Eq(export.ID)).Times(1).Return(export, nil)
+	store.EXPECT().CompleteDataExport(gomock.Any(), gomock.Any()).Times(0)
+
+	processor := &RedisTaskProcessor{store: store}
+
+	payload, err := json.Marshal(PayloadExportUserData{ExportID: export.ID})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskExportUserData(context.Background(), asynq.NewTask(TypeExportUserData, payload))
+	require.NoError(t, err)
+}
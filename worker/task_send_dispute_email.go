@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeSendDisputeEmail = "dispute:send_email"
+
+// PayloadSendDisputeEmail carries the status the dispute moved to, since the dispute may move on
+// before the email is sent and every status change is reported.
+type PayloadSendDisputeEmail struct {
+	DisputeID int64  `json:"dispute_id"`
+	Status    string `json:"status"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendDisputeEmail(
+	ctx context.Context,
+	payload *PayloadSendDisputeEmail,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize dispute email payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeSendDisputeEmail, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue dispute email task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendDisputeEmail tells the user who opened the dispute about its new status.
+func (processor *RedisTaskProcessor) ProcessTaskSendDisputeEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendDisputeEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	dispute, err := processor.store.GetDispute(ctx, payload.DisputeID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("dispute [%d] does not exist: %w", payload.DisputeID, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get dispute: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, dispute.OpenedBy)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("user [%s] does not exist: %w", dispute.OpenedBy, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to retrieve user information: %w", err)
+	}
+
+	subject, message := disputeEmailMessage(dispute, payload.Status)
+
+	err = processor.emailSender.SendEmail(
+		subject,
+		fmt.Sprintf(`
+			Hello %s, <br/>
+			%s<br/>
+		`, user.FullName, message),
+		[]string{user.Email},
+		nil, nil, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send dispute email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("user", user.Username).Int64("dispute", dispute.ID).Str("status", payload.Status).Msg("sent dispute email")
+
+	return nil
+}
+
+func disputeEmailMessage(dispute db.Dispute, status string) (subject string, message string) {
+	switch status {
+	case util.DisputeOpen:
+		return fmt.Sprintf("We received your dispute #%d", dispute.ID),
+			fmt.Sprintf("We received your dispute of transfer %d. A banker will look into it shortly.", dispute.TransferID)
+	case util.DisputeInvestigating:
+		return fmt.Sprintf("We are investigating your dispute #%d", dispute.ID),
+			fmt.Sprintf("A banker has started investigating your dispute of transfer %d.", dispute.TransferID)
+	case util.DisputeResolvedCustomer:
+		return fmt.Sprintf("Your dispute #%d was resolved in your favour", dispute.ID),
+			fmt.Sprintf("Your dispute of transfer %d was resolved in your favour and the amount was credited back to your account.<br/>%s",
+				dispute.TransferID, dispute.ResolutionNote.String)
+	case util.DisputeResolvedMerchant:
+		return fmt.Sprintf("Your dispute #%d was resolved", dispute.ID),
+			fmt.Sprintf("Your dispute of transfer %d was resolved in favour of the merchant, so the transfer stands.<br/>%s",
+				dispute.TransferID, dispute.ResolutionNote.String)
+	}
+
+	return fmt.Sprintf("Your dispute #%d was updated", dispute.ID),
+		fmt.Sprintf("Your dispute of transfer %d is now %s.", dispute.TransferID, status)
+}
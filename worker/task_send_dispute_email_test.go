@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func (distributor *fakeDistributor) DistributeTaskSendDisputeEmail(ctx context.Context, payload *PayloadSendDisputeEmail, opts ...asynq.Option) error {
+	return distributor.record(fmt.Sprintf("%s:%d:%s", TypeSendDisputeEmail, payload.DisputeID, payload.Status))
+}
+
+func TestDomainEventSchedulesDisputeEmail(t *testing.T) {
+	dispute := db.Dispute{
+		ID:       util.RandomInt(1, 1000),
+		OpenedBy: util.RandomOwner(),
+		Status:   util.DisputeInvestigating,
+	}
+	event := newOutboxEvent(t, 1, db.AggregateDispute, strconv.FormatInt(dispute.ID, 10), db.EventDisputeStatusChanged, dispute)
+
+	distributor := &fakeDistributor{}
+	processor := &RedisTaskProcessor{taskDistributor: distributor}
+
+	payload, err := json.Marshal(PayloadDomainEvent{
+		ID:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+	})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskDomainEvent(context.Background(), asynq.NewTask(TypeDomainEvent, payload))
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("%s:%d:%s", TypeSendDisputeEmail, dispute.ID, util.DisputeInvestigating)}, distributor.published)
+}
+
+func TestProcessTaskSendDisputeEmail(t *testing.T) {
+	user := db.User{
+		Username: util.RandomOwner(),
+		FullName: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+	}
+
+	dispute := db.Dispute{
+		ID:             util.RandomInt(1, 1000),
+		TransferID:     util.RandomInt(1, 1000),
+		OpenedBy:       user.Username,
+		Status:         util.DisputeResolvedCustomer,
+		ResolutionNote: pgtype.Text{String: "the card was reported stolen", Valid: true},
+	}
+
+	testCases := []struct {
+		name            string
+		status          string
+		expectedSubject string
+	}{
+		{name: "Open", status: util.DisputeOpen, expectedSubject: "We received your dispute"},
+		{name: "Investigating", status: util.DisputeInvestigating, expectedSubject: "We are investigating your dispute"},
+		{name: "ResolvedCustomer", status: util.DisputeResolvedCustomer, expectedSubject: "resolved in your favour"},
+		{name: "ResolvedMerchant", status: util.DisputeResolvedMerchant, expectedSubject: "was resolved"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+
+			emailSender := &fakeEmailSender{}
+			processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+			payload, err := json.Marshal(PayloadSendDisputeEmail{DisputeID: dispute.ID, Status: tc.status})
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskSendDisputeEmail(context.Background(), asynq.NewTask(TypeSendDisputeEmail, payload))
+			require.NoError(t, err)
+			require.Equal(t, []string{user.Email}, emailSender.to)
+			require.Contains(t, emailSender.content, strconv.FormatInt(dispute.TransferID, 10))
+
+			subject, _ := disputeEmailMessage(dispute, tc.status)
+			require.Contains(t, subject, tc.expectedSubject)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeSendNotificationDigest = "notification:send_digest"
+
+type PayloadSendNotificationDigest struct {
+	Username string `json:"username"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendNotificationDigest(
+	ctx context.Context,
+	payload *PayloadSendNotificationDigest,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification digest payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeSendNotificationDigest, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue notification digest task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendNotificationDigest sends the user one email with all the small transfers batched since the last digest.
+// The items are only marked as sent after the email is sent, so a failed digest is retried in full.
+func (processor *RedisTaskProcessor) ProcessTaskSendNotificationDigest(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendNotificationDigest
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	items, err := processor.store.ListPendingDigestItems(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to list digest items: %w", err)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("user [%s] does not exist: %w", payload.Username, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to retrieve user information: %w", err)
+	}
+
+	subject, content := renderNotificationDigest(user, items)
+
+	err = processor.emailSender.SendEmail(subject, content, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send notification digest: %w", err)
+	}
+
+	err = processor.store.MarkDigestItemsSent(ctx, db.MarkDigestItemsSentParams{
+		Username:   user.Username,
+		LastItemID: items[len(items)-1].ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark digest items as sent: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("user", user.Username).Int("items", len(items)).Msg("sent notification digest")
+
+	return nil
+}
+
+func renderNotificationDigest(user db.User, items []db.NotificationDigestItem) (subject string, content string) {
+	var lines strings.Builder
+	for _, item := range items {
+		direction := "sent from"
+		if item.EventType == util.NotificationMoneyIn {
+			direction = "received on"
+		}
+
+		fmt.Fprintf(&lines, "%s: %d %s %s account %d<br/>\n",
+			item.CreatedAt.Format("2006-01-02 15:04"), item.Amount, item.Currency, direction, item.AccountID)
+	}
+
+	subject = fmt.Sprintf("Your Simple Bank activity: %d transfers", len(items))
+	content = fmt.Sprintf(`
+		Hello %s, <br/>
+		Here are the transfers below your notification threshold since your last digest:<br/>
+		%s
+	`, user.FullName, lines.String())
+	return
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeSendTransferApprovalExpiredEmail = "transfer_approval:send_expired_email"
+
+type PayloadSendTransferApprovalExpiredEmail struct {
+	ApprovalID int64 `json:"approval_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendTransferApprovalExpiredEmail(
+	ctx context.Context,
+	payload *PayloadSendTransferApprovalExpiredEmail,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize transfer approval expired email payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeSendTransferApprovalExpiredEmail, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue transfer approval expired email task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendTransferApprovalExpiredEmail tells the initiator of a transfer that no banker decided on it in time.
+func (processor *RedisTaskProcessor) ProcessTaskSendTransferApprovalExpiredEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTransferApprovalExpiredEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	approval, err := processor.store.GetTransferApproval(ctx, payload.ApprovalID)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("transfer approval [%d] does not exist: %w", payload.ApprovalID, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get transfer approval: %w", err)
+	}
+
+	if approval.Status != util.TransferApprovalExpired {
+		return fmt.Errorf("transfer approval [%d] is %s, not expired: %w", approval.ID, approval.Status, asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUser(ctx, approval.InitiatedBy)
+	if err != nil {
+		if err == db.ErrRecordNotFound {
+			return fmt.Errorf("user [%s] does not exist: %w", approval.InitiatedBy, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to retrieve user information: %w", err)
+	}
+
+	err = processor.emailSender.SendEmail(
+		"Your Simple Bank transfer has expired",
+		fmt.Sprintf(`
+			Hello %s, <br/>
+			Your transfer of %d %s from account %d to account %d required an approval by the bank.<br/>
+			It was not approved before %s, so it has expired and no money was moved.<br/>
+			Please submit the transfer again if you still want to make it.<br/>
+		`, user.FullName, approval.Amount, approval.Currency, approval.FromAccountID, approval.ToAccountID,
+			approval.ExpiresAt.Format(time.RFC1123)),
+		[]string{user.Email},
+		nil, nil, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send transfer approval expired email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("user", user.Username).Int64("approval", approval.ID).Msg("sent transfer approval expired email")
+
+	return nil
+}
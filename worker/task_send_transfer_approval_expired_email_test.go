@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func (distributor *fakeDistributor) DistributeTaskSendTransferApprovalExpiredEmail(ctx context.Context, payload *PayloadSendTransferApprovalExpiredEmail, opts ...asynq.Option) error {
+	return distributor.record(fmt.Sprintf("%s:%d", TypeSendTransferApprovalExpiredEmail, payload.ApprovalID))
+}
+
+func randomExpiredTransferApproval(initiator string) db.TransferApproval {
+	return db.TransferApproval{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1001, 2000),
+		Amount:        util.RandomMoney(),
+		Currency:      util.USD,
+		InitiatedBy:   initiator,
+		Status:        util.TransferApprovalExpired,
+		ExpiresAt:     time.Now().Add(-time.Minute),
+		CreatedAt:     time.Now().Add(-24 * time.Hour),
+	}
+}
+
+func TestDomainEventSchedulesTransferApprovalExpiredEmail(t *testing.T) {
+	approval := randomExpiredTransferApproval(util.RandomOwner())
+	event := newOutboxEvent(t, 1, db.AggregateTransferApproval, strconv.FormatInt(approval.ID, 10), db.EventTransferApprovalExpired, approval)
+
+	distributor := &fakeDistributor{}
+	processor := &RedisTaskProcessor{taskDistributor: distributor}
+
+	payload, err := json.Marshal(PayloadDomainEvent{
+		ID:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+	})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskDomainEvent(context.Background(), asynq.NewTask(TypeDomainEvent, payload))
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("%s:%d", TypeSendTransferApprovalExpiredEmail, approval.ID)}, distributor.published)
+}
+
+func TestProcessTaskSendTransferApprovalExpiredEmail(t *testing.T) {
+	user := db.User{
+		Username: util.RandomOwner(),
+		FullName: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+	}
+
+	testCases := []struct {
+		name        string
+		status      string
+		expectEmail bool
+		expectErr   bool
+	}{
+		{name: "Expired", status: util.TransferApprovalExpired, expectEmail: true},
+		{name: "DecidedMeanwhile", status: util.TransferApprovalApproved, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			approval := randomExpiredTransferApproval(user.Username)
+			approval.Status = tc.status
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetTransferApproval(gomock.Any(), gomock.Eq(approval.ID)).Times(1).Return(approval, nil)
+
+			getUserCalls := 0
+			if tc.expectEmail {
+				getUserCalls = 1
+			}
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(getUserCalls).Return(user, nil)
+
+			emailSender := &fakeEmailSender{}
+			processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+			payload, err := json.Marshal(PayloadSendTransferApprovalExpiredEmail{ApprovalID: approval.ID})
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskSendTransferApprovalExpiredEmail(context.Background(), asynq.NewTask(TypeSendTransferApprovalExpiredEmail, payload))
+			if tc.expectErr {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectEmail {
+				require.Equal(t, []string{user.Email}, emailSender.to)
+				require.Contains(t, emailSender.content, strconv.FormatInt(approval.Amount, 10))
+			} else {
+				require.Empty(t, emailSender.to)
+			}
+		})
+	}
+}
+
+func TestTransferApprovalExpirer(t *testing.T) {
+	now := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fullBatch := make([]db.TransferApproval, transferApprovalExpiryBatchSize)
+	lastBatch := []db.TransferApproval{randomExpiredTransferApproval(util.RandomOwner())}
+
+	arg := db.ExpireTransferApprovalsTxParams{Now: now, BatchSize: transferApprovalExpiryBatchSize}
+
+	store := mockdb.NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().ExpireTransferApprovalsTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(fullBatch, nil),
+		store.EXPECT().ExpireTransferApprovalsTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(lastBatch, nil),
+	)
+
+	expirer := NewTransferApprovalExpirer(store, 0)
+	expirer.now = func() time.Time { return now }
+
+	expired, err := expirer.ExpireOverdue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, transferApprovalExpiryBatchSize+1, expired)
+}
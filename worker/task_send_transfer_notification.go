@@ -0,0 +1,175 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeSendTransferNotification = "notification:send_transfer"
+
+type PayloadSendTransferNotification struct {
+	TransferID int64 `json:"transfer_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendTransferNotification(
+	ctx context.Context,
+	payload *PayloadSendTransferNotification,
+	opts ...asynq.Option,
+) error {
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize transfer notification payload: %w", err)
+	}
+
+	task := asynq.NewTask(TypeSendTransferNotification, jsonPayload, opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue transfer notification task: %w", err)
+	}
+
+	log.Info().
+		Str("type", info.Type).
+		Str("id", info.ID).
+		Str("queue", info.Queue).
+		Bytes("payload", info.Payload).
+		Int("max retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendTransferNotification notifies the owners of both accounts of a completed transfer
+// according to their preferences. Transfers below the minimum amount of the preference are added
+// to the owner's digest instead. A retry may notify the owner of the first account again.
+func (processor *RedisTaskProcessor) ProcessTaskSendTransferNotification(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTransferNotification
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	transfer, err := processor.store.GetTransfer(ctx, payload.TransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("transfer [%d] does not exist: %w", payload.TransferID, asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	sides := []struct {
+		accountID int64
+		eventType string
+	}{
+		{transfer.FromAccountID, util.NotificationMoneyOut},
+		{transfer.ToAccountID, util.NotificationMoneyIn},
+	}
+
+	for _, side := range sides {
+		if err := processor.notifyTransfer(ctx, transfer, side.accountID, side.eventType); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int64("transfer", transfer.ID).Msg("processed transfer notification")
+
+	return nil
+}
+
+// notifyTransfer notifies the owner and the other holders of the account of one side of the transfer.
+func (processor *RedisTaskProcessor) notifyTransfer(ctx context.Context, transfer db.Transfer, accountID int64, eventType string) error {
+	account, err := processor.store.GetAccount(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	holders, err := processor.store.ListAccountHolders(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list account holders: %w", err)
+	}
+
+	usernames := []string{account.Owner}
+	for _, holder := range holders {
+		usernames = append(usernames, holder.Username)
+	}
+
+	for _, username := range usernames {
+		if err := processor.notifyHolder(ctx, transfer, account, username, eventType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyHolder emails the transfer to a holder of the account or adds it to the holder's digest,
+// according to the holder's notification preference.
+func (processor *RedisTaskProcessor) notifyHolder(ctx context.Context, transfer db.Transfer, account db.Account, username string, eventType string) error {
+	preference, err := processor.store.GetNotificationPreference(ctx, db.GetNotificationPreferenceParams{
+		Username:  username,
+		EventType: eventType,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get notification preference: %w", err)
+	}
+
+	if transfer.Amount < preference.MinAmount {
+		err = processor.store.CreateNotificationDigestItem(ctx, db.CreateNotificationDigestItemParams{
+			Username:   username,
+			EventType:  eventType,
+			TransferID: transfer.ID,
+			AccountID:  account.ID,
+			Amount:     transfer.Amount,
+			Currency:   account.Currency,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add transfer to the digest: %w", err)
+		}
+
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve user information: %w", err)
+	}
+
+	subject, content := renderTransferNotification(user, account, transfer, eventType)
+
+	err = processor.emailSender.SendEmail(subject, content, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send transfer notification: %w", err)
+	}
+
+	return nil
+}
+
+func renderTransferNotification(user db.User, account db.Account, transfer db.Transfer, eventType string) (subject string, content string) {
+	if eventType == util.NotificationMoneyIn {
+		subject = fmt.Sprintf("You received %d %s", transfer.Amount, account.Currency)
+		content = fmt.Sprintf(`
+			Hello %s, <br/>
+			Your account %s received %d %s from account %d.<br/>
+		`, user.FullName, account.Number, transfer.Amount, account.Currency, transfer.FromAccountID)
+		return
+	}
+
+	subject = fmt.Sprintf("You sent %d %s", transfer.Amount, account.Currency)
+	content = fmt.Sprintf(`
+		Hello %s, <br/>
+		%d %s left your account %s to account %d.<br/>
+	`, user.FullName, transfer.Amount, account.Currency, account.Number, transfer.ToAccountID)
+	return
+}
@@ -0,0 +1,200 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/Drolfothesgnir/simplebank/db/mock"
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/Drolfothesgnir/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func (distributor *fakeDistributor) DistributeTaskSendTransferNotification(ctx context.Context, payload *PayloadSendTransferNotification, opts ...asynq.Option) error {
+	return distributor.record(fmt.Sprintf("%s:%d", TypeSendTransferNotification, payload.TransferID))
+}
+
+func (distributor *fakeDistributor) DistributeTaskSendNotificationDigest(ctx context.Context, payload *PayloadSendNotificationDigest, opts ...asynq.Option) error {
+	return distributor.record(TypeSendNotificationDigest + ":" + payload.Username)
+}
+
+func TestProcessTaskSendTransferNotification(t *testing.T) {
+	sender := db.User{Username: util.RandomOwner(), FullName: util.RandomOwner(), Email: util.RandomEmail()}
+	receiver := db.User{Username: util.RandomOwner(), FullName: util.RandomOwner(), Email: util.RandomEmail()}
+	coOwner := db.User{Username: util.RandomOwner(), FullName: util.RandomOwner(), Email: util.RandomEmail()}
+
+	fromAccount := db.Account{ID: util.RandomInt(1, 1000), Owner: sender.Username, Currency: util.USD, Number: util.RandomAccountNumber()}
+	toAccount := db.Account{ID: util.RandomInt(1001, 2000), Owner: receiver.Username, Currency: util.USD, Number: util.RandomAccountNumber()}
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        500,
+		Status:        util.TransferCompleted,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		expectedTo []string
+	}{
+		{
+			name: "EmailAboveMinAmount",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().ListAccountHolders(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: sender.Username, EventType: util.NotificationMoneyOut})).
+					Times(1).
+					Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().ListAccountHolders(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: receiver.Username, EventType: util.NotificationMoneyIn})).
+					Times(1).
+					Return(db.NotificationPreference{Username: receiver.Username, EventType: util.NotificationMoneyIn, MinAmount: 100, Channel: util.NotificationChannelEmail}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(receiver.Username)).Times(1).Return(receiver, nil)
+				store.EXPECT().CreateNotificationDigestItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedTo: []string{receiver.Email},
+		},
+		{
+			name: "EmailHolder",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().ListAccountHolders(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: sender.Username, EventType: util.NotificationMoneyOut})).
+					Times(1).
+					Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					ListAccountHolders(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return([]db.AccountHolder{{AccountID: toAccount.ID, Username: coOwner.Username, Role: util.CoOwnerHolderRole}}, nil)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: receiver.Username, EventType: util.NotificationMoneyIn})).
+					Times(1).
+					Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: coOwner.Username, EventType: util.NotificationMoneyIn})).
+					Times(1).
+					Return(db.NotificationPreference{Username: coOwner.Username, EventType: util.NotificationMoneyIn, Channel: util.NotificationChannelEmail}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(coOwner.Username)).Times(1).Return(coOwner, nil)
+			},
+			expectedTo: []string{coOwner.Email},
+		},
+		{
+			name: "DigestBelowMinAmount",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().ListAccountHolders(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().
+					GetNotificationPreference(gomock.Any(), gomock.Eq(db.GetNotificationPreferenceParams{Username: sender.Username, EventType: util.NotificationMoneyOut})).
+					Times(1).
+					Return(db.NotificationPreference{Username: sender.Username, EventType: util.NotificationMoneyOut, MinAmount: 1000, Channel: util.NotificationChannelEmail}, nil)
+				store.EXPECT().
+					CreateNotificationDigestItem(gomock.Any(), gomock.Eq(db.CreateNotificationDigestItemParams{
+						Username:   sender.Username,
+						EventType:  util.NotificationMoneyOut,
+						TransferID: transfer.ID,
+						AccountID:  fromAccount.ID,
+						Amount:     transfer.Amount,
+						Currency:   fromAccount.Currency,
+					})).
+					Times(1).
+					Return(nil)
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().ListAccountHolders(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().GetNotificationPreference(gomock.Any(), gomock.Any()).Times(1).Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+			tc.buildStubs(store)
+
+			emailSender := &fakeEmailSender{}
+			processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+			payload, err := json.Marshal(PayloadSendTransferNotification{TransferID: transfer.ID})
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskSendTransferNotification(context.Background(), asynq.NewTask(TypeSendTransferNotification, payload))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTo, emailSender.to)
+		})
+	}
+}
+
+func TestProcessTaskSendNotificationDigest(t *testing.T) {
+	user := db.User{Username: util.RandomOwner(), FullName: util.RandomOwner(), Email: util.RandomEmail()}
+
+	items := []db.NotificationDigestItem{
+		{ID: 1, Username: user.Username, EventType: util.NotificationMoneyIn, Amount: 3, Currency: util.EUR, CreatedAt: time.Now()},
+		{ID: 7, Username: user.Username, EventType: util.NotificationMoneyOut, Amount: 5, Currency: util.EUR, CreatedAt: time.Now()},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListPendingDigestItems(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(items, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().
+		MarkDigestItemsSent(gomock.Any(), gomock.Eq(db.MarkDigestItemsSentParams{Username: user.Username, LastItemID: 7})).
+		Times(1).
+		Return(nil)
+
+	emailSender := &fakeEmailSender{}
+	processor := &RedisTaskProcessor{store: store, emailSender: emailSender}
+
+	payload, err := json.Marshal(PayloadSendNotificationDigest{Username: user.Username})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskSendNotificationDigest(context.Background(), asynq.NewTask(TypeSendNotificationDigest, payload))
+	require.NoError(t, err)
+	require.Equal(t, []string{user.Email}, emailSender.to)
+	require.Contains(t, emailSender.content, "3 EUR received on")
+	require.Contains(t, emailSender.content, "5 EUR sent from")
+}
+
+func TestNotificationDigestScheduler(t *testing.T) {
+	usernames := []string{util.RandomOwner(), util.RandomOwner()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListDigestRecipients(gomock.Any(), gomock.Eq(db.ListDigestRecipientsParams{Limit: notificationDigestBatchSize, Offset: 0})).
+		Times(1).
+		Return(usernames, nil)
+
+	distributor := &fakeDistributor{fail: map[string]error{
+		// the digest of the first user is still queued
+		TypeSendNotificationDigest + ":" + usernames[0]: asynq.ErrTaskIDConflict,
+	}}
+	scheduler := NewNotificationDigestScheduler(store, distributor, 0)
+
+	scheduled, err := scheduler.ScheduleDigests(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, scheduled)
+	require.Equal(t, []string{TypeSendNotificationDigest + ":" + usernames[1]}, distributor.published)
+}
@@ -54,7 +54,8 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to deserialize task payload: %v: %w", err, asynq.SkipRetry)
 	}
 
-	user, err := processor.store.GetUser(ctx, payload.Username)
+	// the user has just been created, so the replica may not have it yet
+	user, err := processor.store.GetUser(db.WithReadYourWrites(ctx), payload.Username)
 	if err != nil {
 		if err == db.ErrRecordNotFound {
 			return fmt.Errorf("user [%s] does not exist: %w", payload.Username, asynq.SkipRetry)
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	db "github.com/Drolfothesgnir/simplebank/db/sqlc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	transferApprovalExpiryBatchSize       = 100
+	defaultTransferApprovalExpiryInterval = time.Minute
+)
+
+// TransferApprovalExpirer expires the pending transfer approvals that are past their deadline.
+// The initiators are notified through the outbox events stored along with the expiry.
+type TransferApprovalExpirer struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewTransferApprovalExpirer(store db.Store, interval time.Duration) *TransferApprovalExpirer {
+	if interval <= 0 {
+		interval = defaultTransferApprovalExpiryInterval
+	}
+
+	return &TransferApprovalExpirer{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run expires the overdue approvals every interval until ctx is done.
+func (expirer *TransferApprovalExpirer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(expirer.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := expirer.ExpireOverdue(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to expire transfer approvals")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireOverdue expires the overdue approvals batch by batch and returns the number of the expired ones.
+func (expirer *TransferApprovalExpirer) ExpireOverdue(ctx context.Context) (int, error) {
+	now := expirer.now()
+	expired := 0
+
+	for {
+		approvals, err := expirer.store.ExpireTransferApprovalsTx(ctx, db.ExpireTransferApprovalsTxParams{
+			Now:       now,
+			BatchSize: transferApprovalExpiryBatchSize,
+		})
+		if err != nil {
+			return expired, err
+		}
+
+		expired += len(approvals)
+
+		if len(approvals) < transferApprovalExpiryBatchSize {
+			return expired, nil
+		}
+	}
+}